@@ -3,12 +3,17 @@ package address
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-gonic/gin"
 )
@@ -20,19 +25,66 @@ const (
 	getKeyPath      = "/:address/key/:key"
 	getESDTTokens   = "/:address/esdt"
 	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getESDTNFTData  = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getProofPath    = "/:address/proof"
+	getKeyProofPath = "/:address/key/:key/proof"
+	getTransactions = "/:address/transactions"
+
+	urlParamFromNonce = "fromNonce"
+	urlParamToNonce   = "toNonce"
+	urlParamFromEpoch = "fromEpoch"
+	urlParamToEpoch   = "toEpoch"
+	urlParamCursor    = "cursor"
+	urlParamSize      = "size"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
-	GetESDTBalance(address string, key string) (string, string, error)
-	GetAllESDTTokens(address string) ([]string, error)
+	GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options core.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error)
+	GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetESDTBalance(address string, key string, options core.AccountQueryOptions) (string, string, error)
+	GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error)
+	GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetProof(address string, options core.AccountQueryOptions) (*AccountProof, error)
+	GetProofForKey(address string, key string, options core.AccountQueryOptions) (*AccountProof, error)
+	GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*AddressTransactions, error)
 	IsInterfaceNil() bool
 }
 
+// AccountProof represents the merkle proof of an account, and optionally of a key from its data trie.
+// All the byte fields are hex encoded. DataTrieValue is the value saved under the key, while DataTrieLeafValue is
+// the raw leaf the data trie proof commits to: the value followed by the key and the address
+type AccountProof struct {
+	Address           string   `json:"address"`
+	RootHash          string   `json:"rootHash"`
+	Proof             []string `json:"proof"`
+	Value             string   `json:"value"`
+	Key               string   `json:"key,omitempty"`
+	DataTrieRootHash  string   `json:"dataTrieRootHash,omitempty"`
+	DataTrieProof     []string `json:"dataTrieProof,omitempty"`
+	DataTrieValue     string   `json:"dataTrieValue,omitempty"`
+	DataTrieLeafValue string   `json:"dataTrieLeafValue,omitempty"`
+}
+
+// AddressTransaction represents an entry of the transactions history of an address. The hashes are hex encoded
+type AddressTransaction struct {
+	Hash       string `json:"hash"`
+	Type       string `json:"type"`
+	Epoch      uint32 `json:"epoch"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+	Round      uint64 `json:"round"`
+}
+
+// AddressTransactions holds a page of the transactions history of an address, from the newest to the oldest entry.
+// The next cursor should be provided for fetching the following page and is empty when there are no more entries
+type AddressTransactions struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	NextCursor   string                `json:"nextCursor,omitempty"`
+}
+
 type accountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
@@ -49,6 +101,19 @@ type esdtTokenData struct {
 	Properties      string `json:"properties"`
 }
 
+type esdtNFTTokenData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Properties      string   `json:"properties"`
+	Name            string   `json:"name,omitempty"`
+	Nonce           uint64   `json:"nonce,omitempty"`
+	Creator         string   `json:"creator,omitempty"`
+	Royalties       uint32   `json:"royalties,omitempty"`
+	Hash            []byte   `json:"hash,omitempty"`
+	URIs            [][]byte `json:"uris,omitempty"`
+	Attributes      []byte   `json:"attributes,omitempty"`
+}
+
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getAccountPath, GetAccount)
@@ -57,6 +122,10 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getESDTNFTData, GetESDTNFTData)
+	router.RegisterHandler(http.MethodGet, getProofPath, GetProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetProofForKey)
+	router.RegisterHandler(http.MethodGet, getTransactions, GetTransactions)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -89,6 +158,23 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	return facade, true
 }
 
+func getAccountQueryOptions(c *gin.Context, errScope error) (core.AccountQueryOptions, bool) {
+	options, err := shared.ParseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errScope.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return options, false
+	}
+
+	return options, true
+}
+
 // GetAccount returns an accountResponse containing information
 //  about the account correlated with provided address
 func GetAccount(c *gin.Context) {
@@ -98,7 +184,12 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrCouldNotGetAccount)
+	if !ok {
+		return
+	}
+
+	acc, err := facade.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -141,7 +232,12 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetBalance)
+	if !ok {
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -183,7 +279,12 @@ func GetUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := facade.GetUsername(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetUsername)
+	if !ok {
+		return
+	}
+
+	userName, err := facade.GetUsername(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -238,7 +339,12 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetValueForKey)
+	if !ok {
+		return
+	}
+
+	value, err := facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -294,7 +400,12 @@ func GetESDTBalance(c *gin.Context) {
 		return
 	}
 
-	balance, freeze, err := facade.GetESDTBalance(addr, tokenIdentifier)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTBalance)
+	if !ok {
+		return
+	}
+
+	balance, freeze, err := facade.GetESDTBalance(addr, tokenIdentifier, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -323,6 +434,96 @@ func GetESDTBalance(c *gin.Context) {
 	)
 }
 
+// GetESDTNFTData returns the data of the non fungible or semi fungible token with the given nonce, held by the
+// given address
+func GetESDTNFTData(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrNonceInvalid.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTNFTData)
+	if !ok {
+		return
+	}
+
+	esdtData, err := facade.GetESDTNFTTokenData(addr, tokenIdentifier, nonce, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	tokenData := esdtNFTTokenData{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         esdtData.Value.String(),
+		Properties:      hex.EncodeToString(esdtData.Properties),
+	}
+	if esdtData.TokenMetaData != nil {
+		tokenData.Name = string(esdtData.TokenMetaData.Name)
+		tokenData.Nonce = esdtData.TokenMetaData.Nonce
+		tokenData.Creator = hex.EncodeToString(esdtData.TokenMetaData.Creator)
+		tokenData.Royalties = esdtData.TokenMetaData.Royalties
+		tokenData.Hash = esdtData.TokenMetaData.Hash
+		tokenData.URIs = esdtData.TokenMetaData.URIs
+		tokenData.Attributes = esdtData.TokenMetaData.Attributes
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokenData": tokenData},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // GetESDTTokens returns the tokens list from this account
 func GetESDTTokens(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -343,7 +544,12 @@ func GetESDTTokens(c *gin.Context) {
 		return
 	}
 
-	tokens, err := facade.GetAllESDTTokens(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTTokens)
+	if !ok {
+		return
+	}
+
+	tokens, err := facade.GetAllESDTTokens(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -366,6 +572,115 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// GetProof returns the merkle proof of the given account against the current state root hash
+func GetProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, ok := getAccountQueryOptions(c, errors.ErrGetProof)
+	if !ok {
+		return
+	}
+
+	proof, err := facade.GetProof(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetProofForKey returns the merkle proofs of the given account and of the key from its data trie
+func GetProofForKey(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, ok := getAccountQueryOptions(c, errors.ErrGetProof)
+	if !ok {
+		return
+	}
+
+	proof, err := facade.GetProofForKey(addr, key, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -377,3 +692,114 @@ func accountResponseFromBaseAccount(address string, account state.UserAccountHan
 		RootHash: account.GetRootHash(),
 	}
 }
+
+// GetTransactions returns a page of the transactions, smart contract results and rewards that had the given address
+// as sender or receiver. The results can be filtered by block nonce and epoch ranges and are paged using a cursor
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	query, err := parseAddressTransactionsQuery(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, err := facade.GetAddressTransactions(addr, query)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions.Transactions, "nextCursor": transactions.NextCursor},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseAddressTransactionsQuery(c *gin.Context) (dblookupext.AddressTransactionsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := dblookupext.AddressTransactionsQuery{
+		Cursor: urlQuery.Get(urlParamCursor),
+	}
+
+	var err error
+	query.FromNonce, err = parseUintQueryParam(c, urlParamFromNonce, 0, 64)
+	if err != nil {
+		return query, err
+	}
+	query.ToNonce, err = parseUintQueryParam(c, urlParamToNonce, math.MaxUint64, 64)
+	if err != nil {
+		return query, err
+	}
+
+	fromEpoch, err := parseUintQueryParam(c, urlParamFromEpoch, 0, 32)
+	if err != nil {
+		return query, err
+	}
+	toEpoch, err := parseUintQueryParam(c, urlParamToEpoch, math.MaxUint32, 32)
+	if err != nil {
+		return query, err
+	}
+	query.FromEpoch = uint32(fromEpoch)
+	query.ToEpoch = uint32(toEpoch)
+
+	size, err := parseUintQueryParam(c, urlParamSize, 0, 32)
+	if err != nil {
+		return query, err
+	}
+	if size > dblookupext.MaxAddressTransactionsPageSize {
+		return query, fmt.Errorf("%w: %s must not exceed %d",
+			errors.ErrInvalidQueryParameter, urlParamSize, dblookupext.MaxAddressTransactionsPageSize)
+	}
+	query.Size = int(size)
+
+	return query, nil
+}
+
+func parseUintQueryParam(c *gin.Context, name string, defaultValue uint64, bitSize int) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if len(valueStr) == 0 {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
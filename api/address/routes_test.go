@@ -1,10 +1,12 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -18,6 +20,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -65,6 +70,27 @@ type esdtTokenResponse struct {
 	Code  string                `json:"code"`
 }
 
+type esdtNFTTokenData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Name            string   `json:"name"`
+	Nonce           uint64   `json:"nonce"`
+	Creator         string   `json:"creator"`
+	Royalties       uint32   `json:"royalties"`
+	URIs            [][]byte `json:"uris"`
+	Attributes      []byte   `json:"attributes"`
+}
+
+type esdtNFTTokenResponseData struct {
+	esdtNFTTokenData `json:"tokenData"`
+}
+
+type esdtNFTTokenResponse struct {
+	Data  esdtNFTTokenResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 type esdtTokensResponseData struct {
 	Tokens []string `json:"tokens"`
 }
@@ -128,7 +154,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -154,7 +180,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -176,7 +202,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -196,7 +222,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -251,7 +277,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ core.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -274,7 +300,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ core.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -311,7 +337,7 @@ func TestGetUsername_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ core.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -334,7 +360,7 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testUsername := "value"
 	facade := mock.Facade{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ core.AccountQueryOptions) (string, error) {
 			return testUsername, nil
 		},
 	}
@@ -383,7 +409,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ core.AccountQueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -404,7 +430,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ core.AccountQueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -491,7 +517,7 @@ func TestGetESDTBalance_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetESDTBalanceCalled: func(_ string, _ string) (string, string, error) {
+		GetESDTBalanceCalled: func(_ string, _ string, _ core.AccountQueryOptions) (string, string, error) {
 			return "", "", expectedErr
 		},
 	}
@@ -515,7 +541,7 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	testValue := "value"
 	testProperties := "frozen"
 	facade := mock.Facade{
-		GetESDTBalanceCalled: func(_ string, _ string) (string, string, error) {
+		GetESDTBalanceCalled: func(_ string, _ string, _ core.AccountQueryOptions) (string, string, error) {
 			return testValue, testProperties, nil
 		},
 	}
@@ -533,6 +559,83 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	assert.Equal(t, testProperties, esdtBalanceResponseObj.Data.Properties)
 }
 
+func TestGetESDTNFTData_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/address/myAddress/nft/newToken/nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNonceInvalid.Error()))
+}
+
+func TestGetESDTNFTData_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTNFTTokenDataCalled: func(_ string, _ string, _ uint64, _ core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/nft/newToken/nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTNFTData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testNonce := uint64(37)
+	facade := mock.Facade{
+		GetESDTNFTTokenDataCalled: func(_ string, _ string, nonce uint64, _ core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+			return &esdt.ESDigitalToken{
+				Value: big.NewInt(10),
+				TokenMetaData: &esdt.MetaData{
+					Nonce:      nonce,
+					Name:       []byte("name"),
+					Creator:    []byte("creator"),
+					Royalties:  100,
+					URIs:       [][]byte{[]byte("uri")},
+					Attributes: []byte("attributes"),
+				},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/nft/newToken/nonce/%d", testAddress, testNonce), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtNFTTokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "newToken", response.Data.TokenIdentifier)
+	assert.Equal(t, "10", response.Data.Balance)
+	assert.Equal(t, "name", response.Data.Name)
+	assert.Equal(t, testNonce, response.Data.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("creator")), response.Data.Creator)
+	assert.Equal(t, uint32(100), response.Data.Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri")}, response.Data.URIs)
+	assert.Equal(t, []byte("attributes"), response.Data.Attributes)
+}
+
 func TestGetESDTTokens_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -554,7 +657,7 @@ func TestGetESDTTokens_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetAllESDTTokensCalled: func(_ string) ([]string, error) {
+		GetAllESDTTokensCalled: func(_ string, _ core.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -578,7 +681,7 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	testValue1 := "token1"
 	testValue2 := "token2"
 	facade := mock.Facade{
-		GetAllESDTTokensCalled: func(address string) ([]string, error) {
+		GetAllESDTTokensCalled: func(address string, _ core.AccountQueryOptions) ([]string, error) {
 			return []string{testValue1, testValue2}, nil
 		},
 	}
@@ -595,6 +698,227 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+type proofResponseData struct {
+	Proof address.AccountProof `json:"proof"`
+}
+
+type proofResponse struct {
+	Data  proofResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+func TestGetProof_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofCalled: func(_ string, _ core.AccountQueryOptions) (*address.AccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(proofResponseObj.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(proofResponseObj.Error, apiErrors.ErrGetProof.Error()))
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedProof := address.AccountProof{
+		Address:  testAddress,
+		RootHash: "aabb",
+		Proof:    []string{"0102", "0304"},
+		Value:    "ccdd",
+	}
+	facade := mock.Facade{
+		GetProofCalled: func(addr string, _ core.AccountQueryOptions) (*address.AccountProof, error) {
+			assert.Equal(t, testAddress, addr)
+			proof := expectedProof
+			return &proof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, proofResponseObj.Data.Proof)
+}
+
+type addressTransactionsResponseData struct {
+	Transactions []*address.AddressTransaction `json:"transactions"`
+	NextCursor   string                        `json:"nextCursor"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(_ string, _ dblookupext.AddressTransactionsQuery) (*address.AddressTransactions, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAddressTransactions.Error()))
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(_ string, _ dblookupext.AddressTransactionsQuery) (*address.AddressTransactions, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	invalidQueries := []string{"fromNonce=a", "toNonce=-1", "fromEpoch=4294967296", "toEpoch=b", "size=101"}
+	for _, query := range invalidQueries {
+		req, _ := http.NewRequest("GET", "/address/addr/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := addressTransactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTransactions := []*address.AddressTransaction{
+		{Hash: "aa", Type: "TxBlock", Epoch: 2, BlockNonce: 30, BlockHash: "bb", Round: 31},
+	}
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(addr string, query dblookupext.AddressTransactionsQuery) (*address.AddressTransactions, error) {
+			assert.Equal(t, "addr", addr)
+			assert.Equal(t, dblookupext.AddressTransactionsQuery{
+				FromNonce: 10,
+				ToNonce:   math.MaxUint64,
+				FromEpoch: 0,
+				ToEpoch:   3,
+				Cursor:    "57",
+				Size:      5,
+			}, query)
+
+			return &address.AddressTransactions{
+				Transactions: expectedTransactions,
+				NextCursor:   "52",
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/transactions?fromNonce=10&toEpoch=3&cursor=57&size=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTransactions, response.Data.Transactions)
+	assert.Equal(t, "52", response.Data.NextCursor)
+}
+
+func TestGetProofForKey_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofForKeyCalled: func(_ string, _ string, _ core.AccountQueryOptions) (*address.AccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/aa/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(proofResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetProofForKey_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testKey := "aa"
+	expectedProof := address.AccountProof{
+		Address:           testAddress,
+		RootHash:          "aabb",
+		Proof:             []string{"0102"},
+		Value:             "ccdd",
+		Key:               testKey,
+		DataTrieRootHash:  "eeff",
+		DataTrieProof:     []string{"0506"},
+		DataTrieValue:     "0708",
+		DataTrieLeafValue: "0708aa",
+	}
+	facade := mock.Facade{
+		GetProofForKeyCalled: func(addr string, key string, _ core.AccountQueryOptions) (*address.AccountProof, error) {
+			assert.Equal(t, testAddress, addr)
+			assert.Equal(t, testKey, key)
+			proof := expectedProof
+			return &proof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/%s/proof", testAddress, testKey), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, proofResponseObj.Data.Proof)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,8 +930,83 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
 	}
 }
+
+func TestGetBalance_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(_ string, options core.AccountQueryOptions) (*big.Int, error) {
+			assert.Equal(t, core.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}, options)
+			return big.NewInt(10), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "10", getValueForKey(response.Data, "balance"))
+}
+
+func TestGetBalance_WithBlockHashShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(_ string, options core.AccountQueryOptions) (*big.Int, error) {
+			assert.Equal(t, core.AccountQueryOptions{BlockHash: []byte{0xaa, 0xbb}}, options)
+			return big.NewInt(10), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestGetBalance_WithInvalidBlockSelectionShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(_ string, _ core.AccountQueryOptions) (*big.Int, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	urls := []string{
+		"/address/addr/balance?blockNonce=abc",
+		"/address/addr/balance?blockHash=not-hex",
+		"/address/addr/balance?blockNonce=1&blockHash=aabb",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.NotEmpty(t, response.Error)
+	}
+}
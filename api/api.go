@@ -8,11 +8,13 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -148,6 +150,18 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	subscriptionRoutes := ws.Group("/subscription")
+	wrappedSubscriptionRouter, err := wrapper.NewRouterWrapper("subscription", subscriptionRoutes, routesConfig)
+	if err == nil {
+		subscription.Routes(wrappedSubscriptionRouter)
+	}
+
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -31,6 +31,21 @@ var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
+// ErrGetESDTNFTData signals an error in getting esdt nft data for given address, token and nonce
+var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
+
+// ErrNonceInvalid signals that nonce is invalid
+var ErrNonceInvalid = errors.New("nonce is invalid")
+
+// ErrGetProof signals an error in getting the merkle proof for an account or for a key of an account
+var ErrGetProof = errors.New("get proof error")
+
+// ErrGetAddressTransactions signals an error in getting the transactions history of an address
+var ErrGetAddressTransactions = errors.New("get address transactions error")
+
+// ErrGetEvents signals an error in getting the events emitted by an address
+var ErrGetEvents = errors.New("get events error")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -58,6 +73,12 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionsPool signals an error happening when trying to fetch the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
+// ErrGetGasPriceSuggestion signals an error happening when trying to compute the gas price suggestion
+var ErrGetGasPriceSuggestion = errors.New("getting gas price suggestion failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -67,5 +88,8 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrInvalidTransactionsBundle signals that an invalid bundle of transactions has been provided for simulation
+var ErrInvalidTransactionsBundle = errors.New("invalid transactions bundle")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
@@ -0,0 +1,189 @@
+package events
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getEventsPath = "/:address"
+
+	urlParamIdentifier = "identifier"
+	urlParamTopic      = "topic"
+	urlParamFromNonce  = "fromNonce"
+	urlParamToNonce    = "toNonce"
+	urlParamCursor     = "cursor"
+	urlParamSize       = "size"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetEvents(address string, query dblookupext.EventsQuery) (*Events, error)
+	IsInterfaceNil() bool
+}
+
+// Event represents a smart contract log event emitted by an address. The hashes, the topics and the data are hex encoded
+type Event struct {
+	TxHash     string   `json:"txHash"`
+	EventIndex uint32   `json:"eventIndex"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+	Epoch      uint32   `json:"epoch"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Round      uint64   `json:"round"`
+}
+
+// Events holds a page of the events emitted by an address, from the newest to the oldest one.
+// The next cursor should be provided for fetching the following page and is empty when there are no more events
+type Events struct {
+	Events     []*Event `json:"events"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+// Routes defines events related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getEventsPath, GetEvents)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetEvents returns a page of the smart contract events having the given identifier (and, optionally, first topic)
+// that were emitted by the given address. The results can be filtered by block nonce range and are paged using a cursor
+func GetEvents(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	query, err := parseEventsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()),
+		)
+		return
+	}
+
+	events, err := facade.GetEvents(addr, query)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"events": events.Events, "nextCursor": events.NextCursor},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func parseEventsQuery(c *gin.Context) (dblookupext.EventsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := dblookupext.EventsQuery{
+		Identifier: []byte(urlQuery.Get(urlParamIdentifier)),
+		Cursor:     urlQuery.Get(urlParamCursor),
+	}
+	if len(query.Identifier) == 0 {
+		return query, fmt.Errorf("%w: %s is mandatory", errors.ErrInvalidQueryParameter, urlParamIdentifier)
+	}
+
+	topic := urlQuery.Get(urlParamTopic)
+	if len(topic) > 0 {
+		topicBytes, err := hex.DecodeString(topic)
+		if err != nil {
+			return query, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, urlParamTopic)
+		}
+		query.Topic = topicBytes
+	}
+
+	var err error
+	query.FromNonce, err = parseUintQueryParam(c, urlParamFromNonce, 0, 64)
+	if err != nil {
+		return query, err
+	}
+	query.ToNonce, err = parseUintQueryParam(c, urlParamToNonce, math.MaxUint64, 64)
+	if err != nil {
+		return query, err
+	}
+
+	size, err := parseUintQueryParam(c, urlParamSize, 0, 32)
+	if err != nil {
+		return query, err
+	}
+	if size > dblookupext.MaxEventsPageSize {
+		return query, fmt.Errorf("%w: %s must not exceed %d",
+			errors.ErrInvalidQueryParameter, urlParamSize, dblookupext.MaxEventsPageSize)
+	}
+	query.Size = int(size)
+
+	return query, nil
+}
+
+func parseUintQueryParam(c *gin.Context, name string, defaultValue uint64, bitSize int) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if len(valueStr) == 0 {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,218 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventsResponseData struct {
+	Events     []*events.Event `json:"events"`
+	NextCursor string          `json:"nextCursor"`
+}
+
+type eventsResponse struct {
+	Data  eventsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetEvents_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/events/addr?identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetEvents_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/events/addr?identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetEvents_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetEventsCalled: func(_ string, _ dblookupext.EventsQuery) (*events.Events, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events/addr?identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+}
+
+func TestGetEvents_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetEventsCalled: func(_ string, _ dblookupext.EventsQuery) (*events.Events, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	invalidQueries := []string{
+		"",
+		"topic=aa",
+		"identifier=transfer&topic=zz",
+		"identifier=transfer&fromNonce=a",
+		"identifier=transfer&toNonce=-1",
+		"identifier=transfer&size=101",
+	}
+	for _, query := range invalidQueries {
+		req, _ := http.NewRequest("GET", "/events/addr?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetEvents_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := []*events.Event{
+		{
+			TxHash:     "aa",
+			EventIndex: 1,
+			Address:    "addr",
+			Identifier: "transfer",
+			Topics:     []string{"bb", "cc"},
+			Data:       "dd",
+			Epoch:      2,
+			BlockNonce: 30,
+			BlockHash:  "ee",
+			Round:      31,
+		},
+	}
+	facade := mock.Facade{
+		GetEventsCalled: func(addr string, query dblookupext.EventsQuery) (*events.Events, error) {
+			assert.Equal(t, "addr", addr)
+			assert.Equal(t, dblookupext.EventsQuery{
+				Identifier: []byte("transfer"),
+				Topic:      []byte{0xbb},
+				FromNonce:  10,
+				ToNonce:    math.MaxUint64,
+				Cursor:     "57",
+				Size:       5,
+			}, query)
+
+			return &events.Events{
+				Events:     expectedEvents,
+				NextCursor: "52",
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events/addr?identifier=transfer&topic=bb&fromNonce=10&cursor=57&size=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedEvents, response.Data.Events)
+	assert.Equal(t, "52", response.Data.NextCursor)
+}
+
+func startNodeServer(handler events.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("/events")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithFacade(handler))
+	}
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	eventsRoutes := ws.Group("/events")
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -66,7 +66,7 @@ func TestCreateEndpointThrottler_NoThrottlerShouldExecute(t *testing.T) {
 
 	numCalls := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
@@ -90,7 +90,7 @@ func TestCreateEndpointThrottler_ThrottlerCanNotProcessShouldNotExecute(t *testi
 
 	numCalls := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
@@ -123,7 +123,7 @@ func TestCreateEndpointThrottler_ThrottlerStartShouldExecute(t *testing.T) {
 	numStart := uint32(0)
 	numEnd := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -62,7 +63,7 @@ func TestGlobalThrottler_LimitUnderShouldProcessRequest(t *testing.T) {
 
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -83,7 +84,7 @@ func TestGlobalThrottler_LimitOverShouldError(t *testing.T) {
 	numCalls := uint32(0)
 	responseDelay := time.Second
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ core.AccountQueryOptions) (i *big.Int, e error) {
 			time.Sleep(responseDelay)
 			atomic.AddUint32(&numCalls, 1)
 
@@ -4,9 +4,14 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	apiEvents "github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -22,39 +27,56 @@ type Facade struct {
 	ShouldErrorStop            bool
 	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
+	BalanceHandler             func(address string, options core.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler              func(tx *transaction.Transaction) error
+	GetTransactionsPoolCalled               func() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*transaction.ApiPoolSender, error)
+	GetGasPriceSuggestionCalled             func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error)
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryHandler                   func(query *process.SCQuery, options core.AccountQueryOptions) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
+	GetDoubleSigningProofsCalled            func() [][]byte
+	GetValidatorParticipationCalled         func(blsKey string) (*state.ValidatorParticipationHistory, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options core.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
+	GetUsernameCalled                       func(address string, options core.AccountQueryOptions) (string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionsBundleHandler       func(txs []*transaction.Transaction, overrides map[string]*transaction.AccountOverride) ([]*transaction.SimulationResults, error)
+	ValidateTxFieldsForSimulationHandler    func(tx *transaction.Transaction) error
+	SetTransactionGuardianHandler           func(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error)
+	SetTransactionSignaturesHandler         func(tx *transaction.Transaction, signaturesHex []string) ([]byte, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
-	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
-	GetAllESDTTokensCalled                  func(address string) ([]string, error)
+	GetESDTBalanceCalled                    func(address string, key string, options core.AccountQueryOptions) (string, string, error)
+	GetAllESDTTokensCalled                  func(address string, options core.AccountQueryOptions) ([]string, error)
+	GetESDTNFTTokenDataCalled               func(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetProofCalled                          func(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error)
+	GetAddressTransactionsCalled            func(address string, query dblookupext.AddressTransactionsQuery) (*apiAddress.AddressTransactions, error)
+	GetEventsCalled                         func(address string, query dblookupext.EventsQuery) (*apiEvents.Events, error)
+	GetProofForKeyCalled                    func(address string, key string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*apiBlock.APIBlock, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	GetGovernanceProposalsHandler           func() ([]*vm.GovernanceProposalApi, error)
+	SubscribeToBlockEventsCalled            func(subscriber subscription.Subscriber) error
+	UnsubscribeFromBlockEventsCalled        func(subscriber subscription.Subscriber)
 }
 
 // GetUsername -
-func (f *Facade) GetUsername(address string) (string, error) {
+func (f *Facade) GetUsername(address string, options core.AccountQueryOptions) (string, error) {
 	if f.GetUsernameCalled != nil {
-		return f.GetUsernameCalled(address)
+		return f.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
@@ -98,40 +120,85 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetESDTBalance -
-func (f *Facade) GetESDTBalance(address string, key string) (string, string, error) {
+func (f *Facade) GetESDTBalance(address string, key string, options core.AccountQueryOptions) (string, string, error) {
 	if f.GetESDTBalanceCalled != nil {
-		return f.GetESDTBalanceCalled(address, key)
+		return f.GetESDTBalanceCalled(address, key, options)
 	}
 
 	return "", "", nil
 }
 
 // GetAllESDTTokens -
-func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
+func (f *Facade) GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error) {
 	if f.GetAllESDTTokensCalled != nil {
-		return f.GetAllESDTTokensCalled(address)
+		return f.GetAllESDTTokensCalled(address, options)
 	}
 
 	return []string{""}, nil
 }
 
+// GetESDTNFTTokenData -
+func (f *Facade) GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	if f.GetESDTNFTTokenDataCalled != nil {
+		return f.GetESDTNFTTokenDataCalled(address, tokenID, nonce, options)
+	}
+
+	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
+}
+
+// GetProof -
+func (f *Facade) GetProof(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	if f.GetProofCalled != nil {
+		return f.GetProofCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (f *Facade) GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*apiAddress.AddressTransactions, error) {
+	if f.GetAddressTransactionsCalled != nil {
+		return f.GetAddressTransactionsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (f *Facade) GetEvents(address string, query dblookupext.EventsQuery) (*apiEvents.Events, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetProofForKey -
+func (f *Facade) GetProofForKey(address string, key string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	if f.GetProofForKeyCalled != nil {
+		return f.GetProofForKeyCalled(address, key, options)
+	}
+
+	return nil, nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -151,6 +218,33 @@ func (f *Facade) CreateTransaction(
 	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
+// GetTransactionsPool -
+func (f *Facade) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	if f.GetTransactionsPoolCalled != nil {
+		return f.GetTransactionsPoolCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (f *Facade) GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestion -
+func (f *Facade) GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+	if f.GetGasPriceSuggestionCalled != nil {
+		return f.GetGasPriceSuggestionCalled(numBlocks)
+	}
+
+	return nil, nil
+}
+
 // GetTransaction is the mock implementation of a handler's GetTransaction method
 func (f *Facade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return f.GetTransactionHandler(hash, withResults)
@@ -161,12 +255,20 @@ func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*tra
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// SimulateTransactionsBundle -
+func (f *Facade) SimulateTransactionsBundle(
+	txs []*transaction.Transaction,
+	overrides map[string]*transaction.AccountOverride,
+) ([]*transaction.SimulationResults, error) {
+	return f.SimulateTransactionsBundleHandler(txs, overrides)
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
 }
 
-//ValidateTransaction --
+// ValidateTransaction --
 func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
 }
@@ -176,14 +278,59 @@ func (f *Facade) ValidateTransactionForSimulation(tx *transaction.Transaction) e
 	return f.ValidateTransactionForSimulationHandler(tx)
 }
 
+// SetTransactionGuardian -
+func (f *Facade) SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error) {
+	if f.SetTransactionGuardianHandler != nil {
+		return f.SetTransactionGuardianHandler(tx, guardian, guardianSignatureHex)
+	}
+
+	return nil, nil
+}
+
+// SetTransactionSignatures -
+func (f *Facade) SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error) {
+	if f.SetTransactionSignaturesHandler != nil {
+		return f.SetTransactionSignaturesHandler(tx, signaturesHex)
+	}
+
+	return nil, nil
+}
+
+// ValidateTransactionFieldsForSimulation -
+func (f *Facade) ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error {
+	if f.ValidateTxFieldsForSimulationHandler != nil {
+		return f.ValidateTxFieldsForSimulationHandler(tx)
+	}
+
+	return nil
+}
+
 // ValidatorStatisticsApi is the mock implementation of a handler's ValidatorStatisticsApi method
 func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetDoubleSigningProofs -
+func (f *Facade) GetDoubleSigningProofs() [][]byte {
+	if f.GetDoubleSigningProofsCalled != nil {
+		return f.GetDoubleSigningProofsCalled()
+	}
+
+	return make([][]byte, 0)
+}
+
+// GetValidatorParticipation -
+func (f *Facade) GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error) {
+	if f.GetValidatorParticipationCalled != nil {
+		return f.GetValidatorParticipationCalled(blsKey)
+	}
+
+	return &state.ValidatorParticipationHistory{}, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
-func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
-	return f.ExecuteSCQueryHandler(query)
+func (f *Facade) ExecuteSCQuery(query *process.SCQuery, options core.AccountQueryOptions) (*vm.VMOutputApi, error) {
+	return f.ExecuteSCQueryHandler(query, options)
 }
 
 // StatusMetrics is the mock implementation for the StatusMetrics
@@ -196,6 +343,11 @@ func (f *Facade) GetTotalStakedValue() (*big.Int, error) {
 	return f.GetTotalStakedValueHandler()
 }
 
+// GetGovernanceProposals -
+func (f *Facade) GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error) {
+	return f.GetGovernanceProposalsHandler()
+}
+
 // ComputeTransactionGasLimit --
 func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -254,6 +406,22 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock,
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// SubscribeToBlockEvents -
+func (f *Facade) SubscribeToBlockEvents(subscriber subscription.Subscriber) error {
+	if f.SubscribeToBlockEventsCalled != nil {
+		return f.SubscribeToBlockEventsCalled(subscriber)
+	}
+
+	return nil
+}
+
+// UnsubscribeFromBlockEvents -
+func (f *Facade) UnsubscribeFromBlockEvents(subscriber subscription.Subscriber) {
+	if f.UnsubscribeFromBlockEventsCalled != nil {
+		f.UnsubscribeFromBlockEventsCalled(subscriber)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
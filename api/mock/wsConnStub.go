@@ -13,25 +13,28 @@ type WsConnStub struct {
 // Close -
 func (wcs *WsConnStub) Close() error {
 	wcs.mutHandlers.Lock()
-	defer wcs.mutHandlers.Unlock()
+	handler := wcs.closeCalled
+	wcs.mutHandlers.Unlock()
 
-	return wcs.closeCalled()
+	return handler()
 }
 
 // ReadMessage -
 func (wcs *WsConnStub) ReadMessage() (messageType int, p []byte, err error) {
 	wcs.mutHandlers.Lock()
-	defer wcs.mutHandlers.Unlock()
+	handler := wcs.readMessageCalled
+	wcs.mutHandlers.Unlock()
 
-	return wcs.readMessageCalled()
+	return handler()
 }
 
 // WriteMessage -
 func (wcs *WsConnStub) WriteMessage(messageType int, data []byte) error {
 	wcs.mutHandlers.Lock()
-	defer wcs.mutHandlers.Unlock()
+	handler := wcs.writeMessageCalled
+	wcs.mutHandlers.Unlock()
 
-	return wcs.writeMessageCalled(messageType, data)
+	return handler(messageType, data)
 }
 
 // SetReadMessageHandler -
@@ -1,12 +1,16 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
@@ -16,11 +20,23 @@ const (
 	getStatusPath   = "/status"
 	economicsPath   = "/economics"
 	totalStakedPath = "/total-staked"
+	governancePath  = "/governance"
+	gasPricePath    = "/gas-price"
+
+	urlParamBlocks = "blocks"
 )
 
+// DefaultGasPriceBlocks is the number of recent blocks analysed by the gas price oracle when not specified otherwise
+const DefaultGasPriceBlocks = 20
+
+// MaxGasPriceBlocks is the maximum number of recent blocks that can be analysed by the gas price oracle
+const MaxGasPriceBlocks = 100
+
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
+	GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error)
+	GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
@@ -31,6 +47,8 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
 	router.RegisterHandler(http.MethodGet, totalStakedPath, GetTotalStaked)
+	router.RegisterHandler(http.MethodGet, governancePath, GetGovernanceProposals)
+	router.RegisterHandler(http.MethodGet, gasPricePath, GetGasPriceSuggestion)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -152,3 +170,92 @@ func GetTotalStaked(c *gin.Context) {
 	)
 	return
 }
+
+// GetGovernanceProposals is the endpoint that will return the governance proposals along with their tallies and status
+func GetGovernanceProposals(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	proposals, err := facade.GetGovernanceProposals()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposals": proposals},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetGasPriceSuggestion is the endpoint that will return the gas prices suggested from the analysis of the recent blocks
+// of the current shard. The optional ?blocks URL parameter sets the number of analysed blocks
+func GetGasPriceSuggestion(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	numBlocks, err := parseNumBlocks(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestion.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	suggestion, err := facade.GetGasPriceSuggestion(numBlocks)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestion.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"gasPrice": suggestion},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseNumBlocks(c *gin.Context) (uint32, error) {
+	numBlocksStr := c.Request.URL.Query().Get(urlParamBlocks)
+	if len(numBlocksStr) == 0 {
+		return DefaultGasPriceBlocks, nil
+	}
+
+	numBlocks, err := strconv.ParseUint(numBlocksStr, 10, 32)
+	if err != nil || numBlocks == 0 || numBlocks > MaxGasPriceBlocks {
+		return 0, fmt.Errorf("%w: %s must be between 1 and %d",
+			errors.ErrInvalidQueryParameter, urlParamBlocks, MaxGasPriceBlocks)
+	}
+
+	return uint32(numBlocks), nil
+}
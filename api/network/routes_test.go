@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"encoding/json"
+	errs "errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -19,6 +20,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
@@ -210,6 +213,130 @@ func TestTotalStaked_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestGetGovernanceProposals_ShouldWork(t *testing.T) {
+	proposals := []*vm.GovernanceProposalApi{
+		{
+			Reference:  "aabb",
+			Yes:        10,
+			Status:     "passed",
+			ActionType: "changeEconomics",
+		},
+	}
+	facade := &mock.Facade{}
+	facade.GetGovernanceProposalsHandler = func() ([]*vm.GovernanceProposalApi, error) {
+		return proposals, nil
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/governance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceProposalsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.Equal(t, proposals, response.Data.Proposals)
+}
+
+func TestGetGovernanceProposals_FacadeErrorShouldErr(t *testing.T) {
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{}
+	facade.GetGovernanceProposalsHandler = func() ([]*vm.GovernanceProposalApi, error) {
+		return nil, expectedErr
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/governance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestGetGasPriceSuggestion_ShouldWork(t *testing.T) {
+	suggestion := &transaction.ApiGasPriceSuggestion{
+		MinimumGasPrice:  1000000000,
+		MedianGasPrice:   1200000000,
+		FastGasPrice:     1500000000,
+		NumBlocks:        7,
+		AverageBlockFill: 0.5,
+	}
+	numBlocksProvided := make([]uint32, 0)
+	facade := &mock.Facade{}
+	facade.GetGasPriceSuggestionCalled = func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+		numBlocksProvided = append(numBlocksProvided, numBlocks)
+		return suggestion, nil
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := gasPriceResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.Equal(t, suggestion, response.Data.GasPrice)
+
+	req, _ = http.NewRequest(http.MethodGet, "/network/gas-price?blocks=7", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []uint32{network.DefaultGasPriceBlocks, 7}, numBlocksProvided)
+}
+
+func TestGetGasPriceSuggestion_InvalidNumBlocksShouldErr(t *testing.T) {
+	facade := &mock.Facade{}
+	facade.GetGasPriceSuggestionCalled = func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+		assert.Fail(t, "should have not been called")
+		return nil, nil
+	}
+
+	ws := startNodeServer(facade)
+	invalidValues := []string{"0", "-1", "abc", fmt.Sprintf("%d", network.MaxGasPriceBlocks+1)}
+	for _, value := range invalidValues {
+		req, _ := http.NewRequest(http.MethodGet, "/network/gas-price?blocks="+value, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, errors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestGetGasPriceSuggestion_FacadeErrorShouldErr(t *testing.T) {
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{}
+	facade.GetGasPriceSuggestionCalled = func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+		return nil, expectedErr
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/gas-price", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetGasPriceSuggestion.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -246,6 +373,26 @@ func startNodeServerWrongFacade() *gin.Engine {
 	return ws
 }
 
+type governanceProposalsResponseData struct {
+	Proposals []*vm.GovernanceProposalApi `json:"proposals"`
+}
+
+type governanceProposalsResponse struct {
+	Data  governanceProposalsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  shared.ReturnCode               `json:"code"`
+}
+
+type gasPriceResponseData struct {
+	GasPrice *transaction.ApiGasPriceSuggestion `json:"gasPrice"`
+}
+
+type gasPriceResponse struct {
+	Data  gasPriceResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  shared.ReturnCode    `json:"code"`
+}
+
 type GeneralResponse struct {
 	Message string `json:"message"`
 	Error   string `json:"error"`
@@ -260,6 +407,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/total-staked", Open: true},
+					{Name: "/governance", Open: true},
+					{Name: "/gas-price", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/gin-gonic/gin"
+)
+
+// UrlParameterBlockNonce is the name of the optional URL parameter which selects a block by its nonce
+const UrlParameterBlockNonce = "blockNonce"
+
+// UrlParameterBlockHash is the name of the optional URL parameter which selects a block by its hex encoded hash
+const UrlParameterBlockHash = "blockHash"
+
+// ParseAccountQueryOptions extracts the optional block selection parameters from the request's URL
+func ParseAccountQueryOptions(c *gin.Context) (core.AccountQueryOptions, error) {
+	options := core.AccountQueryOptions{}
+	query := c.Request.URL.Query()
+
+	blockNonceStr := query.Get(UrlParameterBlockNonce)
+	blockHashStr := query.Get(UrlParameterBlockHash)
+	if len(blockNonceStr) > 0 && len(blockHashStr) > 0 {
+		return options, fmt.Errorf("%w: only one of %s and %s can be provided",
+			errors.ErrInvalidQueryParameter, UrlParameterBlockNonce, UrlParameterBlockHash)
+	}
+
+	if len(blockNonceStr) > 0 {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidBlockNonce, err.Error())
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	if len(blockHashStr) > 0 {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s: %s", errors.ErrInvalidQueryParameter, UrlParameterBlockHash, err.Error())
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	return options, nil
+}
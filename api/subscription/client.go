@@ -0,0 +1,204 @@
+package subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	notificationsQueueSize    = 100
+	responsesQueueSize        = 10
+	maxSubscriptionsPerClient = 10
+)
+
+// wsClient handles a web socket connection: it reads the subscription requests sent by the client and writes
+// back the responses and the notifications matching the client's subscriptions
+type wsClient struct {
+	conn wsConn
+
+	mutSubscriptions sync.RWMutex
+	subscriptions    map[string]*subscription
+
+	notifications chan *Notification
+	responses     chan *Response
+	numDropped    uint64
+	closeChan     chan struct{}
+	closeOnce     sync.Once
+}
+
+func newWsClient(conn wsConn) (*wsClient, error) {
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+
+	return &wsClient{
+		conn:          conn,
+		subscriptions: make(map[string]*subscription),
+		notifications: make(chan *Notification, notificationsQueueSize),
+		responses:     make(chan *Response, responsesQueueSize),
+		closeChan:     make(chan struct{}),
+	}, nil
+}
+
+// Notify queues the notifications matching the client's subscriptions. It never blocks: if the client is too slow
+// and its queue is full, the notifications are dropped and the client is informed about it with the next one
+func (wc *wsClient) Notify(blockEvents *BlockEvents) {
+	if blockEvents == nil || blockEvents.Block == nil {
+		return
+	}
+
+	notifications := make([]*Notification, 0)
+	wc.mutSubscriptions.RLock()
+	for _, s := range wc.subscriptions {
+		notifications = append(notifications, s.matchingNotifications(blockEvents)...)
+	}
+	wc.mutSubscriptions.RUnlock()
+
+	for _, notification := range notifications {
+		select {
+		case wc.notifications <- notification:
+		default:
+			atomic.AddUint64(&wc.numDropped, 1)
+		}
+	}
+}
+
+// StartBlocking starts handling the client's requests and sending the notifications. It returns when the
+// connection is closed
+func (wc *wsClient) StartBlocking() {
+	defer func() {
+		_ = wc.conn.Close()
+	}()
+
+	go wc.readContinuously()
+	wc.writeContinuously()
+}
+
+func (wc *wsClient) readContinuously() {
+	defer wc.close()
+
+	for {
+		_, message, err := wc.conn.ReadMessage()
+		if err != nil {
+			log.Debug("subscription web socket read", "error", err.Error())
+			return
+		}
+
+		response := wc.handleMessage(message)
+		select {
+		case wc.responses <- response:
+		case <-wc.closeChan:
+			return
+		}
+	}
+}
+
+func (wc *wsClient) writeContinuously() {
+	defer wc.close()
+
+	for {
+		var message interface{}
+		select {
+		case <-wc.closeChan:
+			return
+		case response := <-wc.responses:
+			message = response
+		case notification := <-wc.notifications:
+			notification.NumDropped = atomic.SwapUint64(&wc.numDropped, 0)
+			message = notification
+		}
+
+		err := wc.writeMessage(message)
+		if err != nil {
+			log.Debug("subscription web socket write", "error", err.Error())
+			return
+		}
+	}
+}
+
+func (wc *wsClient) writeMessage(message interface{}) error {
+	buff, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return wc.conn.WriteMessage(websocket.TextMessage, buff)
+}
+
+func (wc *wsClient) handleMessage(message []byte) *Response {
+	request := &Request{}
+	err := json.Unmarshal(message, request)
+	if err != nil {
+		return &Response{Error: fmt.Sprintf("invalid request: %s", err.Error())}
+	}
+
+	response := &Response{
+		ID:     request.ID,
+		Action: request.Action,
+	}
+
+	switch request.Action {
+	case ActionSubscribe:
+		err = wc.subscribe(request)
+	case ActionUnsubscribe:
+		err = wc.unsubscribe(request.ID)
+	default:
+		err = fmt.Errorf("%w: %s", ErrInvalidAction, request.Action)
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response
+}
+
+func (wc *wsClient) subscribe(request *Request) error {
+	s, err := newSubscription(request)
+	if err != nil {
+		return err
+	}
+
+	wc.mutSubscriptions.Lock()
+	defer wc.mutSubscriptions.Unlock()
+
+	_, exists := wc.subscriptions[s.id]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrSubscriptionAlreadyExists, s.id)
+	}
+	if len(wc.subscriptions) >= maxSubscriptionsPerClient {
+		return fmt.Errorf("%w: maximum %d", ErrTooManySubscriptions, maxSubscriptionsPerClient)
+	}
+
+	wc.subscriptions[s.id] = s
+
+	return nil
+}
+
+func (wc *wsClient) unsubscribe(id string) error {
+	wc.mutSubscriptions.Lock()
+	defer wc.mutSubscriptions.Unlock()
+
+	_, exists := wc.subscriptions[id]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrSubscriptionNotFound, id)
+	}
+
+	delete(wc.subscriptions, id)
+
+	return nil
+}
+
+func (wc *wsClient) close() {
+	wc.closeOnce.Do(func() {
+		close(wc.closeChan)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wc *wsClient) IsInterfaceNil() bool {
+	return wc == nil
+}
@@ -0,0 +1,302 @@
+package subscription_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlockEvents() *subscription.BlockEvents {
+	return &subscription.BlockEvents{
+		Block: &block.APIBlock{
+			Nonce: 37,
+			Hash:  "aabb",
+			MiniBlocks: []*block.APIMiniBlock{
+				{
+					Hash: "mb",
+					Transactions: []*transaction.ApiTransactionResult{
+						{Hash: "tx1", Sender: "alice", Receiver: "bob"},
+						{Hash: "tx2", Sender: "carol", Receiver: "dave"},
+						{Hash: "tx3", Sender: "bob", Receiver: "carol"},
+					},
+				},
+			},
+		},
+		Events: []*subscription.Event{
+			{TxHash: "tx1", Address: "sc1", Identifier: "transfer", Topics: []string{"aa", "bb"}},
+			{TxHash: "tx2", Address: "sc2", Identifier: "transfer", Topics: []string{"cc"}},
+			{TxHash: "tx3", Address: "sc1", Identifier: "mint", Topics: []string{"bb"}},
+		},
+	}
+}
+
+func createClientWithSubscription(t *testing.T, request *subscription.Request) subscriptionClient {
+	client, _ := subscription.NewWsClient(&mock.WsConnStub{})
+	request.Action = subscription.ActionSubscribe
+	buff, _ := json.Marshal(request)
+
+	response := client.HandleMessage(buff)
+	require.Empty(t, response.Error)
+
+	return client
+}
+
+type subscriptionClient interface {
+	Notify(blockEvents *subscription.BlockEvents)
+	NumQueuedNotifications() int
+	PopNotification() *subscription.Notification
+}
+
+func popAllNotifications(client subscriptionClient) []*subscription.Notification {
+	notifications := make([]*subscription.Notification, 0)
+	for client.NumQueuedNotifications() > 0 {
+		notifications = append(notifications, client.PopNotification())
+	}
+
+	return notifications
+}
+
+//------- NewWsClient
+
+func TestNewWsClient_NilConnShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, err := subscription.NewWsClient(nil)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, subscription.ErrNilWsConn, err)
+}
+
+func TestNewWsClient_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	client, err := subscription.NewWsClient(&mock.WsConnStub{})
+
+	assert.False(t, check.IfNil(client))
+	assert.Nil(t, err)
+}
+
+//------- handle requests
+
+func TestWsClient_HandleMessageInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	client, _ := subscription.NewWsClient(&mock.WsConnStub{})
+
+	response := client.HandleMessage([]byte("not a json"))
+	assert.True(t, strings.Contains(response.Error, "invalid request"))
+
+	response = client.HandleMessage([]byte(`{"action":"dance","id":"1"}`))
+	assert.True(t, strings.Contains(response.Error, subscription.ErrInvalidAction.Error()))
+
+	response = client.HandleMessage([]byte(`{"action":"subscribe","topic":"blocks"}`))
+	assert.Equal(t, subscription.ErrEmptySubscriptionID.Error(), response.Error)
+
+	response = client.HandleMessage([]byte(`{"action":"subscribe","id":"1","topic":"accounts"}`))
+	assert.True(t, strings.Contains(response.Error, subscription.ErrInvalidTopic.Error()))
+
+	response = client.HandleMessage([]byte(`{"action":"subscribe","id":"1","topic":"transactions"}`))
+	assert.Equal(t, subscription.ErrMissingAddresses.Error(), response.Error)
+
+	response = client.HandleMessage([]byte(`{"action":"subscribe","id":"1","topic":"events","eventTopics":["not hex"]}`))
+	assert.True(t, strings.Contains(response.Error, "invalid event topic"))
+
+	response = client.HandleMessage([]byte(`{"action":"unsubscribe","id":"1"}`))
+	assert.True(t, strings.Contains(response.Error, subscription.ErrSubscriptionNotFound.Error()))
+
+	assert.Equal(t, 0, client.NumSubscriptions())
+}
+
+func TestWsClient_HandleMessageTooManyFilterValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, _ := subscription.NewWsClient(&mock.WsConnStub{})
+	request := &subscription.Request{
+		Action:    subscription.ActionSubscribe,
+		ID:        "1",
+		Topic:     subscription.TopicTransactions,
+		Addresses: make([]string, 101),
+	}
+	buff, _ := json.Marshal(request)
+
+	response := client.HandleMessage(buff)
+
+	assert.True(t, strings.Contains(response.Error, subscription.ErrTooManyFilterValues.Error()))
+}
+
+func TestWsClient_HandleMessageSubscribeAndUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	client, _ := subscription.NewWsClient(&mock.WsConnStub{})
+
+	response := client.HandleMessage([]byte(`{"action":"subscribe","id":"1","topic":"blocks"}`))
+	assert.Equal(t, &subscription.Response{ID: "1", Action: subscription.ActionSubscribe}, response)
+	assert.Equal(t, 1, client.NumSubscriptions())
+
+	response = client.HandleMessage([]byte(`{"action":"subscribe","id":"1","topic":"blocks"}`))
+	assert.True(t, strings.Contains(response.Error, subscription.ErrSubscriptionAlreadyExists.Error()))
+
+	response = client.HandleMessage([]byte(`{"action":"unsubscribe","id":"1"}`))
+	assert.Equal(t, &subscription.Response{ID: "1", Action: subscription.ActionUnsubscribe}, response)
+	assert.Equal(t, 0, client.NumSubscriptions())
+}
+
+func TestWsClient_HandleMessageTooManySubscriptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, _ := subscription.NewWsClient(&mock.WsConnStub{})
+	for i := 0; i < subscription.MaxSubscriptionsPerClient; i++ {
+		request := &subscription.Request{Action: subscription.ActionSubscribe, ID: string(rune('a' + i)), Topic: subscription.TopicBlocks}
+		buff, _ := json.Marshal(request)
+		response := client.HandleMessage(buff)
+		require.Empty(t, response.Error)
+	}
+
+	response := client.HandleMessage([]byte(`{"action":"subscribe","id":"extra","topic":"blocks"}`))
+
+	assert.True(t, strings.Contains(response.Error, subscription.ErrTooManySubscriptions.Error()))
+}
+
+//------- Notify
+
+func TestWsClient_NotifyBlocksShouldSendBlockWithoutTransactions(t *testing.T) {
+	t.Parallel()
+
+	client := createClientWithSubscription(t, &subscription.Request{ID: "1", Topic: subscription.TopicBlocks})
+	blockEvents := createBlockEvents()
+
+	client.Notify(blockEvents)
+
+	notifications := popAllNotifications(client)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, "1", notifications[0].SubscriptionID)
+	assert.Equal(t, uint64(37), notifications[0].BlockNonce)
+	assert.Equal(t, "aabb", notifications[0].BlockHash)
+	assert.Equal(t, "mb", notifications[0].Block.MiniBlocks[0].Hash)
+	assert.Nil(t, notifications[0].Block.MiniBlocks[0].Transactions)
+	assert.Equal(t, 3, len(blockEvents.Block.MiniBlocks[0].Transactions))
+}
+
+func TestWsClient_NotifyTransactionsShouldFilterBySenderOrReceiver(t *testing.T) {
+	t.Parallel()
+
+	client := createClientWithSubscription(t, &subscription.Request{
+		ID:        "1",
+		Topic:     subscription.TopicTransactions,
+		Addresses: []string{"bob"},
+	})
+
+	client.Notify(createBlockEvents())
+
+	notifications := popAllNotifications(client)
+	require.Equal(t, 2, len(notifications))
+	assert.Equal(t, "tx1", notifications[0].Transaction.Hash)
+	assert.Equal(t, "tx3", notifications[1].Transaction.Hash)
+}
+
+func TestWsClient_NotifyEventsShouldApplyAllFilters(t *testing.T) {
+	t.Parallel()
+
+	client := createClientWithSubscription(t, &subscription.Request{ID: "all", Topic: subscription.TopicEvents})
+	client.Notify(createBlockEvents())
+	assert.Equal(t, 3, len(popAllNotifications(client)))
+
+	client = createClientWithSubscription(t, &subscription.Request{
+		ID:          "filtered",
+		Topic:       subscription.TopicEvents,
+		Addresses:   []string{"sc1"},
+		EventTopics: []string{"bb"},
+		Identifiers: []string{"transfer"},
+	})
+	client.Notify(createBlockEvents())
+
+	notifications := popAllNotifications(client)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, "tx1", notifications[0].Event.TxHash)
+}
+
+func TestWsClient_NotifyFullQueueShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	client := createClientWithSubscription(t, &subscription.Request{ID: "1", Topic: subscription.TopicBlocks})
+
+	chDone := make(chan struct{})
+	go func() {
+		for i := 0; i < subscription.NotificationsQueueSize+10; i++ {
+			client.Notify(createBlockEvents())
+		}
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "notify should not have blocked")
+	}
+	assert.Equal(t, subscription.NotificationsQueueSize, client.NumQueuedNotifications())
+}
+
+//------- StartBlocking
+
+func TestWsClient_StartBlockingShouldRespondAndSendNotificationsUntilReadFails(t *testing.T) {
+	t.Parallel()
+
+	chRead := make(chan []byte)
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		message, ok := <-chRead
+		if !ok {
+			return 0, nil, errors.New("closed")
+		}
+
+		return websocket.TextMessage, message, nil
+	})
+	mutWritten := sync.Mutex{}
+	written := make([]string, 0)
+	conn.SetWriteMessageHandler(func(messageType int, data []byte) error {
+		mutWritten.Lock()
+		written = append(written, string(data))
+		mutWritten.Unlock()
+
+		return nil
+	})
+	closeCalled := make(chan struct{})
+	conn.SetCloseHandler(func() error {
+		close(closeCalled)
+		return nil
+	})
+	client, _ := subscription.NewWsClient(conn)
+
+	go client.StartBlocking()
+
+	chRead <- []byte(`{"action":"subscribe","id":"1","topic":"blocks"}`)
+	time.Sleep(time.Millisecond * 100)
+	client.Notify(createBlockEvents())
+	time.Sleep(time.Millisecond * 100)
+	close(chRead)
+
+	select {
+	case <-closeCalled:
+	case <-time.After(time.Second):
+		assert.Fail(t, "connection should have been closed")
+	}
+
+	mutWritten.Lock()
+	defer mutWritten.Unlock()
+	require.Equal(t, 2, len(written))
+	assert.Equal(t, `{"id":"1","action":"subscribe"}`, written[0])
+	assert.True(t, strings.Contains(written[1], `"subscriptionId":"1"`))
+	assert.True(t, strings.Contains(written[1], `"blockNonce":37`))
+}
@@ -0,0 +1,33 @@
+package subscription
+
+import "errors"
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidAction signals that an unknown request action has been provided
+var ErrInvalidAction = errors.New("invalid action")
+
+// ErrInvalidTopic signals that an unknown subscription topic has been provided
+var ErrInvalidTopic = errors.New("invalid topic")
+
+// ErrEmptySubscriptionID signals that an empty subscription identifier has been provided
+var ErrEmptySubscriptionID = errors.New("empty subscription id")
+
+// ErrSubscriptionAlreadyExists signals that a subscription with the same identifier already exists
+var ErrSubscriptionAlreadyExists = errors.New("subscription already exists")
+
+// ErrSubscriptionNotFound signals that the requested subscription does not exist
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// ErrTooManySubscriptions signals that the client has reached the maximum number of subscriptions
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+// ErrTooManyFilterValues signals that a subscription filter contains too many values
+var ErrTooManyFilterValues = errors.New("too many filter values")
+
+// ErrMissingAddresses signals that a transactions subscription has been requested without any address
+var ErrMissingAddresses = errors.New("at least one address should be provided")
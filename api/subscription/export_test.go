@@ -0,0 +1,31 @@
+package subscription
+
+const NotificationsQueueSize = notificationsQueueSize
+const MaxSubscriptionsPerClient = maxSubscriptionsPerClient
+
+type WsConn interface {
+	wsConn
+}
+
+func NewWsClient(conn WsConn) (*wsClient, error) {
+	return newWsClient(conn)
+}
+
+func (wc *wsClient) HandleMessage(message []byte) *Response {
+	return wc.handleMessage(message)
+}
+
+func (wc *wsClient) NumSubscriptions() int {
+	wc.mutSubscriptions.RLock()
+	defer wc.mutSubscriptions.RUnlock()
+
+	return len(wc.subscriptions)
+}
+
+func (wc *wsClient) NumQueuedNotifications() int {
+	return len(wc.notifications)
+}
+
+func (wc *wsClient) PopNotification() *Notification {
+	return <-wc.notifications
+}
@@ -0,0 +1,186 @@
+package subscription
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+const maxFilterValues = 100
+
+type subscription struct {
+	id          string
+	topic       string
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+	eventTopics map[string]struct{}
+}
+
+func newSubscription(request *Request) (*subscription, error) {
+	if len(request.ID) == 0 {
+		return nil, ErrEmptySubscriptionID
+	}
+
+	switch request.Topic {
+	case TopicBlocks:
+	case TopicTransactions:
+		if len(request.Addresses) == 0 {
+			return nil, ErrMissingAddresses
+		}
+	case TopicEvents:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTopic, request.Topic)
+	}
+
+	eventTopics := make([]string, 0, len(request.EventTopics))
+	for _, eventTopic := range request.EventTopics {
+		_, err := hex.DecodeString(eventTopic)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event topic %s: %w", eventTopic, err)
+		}
+
+		eventTopics = append(eventTopics, eventTopic)
+	}
+
+	addresses, err := createFilterSet(request.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	identifiers, err := createFilterSet(request.Identifiers)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := createFilterSet(eventTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription{
+		id:          request.ID,
+		topic:       request.Topic,
+		addresses:   addresses,
+		identifiers: identifiers,
+		eventTopics: topics,
+	}, nil
+}
+
+func createFilterSet(values []string) (map[string]struct{}, error) {
+	if len(values) > maxFilterValues {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", ErrTooManyFilterValues, len(values), maxFilterValues)
+	}
+
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// matchingNotifications returns the notifications that should be sent for the provided block events
+func (s *subscription) matchingNotifications(blockEvents *BlockEvents) []*Notification {
+	switch s.topic {
+	case TopicBlocks:
+		notification := s.newNotification(blockEvents.Block)
+		notification.Block = blockWithoutTransactions(blockEvents.Block)
+		return []*Notification{notification}
+	case TopicTransactions:
+		return s.matchingTransactions(blockEvents.Block)
+	case TopicEvents:
+		return s.matchingEvents(blockEvents)
+	default:
+		return nil
+	}
+}
+
+func (s *subscription) newNotification(apiBlock *block.APIBlock) *Notification {
+	return &Notification{
+		SubscriptionID: s.id,
+		Topic:          s.topic,
+		BlockNonce:     apiBlock.Nonce,
+		BlockHash:      apiBlock.Hash,
+	}
+}
+
+func (s *subscription) matchingTransactions(apiBlock *block.APIBlock) []*Notification {
+	notifications := make([]*Notification, 0)
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		for _, tx := range miniBlock.Transactions {
+			if !s.isTransactionMatching(tx) {
+				continue
+			}
+
+			notification := s.newNotification(apiBlock)
+			notification.Transaction = tx
+			notifications = append(notifications, notification)
+		}
+	}
+
+	return notifications
+}
+
+func (s *subscription) isTransactionMatching(tx *transaction.ApiTransactionResult) bool {
+	if tx == nil {
+		return false
+	}
+
+	return isInSet(s.addresses, tx.Sender) || isInSet(s.addresses, tx.Receiver)
+}
+
+func (s *subscription) matchingEvents(blockEvents *BlockEvents) []*Notification {
+	notifications := make([]*Notification, 0)
+	for _, event := range blockEvents.Events {
+		if !s.isEventMatching(event) {
+			continue
+		}
+
+		notification := s.newNotification(blockEvents.Block)
+		notification.Event = event
+		notifications = append(notifications, notification)
+	}
+
+	return notifications
+}
+
+// isEventMatching returns true if the event matches all the non-empty filters of the subscription
+func (s *subscription) isEventMatching(event *Event) bool {
+	if event == nil {
+		return false
+	}
+	if len(s.addresses) > 0 && !isInSet(s.addresses, event.Address) {
+		return false
+	}
+	if len(s.identifiers) > 0 && !isInSet(s.identifiers, event.Identifier) {
+		return false
+	}
+	if len(s.eventTopics) == 0 {
+		return true
+	}
+
+	for _, eventTopic := range event.Topics {
+		if isInSet(s.eventTopics, eventTopic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isInSet(set map[string]struct{}, value string) bool {
+	_, found := set[value]
+	return found
+}
+
+func blockWithoutTransactions(apiBlock *block.APIBlock) *block.APIBlock {
+	blockCopy := *apiBlock
+	blockCopy.MiniBlocks = make([]*block.APIMiniBlock, 0, len(apiBlock.MiniBlocks))
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		miniBlockCopy := *miniBlock
+		miniBlockCopy.Transactions = nil
+		blockCopy.MiniBlocks = append(blockCopy.MiniBlocks, &miniBlockCopy)
+	}
+
+	return &blockCopy
+}
@@ -0,0 +1,15 @@
+package subscription
+
+import "io"
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+}
+
+// Subscriber defines a component which receives all the finalized blocks and their log events
+type Subscriber interface {
+	Notify(blockEvents *BlockEvents)
+	IsInterfaceNil() bool
+}
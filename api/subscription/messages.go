@@ -0,0 +1,65 @@
+package subscription
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+const (
+	// TopicBlocks is the subscription topic for new finalized blocks
+	TopicBlocks = "blocks"
+	// TopicTransactions is the subscription topic for finalized transactions touching a set of addresses
+	TopicTransactions = "transactions"
+	// TopicEvents is the subscription topic for smart contract log events
+	TopicEvents = "events"
+
+	// ActionSubscribe is the request action used to create a new subscription
+	ActionSubscribe = "subscribe"
+	// ActionUnsubscribe is the request action used to remove an existing subscription
+	ActionUnsubscribe = "unsubscribe"
+)
+
+// Request represents a message sent by a client over the web socket in order to manage its subscriptions
+type Request struct {
+	Action      string   `json:"action"`
+	ID          string   `json:"id"`
+	Topic       string   `json:"topic,omitempty"`
+	Addresses   []string `json:"addresses,omitempty"`
+	Identifiers []string `json:"identifiers,omitempty"`
+	EventTopics []string `json:"eventTopics,omitempty"`
+}
+
+// Response represents the message sent back to a client after handling one of its requests
+type Response struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Event represents a smart contract log event generated by a finalized transaction
+type Event struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
+
+// BlockEvents holds a finalized block, including its transactions, together with the log events they generated
+type BlockEvents struct {
+	Block  *block.APIBlock
+	Events []*Event
+}
+
+// Notification represents a message sent to a client whenever new data matches one of its subscriptions.
+// NumDropped holds the number of notifications dropped since the previous one because the client was too slow
+type Notification struct {
+	SubscriptionID string                            `json:"subscriptionId"`
+	Topic          string                            `json:"topic"`
+	BlockNonce     uint64                            `json:"blockNonce"`
+	BlockHash      string                            `json:"blockHash"`
+	Block          *block.APIBlock                   `json:"block,omitempty"`
+	Transaction    *transaction.ApiTransactionResult `json:"transaction,omitempty"`
+	Event          *Event                            `json:"event,omitempty"`
+	NumDropped     uint64                            `json:"numDropped,omitempty"`
+}
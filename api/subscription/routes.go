@@ -0,0 +1,105 @@
+package subscription
+
+import (
+	"net/http"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	subscribeEndpoint = "/subscription/ws"
+	subscribePath     = "/ws"
+)
+
+var log = logger.GetOrCreate("api/subscription")
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	SubscribeToBlockEvents(subscriber Subscriber) error
+	UnsubscribeFromBlockEvents(subscriber Subscriber)
+	IsInterfaceNil() bool
+}
+
+// Routes defines subscription related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(
+		http.MethodGet,
+		subscribePath,
+		middleware.CreateEndpointThrottler(subscribeEndpoint),
+		Subscribe,
+	)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// Subscribe upgrades the connection to a web socket on which the client can subscribe to new finalized blocks,
+// transactions touching given addresses and smart contract log events. The connection is kept open until
+// the client closes it
+func Subscribe(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscription web socket upgrade", "error", err.Error())
+		return
+	}
+
+	client, err := newWsClient(conn)
+	if err != nil {
+		log.Debug("subscription web socket client", "error", err.Error())
+		_ = conn.Close()
+		return
+	}
+
+	err = facade.SubscribeToBlockEvents(client)
+	if err != nil {
+		_ = client.writeMessage(&Response{Error: err.Error()})
+		_ = conn.Close()
+		return
+	}
+	defer facade.UnsubscribeFromBlockEvents(client)
+
+	client.StartBlocking()
+}
@@ -0,0 +1,143 @@
+package subscription_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startNodeServer(handler subscription.FacadeHandler) *httptest.Server {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	subscriptionRoutes := ws.Group("/subscription")
+	if handler != nil {
+		subscriptionRoutes.Use(middleware.WithFacade(handler))
+	}
+	subscriptionRoute, _ := wrapper.NewRouterWrapper("subscription", subscriptionRoutes, getRoutesConfig())
+	subscription.Routes(subscriptionRoute)
+
+	return httptest.NewServer(ws)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscription": {
+				[]config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscription/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+
+	return conn
+}
+
+func TestSubscribe_NotAWebSocketShouldFail(t *testing.T) {
+	t.Parallel()
+
+	server := startNodeServer(&mock.Facade{})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/subscription/ws")
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSubscribe_FacadeErrorsShouldSendErrorAndClose(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		SubscribeToBlockEventsCalled: func(subscriber subscription.Subscriber) error {
+			return expectedErr
+		},
+	}
+	server := startNodeServer(facade)
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	response := &subscription.Response{}
+	err := conn.ReadJSON(response)
+	require.Nil(t, err)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+
+	_, _, err = conn.ReadMessage()
+	assert.NotNil(t, err)
+}
+
+func TestSubscribe_ShouldReceiveNotifications(t *testing.T) {
+	t.Parallel()
+
+	chSubscriber := make(chan subscription.Subscriber, 1)
+	chUnsubscribed := make(chan struct{})
+	facade := &mock.Facade{
+		SubscribeToBlockEventsCalled: func(subscriber subscription.Subscriber) error {
+			chSubscriber <- subscriber
+			return nil
+		},
+		UnsubscribeFromBlockEventsCalled: func(subscriber subscription.Subscriber) {
+			close(chUnsubscribed)
+		},
+	}
+	server := startNodeServer(facade)
+	defer server.Close()
+
+	conn := dial(t, server)
+	subscriber := <-chSubscriber
+
+	err := conn.WriteJSON(&subscription.Request{
+		Action:    subscription.ActionSubscribe,
+		ID:        "txs",
+		Topic:     subscription.TopicTransactions,
+		Addresses: []string{"carol"},
+	})
+	require.Nil(t, err)
+
+	response := &subscription.Response{}
+	err = conn.ReadJSON(response)
+	require.Nil(t, err)
+	assert.Equal(t, &subscription.Response{ID: "txs", Action: subscription.ActionSubscribe}, response)
+
+	subscriber.Notify(createBlockEvents())
+	for _, expectedHash := range []string{"tx2", "tx3"} {
+		notification := &subscription.Notification{}
+		err = conn.ReadJSON(notification)
+		require.Nil(t, err)
+		assert.Equal(t, "txs", notification.SubscriptionID)
+		assert.Equal(t, expectedHash, notification.Transaction.Hash)
+	}
+
+	_ = conn.Close()
+	select {
+	case <-chUnsubscribed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "subscriber should have been removed")
+	}
+}
@@ -19,24 +19,39 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionsPoolPath          = "/pool"
+
+	transactionsPoolSegment = "pool"
+	urlParamSender          = "sender"
+
+	// MaxTransactionsInSimulationBundle is the maximum number of transactions that can be simulated in a single bundle
+	MaxTransactionsInSimulationBundle = 50
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error)
+	SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
+	ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction, overrides map[string]*transaction.AccountOverride) ([]*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPool() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -71,6 +86,25 @@ type SendTxRequest struct {
 	ChainID   string `form:"chainID" json:"chainID"`
 	Version   uint32 `form:"version" json:"version"`
 	Options   uint32 `json:"options,omitempty"`
+
+	Guardian          string `json:"guardian,omitempty"`
+	GuardianSignature string `json:"guardianSignature,omitempty"`
+
+	Signatures []string `json:"signatures,omitempty"`
+}
+
+// SimulateTxRequest represents the structure that maps and validates user input for simulating a transaction. The
+// optional state overrides, keyed by the bech32 addresses, replace the accounts' values for the simulation only
+type SimulateTxRequest struct {
+	SendTxRequest
+	StateOverrides map[string]*transaction.AccountOverride `json:"stateOverrides,omitempty"`
+}
+
+// SimulateBundleRequest represents the structure that maps and validates user input for simulating an ordered bundle
+// of transactions, each of them being executed on top of the state changes made by the previous ones
+type SimulateBundleRequest struct {
+	Transactions   []*SendTxRequest                        `json:"transactions"`
+	StateOverrides map[string]*transaction.AccountOverride `json:"stateOverrides,omitempty"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -97,6 +131,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(simulateTransactionEndpoint),
 		SimulateTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodPost,
+		simulateBundlePath,
+		middleware.CreateEndpointThrottler(simulateBundleEndpoint),
+		SimulateBundle,
+	)
 	router.RegisterHandler(http.MethodPost, costPath, ComputeTransactionGasLimit)
 	router.RegisterHandler(
 		http.MethodPost,
@@ -104,12 +144,26 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(sendMultipleTransactionsEndpoint),
 		SendMultipleTransactions,
 	)
-	router.RegisterHandler(
-		http.MethodGet,
-		getTransactionPath,
+
+	getTransactionHandlers := []gin.HandlerFunc{
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
 		GetTransaction,
-	)
+	}
+	if router.IsEndpointActive(getTransactionsPoolPath) {
+		// the router does not accept a static path next to the :txhash wildcard, so the pool requests are
+		// intercepted before reaching the transaction handler
+		getTransactionHandlers = append([]gin.HandlerFunc{interceptTransactionsPoolRequests}, getTransactionHandlers...)
+	}
+	router.RegisterHandler(http.MethodGet, getTransactionPath, getTransactionHandlers...)
+}
+
+func interceptTransactionsPoolRequests(c *gin.Context) {
+	if c.Param("txhash") != transactionsPoolSegment {
+		return
+	}
+
+	GetTransactionsPool(c)
+	c.Abort()
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -142,14 +196,15 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	return facade, true
 }
 
-// SimulateTransaction will receive a transaction from the client and will simulate it's execution and return the results
+// SimulateTransaction will receive a transaction from the client and will simulate it's execution and return the results.
+// When state overrides are provided, the transaction is executed on top of the state having them applied
 func SimulateTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
 		return
 	}
 
-	var gtx = SendTxRequest{}
+	var gtx = SimulateTxRequest{}
 	err := c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
@@ -163,19 +218,7 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := facade.CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.Sender,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
-	)
+	tx, txHash, err := createTransaction(facade, &gtx.SendTxRequest)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -188,7 +231,13 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
-	err = facade.ValidateTransactionForSimulation(tx)
+	hasOverrides := len(gtx.StateOverrides) > 0
+	if hasOverrides {
+		// the nonce and the balance are checked against the overridden state, while simulating
+		err = facade.ValidateTransactionFieldsForSimulation(tx)
+	} else {
+		err = facade.ValidateTransactionForSimulation(tx)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -201,7 +250,16 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := facade.SimulateTransactionExecution(tx)
+	var executionResults *transaction.SimulationResults
+	if hasOverrides {
+		var bundleResults []*transaction.SimulationResults
+		bundleResults, err = facade.SimulateTransactionsBundle([]*transaction.Transaction{tx}, gtx.StateOverrides)
+		if err == nil {
+			executionResults = bundleResults[0]
+		}
+	} else {
+		executionResults, err = facade.SimulateTransactionExecution(tx)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -225,6 +283,141 @@ func SimulateTransaction(c *gin.Context) {
 	)
 }
 
+// SimulateBundle will receive an ordered list of transactions from the client and will simulate their execution, one
+// after the other, on top of the state having the provided overrides applied. The results of each transaction, in the
+// order of the request, are returned
+func SimulateBundle(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = SimulateBundleRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(request.Transactions) == 0 || len(request.Transactions) > MaxTransactionsInSimulationBundle {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data: nil,
+				Error: fmt.Sprintf("%s: %s, between 1 and %d transactions should be provided",
+					errors.ErrValidation.Error(), errors.ErrInvalidTransactionsBundle.Error(), MaxTransactionsInSimulationBundle),
+				Code: shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs, txsHashes, err := createBundleTransactions(facade, request.Transactions)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	results, err := facade.SimulateTransactionsBundle(txs, request.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range results {
+		result.Hash = hex.EncodeToString(txsHashes[idx])
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": results},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func createBundleTransactions(facade FacadeHandler, requests []*SendTxRequest) ([]*transaction.Transaction, [][]byte, error) {
+	txs := make([]*transaction.Transaction, 0, len(requests))
+	txsHashes := make([][]byte, 0, len(requests))
+	for idx, request := range requests {
+		if request == nil {
+			return nil, nil, fmt.Errorf("transaction %d: %w", idx, errors.ErrInvalidTransactionsBundle)
+		}
+
+		tx, txHash, err := createTransaction(facade, request)
+		if err != nil {
+			return nil, nil, fmt.Errorf("transaction %d: %w", idx, err)
+		}
+
+		// the nonces and the balances are checked against the state changed by the previous transactions, while simulating
+		err = facade.ValidateTransactionFieldsForSimulation(tx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("transaction %d: %w", idx, err)
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, txHash)
+	}
+
+	return txs, txsHashes, nil
+}
+
+func createTransaction(facade FacadeHandler, request *SendTxRequest) (*transaction.Transaction, []byte, error) {
+	tx, txHash, err := facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.Sender,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(request.Guardian) > 0 || len(request.GuardianSignature) > 0 {
+		txHash, err = facade.SetTransactionGuardian(tx, request.Guardian, request.GuardianSignature)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(request.Signatures) > 0 {
+		txHash, err = facade.SetTransactionSignatures(tx, request.Signatures)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return tx, txHash, nil
+}
+
 // SendTransaction will receive a transaction from the client and propagate it for processing
 func SendTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -246,19 +439,7 @@ func SendTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := facade.CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.Sender,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
-	)
+	tx, txHash, err := createTransaction(facade, &gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -337,19 +518,7 @@ func SendMultipleTransactions(c *gin.Context) {
 
 	txsHashes := make(map[int]string)
 	for idx, receivedTx := range gtx {
-		tx, txHash, err = facade.CreateTransaction(
-			receivedTx.Nonce,
-			receivedTx.Value,
-			receivedTx.Receiver,
-			receivedTx.Sender,
-			receivedTx.GasPrice,
-			receivedTx.GasLimit,
-			receivedTx.Data,
-			receivedTx.Signature,
-			receivedTx.ChainID,
-			receivedTx.Version,
-			receivedTx.Options,
-		)
+		tx, txHash, err = createTransaction(facade, &receivedTx)
 		if err != nil {
 			continue
 		}
@@ -466,19 +635,7 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
-	tx, _, err := facade.CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.Sender,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
-	)
+	tx, _, err := createTransaction(facade, &gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -522,3 +679,65 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 
 	return strconv.ParseBool(withResultsStr)
 }
+
+// GetTransactionsPool returns the transactions sent from the accounts of the current shard that are waiting in the pool.
+// When the sender URL parameter is provided, only the sender's transactions are returned, together with the queued
+// nonces, the nonce gaps against the account nonce and the sender's score
+func GetTransactionsPool(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	sender := c.Request.URL.Query().Get(urlParamSender)
+	if len(sender) > 0 {
+		getTransactionsPoolForSender(c, facade, sender)
+		return
+	}
+
+	pool, err := facade.GetTransactionsPool()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txPool": pool},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getTransactionsPoolForSender(c *gin.Context, facade FacadeHandler, sender string) {
+	senderPool, err := facade.GetTransactionsPoolForSender(sender)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txPool": senderPool},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -37,6 +37,26 @@ type transactionResponse struct {
 	Code  string                  `json:"code"`
 }
 
+type txPoolResponseData struct {
+	TxPool *tr.ApiTransactionsPool `json:"txPool"`
+}
+
+type txPoolResponse struct {
+	Data  txPoolResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+type txPoolForSenderResponseData struct {
+	TxPool *tr.ApiPoolSender `json:"txPool"`
+}
+
+type txPoolForSenderResponse struct {
+	Data  txPoolForSenderResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
 type sendMultipleTxsResponseData struct {
 	TxsSent   int      `json:"txsSent"`
 	TxsHashes []string `json:"txsHashes"`
@@ -54,6 +74,16 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateBundleResponseData struct {
+	Results []*tr.SimulationResults `json:"results"`
+}
+
+type simulateBundleResponse struct {
+	Data  simulateBundleResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -374,6 +404,100 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, hexTxHash, response.Data.TxHash)
 }
 
+func TestSendTransaction_WithGuardianShouldSetGuardianFields(t *testing.T) {
+	t.Parallel()
+	guardian := "guardian"
+	guardianSignature := "eeff0011"
+	hexTxHash := "deadbeef"
+
+	setGuardianWasCalled := false
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, []byte("hash without guardian"), nil
+		},
+		SetTransactionGuardianHandler: func(tx *tr.Transaction, guardianAddress string, guardianSignatureHex string) ([]byte, error) {
+			setGuardianWasCalled = true
+			assert.Equal(t, guardian, guardianAddress)
+			assert.Equal(t, guardianSignature, guardianSignatureHex)
+
+			txHash, _ := hex.DecodeString(hexTxHash)
+			return txHash, nil
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (u uint64, err error) {
+			return 1, nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := fmt.Sprintf(
+		`{"nonce": 1, "sender": "sender", "receiver": "receiver", "value": "10", "signature": "aabbccdd", "version": 2, "options": 2, "guardian": "%s", "guardianSignature": "%s"}`,
+		guardian,
+		guardianSignature,
+	)
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendSingleTxResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, hexTxHash, response.Data.TxHash)
+	assert.True(t, setGuardianWasCalled)
+}
+
+func TestSendTransaction_WithSignaturesShouldSetMultisigSignatures(t *testing.T) {
+	t.Parallel()
+	signatures := []string{"aabb", "ccdd"}
+	hexTxHash := "deadbeef"
+
+	setSignaturesWasCalled := false
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, []byte("hash without signatures"), nil
+		},
+		SetTransactionGuardianHandler: func(tx *tr.Transaction, guardianAddress string, guardianSignatureHex string) ([]byte, error) {
+			assert.Fail(t, "should have not set the guardian")
+			return nil, nil
+		},
+		SetTransactionSignaturesHandler: func(tx *tr.Transaction, signaturesHex []string) ([]byte, error) {
+			setSignaturesWasCalled = true
+			assert.Equal(t, signatures, signaturesHex)
+
+			txHash, _ := hex.DecodeString(hexTxHash)
+			return txHash, nil
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (u uint64, err error) {
+			return 1, nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"nonce": 1, "sender": "sender", "receiver": "receiver", "value": "10", "version": 1, "signatures": ["aabb", "ccdd"]}`
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendSingleTxResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, hexTxHash, response.Data.TxHash)
+	assert.True(t, setSignaturesWasCalled)
+}
+
 func TestSendMultipleTransactions_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -767,6 +891,169 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_WithStateOverridesShouldSimulateOnTheOverriddenState(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(7)
+	expectedOverrides := map[string]*tr.AccountOverride{
+		"sender1": {Balance: "1000", Nonce: &nonce, Storage: map[string]string{"aa": "bb"}},
+	}
+	expectedStateDiff := []*tr.AccountStateDiff{
+		{Address: "sender1", BalanceBefore: "1000", BalanceAfter: "900", NonceBefore: 7, NonceAfter: 8},
+	}
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(_ *tr.Transaction) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+		SimulateTransactionExecutionHandler: func(_ *tr.Transaction) (*tr.SimulationResults, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		SimulateTransactionsBundleHandler: func(txs []*tr.Transaction, overrides map[string]*tr.AccountOverride) ([]*tr.SimulationResults, error) {
+			assert.Equal(t, []*tr.Transaction{{Nonce: 7}}, txs)
+			assert.Equal(t, expectedOverrides, overrides)
+			return []*tr.SimulationResults{{Status: tr.TxStatusSuccess, StateDiff: expectedStateDiff}}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	request := transaction.SimulateTxRequest{
+		SendTxRequest:  transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 7},
+		StateOverrides: expectedOverrides,
+	}
+	jsonBytes, _ := json.Marshal(request)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := struct {
+		Data struct {
+			Result *tr.SimulationResults `json:"result"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), simulateResponse.Data.Result.Hash)
+	assert.Equal(t, expectedStateDiff, simulateResponse.Data.Result.StateDiff)
+}
+
+func TestSimulateBundle_InvalidBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, []byte("hash"), nil
+		},
+		SimulateTransactionsBundleHandler: func(_ []*tr.Transaction, _ map[string]*tr.AccountOverride) ([]*tr.SimulationResults, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	tooManyTxs := make([]*transaction.SendTxRequest, transaction.MaxTransactionsInSimulationBundle+1)
+	for idx := range tooManyTxs {
+		tooManyTxs[idx] = &transaction.SendTxRequest{}
+	}
+	invalidRequests := []transaction.SimulateBundleRequest{
+		{},
+		{Transactions: tooManyTxs},
+		{Transactions: []*transaction.SendTxRequest{{}, nil}},
+	}
+	for _, request := range invalidRequests {
+		jsonBytes, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		bundleResponse := simulateBundleResponse{}
+		loadResponse(resp.Body, &bundleResponse)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(bundleResponse.Error, apiErrors.ErrInvalidTransactionsBundle.Error()))
+	}
+}
+
+func TestSimulateBundle_ValidateErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid signature")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTxFieldsForSimulationHandler: func(tx *tr.Transaction) error {
+			if tx.Nonce == 2 {
+				return expectedErr
+			}
+			return nil
+		},
+		SimulateTransactionsBundleHandler: func(_ []*tr.Transaction, _ map[string]*tr.AccountOverride) ([]*tr.SimulationResults, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	request := transaction.SimulateBundleRequest{
+		Transactions: []*transaction.SendTxRequest{{Nonce: 1}, {Nonce: 2}},
+	}
+	jsonBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	bundleResponse := simulateBundleResponse{}
+	loadResponse(resp.Body, &bundleResponse)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(bundleResponse.Error, "transaction 1: "+expectedErr.Error()))
+}
+
+func TestSimulateBundle_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedOverrides := map[string]*tr.AccountOverride{
+		"sender1": {Balance: "1000"},
+	}
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte(fmt.Sprintf("hash%d", nonce)), nil
+		},
+		SimulateTransactionsBundleHandler: func(txs []*tr.Transaction, overrides map[string]*tr.AccountOverride) ([]*tr.SimulationResults, error) {
+			assert.Equal(t, []*tr.Transaction{{Nonce: 1}, {Nonce: 2}}, txs)
+			assert.Equal(t, expectedOverrides, overrides)
+			return []*tr.SimulationResults{
+				{Status: tr.TxStatusSuccess},
+				{Status: tr.TxStatusFail, FailReason: "insufficient funds"},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	request := transaction.SimulateBundleRequest{
+		Transactions:   []*transaction.SendTxRequest{{Nonce: 1}, {Nonce: 2}},
+		StateOverrides: expectedOverrides,
+	}
+	jsonBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	bundleResponse := simulateBundleResponse{}
+	loadResponse(resp.Body, &bundleResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*tr.SimulationResults{
+		{Status: tr.TxStatusSuccess, Hash: hex.EncodeToString([]byte("hash1"))},
+		{Status: tr.TxStatusFail, FailReason: "insufficient funds", Hash: hex.EncodeToString([]byte("hash2"))},
+	}, bundleResponse.Data.Results)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -793,6 +1080,16 @@ func startNodeServer(handler transaction.FacadeHandler) *gin.Engine {
 	return ws
 }
 
+func startNodeServerWithRoutesConfig(handler transaction.FacadeHandler, routesConfig config.ApiRoutesConfig) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ginTransactionRoute := ws.Group("/transaction")
+	ginTransactionRoute.Use(middleware.WithFacade(handler))
+	transactionRoute, _ := wrapper.NewRouterWrapper("transaction", ginTransactionRoute, routesConfig)
+	transaction.Routes(transactionRoute)
+	return ws
+}
+
 func startNodeServerWrongFacade() *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -816,8 +1113,129 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
+					{Name: "/pool", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestGetTransactionsPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := &tr.ApiTransactionsPool{
+		NumSenders: 1,
+		Transactions: []*tr.ApiPoolTransaction{
+			{Hash: "aa", Nonce: 3, Sender: "alice", Receiver: "bob", Value: "10", GasPrice: 5, GasLimit: 6},
+		},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*tr.ApiTransactionsPool, error) {
+			return expectedPool, nil
+		},
+		GetTransactionHandler: func(_ string, _ bool) (*tr.ApiTransactionResult, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedPool, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_ForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSenderPool := &tr.ApiPoolSender{
+		Sender:            "alice",
+		AccountNonce:      2,
+		AccountNonceKnown: true,
+		Score:             37,
+		QueuedNonces:      []uint64{4},
+		NonceGaps:         []tr.ApiNonceGap{{From: 2, To: 3}},
+		Transactions:      []*tr.ApiPoolTransaction{{Hash: "aa", Nonce: 4, Sender: "alice"}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(sender string) (*tr.ApiPoolSender, error) {
+			assert.Equal(t, "alice", sender)
+			return expectedSenderPool, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolForSenderResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedSenderPool, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*tr.ApiTransactionsPool, error) {
+			return nil, expectedErr
+		},
+		GetTransactionsPoolForSenderCalled: func(_ string) (*tr.ApiPoolSender, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	for _, path := range []string{"/transaction/pool", "/transaction/pool?sender=alice"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+}
+
+func TestGetTransactionsPool_RouteDisabledShouldBeHandledAsTransactionHash(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*tr.ApiTransactionsPool, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetTransactionHandler: func(hash string, _ bool) (*tr.ApiTransactionResult, error) {
+			assert.Equal(t, "pool", hash)
+			return nil, errors.New("invalid hash")
+		},
+	}
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/:txhash", Open: true},
+					{Name: "/pool", Open: false},
 				},
 			},
 		},
 	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	ws := startNodeServerWithRoutesConfig(&facade, routesConfig)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"encoding/hex"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
@@ -10,17 +11,25 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath          = "/statistics"
+	doubleSigningProofsPath = "/double-signing-proofs"
+	participationPath       = "/participation/:pubkey"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetDoubleSigningProofs() [][]byte
+	GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, doubleSigningProofsPath, DoubleSigningProofs)
+	router.RegisterHandler(http.MethodGet, participationPath, Participation)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +91,58 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// DoubleSigningProofs will return the most recent proofs of double signing built by the node, hex encoded as expected
+// in the data field of a slash transaction sent to the staking system smart contract
+func DoubleSigningProofs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	proofs := facade.GetDoubleSigningProofs()
+	hexProofs := make([]string, 0, len(proofs))
+	for _, proof := range proofs {
+		hexProofs = append(hexProofs, hex.EncodeToString(proof))
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proofs": hexProofs},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// Participation will return, for the provided BLS key, the per epoch history of the rounds the validator was selected
+// for, of the blocks it proposed or missed, of the signatures it contributed or missed and of its rating changes
+func Participation(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	history, err := facade.GetValidatorParticipation(c.Param("pubkey"))
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"participation": history.Epochs},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -1,6 +1,7 @@
 package validator_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -113,6 +114,87 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+func TestDoubleSigningProofs_ReturnsHexEncodedProofs(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetDoubleSigningProofsCalled: func() [][]byte {
+			return [][]byte{[]byte("proof 1"), []byte("proof 2")}
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/double-signing-proofs", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	mapResponseData := response.Data.(map[string]interface{})
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("proof 1")), hex.EncodeToString([]byte("proof 2"))}, mapResponseData["proofs"])
+}
+
+func TestParticipation_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "validator participation history is not enabled"
+	facade := mock.Facade{
+		GetValidatorParticipationCalled: func(blsKey string) (*state.ValidatorParticipationHistory, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/participation/abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.Equal(t, errStr, response.Error)
+}
+
+func TestParticipation_ReturnsTheHistory(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetValidatorParticipationCalled: func(blsKey string) (*state.ValidatorParticipationHistory, error) {
+			assert.Equal(t, "abcd", blsKey)
+			return &state.ValidatorParticipationHistory{
+				Epochs: []*state.ValidatorParticipation{
+					{Epoch: 4, NumSelected: 20, NumProposed: 2, NumMissedSignatures: 3, RatingDelta: -120},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/participation/abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	mapResponseData := response.Data.(map[string]interface{})
+	epochs := mapResponseData["participation"].([]interface{})
+	assert.Equal(t, 1, len(epochs))
+	participation := epochs[0].(map[string]interface{})
+	assert.Equal(t, float64(4), participation["epoch"])
+	assert.Equal(t, float64(20), participation["numSelected"])
+	assert.Equal(t, float64(3), participation["numMissedSignatures"])
+	assert.Equal(t, float64(-120), participation["ratingDelta"])
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +239,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/double-signing-proofs", Open: true},
+					{Name: "/participation/:pubkey", Open: true},
 				},
 			},
 		},
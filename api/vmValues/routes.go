@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -24,7 +25,7 @@ const (
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(query *process.SCQuery, options core.AccountQueryOptions) (*vm.VMOutputApi, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -111,7 +112,12 @@ func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
 		return nil, err
 	}
 
-	return ef.ExecuteSCQuery(command)
+	options, err := shared.ParseAccountQueryOptions(context)
+	if err != nil {
+		return nil, err
+	}
+
+	return ef.ExecuteSCQuery(command, options)
 }
 
 func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -47,7 +48,7 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{valueBuff},
 			}, nil
@@ -74,7 +75,7 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{[]byte(valueBuff)},
 			}, nil
@@ -101,7 +102,7 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vm.VMOutputApi{
@@ -128,7 +129,7 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -150,6 +151,56 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery, options core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
+			require.Equal(t, core.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true}, options)
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query?blockNonce=5", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+}
+
+func TestQuery_WithInvalidBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
+			require.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/query?blockNonce=abc", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidBlockNonce.Error())
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -167,7 +218,7 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return nil, errExpected
 		},
 	}
@@ -186,7 +237,7 @@ func TestAllRoutes_WhenBadAddressShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid address")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{}, nil
 		},
 	}
@@ -205,7 +256,7 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{}, nil
 		},
 	}
@@ -224,7 +275,7 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{}, nil
 		},
 	}
@@ -242,7 +293,7 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ core.AccountQueryOptions) (vmOutput *vm.VMOutputApi, e error) {
 			return &vm.VMOutputApi{}, nil
 		},
 	}
@@ -34,12 +34,13 @@ func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig
 
 // RegisterHandler will register the handler for the given method and path
 func (rw *RouterWrapper) RegisterHandler(method string, path string, handlers ...gin.HandlerFunc) {
-	if rw.isEndpointActive(path) {
+	if rw.IsEndpointActive(path) {
 		rw.router.Handle(method, path, handlers...)
 	}
 }
 
-func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
+// IsEndpointActive returns true if the given path is configured as an open route
+func (rw *RouterWrapper) IsEndpointActive(endpointToCheck string) bool {
 	rw.mutRoutesConfig.RLock()
 	routesConfig := rw.routesConfig
 	rw.mutRoutesConfig.RUnlock()
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	"github.com/urfave/cli"
+)
+
+const noRound = -1
+
+type flags struct {
+	path      string
+	round     int64
+	fromRound int64
+	toRound   int64
+}
+
+var (
+	nodeHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// pathFlag defines a flag for setting the trace file or the folder holding the trace files
+	pathFlag = cli.StringFlag{
+		Name:        "path",
+		Usage:       "This string flag specifies the consensus trace file or the folder holding the trace files written by the node",
+		Value:       "consensus-trace",
+		Destination: &flagsValues.path,
+	}
+
+	// roundFlag defines a flag for the single round to be rendered
+	roundFlag = cli.Int64Flag{
+		Name:        "round",
+		Usage:       "This int flag specifies the round to be rendered. If set, the from-round and to-round flags are ignored",
+		Value:       noRound,
+		Destination: &flagsValues.round,
+	}
+
+	// fromRoundFlag defines a flag for the first round of the rendered range
+	fromRoundFlag = cli.Int64Flag{
+		Name:        "from-round",
+		Usage:       "This int flag specifies the first round, inclusive, of the rendered range",
+		Value:       0,
+		Destination: &flagsValues.fromRound,
+	}
+
+	// toRoundFlag defines a flag for the last round of the rendered range
+	toRoundFlag = cli.Int64Flag{
+		Name:        "to-round",
+		Usage:       "This int flag specifies the last round, inclusive, of the rendered range. If not set, the range is not bounded",
+		Value:       math.MaxInt64,
+		Destination: &flagsValues.toRound,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("consensustrace")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = nodeHelpTemplate
+	cliApp.Name = "Elrond consensus trace viewer"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond consensustrace application renders the consensus rounds timelines recorded by a node, for postmortems"
+	cliApp.Flags = []cli.Flag{
+		pathFlag,
+		roundFlag,
+		fromRoundFlag,
+		toRoundFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	cliApp.Action = renderRounds
+}
+
+func renderRounds(_ *cli.Context) error {
+	fromRound, toRound := flagsValues.fromRound, flagsValues.toRound
+	if flagsValues.round != noRound {
+		fromRound, toRound = flagsValues.round, flagsValues.round
+	}
+	if fromRound > toRound {
+		return fmt.Errorf("invalid rounds range [%d, %d]", fromRound, toRound)
+	}
+
+	traces, err := consensusTrace.LoadRoundTraces(flagsValues.path, fromRound, toRound)
+	if err != nil {
+		return err
+	}
+	if len(traces) == 0 {
+		return errors.New("no round has been recorded in the provided range")
+	}
+
+	for _, trace := range traces {
+		err = consensusTrace.DisplayRoundTrace(os.Stdout, trace)
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// Record holds a decoded (key, value) pair of a storage unit
+type Record struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+type trieNodeView struct {
+	Type     string   `json:"type"`
+	Key      string   `json:"key,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	Children []string `json:"children,omitempty"`
+}
+
+type recordDecoder struct {
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+}
+
+func (rd *recordDecoder) decode(recType recordsType, key []byte, value []byte) *Record {
+	record := &Record{
+		Key: hex.EncodeToString(key),
+	}
+
+	var decodedValue interface{}
+	var err error
+	switch recType {
+	case shardHeaderRecords:
+		decodedValue, err = rd.unmarshal(&block.Header{}, value)
+	case metaBlockRecords:
+		decodedValue, err = rd.unmarshal(&block.MetaBlock{}, value)
+	case miniBlockRecords:
+		decodedValue, err = rd.unmarshal(&block.MiniBlock{}, value)
+	case transactionRecords:
+		decodedValue, err = rd.unmarshal(&transaction.Transaction{}, value)
+	case smartContractResultRecords:
+		decodedValue, err = rd.unmarshal(&smartContractResult.SmartContractResult{}, value)
+	case rewardTransactionRecords:
+		decodedValue, err = rd.unmarshal(&rewardTx.RewardTx{}, value)
+	case nonceToHashRecords:
+		nonce, errConvert := rd.uint64ByteSliceConverter.ToUint64(key)
+		if errConvert == nil {
+			record.Key = fmt.Sprintf("%d", nonce)
+		}
+		decodedValue = hex.EncodeToString(value)
+	case trieNodeRecords:
+		decodedValue, err = rd.decodeTrieNode(value)
+	default:
+		decodedValue = hex.EncodeToString(value)
+	}
+
+	if err != nil {
+		record.Value = hex.EncodeToString(value)
+		record.Error = err.Error()
+		return record
+	}
+
+	record.Value = decodedValue
+
+	return record
+}
+
+func (rd *recordDecoder) unmarshal(obj interface{}, value []byte) (interface{}, error) {
+	err := rd.marshalizer.Unmarshal(obj, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (rd *recordDecoder) decodeTrieNode(value []byte) (*trieNodeView, error) {
+	nodeData, err := trie.DecodeStoredNode(value, rd.marshalizer, rd.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &trieNodeView{
+		Type:     nodeData.Type,
+		Key:      hex.EncodeToString(nodeData.Key),
+		Value:    hex.EncodeToString(nodeData.Value),
+		Children: make([]string, 0, len(nodeData.ChildrenHashes)),
+	}
+	for _, childHash := range nodeData.ChildrenHashes {
+		view.Children = append(view.Children, hex.EncodeToString(childHash))
+	}
+
+	return view, nil
+}
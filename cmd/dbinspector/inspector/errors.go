@@ -0,0 +1,36 @@
+package inspector
+
+import "errors"
+
+// ErrEmptyDbFilePath signals that an empty database file path has been provided
+var ErrEmptyDbFilePath = errors.New("empty db file path")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrNilDirectoryReader signals that a nil directory reader has been provided
+var ErrNilDirectoryReader = errors.New("nil directory reader")
+
+// ErrNilRecordHandler signals that a nil record handler has been provided
+var ErrNilRecordHandler = errors.New("nil record handler")
+
+// ErrUnknownUnit signals that the provided storage unit name is not known
+var ErrUnknownUnit = errors.New("unknown storage unit")
+
+// ErrNotATrieUnit signals that the provided storage unit does not hold trie nodes
+var ErrNotATrieUnit = errors.New("the storage unit does not hold trie nodes")
+
+// ErrNoRootHash signals that no root hash has been provided
+var ErrNoRootHash = errors.New("no root hash provided")
+
+// ErrIncompleteTrie signals that the dangling entries can not be safely removed because some trie nodes are missing or corrupted
+var ErrIncompleteTrie = errors.New("the trie has missing or corrupted nodes, dangling entries will not be removed")
+
+// ErrNoDatabaseFound signals that no database has been found in the provided path
+var ErrNoDatabaseFound = errors.New("no database found")
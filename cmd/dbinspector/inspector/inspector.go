@@ -0,0 +1,287 @@
+package inspector
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+var _ DbInspectorHandler = (*dbInspector)(nil)
+
+var log = logger.GetOrCreate("dbinspector/inspector")
+
+const epochDirectoryPrefix = factory.DefaultEpochString + "_"
+const shardDirectoryPrefix = factory.DefaultShardString + "_"
+
+// StaticEpoch is the epoch name reported for the databases that are not split by epochs
+const StaticEpoch = factory.DefaultStaticDbString
+
+// ArgsInspector holds the arguments needed for creating a new database inspector
+type ArgsInspector struct {
+	DbPathWithChainID string
+	GeneralConfig     config.Config
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	PathManager       storage.PathManagerHandler
+	DirectoryReader   storage.DirectoryReaderHandler
+}
+
+// DatabaseInfo holds the storage units found for an epoch and shard
+type DatabaseInfo struct {
+	Epoch string          `json:"epoch"`
+	Shard string          `json:"shard"`
+	Units []*UnitLocation `json:"units"`
+}
+
+// UnitLocation holds a directory of a database and the configured units stored in it
+type UnitLocation struct {
+	Directory string   `json:"directory"`
+	Units     []string `json:"units,omitempty"`
+}
+
+// ArgsDump holds the arguments needed for dumping the records of a storage unit
+type ArgsDump struct {
+	UnitName string
+	Shard    string
+	Epoch    uint32
+	Key      []byte
+	Limit    int
+}
+
+type dbInspector struct {
+	dbPathWithChainID string
+	units             map[string]*unitInfo
+	pathManager       storage.PathManagerHandler
+	directoryReader   storage.DirectoryReaderHandler
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	decoder           *recordDecoder
+}
+
+// NewDbInspector returns a new instance of the offline database inspector
+func NewDbInspector(args ArgsInspector) (*dbInspector, error) {
+	if len(args.DbPathWithChainID) == 0 {
+		return nil, ErrEmptyDbFilePath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+	if check.IfNil(args.DirectoryReader) {
+		return nil, ErrNilDirectoryReader
+	}
+
+	return &dbInspector{
+		dbPathWithChainID: args.DbPathWithChainID,
+		units:             createUnits(args.GeneralConfig),
+		pathManager:       args.PathManager,
+		directoryReader:   args.DirectoryReader,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		decoder: &recordDecoder{
+			marshalizer:              args.Marshalizer,
+			hasher:                   args.Hasher,
+			uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		},
+	}, nil
+}
+
+// UnitNames returns the sorted names of the storage units that can be inspected
+func (dbi *dbInspector) UnitNames() []string {
+	names := make([]string, 0, len(dbi.units))
+	for name := range dbi.units {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ListDatabases returns the epochs, shards and storage units found in the database directory
+func (dbi *dbInspector) ListDatabases() ([]*DatabaseInfo, error) {
+	epochDirectories, err := dbi.directoryReader.ListDirectoriesAsString(dbi.dbPathWithChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	sortEpochDirectories(epochDirectories)
+
+	dbs := make([]*DatabaseInfo, 0)
+	for _, epochDirectory := range epochDirectories {
+		epoch, ok := epochFromDirectory(epochDirectory)
+		if !ok {
+			log.Debug("skipping directory", "name", epochDirectory)
+			continue
+		}
+
+		epochPath := filepath.Join(dbi.dbPathWithChainID, epochDirectory)
+		shardDirectories, errList := dbi.directoryReader.ListDirectoriesAsString(epochPath)
+		if errList != nil {
+			log.Warn("cannot list shard directories", "path", epochPath, "error", errList)
+			continue
+		}
+
+		sort.Strings(shardDirectories)
+		for _, shardDirectory := range shardDirectories {
+			if !strings.HasPrefix(shardDirectory, shardDirectoryPrefix) {
+				continue
+			}
+
+			dbs = append(dbs, &DatabaseInfo{
+				Epoch: epoch,
+				Shard: strings.TrimPrefix(shardDirectory, shardDirectoryPrefix),
+				Units: dbi.listUnits(filepath.Join(epochPath, shardDirectory)),
+			})
+		}
+	}
+
+	if len(dbs) == 0 {
+		return nil, ErrNoDatabaseFound
+	}
+
+	return dbs, nil
+}
+
+func (dbi *dbInspector) listUnits(shardPath string) []*UnitLocation {
+	unitDirectories, err := dbi.directoryReader.ListDirectoriesAsString(shardPath)
+	if err != nil {
+		log.Warn("cannot list storage units", "path", shardPath, "error", err)
+		return nil
+	}
+
+	sort.Strings(unitDirectories)
+	locations := make([]*UnitLocation, 0, len(unitDirectories))
+	for _, unitDirectory := range unitDirectories {
+		locations = append(locations, &UnitLocation{
+			Directory: unitDirectory,
+			Units:     unitNamesForDirectory(dbi.units, unitDirectory),
+		})
+	}
+
+	return locations
+}
+
+func epochFromDirectory(directory string) (string, bool) {
+	if directory == StaticEpoch {
+		return StaticEpoch, true
+	}
+	if !strings.HasPrefix(directory, epochDirectoryPrefix) {
+		return "", false
+	}
+
+	epochString := strings.TrimPrefix(directory, epochDirectoryPrefix)
+	_, err := strconv.ParseUint(epochString, 10, 32)
+	if err != nil {
+		return "", false
+	}
+
+	return epochString, true
+}
+
+// sortEpochDirectories sorts the epoch directories numerically, the static directory being the last one
+func sortEpochDirectories(directories []string) {
+	sort.Slice(directories, func(i, j int) bool {
+		epochI, errI := strconv.ParseUint(strings.TrimPrefix(directories[i], epochDirectoryPrefix), 10, 32)
+		epochJ, errJ := strconv.ParseUint(strings.TrimPrefix(directories[j], epochDirectoryPrefix), 10, 32)
+		if errI != nil || errJ != nil {
+			return errI == nil
+		}
+
+		return epochI < epochJ
+	})
+}
+
+// Dump decodes the records of the provided storage unit and calls the handler for each one of them.
+// The iteration stops when the handler returns false or when the limit is reached
+func (dbi *dbInspector) Dump(args ArgsDump, handler func(record *Record) bool) error {
+	if handler == nil {
+		return ErrNilRecordHandler
+	}
+
+	unit, err := dbi.getUnit(args.UnitName)
+	if err != nil {
+		return err
+	}
+
+	persister, err := dbi.openReadOnly(unit, args.Shard, args.Epoch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	if len(args.Key) > 0 {
+		value, errGet := persister.Get(args.Key)
+		if errGet != nil {
+			return fmt.Errorf("%w for key %x", errGet, args.Key)
+		}
+
+		handler(dbi.decoder.decode(unit.recordsType, args.Key, value))
+		return nil
+	}
+
+	numRecords := 0
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		numRecords++
+		shouldContinue := handler(dbi.decoder.decode(unit.recordsType, key, value))
+
+		return shouldContinue && (args.Limit <= 0 || numRecords < args.Limit)
+	})
+
+	return nil
+}
+
+func (dbi *dbInspector) getUnit(unitName string) (*unitInfo, error) {
+	unit, ok := dbi.units[unitName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownUnit, unitName)
+	}
+
+	return unit, nil
+}
+
+func (dbi *dbInspector) unitPath(unit *unitInfo, shard string, epoch uint32) string {
+	if unit.isStatic {
+		return dbi.pathManager.PathForStatic(shard, unit.dbConfig.FilePath)
+	}
+
+	return dbi.pathManager.PathForEpoch(shard, epoch, unit.dbConfig.FilePath)
+}
+
+func (dbi *dbInspector) openReadOnly(unit *unitInfo, shard string, epoch uint32) (storage.Persister, error) {
+	path := dbi.unitPath(unit, shard, epoch)
+	log.Debug("opening database in read-only mode", "unit", unit.name, "path", path)
+
+	return storageFactory.NewPersisterFactory(unit.dbConfig).CreateReadOnly(path)
+}
+
+func (dbi *dbInspector) openWritable(unit *unitInfo, shard string, epoch uint32) (storage.Persister, error) {
+	path := dbi.unitPath(unit, shard, epoch)
+	log.Debug("opening database in write mode", "unit", unit.name, "path", path)
+
+	return storageFactory.NewPersisterFactory(unit.dbConfig).Create(path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbi *dbInspector) IsInterfaceNil() bool {
+	return dbi == nil
+}
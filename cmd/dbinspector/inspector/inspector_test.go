@@ -0,0 +1,229 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createMockArgs(dbPath string) ArgsInspector {
+	pathManager, _ := pathmanager.NewPathManager(
+		filepath.Join(dbPath, "Epoch_"+core.PathEpochPlaceholder, "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+		filepath.Join(dbPath, "Static", "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+	)
+
+	generalConfig := config.Config{}
+	generalConfig.TxStorage.DB = createTestDBConfig("Transactions")
+	generalConfig.AccountsTrieStorage.DB = createTestDBConfig("AccountsTrie/MainDB")
+	generalConfig.PeerAccountsTrieStorage.DB = createTestDBConfig("PeerAccountsTrie/MainDB")
+
+	return ArgsInspector{
+		DbPathWithChainID: dbPath,
+		GeneralConfig:     generalConfig,
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		PathManager:       pathManager,
+		DirectoryReader:   storageFactory.NewDirectoryReader(),
+	}
+}
+
+func createTestDirectory(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "dbinspector")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func createTestPersister(t *testing.T, path string) storage.Persister {
+	persister, err := leveldb.NewSerialDB(path, 2, 100, 10)
+	require.Nil(t, err)
+
+	return persister
+}
+
+func TestNewDbInspector_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs("db")
+	args.DbPathWithChainID = ""
+	dbi, err := NewDbInspector(args)
+	assert.Nil(t, dbi)
+	assert.Equal(t, ErrEmptyDbFilePath, err)
+
+	args = createMockArgs("db")
+	args.Marshalizer = nil
+	dbi, err = NewDbInspector(args)
+	assert.Nil(t, dbi)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs("db")
+	args.Hasher = nil
+	dbi, err = NewDbInspector(args)
+	assert.Nil(t, dbi)
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgs("db")
+	args.PathManager = nil
+	dbi, err = NewDbInspector(args)
+	assert.Nil(t, dbi)
+	assert.Equal(t, ErrNilPathManager, err)
+
+	args = createMockArgs("db")
+	args.DirectoryReader = nil
+	dbi, err = NewDbInspector(args)
+	assert.Nil(t, dbi)
+	assert.Equal(t, ErrNilDirectoryReader, err)
+}
+
+func TestNewDbInspector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dbi, err := NewDbInspector(createMockArgs("db"))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(dbi))
+	assert.Equal(t, []string{"AccountsTrieStorage", "PeerAccountsTrieStorage", "TxStorage"}, dbi.UnitNames())
+}
+
+func TestDbInspector_ListDatabases(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	for _, epoch := range []uint32{10, 2} {
+		persister := createTestPersister(t, args.PathManager.PathForEpoch("0", epoch, "Transactions"))
+		_ = persister.Close()
+	}
+	persister := createTestPersister(t, args.PathManager.PathForStatic("0", "AccountsTrie/MainDB"))
+	_ = persister.Close()
+	persister = createTestPersister(t, args.PathManager.PathForStatic("metachain", "PeerAccountsTrie/MainDB"))
+	_ = persister.Close()
+
+	dbi, _ := NewDbInspector(args)
+	dbs, err := dbi.ListDatabases()
+	require.Nil(t, err)
+
+	expectedDbs := []*DatabaseInfo{
+		{Epoch: "2", Shard: "0", Units: []*UnitLocation{{Directory: "Transactions", Units: []string{"TxStorage"}}}},
+		{Epoch: "10", Shard: "0", Units: []*UnitLocation{{Directory: "Transactions", Units: []string{"TxStorage"}}}},
+		{Epoch: StaticEpoch, Shard: "0", Units: []*UnitLocation{{Directory: "AccountsTrie", Units: []string{"AccountsTrieStorage"}}}},
+		{Epoch: StaticEpoch, Shard: "metachain", Units: []*UnitLocation{{Directory: "PeerAccountsTrie", Units: []string{"PeerAccountsTrieStorage"}}}},
+	}
+	assert.Equal(t, expectedDbs, dbs)
+}
+
+func TestDbInspector_DumpUnknownUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbi, _ := NewDbInspector(createMockArgs("db"))
+	err := dbi.Dump(ArgsDump{UnitName: "unknown"}, func(_ *Record) bool {
+		return true
+	})
+	assert.True(t, errors.Is(err, ErrUnknownUnit))
+
+	err = dbi.Dump(ArgsDump{UnitName: "TxStorage"}, nil)
+	assert.Equal(t, ErrNilRecordHandler, err)
+}
+
+func TestDbInspector_DumpShouldDecodeRecordsWithoutWriting(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	persister := createTestPersister(t, args.PathManager.PathForEpoch("1", 3, "Transactions"))
+	numTxs := 5
+	for i := 0; i < numTxs; i++ {
+		tx := &transaction.Transaction{Nonce: uint64(i)}
+		buff, _ := args.Marshalizer.Marshal(tx)
+		_ = persister.Put([]byte(fmt.Sprintf("tx hash %d", i)), buff)
+	}
+	_ = persister.Put([]byte("corrupted"), []byte("not a transaction"))
+	_ = persister.Close()
+
+	dbi, _ := NewDbInspector(args)
+
+	records := make([]*Record, 0)
+	dumpArgs := ArgsDump{
+		UnitName: "TxStorage",
+		Shard:    "1",
+		Epoch:    3,
+	}
+	err := dbi.Dump(dumpArgs, func(record *Record) bool {
+		records = append(records, record)
+		return true
+	})
+	require.Nil(t, err)
+	require.Equal(t, numTxs+1, len(records))
+	assert.Equal(t, hex.EncodeToString([]byte("corrupted")), records[0].Key)
+	assert.NotEmpty(t, records[0].Error)
+	assert.Equal(t, hex.EncodeToString([]byte("tx hash 0")), records[1].Key)
+	assert.Equal(t, &transaction.Transaction{Nonce: 0}, records[1].Value)
+
+	dumpArgs.Limit = 2
+	records = make([]*Record, 0)
+	_ = dbi.Dump(dumpArgs, func(record *Record) bool {
+		records = append(records, record)
+		return true
+	})
+	assert.Equal(t, 2, len(records))
+
+	dumpArgs.Key = []byte("tx hash 4")
+	records = make([]*Record, 0)
+	err = dbi.Dump(dumpArgs, func(record *Record) bool {
+		records = append(records, record)
+		return true
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, &transaction.Transaction{Nonce: 4}, records[0].Value)
+
+	dumpArgs.Key = []byte("missing")
+	err = dbi.Dump(dumpArgs, func(_ *Record) bool {
+		return true
+	})
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+
+	dumpArgs.Epoch = 4
+	dumpArgs.Key = nil
+	err = dbi.Dump(dumpArgs, func(_ *Record) bool {
+		return true
+	})
+	assert.NotNil(t, err)
+	_, errStat := os.Stat(args.PathManager.PathForEpoch("1", 4, "Transactions"))
+	assert.True(t, os.IsNotExist(errStat))
+}
@@ -0,0 +1,10 @@
+package inspector
+
+// DbInspectorHandler defines the operations supported by the offline database inspector
+type DbInspectorHandler interface {
+	UnitNames() []string
+	ListDatabases() ([]*DatabaseInfo, error)
+	Dump(args ArgsDump, handler func(record *Record) bool) error
+	CheckTrie(args ArgsCheckTrie) (*TrieCheckReport, error)
+	IsInterfaceNil() bool
+}
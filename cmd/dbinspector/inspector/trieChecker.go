@@ -0,0 +1,168 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsCheckTrie holds the arguments needed for checking a trie storage unit
+type ArgsCheckTrie struct {
+	UnitName       string
+	Shard          string
+	RootHashes     [][]byte
+	RemoveDangling bool
+}
+
+// TrieCheckReport holds the result of a trie storage unit check
+type TrieCheckReport struct {
+	NumReachableNodes int      `json:"numReachableNodes"`
+	NumStoredKeys     int      `json:"numStoredKeys"`
+	MissingNodes      []string `json:"missingNodes"`
+	CorruptedNodes    []string `json:"corruptedNodes"`
+	DanglingKeys      []string `json:"danglingKeys"`
+	NumRemovedKeys    int      `json:"numRemovedKeys"`
+}
+
+// CheckTrie walks the tries starting from the provided root hashes and checks that every node is present and
+// can be decoded. For the accounts trie the data tries of the accounts are walked as well. All the stored
+// entries that are not reachable from the provided roots are reported as dangling and, if required, removed
+func (dbi *dbInspector) CheckTrie(args ArgsCheckTrie) (*TrieCheckReport, error) {
+	if len(args.RootHashes) == 0 {
+		return nil, ErrNoRootHash
+	}
+
+	unit, err := dbi.getUnit(args.UnitName)
+	if err != nil {
+		return nil, err
+	}
+	if unit.recordsType != trieNodeRecords {
+		return nil, fmt.Errorf("%w: %s", ErrNotATrieUnit, args.UnitName)
+	}
+
+	persister, err := dbi.openReadOnly(unit, args.Shard, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &TrieCheckReport{
+		MissingNodes:   make([]string, 0),
+		CorruptedNodes: make([]string, 0),
+		DanglingKeys:   make([]string, 0),
+	}
+	reachable := dbi.walkTries(persister, args.RootHashes, unit.name == accountsTrieUnit, report)
+	danglingKeys := dbi.findDanglingKeys(persister, reachable, report)
+	_ = persister.Close()
+
+	if !args.RemoveDangling || len(danglingKeys) == 0 {
+		return report, nil
+	}
+	if len(report.MissingNodes) > 0 || len(report.CorruptedNodes) > 0 {
+		return report, ErrIncompleteTrie
+	}
+
+	err = dbi.removeKeys(unit, args.Shard, danglingKeys, report)
+
+	return report, err
+}
+
+func (dbi *dbInspector) walkTries(
+	persister storage.Persister,
+	rootHashes [][]byte,
+	walkDataTries bool,
+	report *TrieCheckReport,
+) map[string]struct{} {
+	reachable := make(map[string]struct{})
+	queue := make([][]byte, 0, len(rootHashes))
+	queue = append(queue, rootHashes...)
+
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+
+		_, visited := reachable[string(hash)]
+		if visited {
+			continue
+		}
+
+		encNode, err := persister.Get(hash)
+		if err != nil {
+			report.MissingNodes = append(report.MissingNodes, hex.EncodeToString(hash))
+			continue
+		}
+
+		reachable[string(hash)] = struct{}{}
+		nodeData, err := trie.DecodeStoredNode(encNode, dbi.marshalizer, dbi.hasher)
+		if err != nil {
+			report.CorruptedNodes = append(report.CorruptedNodes, hex.EncodeToString(hash))
+			continue
+		}
+
+		queue = append(queue, nodeData.ChildrenHashes...)
+		if walkDataTries && nodeData.Type == trie.LeafNodeType {
+			queue = append(queue, dbi.getDataTrieRootHash(nodeData.Value)...)
+		}
+	}
+
+	report.NumReachableNodes = len(reachable)
+
+	return reachable
+}
+
+// getDataTrieRootHash returns the data trie root hash if the provided leaf value is an account with a data trie.
+// The leaves holding the smart contracts code are not accounts and will fail to unmarshal
+func (dbi *dbInspector) getDataTrieRootHash(leafValue []byte) [][]byte {
+	account := &state.UserAccountData{}
+	err := dbi.marshalizer.Unmarshal(account, leafValue)
+	if err != nil {
+		return nil
+	}
+	if len(account.RootHash) != dbi.hasher.Size() {
+		return nil
+	}
+
+	return [][]byte{account.RootHash}
+}
+
+func (dbi *dbInspector) findDanglingKeys(
+	persister storage.Persister,
+	reachable map[string]struct{},
+	report *TrieCheckReport,
+) [][]byte {
+	danglingKeys := make([][]byte, 0)
+	persister.RangeKeys(func(key []byte, _ []byte) bool {
+		report.NumStoredKeys++
+
+		_, isReachable := reachable[string(key)]
+		if !isReachable {
+			danglingKeys = append(danglingKeys, key)
+			report.DanglingKeys = append(report.DanglingKeys, hex.EncodeToString(key))
+		}
+
+		return true
+	})
+
+	return danglingKeys
+}
+
+func (dbi *dbInspector) removeKeys(unit *unitInfo, shard string, keys [][]byte, report *TrieCheckReport) error {
+	persister, err := dbi.openWritable(unit, shard, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = persister.Remove(key)
+		if err != nil {
+			_ = persister.Close()
+			return fmt.Errorf("%w while removing key %x", err, key)
+		}
+
+		report.NumRemovedKeys++
+	}
+
+	return persister.Close()
+}
@@ -0,0 +1,190 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTrieData struct {
+	rootHash     []byte
+	dataRootHash []byte
+	danglingKey  []byte
+}
+
+func createTestTrie(t *testing.T, args ArgsInspector, persister storage.Persister) data.Trie {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(persister)
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(trieStorage, args.Marshalizer, args.Hasher, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func saveTestAccountsTrie(t *testing.T, args ArgsInspector, shard string) *testTrieData {
+	persister := createTestPersister(t, args.PathManager.PathForStatic(shard, "AccountsTrie/MainDB"))
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	dataTrie := createTestTrie(t, args, persister)
+	_ = dataTrie.Update([]byte("key1"), []byte("value1"))
+	_ = dataTrie.Update([]byte("key2"), []byte("value2"))
+	require.Nil(t, dataTrie.Commit())
+	dataRootHash, _ := dataTrie.Root()
+
+	account := &state.UserAccountData{
+		Address:  []byte("address with data trie"),
+		RootHash: dataRootHash,
+	}
+	accountBytes, _ := args.Marshalizer.Marshal(account)
+
+	mainTrie := createTestTrie(t, args, persister)
+	_ = mainTrie.Update([]byte("address with data trie"), accountBytes)
+	_ = mainTrie.Update([]byte("address 1"), []byte("account 1"))
+	_ = mainTrie.Update([]byte("address 2"), []byte("account 2"))
+	require.Nil(t, mainTrie.Commit())
+	rootHash, _ := mainTrie.Root()
+
+	danglingKey := args.Hasher.Compute("dangling")
+	_ = persister.Put(danglingKey, []byte("dangling node"))
+
+	return &testTrieData{
+		rootHash:     rootHash,
+		dataRootHash: dataRootHash,
+		danglingKey:  danglingKey,
+	}
+}
+
+func TestDbInspector_CheckTrieInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbi, _ := NewDbInspector(createMockArgs("db"))
+
+	report, err := dbi.CheckTrie(ArgsCheckTrie{UnitName: accountsTrieUnit})
+	assert.Nil(t, report)
+	assert.Equal(t, ErrNoRootHash, err)
+
+	report, err = dbi.CheckTrie(ArgsCheckTrie{UnitName: "unknown", RootHashes: [][]byte{[]byte("root")}})
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, ErrUnknownUnit))
+
+	report, err = dbi.CheckTrie(ArgsCheckTrie{UnitName: "TxStorage", RootHashes: [][]byte{[]byte("root")}})
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, ErrNotATrieUnit))
+}
+
+func TestDbInspector_CheckTrieShouldWalkDataTriesAndReportDanglingKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	trieData := saveTestAccountsTrie(t, args, "0")
+	dbi, _ := NewDbInspector(args)
+
+	report, err := dbi.CheckTrie(ArgsCheckTrie{
+		UnitName:   accountsTrieUnit,
+		Shard:      "0",
+		RootHashes: [][]byte{trieData.rootHash},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.MissingNodes))
+	assert.Equal(t, 0, len(report.CorruptedNodes))
+	assert.Equal(t, []string{hex.EncodeToString(trieData.danglingKey)}, report.DanglingKeys)
+	assert.Equal(t, report.NumStoredKeys-1, report.NumReachableNodes)
+	assert.Equal(t, 0, report.NumRemovedKeys)
+
+	reportDataTrieOnly, err := dbi.CheckTrie(ArgsCheckTrie{
+		UnitName:   accountsTrieUnit,
+		Shard:      "0",
+		RootHashes: [][]byte{trieData.dataRootHash},
+	})
+	require.Nil(t, err)
+	assert.True(t, reportDataTrieOnly.NumReachableNodes < report.NumReachableNodes)
+}
+
+func TestDbInspector_CheckTrieShouldReportMissingRoot(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	trieData := saveTestAccountsTrie(t, args, "0")
+	dbi, _ := NewDbInspector(args)
+
+	missingRoot := args.Hasher.Compute("missing root")
+	report, err := dbi.CheckTrie(ArgsCheckTrie{
+		UnitName:       accountsTrieUnit,
+		Shard:          "0",
+		RootHashes:     [][]byte{trieData.rootHash, missingRoot},
+		RemoveDangling: true,
+	})
+	assert.Equal(t, ErrIncompleteTrie, err)
+	assert.Equal(t, []string{hex.EncodeToString(missingRoot)}, report.MissingNodes)
+	assert.Equal(t, 0, report.NumRemovedKeys)
+}
+
+func TestDbInspector_CheckTrieShouldReportCorruptedNodes(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	trieData := saveTestAccountsTrie(t, args, "0")
+	dbi, _ := NewDbInspector(args)
+
+	report, err := dbi.CheckTrie(ArgsCheckTrie{
+		UnitName:   accountsTrieUnit,
+		Shard:      "0",
+		RootHashes: [][]byte{trieData.rootHash, trieData.danglingKey},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{hex.EncodeToString(trieData.danglingKey)}, report.CorruptedNodes)
+	assert.Equal(t, 0, len(report.DanglingKeys))
+}
+
+func TestDbInspector_CheckTrieShouldRemoveDanglingKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgs(dir)
+	trieData := saveTestAccountsTrie(t, args, "0")
+	dbi, _ := NewDbInspector(args)
+
+	checkArgs := ArgsCheckTrie{
+		UnitName:       accountsTrieUnit,
+		Shard:          "0",
+		RootHashes:     [][]byte{trieData.rootHash},
+		RemoveDangling: true,
+	}
+	report, err := dbi.CheckTrie(checkArgs)
+	require.Nil(t, err)
+	assert.Equal(t, 1, report.NumRemovedKeys)
+
+	report, err = dbi.CheckTrie(checkArgs)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.DanglingKeys))
+	assert.Equal(t, report.NumStoredKeys, report.NumReachableNodes)
+}
@@ -0,0 +1,78 @@
+package inspector
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+type recordsType int
+
+const (
+	rawRecords recordsType = iota
+	shardHeaderRecords
+	metaBlockRecords
+	miniBlockRecords
+	transactionRecords
+	smartContractResultRecords
+	rewardTransactionRecords
+	nonceToHashRecords
+	trieNodeRecords
+)
+
+const accountsTrieUnit = "AccountsTrieStorage"
+const peerAccountsTrieUnit = "PeerAccountsTrieStorage"
+
+type unitInfo struct {
+	name        string
+	dbConfig    config.DBConfig
+	isStatic    bool
+	recordsType recordsType
+}
+
+func createUnits(cfg config.Config) map[string]*unitInfo {
+	units := []*unitInfo{
+		{name: "TxStorage", dbConfig: cfg.TxStorage.DB, recordsType: transactionRecords},
+		{name: "UnsignedTransactionStorage", dbConfig: cfg.UnsignedTransactionStorage.DB, recordsType: smartContractResultRecords},
+		{name: "RewardTxStorage", dbConfig: cfg.RewardTxStorage.DB, recordsType: rewardTransactionRecords},
+		{name: "MiniBlocksStorage", dbConfig: cfg.MiniBlocksStorage.DB, recordsType: miniBlockRecords},
+		{name: "PeerBlockBodyStorage", dbConfig: cfg.PeerBlockBodyStorage.DB, recordsType: miniBlockRecords},
+		{name: "BlockHeaderStorage", dbConfig: cfg.BlockHeaderStorage.DB, recordsType: shardHeaderRecords},
+		{name: "MetaBlockStorage", dbConfig: cfg.MetaBlockStorage.DB, recordsType: metaBlockRecords},
+		{name: "ReceiptsStorage", dbConfig: cfg.ReceiptsStorage.DB, recordsType: rawRecords},
+		{name: "BootstrapStorage", dbConfig: cfg.BootstrapStorage.DB, recordsType: rawRecords},
+		{name: "TxLogsStorage", dbConfig: cfg.TxLogsStorage.DB, recordsType: rawRecords},
+		{name: "MetaHdrNonceHashStorage", dbConfig: cfg.MetaHdrNonceHashStorage.DB, isStatic: true, recordsType: nonceToHashRecords},
+		{name: "StatusMetricsStorage", dbConfig: cfg.StatusMetricsStorage.DB, isStatic: true, recordsType: rawRecords},
+		{name: "HeartbeatStorage", dbConfig: cfg.Heartbeat.HeartbeatStorage.DB, isStatic: true, recordsType: rawRecords},
+		{name: accountsTrieUnit, dbConfig: cfg.AccountsTrieStorage.DB, isStatic: true, recordsType: trieNodeRecords},
+		{name: peerAccountsTrieUnit, dbConfig: cfg.PeerAccountsTrieStorage.DB, isStatic: true, recordsType: trieNodeRecords},
+	}
+
+	unitsMap := make(map[string]*unitInfo, len(units))
+	for _, unit := range units {
+		if len(unit.dbConfig.FilePath) == 0 {
+			continue
+		}
+
+		unitsMap[unit.name] = unit
+	}
+
+	return unitsMap
+}
+
+// unitNamesForDirectory returns the names of the configured units that are stored in the provided directory
+func unitNamesForDirectory(units map[string]*unitInfo, directory string) []string {
+	names := make([]string, 0)
+	for name, unit := range units {
+		topDirectory := strings.Split(unit.dbConfig.FilePath, "/")[0]
+		if topDirectory == directory {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbinspector/inspector"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	dbPath             string
+	nodeConfigFilePath string
+	shard              string
+	epoch              int
+	static             bool
+	unit               string
+	key                string
+	limit              int
+	rootHashes         cli.StringSlice
+	removeDangling     bool
+}
+
+var (
+	nodeHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPathFlag defines a flag for setting the db path where the node's databases are held in
+	dbPathFlag = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "This string flag specifies the path for the database directory, the chain ID directory",
+		Value:       "db",
+		Destination: &flagsValues.dbPath,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// shardFlag defines a flag for the shard whose databases are inspected
+	shardFlag = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "This string flag specifies the shard of the databases: a shard number or metachain",
+		Value:       "0",
+		Destination: &flagsValues.shard,
+	}
+
+	// epochFlag defines a flag for the epoch whose databases are inspected
+	epochFlag = cli.IntFlag{
+		Name:        "epoch",
+		Usage:       "This int flag specifies the epoch of the databases. It is ignored for the static units",
+		Value:       0,
+		Destination: &flagsValues.epoch,
+	}
+
+	// unitFlag defines a flag for the storage unit to be inspected
+	unitFlag = cli.StringFlag{
+		Name:        "unit",
+		Usage:       "This string flag specifies the storage unit, named as in the node's configuration file (e.g. TxStorage)",
+		Destination: &flagsValues.unit,
+	}
+
+	// keyFlag defines a flag for the hex encoded key to be fetched
+	keyFlag = cli.StringFlag{
+		Name:        "key",
+		Usage:       "This string flag specifies the hex encoded key to be fetched. If not set, all the records are dumped",
+		Destination: &flagsValues.key,
+	}
+
+	// limitFlag defines a flag for the maximum number of dumped records
+	limitFlag = cli.IntFlag{
+		Name:        "limit",
+		Usage:       "This int flag specifies the maximum number of dumped records. 0 means no limit",
+		Value:       100,
+		Destination: &flagsValues.limit,
+	}
+
+	// trieUnitFlag defines a flag for the trie storage unit to be checked
+	trieUnitFlag = cli.StringFlag{
+		Name:        "unit",
+		Usage:       "This string flag specifies the trie storage unit: AccountsTrieStorage or PeerAccountsTrieStorage",
+		Value:       "AccountsTrieStorage",
+		Destination: &flagsValues.unit,
+	}
+
+	// rootHashFlag defines a flag for the hex encoded root hashes the tries are walked from
+	rootHashFlag = cli.StringSliceFlag{
+		Name: "root",
+		Usage: "This string flag specifies a hex encoded root hash the trie is walked from. " +
+			"It can be repeated, the nodes not reachable from any of the roots are reported as dangling",
+		Value: &flagsValues.rootHashes,
+	}
+
+	// removeDanglingFlag defines a flag that enables the removal of the dangling entries
+	removeDanglingFlag = cli.BoolFlag{
+		Name:        "remove-dangling",
+		Usage:       "Boolean option for removing the dangling entries. The database is opened for writing only if set",
+		Destination: &flagsValues.removeDangling,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("dbinspector")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = nodeHelpTemplate
+	cliApp.Name = "Elrond database inspector"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond dbinspector application is used to inspect and repair the databases of a stopped node"
+	cliApp.Flags = []cli.Flag{
+		dbPathFlag,
+		nodeConfigFilePathFlag,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the epochs, shards and storage units found in the database directory",
+			Action: listDatabases,
+		},
+		{
+			Name:  "dump",
+			Usage: "decodes and prints the records of a storage unit",
+			Flags: []cli.Flag{
+				shardFlag,
+				epochFlag,
+				unitFlag,
+				keyFlag,
+				limitFlag,
+			},
+			Action: dumpUnit,
+		},
+		{
+			Name:  "check-trie",
+			Usage: "walks a trie from the provided root hashes and reports the missing, corrupted and dangling nodes",
+			Flags: []cli.Flag{
+				shardFlag,
+				trieUnitFlag,
+				rootHashFlag,
+				removeDanglingFlag,
+			},
+			Action: checkTrie,
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func createInspector() (inspector.DbInspectorHandler, error) {
+	nodeConfig := config.Config{}
+	err := core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !core.DoesFileExist(flagsValues.dbPath) {
+		return nil, fmt.Errorf("no db directory found. Path: %s", flagsValues.dbPath)
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(nodeConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := pathmanager.NewPathManager(
+		filepath.Join(
+			flagsValues.dbPath,
+			fmt.Sprintf("%s_%s", factory.DefaultEpochString, core.PathEpochPlaceholder),
+			fmt.Sprintf("%s_%s", factory.DefaultShardString, core.PathShardPlaceholder),
+			core.PathIdentifierPlaceholder),
+		filepath.Join(
+			flagsValues.dbPath,
+			factory.DefaultStaticDbString,
+			fmt.Sprintf("%s_%s", factory.DefaultShardString, core.PathShardPlaceholder),
+			core.PathIdentifierPlaceholder),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.NewDbInspector(inspector.ArgsInspector{
+		DbPathWithChainID: flagsValues.dbPath,
+		GeneralConfig:     nodeConfig,
+		Marshalizer:       marshalizer,
+		Hasher:            hasher,
+		PathManager:       pathManager,
+		DirectoryReader:   storageFactory.NewDirectoryReader(),
+	})
+}
+
+func listDatabases(_ *cli.Context) error {
+	dbi, err := createInspector()
+	if err != nil {
+		return err
+	}
+
+	dbs, err := dbi.ListDatabases()
+	if err != nil {
+		return err
+	}
+
+	return printJSON(dbs)
+}
+
+func dumpUnit(_ *cli.Context) error {
+	dbi, err := createInspector()
+	if err != nil {
+		return err
+	}
+	if len(flagsValues.unit) == 0 {
+		return fmt.Errorf("no unit provided, available units: %v", dbi.UnitNames())
+	}
+
+	key, err := hex.DecodeString(flagsValues.key)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the key", err)
+	}
+
+	args := inspector.ArgsDump{
+		UnitName: flagsValues.unit,
+		Shard:    flagsValues.shard,
+		Epoch:    uint32(flagsValues.epoch),
+		Key:      key,
+		Limit:    flagsValues.limit,
+	}
+
+	var errPrint error
+	err = dbi.Dump(args, func(record *inspector.Record) bool {
+		errPrint = printJSON(record)
+		return errPrint == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return errPrint
+}
+
+func checkTrie(_ *cli.Context) error {
+	dbi, err := createInspector()
+	if err != nil {
+		return err
+	}
+
+	rootHashes := make([][]byte, 0, len(flagsValues.rootHashes))
+	for _, rootHashString := range flagsValues.rootHashes {
+		rootHash, errDecode := hex.DecodeString(rootHashString)
+		if errDecode != nil {
+			return fmt.Errorf("%w while decoding the root hash %s", errDecode, rootHashString)
+		}
+
+		rootHashes = append(rootHashes, rootHash)
+	}
+
+	args := inspector.ArgsCheckTrie{
+		UnitName:       flagsValues.unit,
+		Shard:          flagsValues.shard,
+		RootHashes:     rootHashes,
+		RemoveDangling: flagsValues.removeDangling,
+	}
+
+	report, errCheck := dbi.CheckTrie(args)
+	if report != nil {
+		err = printJSON(report)
+		if err != nil {
+			return err
+		}
+	}
+	if errCheck != nil {
+		return errCheck
+	}
+
+	isTrieComplete := len(report.MissingNodes) == 0 && len(report.CorruptedNodes) == 0
+	if !isTrieComplete {
+		return errors.New("the trie check failed")
+	}
+
+	log.Info("trie check finished",
+		"reachable nodes", report.NumReachableNodes,
+		"dangling keys", len(report.DanglingKeys),
+		"removed keys", report.NumRemovedKeys,
+	)
+
+	return nil
+}
+
+func printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
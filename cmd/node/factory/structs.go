@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftwareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -54,10 +55,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -129,6 +133,9 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	GuardedAccountHandler    process.GuardedAccountHandler
+	MultisigAccountHandler   process.MultisigAccountHandler
+	ValidatorParticipation   process.ValidatorParticipationRecorder
 }
 
 type processComponentsFactoryArgs struct {
@@ -178,6 +185,7 @@ type processComponentsFactoryArgs struct {
 	storageReolverImportPath  string
 	chanGracefullyClose       chan endProcess.ArgEndProcess
 	fallbackHeaderValidator   process.FallbackHeaderValidator
+	governanceActionsHandler  epochStart.GovernanceActionsHandler
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -226,6 +234,7 @@ func NewProcessComponentsFactoryArgs(
 	storageReolverImportPath string,
 	chanGracefullyClose chan endProcess.ArgEndProcess,
 	fallbackHeaderValidator process.FallbackHeaderValidator,
+	governanceActionsHandler epochStart.GovernanceActionsHandler,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
 		coreComponents:            coreComponents,
@@ -273,6 +282,7 @@ func NewProcessComponentsFactoryArgs(
 		storageReolverImportPath:  storageReolverImportPath,
 		chanGracefullyClose:       chanGracefullyClose,
 		fallbackHeaderValidator:   fallbackHeaderValidator,
+		governanceActionsHandler:  governanceActionsHandler,
 	}
 }
 
@@ -377,7 +387,12 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	validatorStatisticsProcessor, err := newValidatorStatisticsProcessor(args)
+	participationRecorder, err := newValidatorParticipationRecorder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorStatisticsProcessor, err := newValidatorStatisticsProcessor(args, participationRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -482,6 +497,35 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	argsGuardedAccount := guardian.ArgsGuardedAccount{
+		Marshalizer:                args.coreData.InternalMarshalizer,
+		EpochNotifier:              args.epochNotifier,
+		TxVersionChecker:           versioning.NewTxVersionChecker(args.coreData.MinTransactionVersion),
+		ActivationEpochsDelay:      args.mainConfig.GeneralSettings.GuardianActivationEpochsDelay,
+		GuardedAccountsEnableEpoch: args.mainConfig.GeneralSettings.GuardedAccountsEnableEpoch,
+	}
+	guardedAccounts, err := guardian.NewGuardedAccount(argsGuardedAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	argsMultisigAccount := multisig.ArgsMultisigAccount{
+		Marshalizer:                 args.coreData.InternalMarshalizer,
+		Accounts:                    args.state.AccountsAdapter,
+		KeyGen:                      args.crypto.TxSignKeyGen,
+		SingleSigner:                args.crypto.TxSingleSigner,
+		PubkeyConv:                  args.state.AddressPubkeyConverter,
+		SignMarshalizer:             args.coreData.TxSignMarshalizer,
+		TxSignHasher:                args.coreData.TxSignHasher,
+		TxVersionChecker:            versioning.NewTxVersionChecker(args.coreData.MinTransactionVersion),
+		EpochNotifier:               args.epochNotifier,
+		MultisigAccountsEnableEpoch: args.mainConfig.GeneralSettings.MultisigAccountsEnableEpoch,
+	}
+	multisigAccounts, err := multisig.NewMultisigAccount(argsMultisigAccount)
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, blackListHandler, err := newInterceptorContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
@@ -499,7 +543,10 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.whiteListHandler,
 		args.whiteListerVerifiedTxs,
 		args.mainConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		args.mainConfig.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 		args.epochNotifier,
+		guardedAccounts,
+		multisigAccounts,
 	)
 	if err != nil {
 		return nil, err
@@ -537,11 +584,14 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		epochStartTrigger,
 		bootStorer,
 		validatorStatisticsProcessor,
+		participationRecorder,
 		headerValidator,
 		blockTracker,
 		pendingMiniBlocksHandler,
 		args.txSimulatorProcessorArgs,
 		headerIntegrityVerifier,
+		guardedAccounts,
+		multisigAccounts,
 	)
 	if err != nil {
 		return nil, err
@@ -586,6 +636,9 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		GuardedAccountHandler:    guardedAccounts,
+		MultisigAccountHandler:   multisigAccounts,
+		ValidatorParticipation:   participationRecorder,
 	}, nil
 }
 
@@ -717,7 +770,7 @@ func indexGenesisBlocks(args *processComponentsFactoryArgs, genesisBlocks map[ui
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -846,7 +899,10 @@ func newInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	transactionSignedWithTxHashEnableEpoch uint32,
+	multiRelayedTxEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return newShardInterceptorContainerFactory(
@@ -866,7 +922,10 @@ func newInterceptorContainerFactory(
 			whiteListHandler,
 			whiteListerVerifiedTxs,
 			transactionSignedWithTxHashEnableEpoch,
+			multiRelayedTxEnableEpoch,
 			epochNotifier,
+			guardedAccounts,
+			multisigAccounts,
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -887,7 +946,10 @@ func newInterceptorContainerFactory(
 			whiteListHandler,
 			whiteListerVerifiedTxs,
 			transactionSignedWithTxHashEnableEpoch,
+			multiRelayedTxEnableEpoch,
 			epochNotifier,
+			guardedAccounts,
+			multisigAccounts,
 		)
 	}
 
@@ -1110,7 +1172,10 @@ func newShardInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	signedTransactionWithTxHashEnableEpoch uint32,
+	multiRelayedTxEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	shardInterceptorsContainerFactoryArgs := interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
@@ -1144,8 +1209,11 @@ func newShardInterceptorContainerFactory(
 		ChainID:                   dataCore.ChainID,
 		MinTransactionVersion:     dataCore.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
+		MultiRelayedTxEnableEpoch: multiRelayedTxEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
+		GuardedAccounts:           guardedAccounts,
+		MultisigAccounts:          multisigAccounts,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1172,7 +1240,10 @@ func newMetaInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	signedTransactionWithTxHashEnableEpoch uint32,
+	multiRelayedTxEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	metaInterceptorsContainerFactoryArgs := interceptorscontainer.MetaInterceptorsContainerFactoryArgs{
@@ -1206,8 +1277,11 @@ func newMetaInterceptorContainerFactory(
 		ChainID:                   dataCore.ChainID,
 		MinTransactionVersion:     dataCore.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
+		MultiRelayedTxEnableEpoch: multiRelayedTxEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
+		GuardedAccounts:           guardedAccounts,
+		MultisigAccounts:          multisigAccounts,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1406,11 +1480,14 @@ func newBlockProcessor(
 	epochStartTrigger epochStart.TriggerHandler,
 	bootStorer process.BootStorer,
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor,
+	participationRecorder process.ValidatorParticipationRecorder,
 	headerValidator process.HeaderConstructionValidator,
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1446,6 +1523,8 @@ func newBlockProcessor(
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
 			workingDir,
+			guardedAccounts,
+			multisigAccounts,
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -1459,6 +1538,7 @@ func newBlockProcessor(
 			forkDetector,
 			processArgs.economicsData,
 			validatorStatisticsProcessor,
+			participationRecorder,
 			processArgs.rounder,
 			epochStartTrigger,
 			bootStorer,
@@ -1467,6 +1547,7 @@ func newBlockProcessor(
 			pendingMiniBlocksHandler,
 			processArgs.stateCheckpointModulus,
 			processArgs.crypto.MessageSignVerifier,
+			processArgs.crypto.SlashingSigVerifier,
 			processArgs.gasSchedule,
 			processArgs.minSizeInBytes,
 			processArgs.maxSizeInBytes,
@@ -1483,6 +1564,9 @@ func newBlockProcessor(
 			processArgs.mainConfig,
 			workingDir,
 			processArgs.rater,
+			guardedAccounts,
+			multisigAccounts,
+			processArgs.governanceActionsHandler,
 		)
 	}
 
@@ -1518,6 +1602,8 @@ func newShardBlockProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
 	workingDir string,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (process.BlockProcessor, error) {
 	argsParser := smartContract.NewArgumentParser()
 
@@ -1527,10 +1613,19 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                  gasSchedule,
+		MapDNSAddresses:              mapDNSAddresses,
+		Marshalizer:                  core.InternalMarshalizer,
+		Accounts:                     stateComponents.AccountsAdapter,
+		ShardCoordinator:             shardCoordinator,
+		GuardedAccounts:              guardedAccounts,
+		MultisigAccounts:             multisigAccounts,
+		EpochNotifier:                epochNotifier,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLocalRolesEnableEpoch:    generalConfig.GeneralSettings.ESDTLocalRolesEnableEpoch,
+		GuardedAccountsEnableEpoch:   generalConfig.GeneralSettings.GuardedAccountsEnableEpoch,
+		MultisigAccountsEnableEpoch:  generalConfig.GeneralSettings.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1611,10 +1706,12 @@ func newShardBlockProcessor(
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:          shardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             epochNotifier,
+		MultiRelayedTxEnableEpoch: generalConfig.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1684,16 +1781,32 @@ func newShardBlockProcessor(
 		ArgsParser:                     argsParser,
 		ScrForwarder:                   scForwarder,
 		RelayedTxEnableEpoch:           config.GeneralSettings.RelayedTransactionsEnableEpoch,
+		MultiRelayedTxEnableEpoch:      config.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      config.GeneralSettings.MetaProtectionEnableEpoch,
 		EpochNotifier:                  epochNotifier,
+		GuardedAccounts:                guardedAccounts,
+		MultisigAccounts:               multisigAccounts,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
-	err = createShardTxSimulatorProcessor(argsNewScProcessor, argsNewTxProcessor, shardCoordinator, data, core, stateComponents, txSimulatorProcessorArgs)
+	err = createShardTxSimulatorProcessor(
+		argsBuiltIn,
+		argsHook,
+		argsNewScProcessor,
+		argsNewTxProcessor,
+		config,
+		economics,
+		gasSchedule,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txSimulatorProcessorArgs,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1817,6 +1930,7 @@ func newMetaBlockProcessor(
 	forkDetector process.ForkDetector,
 	economicsData process.EconomicsDataHandler,
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor,
+	participationRecorder process.ValidatorParticipationRecorder,
 	rounder consensus.Rounder,
 	epochStartTrigger epochStart.TriggerHandler,
 	bootStorer process.BootStorer,
@@ -1825,6 +1939,7 @@ func newMetaBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	stateCheckpointModulus uint,
 	messageSignVerifier vm.MessageSignVerifier,
+	slashingSigVerifier vm.MessageSignVerifier,
 	gasSchedule core.GasScheduleNotifier,
 	minSizeInBytes uint32,
 	maxSizeInBytes uint32,
@@ -1841,13 +1956,25 @@ func newMetaBlockProcessor(
 	generalConfig config.Config,
 	workingDir string,
 	rater sharding.PeerAccountListAndRatingHandler,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
+	governanceActionsHandler epochStart.GovernanceActionsHandler,
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                  gasSchedule,
+		MapDNSAddresses:              make(map[string]struct{}), // no dns for meta
+		Marshalizer:                  core.InternalMarshalizer,
+		Accounts:                     stateComponents.AccountsAdapter,
+		ShardCoordinator:             shardCoordinator,
+		GuardedAccounts:              guardedAccounts,
+		MultisigAccounts:             multisigAccounts,
+		EpochNotifier:                epochNotifier,
+		ESDTNFTEnableEpoch:           generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTMultiTransferEnableEpoch: generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLocalRolesEnableEpoch:    generalConfig.GeneralSettings.ESDTLocalRolesEnableEpoch,
+		GuardedAccountsEnableEpoch:   generalConfig.GeneralSettings.GuardedAccountsEnableEpoch,
+		MultisigAccountsEnableEpoch:  generalConfig.GeneralSettings.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1872,18 +1999,34 @@ func newMetaBlockProcessor(
 		WorkingDir:         workingDir,
 		NilCompiledSCStore: false,
 	}
+
+	argsProofVerifier := slash.ArgDoubleSigningProofVerifier{
+		Marshalizer:      core.InternalMarshalizer,
+		Hasher:           core.Hasher,
+		SigVerifier:      slashingSigVerifier,
+		NodesCoordinator: nodesCoordinator,
+		ChainID:          core.ChainID,
+	}
+	proofVerifier, err := slash.NewDoubleSigningProofVerifier(argsProofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
 	argsNewVMContainer := metachain.ArgsNewVMContainerFactory{
-		ArgBlockChainHook:   argsHook,
-		Economics:           economicsData,
-		MessageSignVerifier: messageSignVerifier,
-		GasSchedule:         gasSchedule,
-		NodesConfigProvider: nodesSetup,
-		Hasher:              core.Hasher,
-		Marshalizer:         core.InternalMarshalizer,
-		SystemSCConfig:      systemSCConfig,
-		ValidatorAccountsDB: stateComponents.PeerAccounts,
-		ChanceComputer:      rater,
-		EpochNotifier:       epochNotifier,
+		ArgBlockChainHook:         argsHook,
+		Economics:                 economicsData,
+		MessageSignVerifier:       messageSignVerifier,
+		ProofVerifier:             proofVerifier,
+		GasSchedule:               gasSchedule,
+		NodesConfigProvider:       nodesSetup,
+		Hasher:                    core.Hasher,
+		Marshalizer:               core.InternalMarshalizer,
+		SystemSCConfig:            systemSCConfig,
+		ValidatorAccountsDB:       stateComponents.PeerAccounts,
+		ChanceComputer:            rater,
+		EpochNotifier:             epochNotifier,
+		ESDTNFTEnableEpoch:        generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTLocalRolesEnableEpoch: generalConfig.GeneralSettings.ESDTLocalRolesEnableEpoch,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
 	if err != nil {
@@ -1925,10 +2068,12 @@ func newMetaBlockProcessor(
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:          shardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             epochNotifier,
+		MultiRelayedTxEnableEpoch: generalConfig.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -1991,7 +2136,10 @@ func newMetaBlockProcessor(
 	}
 
 	err = createMetaTxSimulatorProcessor(
+		argsBuiltIn,
+		argsNewVMContainer,
 		argsNewScProcessor,
+		generalConfig,
 		shardCoordinator,
 		data,
 		core,
@@ -2219,11 +2367,14 @@ func newMetaBlockProcessor(
 		SwitchHysteresisForMinNodesEnableEpoch: generalConfig.GeneralSettings.SwitchHysteresisForMinNodesEnableEpoch,
 		DelegationEnableEpoch:                  systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
 		StakingV2EnableEpoch:                   systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		GovernanceEnableEpoch:                  systemSCConfig.GovernanceSystemSCConfig.EnabledEpoch,
+		SlashingEnableEpoch:                    systemSCConfig.StakingSystemSCConfig.DoubleSigningSlashingEnableEpoch,
 		GenesisNodesConfig:                     nodesSetup,
 		MaxNodesEnableConfig:                   generalConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
 		StakingDataProvider:                    stakingDataProvider,
 		NodesConfigProvider:                    nodesCoordinator,
 		ShardCoordinator:                       shardCoordinator,
+		GovernanceActionsHandler:               governanceActionsHandler,
 	}
 	epochStartSystemSCProcessor, err := metachainEpochStart.NewSystemSCProcessor(argsEpochSystemSC)
 	if err != nil {
@@ -2231,16 +2382,18 @@ func newMetaBlockProcessor(
 	}
 
 	arguments := block.ArgMetaProcessor{
-		ArgBaseProcessor:             argumentsBaseProcessor,
-		SCToProtocol:                 smartContractToProtocol,
-		PendingMiniBlocksHandler:     pendingMiniBlocksHandler,
-		EpochStartDataCreator:        epochStartDataCreator,
-		EpochEconomics:               epochEconomics,
-		EpochRewardsCreator:          epochRewards,
-		EpochValidatorInfoCreator:    validatorInfoCreator,
-		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
-		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
-		RewardsV2EnableEpoch:         systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		ArgBaseProcessor:               argumentsBaseProcessor,
+		SCToProtocol:                   smartContractToProtocol,
+		PendingMiniBlocksHandler:       pendingMiniBlocksHandler,
+		EpochStartDataCreator:          epochStartDataCreator,
+		EpochEconomics:                 epochEconomics,
+		EpochRewardsCreator:            epochRewards,
+		EpochValidatorInfoCreator:      validatorInfoCreator,
+		ValidatorStatisticsProcessor:   validatorStatisticsProcessor,
+		EpochSystemSCProcessor:         epochStartSystemSCProcessor,
+		RewardsV2EnableEpoch:           systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		ValidatorParticipationRecorder: participationRecorder,
+		GovernanceConfigChanges:        governanceActionsHandler,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -2256,16 +2409,91 @@ func newMetaBlockProcessor(
 	return metaProcessor, nil
 }
 
+// createSimulationBlockChainHookArgs returns the arguments of a blockchain hook working over the simulation accounts,
+// having its own built-in functions and compiled smart contracts cache, so that the simulated transactions never
+// touch the node's state
+func createSimulationBlockChainHookArgs(
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
+	simulationAccounts state.AccountsAdapter,
+	generalConfig config.Config,
+) (hooks.ArgBlockChainHook, error) {
+	argsBuiltIn.Accounts = simulationAccounts
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		return hooks.ArgBlockChainHook{}, err
+	}
+	builtInFuncs, err := builtInFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return hooks.ArgBlockChainHook{}, err
+	}
+
+	smartContractsCache, err := createCache(generalConfig.SmartContractDataPool)
+	if err != nil {
+		return hooks.ArgBlockChainHook{}, err
+	}
+
+	argsHook.Accounts = simulationAccounts
+	argsHook.BuiltInFunctions = builtInFuncs
+	argsHook.CompiledSCPool = smartContractsCache
+	argsHook.NilCompiledSCStore = true
+
+	return argsHook, nil
+}
+
+// createShardTxSimulatorProcessor does nothing if the transaction simulation is disabled, in which case the arguments
+// are nil, as the simulator needs its own virtual machines
 func createShardTxSimulatorProcessor(
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	txProcArgs transaction.ArgsNewTxProcessor,
+	config *config.Config,
+	economics process.EconomicsDataHandler,
+	gasSchedule core.GasScheduleNotifier,
 	shardCoordinator sharding.Coordinator,
 	data *mainFactory.DataComponents,
 	core *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 ) error {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
+	if txSimulatorProcessorArgs == nil {
+		return nil
+	}
+
+	simulationAccounts, err := txsimulator.NewSimulationAccountsDB(
+		stateComponents.AccountsAdapter,
+		core.Hasher,
+		core.InternalMarshalizer,
+		stateComponents.AddressPubkeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	argsSimulationHook, err := createSimulationBlockChainHookArgs(argsBuiltIn, argsHook, simulationAccounts, *config)
+	if err != nil {
+		return err
+	}
+
+	vmFactory, err := shard.NewVMContainerFactory(
+		config.VirtualMachine.Execution,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+		gasSchedule,
+		argsSimulationHook,
+		config.GeneralSettings.SCDeployEnableEpoch,
+		config.GeneralSettings.AheadOfTimeGasUsageEnableEpoch,
+	)
+	if err != nil {
+		return err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return err
+	}
+
+	err = builtInFunctions.SetPayableHandler(argsSimulationHook.BuiltInFunctions, vmFactory.BlockChainHookImpl())
 	if err != nil {
 		return err
 	}
@@ -2309,7 +2537,10 @@ func createShardTxSimulatorProcessor(
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 	txProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	scProcArgs.AccountsDB = readOnlyAccountsDB
+	scProcArgs.AccountsDB = simulationAccounts
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.BuiltInFunctions = vmFactory.BlockChainHookImpl().GetBuiltInFunctions()
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
@@ -2317,7 +2548,7 @@ func createShardTxSimulatorProcessor(
 	}
 	txProcArgs.ScProcessor = scProcessor
 
-	txProcArgs.Accounts = readOnlyAccountsDB
+	txProcArgs.Accounts = simulationAccounts
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(txProcArgs)
 	if err != nil {
@@ -2325,12 +2556,19 @@ func createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = simulationAccounts
+	txSimulatorProcessorArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
 
 	return nil
 }
 
+// createMetaTxSimulatorProcessor does nothing if the transaction simulation is disabled, in which case the arguments
+// are nil, as the simulator needs its own virtual machines
 func createMetaTxSimulatorProcessor(
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMContainer metachain.ArgsNewVMContainerFactory,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
+	generalConfig config.Config,
 	shardCoordinator sharding.Coordinator,
 	data *mainFactory.DataComponents,
 	core *mainFactory.CoreComponents,
@@ -2340,6 +2578,46 @@ func createMetaTxSimulatorProcessor(
 	epochNotifier process.EpochNotifier,
 	systemSCConfig *config.SystemSmartContractsConfig,
 ) error {
+	if txSimulatorProcessorArgs == nil {
+		return nil
+	}
+
+	simulationAccounts, err := txsimulator.NewSimulationAccountsDB(
+		stateComponents.AccountsAdapter,
+		core.Hasher,
+		core.InternalMarshalizer,
+		stateComponents.AddressPubkeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
+	argsNewVMContainer.ArgBlockChainHook, err = createSimulationBlockChainHookArgs(
+		argsBuiltIn,
+		argsNewVMContainer.ArgBlockChainHook,
+		simulationAccounts,
+		generalConfig,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the system smart contracts should not change the validators' state either
+	argsNewVMContainer.ValidatorAccountsDB, err = txsimulator.NewReadOnlyAccountsDB(stateComponents.PeerAccounts)
+	if err != nil {
+		return err
+	}
+
+	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
+	if err != nil {
+		return err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return err
+	}
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -2370,21 +2648,20 @@ func createMetaTxSimulatorProcessor(
 	scProcArgs.BadTxForwarder = badTxInterim
 
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
+	scProcArgs.AccountsDB = simulationAccounts
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.BuiltInFunctions = vmFactory.BlockChainHookImpl().GetBuiltInFunctions()
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return err
 	}
 
-	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
-	if err != nil {
-		return err
-	}
-
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
 		Hasher:           core.Hasher,
 		Marshalizer:      core.InternalMarshalizer,
-		Accounts:         accountsWrapper,
+		Accounts:         simulationAccounts,
 		PubkeyConv:       stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
 		ScProcessor:      scProcessor,
@@ -2399,12 +2676,34 @@ func createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = simulationAccounts
+	txSimulatorProcessorArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
 
 	return nil
 }
 
+// newValidatorParticipationRecorder creates the recorder of the validators participation history, which is built only
+// by the metachain nodes, as they are the ones computing the validators statistics
+func newValidatorParticipationRecorder(
+	processComponents *processComponentsFactoryArgs,
+) (process.ValidatorParticipationRecorder, error) {
+	participationConfig := processComponents.mainConfig.ValidatorParticipation
+	if !participationConfig.Enabled || processComponents.shardCoordinator.SelfId() != core.MetachainShardId {
+		return peer.NewDisabledValidatorParticipationRecorder(), nil
+	}
+
+	argsRecorder := peer.ArgValidatorParticipationRecorder{
+		Storer:       processComponents.data.Store.GetStorer(dataRetriever.ValidatorParticipationUnit),
+		Marshalizer:  processComponents.coreData.InternalMarshalizer,
+		MaxNumEpochs: participationConfig.MaxNumEpochs,
+	}
+
+	return peer.NewValidatorParticipationRecorder(argsRecorder)
+}
+
 func newValidatorStatisticsProcessor(
 	processComponents *processComponentsFactoryArgs,
+	participationRecorder process.ValidatorParticipationRecorder,
 ) (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := processComponents.data.Store
@@ -2437,6 +2736,7 @@ func newValidatorStatisticsProcessor(
 		EpochNotifier:                   processComponents.epochNotifier,
 		SwitchJailWaitingEnableEpoch:    processComponents.mainConfig.GeneralSettings.SwitchJailWaitingEnableEpoch,
 		BelowSignedThresholdEnableEpoch: processComponents.mainConfig.GeneralSettings.BelowSignedThresholdEnableEpoch,
+		ParticipationRecorder:           participationRecorder,
 	}
 
 	validatorStatisticsProcessor, err := peer.NewValidatorStatisticsProcessor(arguments)
@@ -45,8 +45,11 @@ import (
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	debugFactory "github.com/ElrondNetwork/elrond-go/debug/factory"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
+	metachainEpochStart "github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -57,22 +60,30 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/governanceAPI"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
+	"github.com/ElrondNetwork/elrond-go/node/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
+	disabledTxSimulator "github.com/ElrondNetwork/elrond-go/node/txsimulator/disabled"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -1188,6 +1199,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	elasticIndexer, err := createElasticIndexer(
 		externalConfig.ElasticSearchConnector,
+		externalConfig.IndexerOutputs,
 		coreComponents.InternalMarshalizer,
 		coreComponents.Hasher,
 		nodesCoordinator,
@@ -1234,11 +1246,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
-		SelfShardID: shardCoordinator.SelfId(),
-		Config:      generalConfig.DbLookupExtensions,
-		Hasher:      coreComponents.Hasher,
-		Marshalizer: coreComponents.InternalMarshalizer,
-		Store:       dataComponents.Store,
+		SelfShardID:      shardCoordinator.SelfId(),
+		Config:           generalConfig.DbLookupExtensions,
+		Hasher:           coreComponents.Hasher,
+		Marshalizer:      coreComponents.InternalMarshalizer,
+		Store:            dataComponents.Store,
+		ShardCoordinator: shardCoordinator,
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1250,9 +1263,14 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
-		AddressPubKeyConverter: addressPubkeyConverter,
-		ShardCoordinator:       shardCoordinator,
+	// the transaction simulator is created only if its routes are open as it runs its own virtual machines
+	var txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator
+	if isTransactionSimulationEnabled(*apiRoutesConfig) {
+		txSimulatorProcessorArgs = &txsimulator.ArgsTxSimulator{
+			AddressPubKeyConverter: addressPubkeyConverter,
+			ShardCoordinator:       shardCoordinator,
+			BlockChain:             dataComponents.Blkc,
+		}
 	}
 
 	fallbackHeaderValidator, err := fallback.NewFallbackHeaderValidator(
@@ -1264,6 +1282,24 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	argsGovernanceActionsHandler := metachainEpochStart.ArgsGovernanceActionsHandler{
+		GasScheduleNotifier: gasScheduleNotifier,
+		EconomicsData:       economicsData,
+		MetaBlockStorage:    dataComponents.Store.GetStorer(dataRetriever.MetaBlockUnit),
+		Marshalizer:         coreComponents.InternalMarshalizer,
+		EpochNotifier:       epochNotifier,
+	}
+	governanceActionsHandler, err := metachainEpochStart.NewGovernanceActionsHandler(argsGovernanceActionsHandler)
+	if err != nil {
+		return err
+	}
+
+	err = governanceActionsHandler.LoadConfigChanges(currentEpoch)
+	if err != nil {
+		return err
+	}
+	epochStartNotifier.RegisterHandler(governanceActionsHandler)
+
 	log.Trace("creating process components")
 	processArgs := factory.NewProcessComponentsFactoryArgs(
 		&coreArgs,
@@ -1310,15 +1346,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ctx.GlobalString(importDbDirectory.Name),
 		chanStopNodeProcess,
 		fallbackHeaderValidator,
+		governanceActionsHandler,
 	)
 	processComponents, err := factory.ProcessComponentsFactory(processArgs)
 	if err != nil {
 		return err
 	}
 
-	transactionSimulator, err := txsimulator.NewTransactionSimulator(*txSimulatorProcessorArgs)
-	if err != nil {
-		return err
+	var transactionSimulator facade.TransactionSimulatorProcessor = disabledTxSimulator.NewDisabledTxSimulator()
+	if txSimulatorProcessorArgs != nil {
+		transactionSimulator, err = txsimulator.NewTransactionSimulator(*txSimulatorProcessorArgs)
+		if err != nil {
+			return err
+		}
 	}
 
 	hardForkTrigger, err := createHardForkTrigger(
@@ -1351,11 +1391,27 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return fmt.Errorf("%w when adding nodeShufflerOut in hardForkTrigger", err)
 	}
 
+	err = governanceActionsHandler.SetHardforkTrigger(hardForkTrigger)
+	if err != nil {
+		return err
+	}
+
 	if !elasticIndexer.IsNilIndexer() {
 		elasticIndexer.SetTxLogsProcessor(processComponents.TxLogsProcessor)
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
 	}
 
+	log.Trace("creating consensus round trace recorder")
+	roundTraceRecorder, err := debugFactory.NewRoundTraceRecorderFactory(consensusTrace.ArgRoundTraceRecorder{
+		Config:     generalConfig.Debug.ConsensusTrace,
+		WorkingDir: workingDir,
+		ShardID:    shardCoordinator.SelfId(),
+		SyncTimer:  syncer,
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating node structure")
 	currentNode, err := createNode(
 		generalConfig,
@@ -1386,6 +1442,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		hardForkTrigger,
 		historyRepository,
 		fallbackHeaderValidator,
+		roundTraceRecorder,
 		isInImportMode,
 	)
 	if err != nil {
@@ -1406,6 +1463,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	log.Trace("creating api resolver structure")
+	proofVerifier, err := createDoubleSigningProofVerifier(coreComponents, cryptoComponents, nodesCoordinator)
+	if err != nil {
+		return err
+	}
+
 	apiWorkingDir := filepath.Join(workingDir, factory.TemporaryPath)
 	apiResolver, err := createApiResolver(
 		generalConfig,
@@ -1423,6 +1485,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		gasScheduleNotifier,
 		economicsData,
 		cryptoComponents.MessageSignVerifier,
+		proofVerifier,
 		genesisNodesConfig,
 		systemSCConfig,
 		rater,
@@ -1452,6 +1515,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("creating block events notifier")
+	blockEventsNotifier, err := subscriptions.NewBlockEventsNotifier(subscriptions.ArgsBlockEventsNotifier{
+		BlockTracker:    processComponents.BlockTracker,
+		BlockProvider:   currentNode,
+		TxLogsStorer:    dataComponents.Store.GetStorer(dataRetriever.TxLogsUnit),
+		Marshalizer:     coreComponents.InternalMarshalizer,
+		PubkeyConverter: stateComponents.AddressPubkeyConverter,
+		SelfShardID:     shardCoordinator.SelfId(),
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
 
@@ -1465,9 +1541,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 			RestApiInterface: ctx.GlobalString(restApiInterface.Name),
 			PprofEnabled:     ctx.GlobalBool(profileMode.Name),
 		},
-		ApiRoutesConfig: *apiRoutesConfig,
-		AccountsState:   stateComponents.AccountsAdapter,
-		PeerState:       stateComponents.PeerAccounts,
+		ApiRoutesConfig:     *apiRoutesConfig,
+		AccountsState:       stateComponents.AccountsAdapter,
+		PeerState:           stateComponents.PeerAccounts,
+		BlockEventsNotifier: blockEventsNotifier,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -1488,6 +1565,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	// the state of the last committed block is loaded when the node starts
+	err = loadTxPoolFromStorage(dataComponents.Datapool.Transactions(), stateComponents.AccountsAdapter)
+	if err != nil {
+		return err
+	}
+
 	log.Info("application is now running")
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -1560,6 +1643,15 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 	storageConfig.DB.MaxBatchSize = storageConfig.DB.MaxBatchSize * int(alterCoefficient)
 }
 
+func loadTxPoolFromStorage(txPool dataRetriever.ShardedDataCacherNotifier, accounts state.AccountsAdapter) error {
+	txPoolPersister, ok := txPool.(dataRetriever.TxPoolPersister)
+	if !ok {
+		return nil
+	}
+
+	return txPoolPersister.LoadFromStorage(accounts)
+}
+
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
@@ -1572,6 +1664,13 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	txPoolPersister, ok := dataComponents.Datapool.Transactions().(dataRetriever.TxPoolPersister)
+	if ok {
+		log.Debug("closing the transactions pool persister...")
+		err = txPoolPersister.Close()
+		log.LogIfError(err)
+	}
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1734,6 +1833,21 @@ func loadMainConfig(filepath string) (*config.Config, error) {
 	return cfg, nil
 }
 
+func isTransactionSimulationEnabled(routesConfig config.ApiRoutesConfig) bool {
+	transactionConfig, ok := routesConfig.APIPackages["transaction"]
+	if !ok {
+		return false
+	}
+
+	for _, cfg := range transactionConfig.Routes {
+		if (cfg.Name == "/simulate" || cfg.Name == "/simulate-bundle") && cfg.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 func loadApiConfig(filepath string) (*config.ApiRoutesConfig, error) {
 	cfg := &config.ApiRoutesConfig{}
 	err := core.LoadTomlFile(cfg, filepath)
@@ -2012,6 +2126,7 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 // authentication for the server is using the username and password
 func createElasticIndexer(
 	elasticSearchConfig config.ElasticSearchConfig,
+	indexerOutputsConfig config.IndexerOutputsConfig,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -2048,6 +2163,16 @@ func createElasticIndexer(
 			UseKibana: elasticSearchConfig.UseKibana,
 		},
 		IsInImportDBMode: isInImportDBMode,
+		JSONFileOutput: indexerFactory.ArgsOutput{
+			Enabled:        indexerOutputsConfig.JSONFile.Enabled,
+			Destination:    indexerOutputsConfig.JSONFile.FilePath,
+			EnabledIndexes: indexerOutputsConfig.JSONFile.EnabledIndexes,
+		},
+		SQLOutput: indexerFactory.ArgsOutput{
+			Enabled:        indexerOutputsConfig.SQL.Enabled,
+			Destination:    indexerOutputsConfig.SQL.DataSourceName,
+			EnabledIndexes: indexerOutputsConfig.SQL.EnabledIndexes,
+		},
 	}
 
 	return indexerFactory.NewIndexer(indexerFactoryArgs)
@@ -2139,6 +2264,7 @@ func createHardForkTrigger(
 		InterceptorDebugConfig:    config.Debug.InterceptorResolver,
 		MinTxVersion:              coreData.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		MultiRelayedTxEnableEpoch: config.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 		TxSignHasher:              coreData.TxSignHasher,
 		EpochNotifier:             epochNotifier,
 	}
@@ -2199,6 +2325,7 @@ func createNode(
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository dblookupext.HistoryRepository,
 	fallbackHeaderValidator consensus.FallbackHeaderValidator,
+	roundTraceRecorder consensus.RoundTraceRecorder,
 	isInImportDbMode bool,
 ) (*node.Node, error) {
 	var err error
@@ -2259,6 +2386,29 @@ func createNode(
 
 	txVersionCheckerHandler := versioning.NewTxVersionChecker(coreData.MinTransactionVersion)
 
+	// the block size estimator is only used to compute the fill of the past blocks, so it has its own throttler
+	blockSizeThrottler, err := throttle.NewBlockSizeThrottle(
+		config.BlockSizeThrottleConfig.MinSizeInBytes,
+		config.BlockSizeThrottleConfig.MaxSizeInBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blockSizeEstimator, err := preprocess.NewBlockSizeComputation(
+		coreData.InternalMarshalizer,
+		blockSizeThrottler,
+		config.BlockSizeThrottleConfig.MaxSizeInBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	doubleSigningDetector, err := createDoubleSigningDetector(config, coreData, crypto, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *node.Node
 	nd, err = node.NewNode(
 		node.WithMessenger(network.NetMessenger),
@@ -2325,9 +2475,16 @@ func createNode(
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
+		node.WithMultiRelayedTxEnableEpoch(config.GeneralSettings.MultiRelayedTransactionsEnableEpoch),
 		node.WithTxSignHasher(coreData.TxSignHasher),
 		node.WithTxVersionChecker(txVersionCheckerHandler),
+		node.WithGuardedAccountHandler(process.GuardedAccountHandler),
+		node.WithMultisigAccountHandler(process.MultisigAccountHandler),
+		node.WithValidatorParticipationRecorder(process.ValidatorParticipation),
 		node.WithImportMode(isInImportDbMode),
+		node.WithBlockSizeEstimator(blockSizeEstimator),
+		node.WithDoubleSigningDetector(doubleSigningDetector),
+		node.WithRoundTraceRecorder(roundTraceRecorder),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2383,6 +2540,49 @@ func createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func createDoubleSigningDetector(
+	config *config.Config,
+	coreData *mainFactory.CoreComponents,
+	crypto *mainFactory.CryptoComponents,
+	nodesCoordinator sharding.NodesCoordinator,
+) (process.DoubleSigningDetector, error) {
+	if !config.DoubleSigningDetector.Enabled {
+		return slash.NewDisabledDoubleSigningDetector(), nil
+	}
+
+	proofVerifier, err := createDoubleSigningProofVerifier(coreData, crypto, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	argsDetector := slash.ArgDoubleSigningDetector{
+		Marshalizer:      coreData.InternalMarshalizer,
+		Hasher:           coreData.Hasher,
+		NodesCoordinator: nodesCoordinator,
+		ProofVerifier:    proofVerifier,
+		CacheCapacity:    config.DoubleSigningDetector.CacheCapacity,
+		MaxNumProofs:     config.DoubleSigningDetector.MaxNumProofs,
+	}
+
+	return slash.NewDoubleSigningDetector(argsDetector)
+}
+
+func createDoubleSigningProofVerifier(
+	coreData *mainFactory.CoreComponents,
+	crypto *mainFactory.CryptoComponents,
+	nodesCoordinator sharding.NodesCoordinator,
+) (vm.DoubleSigningProofVerifier, error) {
+	argsProofVerifier := slash.ArgDoubleSigningProofVerifier{
+		Marshalizer:      coreData.InternalMarshalizer,
+		Hasher:           coreData.Hasher,
+		SigVerifier:      crypto.SlashingSigVerifier,
+		NodesCoordinator: nodesCoordinator,
+		ChainID:          coreData.ChainID,
+	}
+
+	return slash.NewDoubleSigningProofVerifier(argsProofVerifier)
+}
+
 func initStatsFileMonitor(
 	config *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -2437,6 +2637,7 @@ func createApiResolver(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
+	proofVerifier vm.DoubleSigningProofVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
@@ -2458,6 +2659,7 @@ func createApiResolver(
 		gasScheduleNotifier,
 		economics,
 		messageSigVerifier,
+		proofVerifier,
 		nodesSetup,
 		systemSCConfig,
 		rater,
@@ -2472,16 +2674,21 @@ func createApiResolver(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           pubkeyConv,
+		ShardCoordinator:          shardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             epochNotifier,
+		MultiRelayedTxEnableEpoch: generalConfig.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -2504,7 +2711,18 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler)
+	argsGovernanceProposals := &governanceAPI.ArgsGovernanceProposalsHandler{
+		ShardID:             shardCoordinator.SelfId(),
+		InternalMarshalizer: marshalizer,
+		Accounts:            accnts,
+		PubkeyConverter:     pubkeyConv,
+	}
+	governanceProposalsHandler, err := governanceAPI.CreateGovernanceProposalsHandler(argsGovernanceProposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler, governanceProposalsHandler)
 }
 
 //TODO refactor this code when moving into feat/soft-restart. Maybe use arguments instead of endless parameter lists
@@ -2523,6 +2741,7 @@ func createScQueryService(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
+	proofVerifier vm.DoubleSigningProofVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
@@ -2551,6 +2770,7 @@ func createScQueryService(
 			gasScheduleNotifier,
 			economics,
 			messageSigVerifier,
+			proofVerifier,
 			nodesSetup,
 			systemSCConfig,
 			rater,
@@ -2589,6 +2809,7 @@ func createScQueryElement(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
+	proofVerifier vm.DoubleSigningProofVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
@@ -2599,10 +2820,18 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	historicalAccounts, err := state.NewHistoricalAccountsDB(accnts, hasher, marshalizer, stateFactory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncs, err := createBuiltinFuncs(
 		gasScheduleNotifier,
 		marshalizer,
-		accnts,
+		historicalAccounts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
 	)
 	if err != nil {
 		return nil, err
@@ -2617,7 +2846,7 @@ func createScQueryElement(
 	scStorage := generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           accnts,
+		Accounts:           historicalAccounts,
 		PubkeyConv:         pubkeyConv,
 		StorageService:     storageService,
 		BlockChain:         blockChain,
@@ -2634,17 +2863,20 @@ func createScQueryElement(
 
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		argsNewVmFactory := metachain.ArgsNewVMContainerFactory{
-			ArgBlockChainHook:   argsHook,
-			Economics:           economics,
-			MessageSignVerifier: messageSigVerifier,
-			GasSchedule:         gasScheduleNotifier,
-			NodesConfigProvider: nodesSetup,
-			Hasher:              hasher,
-			Marshalizer:         marshalizer,
-			SystemSCConfig:      systemSCConfig,
-			ValidatorAccountsDB: validatorAccounts,
-			ChanceComputer:      rater,
-			EpochNotifier:       epochNotifier,
+			ArgBlockChainHook:         argsHook,
+			Economics:                 economics,
+			MessageSignVerifier:       messageSigVerifier,
+			ProofVerifier:             proofVerifier,
+			GasSchedule:               gasScheduleNotifier,
+			NodesConfigProvider:       nodesSetup,
+			Hasher:                    hasher,
+			Marshalizer:               marshalizer,
+			SystemSCConfig:            systemSCConfig,
+			ValidatorAccountsDB:       validatorAccounts,
+			ChanceComputer:            rater,
+			EpochNotifier:             epochNotifier,
+			ESDTNFTEnableEpoch:        generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+			ESDTLocalRolesEnableEpoch: generalConfig.GeneralSettings.ESDTLocalRolesEnableEpoch,
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
@@ -2676,19 +2908,31 @@ func createScQueryElement(
 		return nil, err
 	}
 
-	return smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	return smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain, historicalAccounts)
 }
 
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	epochNotifier process.EpochNotifier,
+	generalSettings config.GeneralSettingsConfig,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:                  gasScheduleNotifier,
+		MapDNSAddresses:              make(map[string]struct{}),
+		Marshalizer:                  marshalizer,
+		Accounts:                     accnts,
+		ShardCoordinator:             shardCoordinator,
+		GuardedAccounts:              guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts:             multisig.NewDisabledMultisigAccount(),
+		EpochNotifier:                epochNotifier,
+		ESDTNFTEnableEpoch:           generalSettings.ESDTNFTEnableEpoch,
+		ESDTMultiTransferEnableEpoch: generalSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLocalRolesEnableEpoch:    generalSettings.ESDTLocalRolesEnableEpoch,
+		GuardedAccountsEnableEpoch:   generalSettings.GuardedAccountsEnableEpoch,
+		MultisigAccountsEnableEpoch:  generalSettings.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
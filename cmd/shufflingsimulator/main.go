@@ -0,0 +1,253 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/shufflingsimulator/shuffling"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+const (
+	tableFormat = "table"
+	jsonFormat  = "json"
+)
+
+type flags struct {
+	nodesSetupFilePath   string
+	nodeConfigFilePath   string
+	stakingQueueFilePath string
+	numEpochs            uint
+	randomness           string
+	format               string
+}
+
+var (
+	nodeHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodesSetupFilePathFlag defines a flag which holds the genesis nodes setup file path
+	nodesSetupFilePathFlag = cli.StringFlag{
+		Name:        "nodes-setup",
+		Usage:       "This string flag specifies the `filepath` for the genesis nodes setup json file",
+		Value:       "../node/config/nodesSetup.json",
+		Destination: &flagsValues.nodesSetupFilePath,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name: "node-config",
+		Usage: "This string flag specifies the `filepath` for the node's toml configuration file, holding the " +
+			"MaxNodesChangeEnableEpoch settings",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// stakingQueueFilePathFlag defines a flag which holds the staking queue inputs file path
+	stakingQueueFilePathFlag = cli.StringFlag{
+		Name: "staking-queue",
+		Usage: "This string flag specifies the `filepath` for the json file holding the initial staking queue size " +
+			"and the number of staked and unstaked nodes per epoch. If not set, no node is staked or unstaked",
+		Value:       "",
+		Destination: &flagsValues.stakingQueueFilePath,
+	}
+
+	// numEpochsFlag defines a flag for the number of simulated epochs
+	numEpochsFlag = cli.UintFlag{
+		Name:        "epochs",
+		Usage:       "This uint flag specifies the number of simulated epochs",
+		Value:       10,
+		Destination: &flagsValues.numEpochs,
+	}
+
+	// randomnessFlag defines a flag for the randomness of the simulation
+	randomnessFlag = cli.StringFlag{
+		Name:        "randomness",
+		Usage:       "This string flag specifies, hex encoded, the randomness from which the randomness of each epoch is derived",
+		Value:       "",
+		Destination: &flagsValues.randomness,
+	}
+
+	// formatFlag defines a flag for the output format
+	formatFlag = cli.StringFlag{
+		Name:        "format",
+		Usage:       "This string flag specifies the output format: table or json",
+		Value:       tableFormat,
+		Destination: &flagsValues.format,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("shufflingsimulator")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = nodeHelpTemplate
+	cliApp.Name = "Elrond shuffling simulator"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond shufflingsimulator application simulates the validators shuffling over a number of epochs, " +
+		"to predict the effects of the nodes setup, max nodes and staking changes"
+	cliApp.Flags = []cli.Flag{
+		nodesSetupFilePathFlag,
+		nodeConfigFilePathFlag,
+		stakingQueueFilePathFlag,
+		numEpochsFlag,
+		randomnessFlag,
+		formatFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	cliApp.Action = simulateShuffling
+}
+
+func simulateShuffling(_ *cli.Context) error {
+	if flagsValues.format != tableFormat && flagsValues.format != jsonFormat {
+		return fmt.Errorf("unknown output format %s", flagsValues.format)
+	}
+	if len(flagsValues.randomness) == 0 {
+		return errors.New("the randomness must be provided")
+	}
+	randomness, err := hex.DecodeString(flagsValues.randomness)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the randomness", err)
+	}
+
+	nodeConfig := config.Config{}
+	err = core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	nodesSetup, err := loadNodesSetup(nodeConfig)
+	if err != nil {
+		return err
+	}
+
+	var stakingQueue *shuffling.StakingQueueConfig
+	if len(flagsValues.stakingQueueFilePath) > 0 {
+		stakingQueue, err = shuffling.LoadStakingQueueConfig(flagsValues.stakingQueueFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	eligibleNodesInfo, waitingNodesInfo := nodesSetup.InitialNodesInfo()
+	eligible, err := sharding.NodesInfoToValidators(eligibleNodesInfo)
+	if err != nil {
+		return err
+	}
+	waiting, err := sharding.NodesInfoToValidators(waitingNodesInfo)
+	if err != nil {
+		return err
+	}
+
+	shuffler, err := sharding.NewHashValidatorsShuffler(&sharding.NodesShufflerArgs{
+		NodesShard:           nodesSetup.MinNodesPerShard,
+		NodesMeta:            nodesSetup.MetaChainMinNodes,
+		Hysteresis:           nodesSetup.Hysteresis,
+		Adaptivity:           nodesSetup.Adaptivity,
+		ShuffleBetweenShards: true,
+		MaxNodesEnableConfig: nodeConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	simulator, err := shuffling.NewSimulator(shuffling.ArgsSimulator{
+		Eligible:             eligible,
+		Waiting:              waiting,
+		Shuffler:             shuffler,
+		MaxNodesEnableConfig: nodeConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
+		StakingQueue:         stakingQueue,
+		Hasher:               sha256.Sha256{},
+		Randomness:           randomness,
+		NumEpochs:            uint32(flagsValues.numEpochs),
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := simulator.Run()
+	if err != nil {
+		return err
+	}
+
+	if flagsValues.format == jsonFormat {
+		return printJSON(report)
+	}
+
+	tables, err := shuffling.DisplayReport(report)
+	if err != nil {
+		return err
+	}
+	fmt.Print(tables)
+
+	return nil
+}
+
+func loadNodesSetup(nodeConfig config.Config) (*sharding.NodesSetup, error) {
+	addressPubkeyConverter, err := stateFactory.NewPubkeyConverter(nodeConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for AddressPubKeyConverter", err)
+	}
+	validatorPubkeyConverter, err := stateFactory.NewPubkeyConverter(nodeConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for ValidatorPubkeyConverter", err)
+	}
+
+	return sharding.NewNodesSetup(
+		flagsValues.nodesSetupFilePath,
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		nodeConfig.GeneralSettings.GenesisMaxNumberOfShards,
+	)
+}
+
+func printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
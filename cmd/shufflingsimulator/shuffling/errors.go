@@ -0,0 +1,24 @@
+package shuffling
+
+import "errors"
+
+// ErrNilNodesShuffler signals that a nil nodes shuffler has been provided
+var ErrNilNodesShuffler = errors.New("nil nodes shuffler")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyRandomness signals that an empty randomness has been provided
+var ErrEmptyRandomness = errors.New("empty randomness")
+
+// ErrInvalidNumberOfEpochs signals that the number of simulated epochs is invalid
+var ErrInvalidNumberOfEpochs = errors.New("invalid number of epochs")
+
+// ErrNoEligibleNodes signals that the initial eligible lists do not hold any node
+var ErrNoEligibleNodes = errors.New("no eligible nodes")
+
+// ErrMissingMetachainNodes signals that the initial eligible lists do not hold any metachain node
+var ErrMissingMetachainNodes = errors.New("missing metachain eligible nodes")
+
+// ErrInvalidStakingEpoch signals that a staking queue entry is set for an epoch that is not simulated
+var ErrInvalidStakingEpoch = errors.New("invalid staking epoch")
@@ -0,0 +1,229 @@
+package shuffling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/display"
+)
+
+// ShardReport holds the sizes of a shard's lists at the start of an epoch
+type ShardReport struct {
+	ShardID        uint32 `json:"shardID"`
+	NumEligible    uint32 `json:"numEligible"`
+	NumWaiting     uint32 `json:"numWaiting"`
+	NumShuffledOut uint32 `json:"numShuffledOut"`
+}
+
+// EpochReport holds the outcome of the shuffling done at the start of an epoch
+type EpochReport struct {
+	Epoch                  uint32         `json:"epoch"`
+	MaxNumNodes            uint32         `json:"maxNumNodes"`
+	NodesToShufflePerShard uint32         `json:"nodesToShufflePerShard"`
+	NumNewNodes            uint32         `json:"numNewNodes"`
+	NumLeaving             uint32         `json:"numLeaving"`
+	NumStillRemaining      uint32         `json:"numStillRemaining"`
+	QueueSize              uint32         `json:"queueSize"`
+	Shards                 []*ShardReport `json:"shards"`
+}
+
+// Statistics summarizes a set of values
+type Statistics struct {
+	Count   int     `json:"count"`
+	Min     uint32  `json:"min"`
+	Max     uint32  `json:"max"`
+	Average float64 `json:"average"`
+	Median  float64 `json:"median"`
+}
+
+// Report holds the outcome of a whole simulation. The durations are expressed in epochs
+type Report struct {
+	NumEpochs uint32         `json:"numEpochs"`
+	Epochs    []*EpochReport `json:"epochs"`
+
+	// WaitingEpochs holds how long the nodes stayed in the waiting lists before becoming eligible
+	WaitingEpochs Statistics `json:"waitingEpochs"`
+	// StillWaitingEpochs holds how long the nodes found in the waiting lists at the end of the simulation have been
+	// waiting so far
+	StillWaitingEpochs Statistics `json:"stillWaitingEpochs"`
+	// EligibleEpochs holds how long the nodes stayed eligible before being shuffled out
+	EligibleEpochs Statistics `json:"eligibleEpochs"`
+	// ShuffledOutPerNode holds how many times each node found in the lists at the end of the simulation has been
+	// shuffled out
+	ShuffledOutPerNode Statistics `json:"shuffledOutPerNode"`
+
+	NumShuffledOut  uint32 `json:"numShuffledOut"`
+	NumShardChanges uint32 `json:"numShardChanges"`
+}
+
+type statisticsCollector struct {
+	waitingPeriods  []uint32
+	eligiblePeriods []uint32
+	shuffledOut     map[string]uint32
+	numShardChanges uint32
+}
+
+func newStatisticsCollector() *statisticsCollector {
+	return &statisticsCollector{
+		waitingPeriods:  make([]uint32, 0),
+		eligiblePeriods: make([]uint32, 0),
+		shuffledOut:     make(map[string]uint32),
+	}
+}
+
+func (sc *statisticsCollector) addWaitingPeriod(numEpochs uint32) {
+	sc.waitingPeriods = append(sc.waitingPeriods, numEpochs)
+}
+
+func (sc *statisticsCollector) addEligiblePeriod(numEpochs uint32, pubKey string, changedShard bool) {
+	sc.eligiblePeriods = append(sc.eligiblePeriods, numEpochs)
+	sc.shuffledOut[pubKey]++
+	if changedShard {
+		sc.numShardChanges++
+	}
+}
+
+func (sc *statisticsCollector) fillReport(report *Report, nodes map[string]*nodeState, lastEpoch uint32) {
+	stillWaiting := make([]uint32, 0)
+	shuffledOutPerNode := make([]uint32, 0, len(nodes))
+	for pubKey, state := range nodes {
+		if state.list == waitingList {
+			stillWaiting = append(stillWaiting, lastEpoch-state.since)
+		}
+		shuffledOutPerNode = append(shuffledOutPerNode, sc.shuffledOut[pubKey])
+	}
+
+	report.WaitingEpochs = computeStatistics(sc.waitingPeriods)
+	report.StillWaitingEpochs = computeStatistics(stillWaiting)
+	report.EligibleEpochs = computeStatistics(sc.eligiblePeriods)
+	report.ShuffledOutPerNode = computeStatistics(shuffledOutPerNode)
+	report.NumShuffledOut = uint32(len(sc.eligiblePeriods))
+	report.NumShardChanges = sc.numShardChanges
+}
+
+func computeStatistics(values []uint32) Statistics {
+	if len(values) == 0 {
+		return Statistics{}
+	}
+
+	sorted := append(make([]uint32, 0, len(values)), values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	sum := uint64(0)
+	for _, value := range sorted {
+		sum += uint64(value)
+	}
+
+	middle := len(sorted) / 2
+	median := float64(sorted[middle])
+	if len(sorted)%2 == 0 {
+		median = float64(sorted[middle-1]+sorted[middle]) / 2
+	}
+
+	return Statistics{
+		Count:   len(sorted),
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		Average: float64(sum) / float64(len(sorted)),
+		Median:  median,
+	}
+}
+
+// DisplayReport renders the report as tables: the lists sizes per epoch and shard, the staking changes per epoch
+// and the waiting and shuffling statistics
+func DisplayReport(report *Report) (string, error) {
+	shardsTable, err := createShardsTable(report)
+	if err != nil {
+		return "", err
+	}
+
+	epochsTable, err := createEpochsTable(report)
+	if err != nil {
+		return "", err
+	}
+
+	statisticsTable, err := createStatisticsTable(report)
+	if err != nil {
+		return "", err
+	}
+
+	builder := &strings.Builder{}
+	builder.WriteString("Eligible and waiting lists per epoch and shard\n")
+	builder.WriteString(shardsTable)
+	builder.WriteString("\nStaking and leaving nodes per epoch\n")
+	builder.WriteString(epochsTable)
+	builder.WriteString(fmt.Sprintf("\nStatistics over %d epochs, durations in epochs\n", report.NumEpochs))
+	builder.WriteString(statisticsTable)
+
+	return builder.String(), nil
+}
+
+func createShardsTable(report *Report) (string, error) {
+	header := []string{"Epoch", "Shard", "Eligible", "Waiting", "Shuffled out"}
+	lines := make([]*display.LineData, 0)
+	for _, epochReport := range report.Epochs {
+		for i, shardReport := range epochReport.Shards {
+			isLastShard := i == len(epochReport.Shards)-1
+			lines = append(lines, display.NewLineData(isLastShard, []string{
+				fmt.Sprintf("%d", epochReport.Epoch),
+				core.GetShardIDString(shardReport.ShardID),
+				fmt.Sprintf("%d", shardReport.NumEligible),
+				fmt.Sprintf("%d", shardReport.NumWaiting),
+				fmt.Sprintf("%d", shardReport.NumShuffledOut),
+			}))
+		}
+	}
+
+	return display.CreateTableString(header, lines)
+}
+
+func createEpochsTable(report *Report) (string, error) {
+	header := []string{"Epoch", "Max nodes", "To shuffle per shard", "New", "Leaving", "Still remaining", "Queue"}
+	lines := make([]*display.LineData, 0, len(report.Epochs))
+	for _, epochReport := range report.Epochs {
+		lines = append(lines, display.NewLineData(false, []string{
+			fmt.Sprintf("%d", epochReport.Epoch),
+			fmt.Sprintf("%d", epochReport.MaxNumNodes),
+			fmt.Sprintf("%d", epochReport.NodesToShufflePerShard),
+			fmt.Sprintf("%d", epochReport.NumNewNodes),
+			fmt.Sprintf("%d", epochReport.NumLeaving),
+			fmt.Sprintf("%d", epochReport.NumStillRemaining),
+			fmt.Sprintf("%d", epochReport.QueueSize),
+		}))
+	}
+
+	return display.CreateTableString(header, lines)
+}
+
+func createStatisticsTable(report *Report) (string, error) {
+	header := []string{"Metric", "Count", "Min", "Max", "Average", "Median"}
+	lines := []*display.LineData{
+		createStatisticsLine("Epochs waiting before eligible", report.WaitingEpochs),
+		createStatisticsLine("Epochs still waiting at the end", report.StillWaitingEpochs),
+		createStatisticsLine("Epochs eligible before shuffled out", report.EligibleEpochs),
+		createStatisticsLine("Times shuffled out per node", report.ShuffledOutPerNode),
+	}
+
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		return "", err
+	}
+
+	return table + fmt.Sprintf("Shuffled out nodes: %d, of which moved to another shard: %d\n",
+		report.NumShuffledOut, report.NumShardChanges), nil
+}
+
+func createStatisticsLine(metric string, statistics Statistics) *display.LineData {
+	return display.NewLineData(false, []string{
+		metric,
+		fmt.Sprintf("%d", statistics.Count),
+		fmt.Sprintf("%d", statistics.Min),
+		fmt.Sprintf("%d", statistics.Max),
+		fmt.Sprintf("%.2f", statistics.Average),
+		fmt.Sprintf("%.1f", statistics.Median),
+	})
+}
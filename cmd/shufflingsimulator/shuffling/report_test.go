@@ -0,0 +1,53 @@
+package shuffling
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeStatistics_EmptyValuesShouldReturnEmptyStatistics(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Statistics{}, computeStatistics(nil))
+}
+
+func TestComputeStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Statistics{Count: 3, Min: 1, Max: 5, Average: 3, Median: 3}, computeStatistics([]uint32{5, 1, 3}))
+	assert.Equal(t, Statistics{Count: 4, Min: 1, Max: 8, Average: 3.5, Median: 2.5}, computeStatistics([]uint32{8, 1, 3, 2}))
+}
+
+func TestDisplayReport_ShouldRenderAllTables(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		NumEpochs: 1,
+		Epochs: []*EpochReport{
+			{
+				Epoch:       1,
+				MaxNumNodes: 36,
+				NumNewNodes: 7,
+				QueueSize:   2,
+				Shards: []*ShardReport{
+					{ShardID: 0, NumEligible: 4, NumWaiting: 3, NumShuffledOut: 1},
+					{ShardID: core.MetachainShardId, NumEligible: 4, NumWaiting: 2, NumShuffledOut: 1},
+				},
+			},
+		},
+		WaitingEpochs:  Statistics{Count: 2, Min: 1, Max: 2, Average: 1.5, Median: 1.5},
+		NumShuffledOut: 2,
+	}
+
+	tables, err := DisplayReport(report)
+	require.Nil(t, err)
+
+	assert.True(t, strings.Contains(tables, "metachain"))
+	assert.True(t, strings.Contains(tables, "Epochs waiting before eligible"))
+	assert.True(t, strings.Contains(tables, "1.50"))
+	assert.True(t, strings.Contains(tables, "Shuffled out nodes: 2"))
+}
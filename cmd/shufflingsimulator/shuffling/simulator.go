@@ -0,0 +1,412 @@
+package shuffling
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("shufflingsimulator/shuffling")
+
+const stakedNodesKeyPrefix = "staked-node-"
+
+// ArgsSimulator holds the arguments needed for creating a new shuffling simulator
+type ArgsSimulator struct {
+	Eligible             map[uint32][]sharding.Validator
+	Waiting              map[uint32][]sharding.Validator
+	Shuffler             sharding.NodesShuffler
+	MaxNodesEnableConfig []config.MaxNodesChangeConfig
+	StakingQueue         *StakingQueueConfig
+	Hasher               hashing.Hasher
+	Randomness           []byte
+	NumEpochs            uint32
+}
+
+type nodeList string
+
+const (
+	eligibleList nodeList = "eligible"
+	waitingList  nodeList = "waiting"
+)
+
+type nodeState struct {
+	list    nodeList
+	shardID uint32
+	since   uint32
+}
+
+// simulator replays, epoch by epoch, what the nodes coordinator does at each start of epoch: the staking queue is
+// processed against the maximum number of nodes, the leaving nodes are selected and the real nodes shuffler
+// computes the new eligible and waiting lists
+type simulator struct {
+	shuffler             sharding.NodesShuffler
+	maxNodesEnableConfig []config.MaxNodesChangeConfig
+	stakingChanges       map[uint32]*EpochStakingChanges
+	hasher               hashing.Hasher
+	randomness           []byte
+	numEpochs            uint32
+
+	eligible       map[uint32][]sharding.Validator
+	waiting        map[uint32][]sharding.Validator
+	stillRemaining []sharding.Validator
+	stakingQueue   []sharding.Validator
+	numStakedNodes uint32
+	numNewKeys     uint32
+	nodes          map[string]*nodeState
+	statistics     *statisticsCollector
+}
+
+// NewSimulator creates a new shuffling simulator
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	if check.IfNil(args.Shuffler) {
+		return nil, ErrNilNodesShuffler
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.Randomness) == 0 {
+		return nil, ErrEmptyRandomness
+	}
+	if args.NumEpochs == 0 {
+		return nil, ErrInvalidNumberOfEpochs
+	}
+	if len(args.Eligible) == 0 {
+		return nil, ErrNoEligibleNodes
+	}
+	if len(args.Eligible[core.MetachainShardId]) == 0 {
+		return nil, ErrMissingMetachainNodes
+	}
+
+	stakingChanges, err := args.StakingQueue.changesByEpoch(args.NumEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	maxNodesEnableConfig := make([]config.MaxNodesChangeConfig, len(args.MaxNodesEnableConfig))
+	copy(maxNodesEnableConfig, args.MaxNodesEnableConfig)
+	sort.Slice(maxNodesEnableConfig, func(i, j int) bool {
+		return maxNodesEnableConfig[i].EpochEnable < maxNodesEnableConfig[j].EpochEnable
+	})
+
+	sim := &simulator{
+		shuffler:             args.Shuffler,
+		maxNodesEnableConfig: maxNodesEnableConfig,
+		stakingChanges:       stakingChanges,
+		hasher:               args.Hasher,
+		randomness:           args.Randomness,
+		numEpochs:            args.NumEpochs,
+		eligible:             copyValidatorsMap(args.Eligible),
+		waiting:              copyValidatorsMap(args.Waiting),
+		stillRemaining:       make([]sharding.Validator, 0),
+		stakingQueue:         make([]sharding.Validator, 0),
+		nodes:                make(map[string]*nodeState),
+		statistics:           newStatisticsCollector(),
+	}
+
+	sim.trackNodes(sim.eligible, eligibleList, 0)
+	sim.trackNodes(sim.waiting, waitingList, 0)
+	sim.numStakedNodes = uint32(len(sim.nodes))
+	if args.StakingQueue != nil {
+		sim.stakeNodes(args.StakingQueue.InitialQueueSize, 0)
+	}
+
+	return sim, nil
+}
+
+func (sim *simulator) trackNodes(validators map[uint32][]sharding.Validator, list nodeList, epoch uint32) {
+	for shardID, shardValidators := range validators {
+		for _, v := range shardValidators {
+			sim.nodes[string(v.PubKey())] = &nodeState{
+				list:    list,
+				shardID: shardID,
+				since:   epoch,
+			}
+		}
+	}
+}
+
+// Run simulates all the epochs and returns the report
+func (sim *simulator) Run() (*Report, error) {
+	report := &Report{
+		NumEpochs: sim.numEpochs,
+		Epochs:    make([]*EpochReport, 0, sim.numEpochs),
+	}
+
+	for epoch := uint32(1); epoch <= sim.numEpochs; epoch++ {
+		epochReport, err := sim.simulateEpoch(epoch)
+		if err != nil {
+			return nil, fmt.Errorf("%w in epoch %d", err, epoch)
+		}
+
+		report.Epochs = append(report.Epochs, epochReport)
+	}
+
+	sim.statistics.fillReport(report, sim.nodes, sim.numEpochs)
+
+	return report, nil
+}
+
+func (sim *simulator) simulateEpoch(epoch uint32) (*EpochReport, error) {
+	randomness := sim.epochRandomness(epoch)
+	maxNodesConfig, hasMaxNodesConfig := sim.maxNodesConfig(epoch)
+
+	changes, ok := sim.stakingChanges[epoch]
+	if !ok {
+		changes = &EpochStakingChanges{Epoch: epoch}
+	}
+
+	unStakeLeaving := sim.selectUnStakedNodes(changes.NumUnStaked, randomness)
+	sim.stakeNodes(changes.NumStaked, epoch)
+
+	numToActivate := uint32(len(sim.stakingQueue))
+	if hasMaxNodesConfig {
+		numToActivate = 0
+		if maxNodesConfig.MaxNumNodes > sim.numStakedNodes {
+			numToActivate = core.MinUint32(maxNodesConfig.MaxNumNodes-sim.numStakedNodes, uint32(len(sim.stakingQueue)))
+		}
+	}
+	newNodes := sim.stakingQueue[:numToActivate]
+	sim.stakingQueue = sim.stakingQueue[numToActivate:]
+	sim.numStakedNodes += numToActivate
+
+	resUpdateNodes, err := sim.shuffler.UpdateNodeLists(sharding.ArgsUpdateNodes{
+		Eligible:       sortedValidatorsMap(sim.eligible),
+		Waiting:        sortedValidatorsMap(sim.waiting),
+		NewNodes:       sortedValidators(newNodes),
+		UnStakeLeaving: sortedValidators(unStakeLeaving),
+		Rand:           randomness,
+		NbShards:       uint32(len(sim.eligible) - 1),
+		Epoch:          epoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	epochReport := &EpochReport{
+		Epoch:             epoch,
+		QueueSize:         uint32(len(sim.stakingQueue)),
+		NumNewNodes:       uint32(len(newNodes)),
+		NumLeaving:        uint32(len(resUpdateNodes.Leaving)),
+		NumStillRemaining: uint32(len(resUpdateNodes.StillRemaining)),
+	}
+	if hasMaxNodesConfig {
+		epochReport.MaxNumNodes = maxNodesConfig.MaxNumNodes
+		epochReport.NodesToShufflePerShard = maxNodesConfig.NodesToShufflePerShard
+	}
+
+	shuffledOut := sim.updateNodes(resUpdateNodes, epoch)
+	epochReport.Shards = sim.createShardReports(shuffledOut)
+
+	sim.eligible = resUpdateNodes.Eligible
+	sim.waiting = resUpdateNodes.Waiting
+	sim.stillRemaining = resUpdateNodes.StillRemaining
+
+	log.Debug("simulated epoch",
+		"epoch", epoch,
+		"new nodes", epochReport.NumNewNodes,
+		"leaving", epochReport.NumLeaving,
+		"still remaining", epochReport.NumStillRemaining,
+		"queue size", epochReport.QueueSize,
+	)
+
+	return epochReport, nil
+}
+
+// epochRandomness derives the randomness of an epoch from the randomness of the simulation, as the start of epoch
+// meta block's previous random seed is not known in advance
+func (sim *simulator) epochRandomness(epoch uint32) []byte {
+	epochBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(epochBytes, epoch)
+
+	return sim.hasher.Compute(string(sim.randomness) + string(epochBytes))
+}
+
+// maxNodesConfig returns the max nodes config enabled in the provided epoch, the same way the shuffler selects it
+func (sim *simulator) maxNodesConfig(epoch uint32) (config.MaxNodesChangeConfig, bool) {
+	selected := config.MaxNodesChangeConfig{}
+	found := false
+	for _, maxNodesConfig := range sim.maxNodesEnableConfig {
+		if epoch >= maxNodesConfig.EpochEnable {
+			selected = maxNodesConfig
+			found = true
+		}
+	}
+
+	return selected, found
+}
+
+// stakeNodes adds new nodes at the end of the staking queue
+func (sim *simulator) stakeNodes(numNodes uint32, epoch uint32) {
+	for i := uint32(0); i < numNodes; i++ {
+		pubKey := sim.hasher.Compute(fmt.Sprintf("%s%d", stakedNodesKeyPrefix, sim.numNewKeys))
+		v, err := sharding.NewValidator(pubKey, 1, sim.numNewKeys)
+		sim.numNewKeys++
+		if err != nil {
+			log.Warn("could not create staked node", "epoch", epoch, "error", err.Error())
+			continue
+		}
+
+		sim.stakingQueue = append(sim.stakingQueue, v)
+	}
+}
+
+// selectUnStakedNodes picks the nodes that unstake among the eligible and waiting nodes that are not already
+// leaving. The nodes that could not leave in the previous epochs are leaving again, as their unstake is still
+// pending
+func (sim *simulator) selectUnStakedNodes(numNodes uint32, randomness []byte) []sharding.Validator {
+	leaving := make([]sharding.Validator, 0, len(sim.stillRemaining)+int(numNodes))
+	leaving = append(leaving, sim.stillRemaining...)
+
+	alreadyLeaving := make(map[string]struct{})
+	for _, v := range sim.stillRemaining {
+		alreadyLeaving[string(v.PubKey())] = struct{}{}
+	}
+
+	candidates := make([]sharding.Validator, 0)
+	for _, validators := range []map[uint32][]sharding.Validator{sim.eligible, sim.waiting} {
+		for _, shardValidators := range validators {
+			for _, v := range shardValidators {
+				if _, ok := alreadyLeaving[string(v.PubKey())]; !ok {
+					candidates = append(candidates, v)
+				}
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		hashI := sim.hasher.Compute(string(randomness) + string(candidates[i].PubKey()))
+		hashJ := sim.hasher.Compute(string(randomness) + string(candidates[j].PubKey()))
+
+		return bytes.Compare(hashI, hashJ) < 0
+	})
+
+	numNodes = core.MinUint32(numNodes, uint32(len(candidates)))
+	leaving = append(leaving, candidates[:numNodes]...)
+	sim.numStakedNodes -= numNodes
+
+	return leaving
+}
+
+// updateNodes compares the new lists with the previous state of each node and returns the number of eligible nodes
+// shuffled out from each shard
+func (sim *simulator) updateNodes(resUpdateNodes *sharding.ResUpdateNodes, epoch uint32) map[uint32]uint32 {
+	shuffledOut := make(map[uint32]uint32)
+	newStates := make(map[string]*nodeState)
+
+	addStates := func(validators map[uint32][]sharding.Validator, list nodeList) {
+		for shardID, shardValidators := range validators {
+			for _, v := range shardValidators {
+				newStates[string(v.PubKey())] = &nodeState{list: list, shardID: shardID, since: epoch}
+			}
+		}
+	}
+	addStates(resUpdateNodes.Eligible, eligibleList)
+	addStates(resUpdateNodes.Waiting, waitingList)
+
+	for pubKey, newState := range newStates {
+		oldState, ok := sim.nodes[pubKey]
+		if !ok {
+			sim.nodes[pubKey] = newState
+			continue
+		}
+		if oldState.list == newState.list {
+			continue
+		}
+
+		switch newState.list {
+		case eligibleList:
+			sim.statistics.addWaitingPeriod(epoch - oldState.since)
+		case waitingList:
+			sim.statistics.addEligiblePeriod(epoch-oldState.since, pubKey, oldState.shardID != newState.shardID)
+			shuffledOut[oldState.shardID]++
+		}
+		sim.nodes[pubKey] = newState
+	}
+
+	for pubKey := range sim.nodes {
+		if _, ok := newStates[pubKey]; !ok {
+			delete(sim.nodes, pubKey)
+		}
+	}
+
+	return shuffledOut
+}
+
+func (sim *simulator) createShardReports(shuffledOut map[uint32]uint32) []*ShardReport {
+	eligibleCount := make(map[uint32]uint32)
+	waitingCount := make(map[uint32]uint32)
+	shardIDs := make(map[uint32]struct{})
+	for _, state := range sim.nodes {
+		shardIDs[state.shardID] = struct{}{}
+		if state.list == eligibleList {
+			eligibleCount[state.shardID]++
+			continue
+		}
+		waitingCount[state.shardID]++
+	}
+
+	shardReports := make([]*ShardReport, 0, len(shardIDs))
+	for shardID := range shardIDs {
+		shardReports = append(shardReports, &ShardReport{
+			ShardID:        shardID,
+			NumEligible:    eligibleCount[shardID],
+			NumWaiting:     waitingCount[shardID],
+			NumShuffledOut: shuffledOut[shardID],
+		})
+	}
+
+	sort.Slice(shardReports, func(i, j int) bool {
+		return shardReports[i].ShardID < shardReports[j].ShardID
+	})
+
+	return shardReports
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sim *simulator) IsInterfaceNil() bool {
+	return sim == nil
+}
+
+func copyValidatorsMap(validators map[uint32][]sharding.Validator) map[uint32][]sharding.Validator {
+	validatorsCopy := make(map[uint32][]sharding.Validator, len(validators))
+	for shardID, shardValidators := range validators {
+		validatorsCopy[shardID] = append(make([]sharding.Validator, 0, len(shardValidators)), shardValidators...)
+	}
+
+	return validatorsCopy
+}
+
+// sortedValidatorsMap sorts the lists by index and public key, as the nodes coordinator does before shuffling
+func sortedValidatorsMap(validators map[uint32][]sharding.Validator) map[uint32][]sharding.Validator {
+	sortedMap := copyValidatorsMap(validators)
+	for _, shardValidators := range sortedMap {
+		sortValidators(shardValidators)
+	}
+
+	return sortedMap
+}
+
+func sortedValidators(validators []sharding.Validator) []sharding.Validator {
+	sortedList := append(make([]sharding.Validator, 0, len(validators)), validators...)
+	sortValidators(sortedList)
+
+	return sortedList
+}
+
+func sortValidators(validators []sharding.Validator) {
+	sort.Slice(validators, func(i, j int) bool {
+		if validators[i].Index() == validators[j].Index() {
+			return bytes.Compare(validators[i].PubKey(), validators[j].PubKey()) < 0
+		}
+
+		return validators[i].Index() < validators[j].Index()
+	})
+}
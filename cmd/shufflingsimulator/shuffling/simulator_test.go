@@ -0,0 +1,197 @@
+package shuffling
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodesPerShard = 4
+const numShards = 2
+
+func createValidators(t *testing.T, prefix string, numPerShard int) map[uint32][]sharding.Validator {
+	validators := make(map[uint32][]sharding.Validator)
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		for i := 0; i < numPerShard; i++ {
+			pubKey := []byte(fmt.Sprintf("%s-%d-%d", prefix, shardID, i))
+			v, err := sharding.NewValidator(pubKey, 1, uint32(i))
+			require.Nil(t, err)
+
+			validators[shardID] = append(validators[shardID], v)
+		}
+	}
+
+	return validators
+}
+
+func createMockArgsSimulator(t *testing.T) ArgsSimulator {
+	maxNodesEnableConfig := []config.MaxNodesChangeConfig{
+		{EpochEnable: 0, MaxNumNodes: 18, NodesToShufflePerShard: 1},
+		{EpochEnable: 3, MaxNumNodes: 24, NodesToShufflePerShard: 2},
+	}
+
+	shuffler, err := sharding.NewHashValidatorsShuffler(&sharding.NodesShufflerArgs{
+		NodesShard:           nodesPerShard,
+		NodesMeta:            nodesPerShard,
+		Hysteresis:           0.2,
+		Adaptivity:           false,
+		ShuffleBetweenShards: true,
+		MaxNodesEnableConfig: maxNodesEnableConfig,
+	})
+	require.Nil(t, err)
+
+	return ArgsSimulator{
+		Eligible:             createValidators(t, "eligible", nodesPerShard),
+		Waiting:              createValidators(t, "waiting", 1),
+		Shuffler:             shuffler,
+		MaxNodesEnableConfig: maxNodesEnableConfig,
+		StakingQueue:         &StakingQueueConfig{},
+		Hasher:               sha256.Sha256{},
+		Randomness:           []byte("randomness"),
+		NumEpochs:            6,
+	}
+}
+
+func TestNewSimulator_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator(t)
+	args.Shuffler = nil
+	sim, err := NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrNilNodesShuffler, err)
+
+	args = createMockArgsSimulator(t)
+	args.Hasher = nil
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgsSimulator(t)
+	args.Randomness = nil
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrEmptyRandomness, err)
+
+	args = createMockArgsSimulator(t)
+	args.NumEpochs = 0
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrInvalidNumberOfEpochs, err)
+
+	args = createMockArgsSimulator(t)
+	args.Eligible = nil
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrNoEligibleNodes, err)
+
+	args = createMockArgsSimulator(t)
+	delete(args.Eligible, core.MetachainShardId)
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrMissingMetachainNodes, err)
+
+	args = createMockArgsSimulator(t)
+	args.StakingQueue.Epochs = []*EpochStakingChanges{{Epoch: 0, NumStaked: 1}}
+	sim, err = NewSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.True(t, errors.Is(err, ErrInvalidStakingEpoch))
+}
+
+func TestSimulator_RunShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	run := func(randomness string) *Report {
+		args := createMockArgsSimulator(t)
+		args.Randomness = []byte(randomness)
+		args.StakingQueue.InitialQueueSize = 10
+
+		sim, err := NewSimulator(args)
+		require.Nil(t, err)
+		report, err := sim.Run()
+		require.Nil(t, err)
+
+		return report
+	}
+
+	report := run("randomness")
+	assert.Equal(t, report, run("randomness"))
+	assert.NotEqual(t, report, run("other randomness"))
+}
+
+func TestSimulator_RunShouldActivateQueuedNodesUpToMaxNumNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator(t)
+	args.StakingQueue = &StakingQueueConfig{
+		InitialQueueSize: 10,
+		Epochs: []*EpochStakingChanges{
+			{Epoch: 2, NumUnStaked: 1},
+			{Epoch: 7, NumStaked: 100},
+		},
+	}
+
+	sim, err := NewSimulator(args)
+	require.Nil(t, err)
+	report, err := sim.Run()
+	require.Nil(t, err)
+	require.Equal(t, 6, len(report.Epochs))
+
+	// 15 staked nodes at genesis, the first max nodes config allows 18
+	assert.Equal(t, uint32(3), report.Epochs[0].NumNewNodes)
+	assert.Equal(t, uint32(7), report.Epochs[0].QueueSize)
+	assert.Equal(t, uint32(18), report.Epochs[0].MaxNumNodes)
+
+	// the unstaked node frees one slot
+	assert.Equal(t, uint32(1), report.Epochs[1].NumLeaving)
+	assert.Equal(t, uint32(1), report.Epochs[1].NumNewNodes)
+
+	// the second max nodes config allows 6 more nodes
+	assert.Equal(t, uint32(24), report.Epochs[2].MaxNumNodes)
+	assert.Equal(t, uint32(2), report.Epochs[2].NodesToShufflePerShard)
+	assert.Equal(t, uint32(6), report.Epochs[2].NumNewNodes)
+	assert.Equal(t, uint32(0), report.Epochs[2].QueueSize)
+
+	lastEpoch := report.Epochs[len(report.Epochs)-1]
+	numNodes := uint32(0)
+	for _, shardReport := range lastEpoch.Shards {
+		assert.Equal(t, uint32(nodesPerShard), shardReport.NumEligible)
+		numNodes += shardReport.NumEligible + shardReport.NumWaiting
+	}
+	assert.Equal(t, uint32(24), numNodes)
+	assert.Equal(t, numShards+1, len(lastEpoch.Shards))
+}
+
+func TestSimulator_RunShouldReportShuffledOutAndWaitingNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator(t)
+
+	sim, err := NewSimulator(args)
+	require.Nil(t, err)
+	report, err := sim.Run()
+	require.Nil(t, err)
+
+	numShuffledOut := uint32(0)
+	for _, epochReport := range report.Epochs {
+		for _, shardReport := range epochReport.Shards {
+			assert.Equal(t, uint32(1), shardReport.NumShuffledOut)
+			numShuffledOut += shardReport.NumShuffledOut
+		}
+	}
+
+	assert.Equal(t, numShuffledOut, report.NumShuffledOut)
+	assert.Equal(t, int(numShuffledOut), report.EligibleEpochs.Count)
+	assert.Equal(t, int(numShuffledOut), report.WaitingEpochs.Count)
+	assert.Equal(t, uint32(1), report.WaitingEpochs.Min)
+	assert.Equal(t, 15, report.ShuffledOutPerNode.Count)
+	assert.Equal(t, 3, report.StillWaitingEpochs.Count)
+}
@@ -0,0 +1,64 @@
+package shuffling
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// EpochStakingChanges holds the staking operations processed at the start of an epoch: the new stake requests
+// entering the staking queue and the number of active nodes that unstake
+type EpochStakingChanges struct {
+	Epoch       uint32 `json:"epoch"`
+	NumStaked   uint32 `json:"numStaked"`
+	NumUnStaked uint32 `json:"numUnStaked"`
+}
+
+// StakingQueueConfig holds the staking queue inputs of a simulation: the nodes already waiting in the staking queue
+// at genesis and the staking operations done in each epoch
+type StakingQueueConfig struct {
+	InitialQueueSize uint32                 `json:"initialQueueSize"`
+	Epochs           []*EpochStakingChanges `json:"epochs"`
+}
+
+// LoadStakingQueueConfig reads the staking queue inputs from a json file
+func LoadStakingQueueConfig(filePath string) (*StakingQueueConfig, error) {
+	stakingQueueConfig := &StakingQueueConfig{}
+	err := core.LoadJsonFile(stakingQueueConfig, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakingQueueConfig, nil
+}
+
+// changesByEpoch returns the staking operations grouped by epoch. The operations set more than once for the same
+// epoch are summed up and the ones set after the last simulated epoch are ignored
+func (sqc *StakingQueueConfig) changesByEpoch(numEpochs uint32) (map[uint32]*EpochStakingChanges, error) {
+	changes := make(map[uint32]*EpochStakingChanges)
+	if sqc == nil {
+		return changes, nil
+	}
+
+	for _, epochChanges := range sqc.Epochs {
+		if epochChanges == nil {
+			continue
+		}
+		if epochChanges.Epoch == 0 {
+			return nil, fmt.Errorf("%w: the staking changes can not be set for the genesis epoch", ErrInvalidStakingEpoch)
+		}
+		if epochChanges.Epoch > numEpochs {
+			continue
+		}
+
+		existing, ok := changes[epochChanges.Epoch]
+		if !ok {
+			existing = &EpochStakingChanges{Epoch: epochChanges.Epoch}
+			changes[epochChanges.Epoch] = existing
+		}
+		existing.NumStaked += epochChanges.NumStaked
+		existing.NumUnStaked += epochChanges.NumUnStaked
+	}
+
+	return changes, nil
+}
@@ -9,6 +9,8 @@ type CacheConfig struct {
 	SizeInBytes          uint64
 	SizeInBytesPerSender uint32
 	Shards               uint32
+
+	MinGasPriceBumpPercentageForReplacement uint32
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -164,11 +166,14 @@ type Config struct {
 	Debug    DebugConfig
 	Health   HealthServiceConfig
 
-	SoftwareVersionConfig SoftwareVersionConfig
-	DbLookupExtensions    DbLookupExtensionsConfig
-	Versions              VersionsConfig
-	GasSchedule           GasScheduleConfig
-	Logs                  LogsConfig
+	SoftwareVersionConfig  SoftwareVersionConfig
+	DbLookupExtensions     DbLookupExtensionsConfig
+	TxPoolPersistence      TxPoolPersistenceConfig
+	DoubleSigningDetector  DoubleSigningDetectorConfig
+	ValidatorParticipation ValidatorParticipationConfig
+	Versions               VersionsConfig
+	GasSchedule            GasScheduleConfig
+	Logs                   LogsConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -220,6 +225,7 @@ type GeneralSettingsConfig struct {
 	SCDeployEnableEpoch                    uint32
 	BuiltInFunctionsEnableEpoch            uint32
 	RelayedTransactionsEnableEpoch         uint32
+	MultiRelayedTransactionsEnableEpoch    uint32
 	PenalizedTooMuchGasEnableEpoch         uint32
 	SwitchJailWaitingEnableEpoch           uint32
 	SwitchHysteresisForMinNodesEnableEpoch uint32
@@ -228,6 +234,12 @@ type GeneralSettingsConfig struct {
 	MetaProtectionEnableEpoch              uint32
 	AheadOfTimeGasUsageEnableEpoch         uint32
 	GasPriceModifierEnableEpoch            uint32
+	GuardedAccountsEnableEpoch             uint32
+	GuardianActivationEpochsDelay          uint32
+	MultisigAccountsEnableEpoch            uint32
+	ESDTNFTEnableEpoch                     uint32
+	ESDTMultiTransferEnableEpoch           uint32
+	ESDTLocalRolesEnableEpoch              uint32
 	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
@@ -384,12 +396,46 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
+	EventsIndexEnabled                 bool
+	EventsStorageConfig                StorageConfig
+}
+
+// TxPoolPersistenceConfig holds the configuration for the persistence of the transactions pool
+type TxPoolPersistenceConfig struct {
+	Enabled                   bool
+	SnapshotIntervalInSeconds int
+	StorageConfig             StorageConfig
+}
+
+// DoubleSigningDetectorConfig holds the configuration for the detection of double signing in consensus
+type DoubleSigningDetectorConfig struct {
+	Enabled       bool
+	CacheCapacity int
+	MaxNumProofs  int
+}
+
+// ValidatorParticipationConfig holds the configuration for the history of the validators participation in consensus
+type ValidatorParticipationConfig struct {
+	Enabled       bool
+	MaxNumEpochs  uint32
+	StorageConfig StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	ConsensusTrace      ConsensusTraceDebugConfig
+}
+
+// ConsensusTraceDebugConfig will hold the consensus round trace recorder configuration
+type ConsensusTraceDebugConfig struct {
+	Enabled       bool
+	FolderPath    string
+	RoundsPerFile int
+	MaxNumFiles   int
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -3,6 +3,7 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	IndexerOutputs         IndexerOutputsConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -15,3 +16,23 @@ type ElasticSearchConfig struct {
 	Password         string
 	EnabledIndexes   []string
 }
+
+// IndexerOutputsConfig will hold the configuration for the indexer outputs that can be used besides elastic search
+type IndexerOutputsConfig struct {
+	JSONFile JSONFileOutputConfig
+	SQL      SQLOutputConfig
+}
+
+// JSONFileOutputConfig will hold the configuration for the newline delimited JSON file indexer output
+type JSONFileOutputConfig struct {
+	Enabled        bool
+	FilePath       string
+	EnabledIndexes []string
+}
+
+// SQLOutputConfig will hold the configuration for the SQL database indexer output
+type SQLOutputConfig struct {
+	Enabled        bool
+	DataSourceName string
+	EnabledIndexes []string
+}
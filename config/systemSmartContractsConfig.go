@@ -25,6 +25,8 @@ type StakingSystemSCConfig struct {
 	StakeEnableEpoch                     uint32
 	DoubleKeyProtectionEnableEpoch       uint32
 	ActivateBLSPubKeyMessageVerification bool
+	DoubleSigningSlashingEnableEpoch     uint32
+	DoubleSigningSlashPercentage         float64
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
@@ -36,12 +38,13 @@ type ESDTSystemSCConfig struct {
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
 type GovernanceSystemSCConfig struct {
-	ProposalCost     string
-	NumNodes         int64
-	MinQuorum        int32
-	MinPassThreshold int32
-	MinVetoThreshold int32
-	EnabledEpoch     uint32
+	ProposalCost             string
+	NumNodes                 int64
+	MinQuorum                int32
+	MinPassThreshold         int32
+	MinVetoThreshold         int32
+	EnabledEpoch             uint32
+	ProposalsListEnableEpoch uint32
 }
 
 // DelegationManagerSystemSCConfig defines a set of constants to initialize the delegation manager system smart contract
@@ -223,6 +223,18 @@ func TestTomlExternalParser(t *testing.T) {
 			Username: elasticUsername,
 			Password: elasticPassword,
 		},
+		IndexerOutputs: IndexerOutputsConfig{
+			JSONFile: JSONFileOutputConfig{
+				Enabled:        true,
+				FilePath:       "indexer.json",
+				EnabledIndexes: []string{"blocks"},
+			},
+			SQL: SQLOutputConfig{
+				Enabled:        false,
+				DataSourceName: "indexer.db",
+				EnabledIndexes: []string{"transactions", "miniblocks"},
+			},
+		},
 	}
 
 	testString := `
@@ -230,7 +242,18 @@ func TestTomlExternalParser(t *testing.T) {
     Enabled = true
     URL = "` + indexerURL + `"
     Username = "` + elasticUsername + `"
-    Password = "` + elasticPassword + `"`
+    Password = "` + elasticPassword + `"
+
+[IndexerOutputs]
+    [IndexerOutputs.JSONFile]
+        Enabled = true
+        FilePath = "indexer.json"
+        EnabledIndexes = ["blocks"]
+
+    [IndexerOutputs.SQL]
+        Enabled = false
+        DataSourceName = "indexer.db"
+        EnabledIndexes = ["transactions", "miniblocks"]`
 
 	cfg := ExternalConfig{}
 
@@ -127,3 +127,17 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// RoundTraceRecorder defines the behaviour of a component able to record the timeline of each consensus round: the
+// consensus messages sent and received, the progress of the signatures and the reasons for which the round failed
+type RoundTraceRecorder interface {
+	StartRound(round int64, roundTimeStamp time.Time)
+	SetLeader(round int64, leader []byte, isSelfLeader bool, consensusGroupSize int)
+	ReceivedMessage(round int64, messageType string, pubKey []byte)
+	SentMessage(round int64, messageType string)
+	SignaturesProgress(round int64, bitmap []byte, numSignatures int, threshold int)
+	RoundFailed(round int64, reason string)
+	RoundCompleted(round int64, nonce uint64)
+	Close() error
+	IsInterfaceNil() bool
+}
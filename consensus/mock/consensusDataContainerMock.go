@@ -34,6 +34,7 @@ type ConsensusCoreMock struct {
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
+	roundTraceRecorder      consensus.RoundTraceRecorder
 }
 
 // GetAntiFloodHandler -
@@ -211,6 +212,16 @@ func (ccm *ConsensusCoreMock) SetFallbackHeaderValidator(fallbackHeaderValidator
 	ccm.fallbackHeaderValidator = fallbackHeaderValidator
 }
 
+// RoundTraceRecorder -
+func (ccm *ConsensusCoreMock) RoundTraceRecorder() consensus.RoundTraceRecorder {
+	return ccm.roundTraceRecorder
+}
+
+// SetRoundTraceRecorder -
+func (ccm *ConsensusCoreMock) SetRoundTraceRecorder(roundTraceRecorder consensus.RoundTraceRecorder) {
+	ccm.roundTraceRecorder = roundTraceRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
@@ -0,0 +1,37 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// DoubleSigningDetectorStub -
+type DoubleSigningDetectorStub struct {
+	ReceivedHeaderCalled         func(header data.HeaderHandler, headerHash []byte)
+	ReceivedSignatureShareCalled func(pubKey []byte, headerHash []byte, signatureShare []byte)
+	GetProofsCalled              func() [][]byte
+}
+
+// ReceivedHeader -
+func (stub *DoubleSigningDetectorStub) ReceivedHeader(header data.HeaderHandler, headerHash []byte) {
+	if stub.ReceivedHeaderCalled != nil {
+		stub.ReceivedHeaderCalled(header, headerHash)
+	}
+}
+
+// ReceivedSignatureShare -
+func (stub *DoubleSigningDetectorStub) ReceivedSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if stub.ReceivedSignatureShareCalled != nil {
+		stub.ReceivedSignatureShareCalled(pubKey, headerHash, signatureShare)
+	}
+}
+
+// GetProofs -
+func (stub *DoubleSigningDetectorStub) GetProofs() [][]byte {
+	if stub.GetProofsCalled != nil {
+		return stub.GetProofsCalled()
+	}
+	return make([][]byte, 0)
+}
+
+// IsInterfaceNil -
+func (stub *DoubleSigningDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
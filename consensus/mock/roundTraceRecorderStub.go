@@ -0,0 +1,78 @@
+package mock
+
+import "time"
+
+// RoundTraceRecorderStub -
+type RoundTraceRecorderStub struct {
+	StartRoundCalled         func(round int64, roundTimeStamp time.Time)
+	SetLeaderCalled          func(round int64, leader []byte, isSelfLeader bool, consensusGroupSize int)
+	ReceivedMessageCalled    func(round int64, messageType string, pubKey []byte)
+	SentMessageCalled        func(round int64, messageType string)
+	SignaturesProgressCalled func(round int64, bitmap []byte, numSignatures int, threshold int)
+	RoundFailedCalled        func(round int64, reason string)
+	RoundCompletedCalled     func(round int64, nonce uint64)
+	CloseCalled              func() error
+}
+
+// StartRound -
+func (stub *RoundTraceRecorderStub) StartRound(round int64, roundTimeStamp time.Time) {
+	if stub.StartRoundCalled != nil {
+		stub.StartRoundCalled(round, roundTimeStamp)
+	}
+}
+
+// SetLeader -
+func (stub *RoundTraceRecorderStub) SetLeader(round int64, leader []byte, isSelfLeader bool, consensusGroupSize int) {
+	if stub.SetLeaderCalled != nil {
+		stub.SetLeaderCalled(round, leader, isSelfLeader, consensusGroupSize)
+	}
+}
+
+// ReceivedMessage -
+func (stub *RoundTraceRecorderStub) ReceivedMessage(round int64, messageType string, pubKey []byte) {
+	if stub.ReceivedMessageCalled != nil {
+		stub.ReceivedMessageCalled(round, messageType, pubKey)
+	}
+}
+
+// SentMessage -
+func (stub *RoundTraceRecorderStub) SentMessage(round int64, messageType string) {
+	if stub.SentMessageCalled != nil {
+		stub.SentMessageCalled(round, messageType)
+	}
+}
+
+// SignaturesProgress -
+func (stub *RoundTraceRecorderStub) SignaturesProgress(round int64, bitmap []byte, numSignatures int, threshold int) {
+	if stub.SignaturesProgressCalled != nil {
+		stub.SignaturesProgressCalled(round, bitmap, numSignatures, threshold)
+	}
+}
+
+// RoundFailed -
+func (stub *RoundTraceRecorderStub) RoundFailed(round int64, reason string) {
+	if stub.RoundFailedCalled != nil {
+		stub.RoundFailedCalled(round, reason)
+	}
+}
+
+// RoundCompleted -
+func (stub *RoundTraceRecorderStub) RoundCompleted(round int64, nonce uint64) {
+	if stub.RoundCompletedCalled != nil {
+		stub.RoundCompletedCalled(round, nonce)
+	}
+}
+
+// Close -
+func (stub *RoundTraceRecorderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RoundTraceRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -83,12 +83,14 @@ func (sr *subroundBlock) doBlockJob() bool {
 	header, err := sr.createHeader()
 	if err != nil {
 		log.Debug("doBlockJob.createHeader", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "block header could not be created: "+err.Error())
 		return false
 	}
 
 	header, body, err := sr.createBlock(header)
 	if err != nil {
 		log.Debug("doBlockJob.createBlock", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "block could not be created: "+err.Error())
 		return false
 	}
 
@@ -189,6 +191,7 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 		return false
 	}
 
+	sr.RoundTraceRecorder().SentMessage(sr.RoundIndex, getStringValue(MtBlockBodyAndHeader))
 	log.Debug("step 1: block body and header have been sent",
 		"nonce", headerHandler.GetNonce(),
 		"hash", headerHash)
@@ -224,6 +227,7 @@ func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalized
 		return false
 	}
 
+	sr.RoundTraceRecorder().SentMessage(sr.RoundIndex, getStringValue(MtBlockBody))
 	log.Debug("step 1: block body has been sent")
 
 	sr.Body = bodyHandler
@@ -257,6 +261,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 		return false
 	}
 
+	sr.RoundTraceRecorder().SentMessage(sr.RoundIndex, getStringValue(MtBlockHeader))
 	log.Debug("step 1: block header has been sent",
 		"nonce", headerHandler.GetNonce(),
 		"hash", headerHash)
@@ -510,6 +515,7 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 			"round", sr.Rounder().Index(),
 			"subround", sr.Name(),
 			"error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "received block could not be processed: "+err.Error())
 
 		sr.RoundCanceled = true
 
@@ -181,6 +181,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	err := sr.checkSignaturesValidity(bitmap)
 	if err != nil {
 		log.Debug("doEndRoundJob.checkSignaturesValidity", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "signatures are not valid: "+err.Error())
 		return false
 	}
 
@@ -188,6 +189,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
 	if err != nil {
 		log.Debug("doEndRoundJob.AggregateSigs", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "signatures could not be aggregated: "+err.Error())
 		return false
 	}
 
@@ -225,10 +227,12 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	}
 	if err != nil {
 		log.Debug("doEndRoundJob.CommitBlock", "error", err)
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "block could not be committed: "+err.Error())
 		return false
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundTraceRecorder().RoundCompleted(sr.RoundIndex, sr.Header.GetNonce())
 
 	sr.displayStatistics()
 
@@ -270,6 +274,7 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		return
 	}
 
+	sr.RoundTraceRecorder().SentMessage(sr.RoundIndex, getStringValue(MtBlockHeaderFinalInfo))
 	log.Debug("step 3: block header final info has been sent",
 		"PubKeysBitmap", sr.Header.GetPubKeysBitmap(),
 		"AggregateSignature", sr.Header.GetSignature(),
@@ -331,10 +336,12 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 	if err != nil {
 		log.Debug("doEndRoundJobByParticipant.CommitBlock", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "block could not be committed: "+err.Error())
 		return false
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundTraceRecorder().RoundCompleted(sr.RoundIndex, header.GetNonce())
 
 	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		err = sr.setHeaderForValidator(header)
@@ -528,6 +535,7 @@ func (sr *subroundEndRound) isOutOfTime() bool {
 		log.Debug("canceled round, time is out",
 			"round", sr.SyncTimer().FormattedCurrentTime(), sr.Rounder().Index(),
 			"subround", sr.Name())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "round has been canceled as the time for committing the block is out")
 
 		sr.RoundCanceled = true
 		return true
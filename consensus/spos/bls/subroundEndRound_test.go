@@ -499,6 +499,30 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobShouldRecordTheRoundInTheRoundTrace(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	completedNonce := uint64(0)
+	failureReasons := make([]string, 0)
+	container.SetRoundTraceRecorder(&mock.RoundTraceRecorderStub{
+		RoundCompletedCalled: func(round int64, nonce uint64) {
+			completedNonce = nonce
+		},
+		RoundFailedCalled: func(round int64, reason string) {
+			failureReasons = append(failureReasons, reason)
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+	sr.Header = &block.Header{Nonce: 37}
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, uint64(37), completedNonce)
+	assert.Empty(t, failureReasons)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
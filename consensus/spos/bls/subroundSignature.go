@@ -104,6 +104,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 			return false
 		}
 
+		sr.RoundTraceRecorder().SentMessage(sr.RoundIndex, getStringValue(MtSignature))
 		log.Debug("step 2: signature has been sent")
 	}
 
@@ -180,6 +181,13 @@ func (sr *subroundSignature) receivedSignature(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.RoundTraceRecorder().SignaturesProgress(
+		sr.RoundIndex,
+		sr.GenerateBitmap(SrSignature),
+		sr.getNumOfSignaturesCollected(),
+		sr.Threshold(sr.Current()),
+	)
+
 	sr.PeerHonestyHandler().ChangeScore(
 		node,
 		spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -78,6 +78,7 @@ func (sr *subroundStartRound) doStartRoundJob() bool {
 	sr.ResetConsensusState()
 	sr.RoundIndex = sr.Rounder().Index()
 	sr.RoundTimeStamp = sr.Rounder().TimeStamp()
+	sr.RoundTraceRecorder().StartRound(sr.RoundIndex, sr.RoundTimeStamp)
 	topic := spos.GetConsensusTopicID(sr.ShardCoordinator())
 	sr.GetAntiFloodHandler().ResetForTopic(topic)
 	sr.resetConsensusMessages()
@@ -104,6 +105,7 @@ func (sr *subroundStartRound) doStartRoundConsensusCheck() bool {
 func (sr *subroundStartRound) initCurrentRound() bool {
 	nodeState := sr.BootStrapper().GetNodeState()
 	if nodeState != core.NsSynchronized { // if node is not synchronized yet, it has to continue the bootstrapping mechanism
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "node is not synchronized")
 		return false
 	}
 
@@ -114,6 +116,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		log.Debug("initCurrentRound.generateNextConsensusGroup",
 			"round index", sr.Rounder().Index(),
 			"error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "consensus group could not be computed: "+err.Error())
 
 		sr.RoundCanceled = true
 
@@ -123,6 +126,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	leader, err := sr.GetLeader()
 	if err != nil {
 		log.Debug("initCurrentRound.GetLeader", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "leader could not be computed: "+err.Error())
 
 		sr.RoundCanceled = true
 
@@ -142,6 +146,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		"messsage", msg)
 
 	pubKeys := sr.ConsensusGroup()
+	sr.RoundTraceRecorder().SetLeader(sr.RoundIndex, []byte(leader), leader == sr.SelfPubKey(), len(pubKeys))
 
 	sr.indexRoundIfNeeded(pubKeys)
 
@@ -159,6 +164,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
 	if err != nil {
 		log.Debug("initCurrentRound.Reset", "error", err.Error())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "multi signer could not be reset: "+err.Error())
 
 		sr.RoundCanceled = true
 
@@ -171,6 +177,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		log.Debug("canceled round, time is out",
 			"round", sr.SyncTimer().FormattedCurrentTime(), sr.Rounder().Index(),
 			"subround", sr.Name())
+		sr.RoundTraceRecorder().RoundFailed(sr.RoundIndex, "round has been canceled as the time for starting it is out")
 
 		sr.RoundCanceled = true
 
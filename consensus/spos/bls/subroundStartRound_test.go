@@ -310,6 +310,29 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenGetNodeStateNot
 	assert.False(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldRecordTheFailureInTheRoundTrace(t *testing.T) {
+	t.Parallel()
+
+	bootstrapperMock := &mock.BootstrapperMock{}
+	bootstrapperMock.GetNodeStateCalled = func() core.NodeState {
+		return core.NsNotSynchronized
+	}
+	failureReason := ""
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+	container.SetRoundTraceRecorder(&mock.RoundTraceRecorderStub{
+		RoundFailedCalled: func(round int64, reason string) {
+			failureReason = reason
+		},
+	})
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.False(t, r)
+	assert.Equal(t, "node is not synchronized", failureReason)
+}
+
 func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenGenerateNextConsensusGroupErr(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +34,7 @@ type ConsensusCore struct {
 	peerHonestyHandler            consensus.PeerHonestyHandler
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
+	roundTraceRecorder            consensus.RoundTraceRecorder
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -57,6 +58,7 @@ type ConsensusCoreArgs struct {
 	PeerHonestyHandler            consensus.PeerHonestyHandler
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
+	RoundTraceRecorder            consensus.RoundTraceRecorder
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -83,6 +85,7 @@ func NewConsensusCore(
 		peerHonestyHandler:            args.PeerHonestyHandler,
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
+		roundTraceRecorder:            args.RoundTraceRecorder,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -188,6 +191,11 @@ func (cc *ConsensusCore) FallbackHeaderValidator() consensus.FallbackHeaderValid
 	return cc.fallbackHeaderValidator
 }
 
+// RoundTraceRecorder will return the recorder of the consensus rounds timeline which will be used in subrounds
+func (cc *ConsensusCore) RoundTraceRecorder() consensus.RoundTraceRecorder {
+	return cc.roundTraceRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
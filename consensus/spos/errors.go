@@ -213,3 +213,9 @@ var ErrMessageTypeLimitReached = errors.New("consensus message type limit has be
 
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
+
+// ErrNilDoubleSigningDetector signals that a nil double signing detector has been provided
+var ErrNilDoubleSigningDetector = errors.New("nil double signing detector")
+
+// ErrNilRoundTraceRecorder signals that a nil round trace recorder has been provided
+var ErrNilRoundTraceRecorder = errors.New("nil round trace recorder")
@@ -141,6 +141,10 @@ func (wrk *Worker) CheckSelfState(cnsDta *consensus.Message) error {
 	return wrk.checkSelfState(cnsDta)
 }
 
+func (wrk *Worker) DoJobOnMessageWithSignature(cnsMsg *consensus.Message) {
+	wrk.doJobOnMessageWithSignature(cnsMsg)
+}
+
 func (rcns *RoundConsensus) EligibleList() map[string]struct{} {
 	return rcns.eligibleNodes
 }
@@ -54,6 +54,8 @@ type ConsensusCoreHandler interface {
 	HeaderSigVerifier() consensus.HeaderSigVerifier
 	// FallbackHeaderValidator returns the fallback header validator handler which will be used in subrounds
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
+	// RoundTraceRecorder returns the recorder of the consensus rounds timeline which will be used in subrounds
+	RoundTraceRecorder() consensus.RoundTraceRecorder
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
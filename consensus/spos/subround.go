@@ -126,28 +126,57 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 	startTime := rounder.TimeStamp()
 	maxTime := rounder.TimeDuration() * MaxThresholdPercent / 100
 
-	sr.Job()
-	if sr.Check() {
+	if sr.StartWork() {
 		return true
 	}
 
 	for {
 		select {
 		case <-sr.consensusStateChangedChannel:
-			if sr.Check() {
+			if sr.CheckWork() {
 				return true
 			}
 		case <-time.After(rounder.RemainingTime(startTime, maxTime)):
-			if sr.Extend != nil {
-				sr.RoundCanceled = true
-				sr.Extend(sr.current)
-			}
+			sr.CancelWork()
 
 			return false
 		}
 	}
 }
 
+// StartWork method does the Job of this Subround and then checks the consensus once, without waiting for the
+// consensus state to change. It is used, together with CheckWork and CancelWork, by the chronologies which drive
+// the subrounds step by step instead of calling DoWork
+func (sr *Subround) StartWork() bool {
+	if sr.Job == nil || sr.Check == nil {
+		return false
+	}
+
+	sr.Job()
+
+	return sr.Check()
+}
+
+// CheckWork method checks if the consensus of this Subround is done
+func (sr *Subround) CheckWork() bool {
+	if sr.Check == nil {
+		return false
+	}
+
+	return sr.Check()
+}
+
+// CancelWork method cancels the current round and calls the Extend method, as it is done when the upper time limit
+// of this Subround is reached
+func (sr *Subround) CancelWork() {
+	if sr.Extend == nil {
+		return
+	}
+
+	sr.RoundCanceled = true
+	sr.Extend(sr.current)
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
@@ -716,6 +716,103 @@ func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhi
 	assert.True(t, r)
 }
 
+func createStartRoundSubround() *spos.Subround {
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	return sr
+}
+
+func TestSubround_StartWorkShouldReturnFalseWhenJobFunctionIsNotSet(t *testing.T) {
+	t.Parallel()
+
+	sr := createStartRoundSubround()
+	sr.Job = nil
+	sr.Check = func() bool {
+		return true
+	}
+
+	assert.False(t, sr.StartWork())
+}
+
+func TestSubround_StartWorkShouldDoTheJobAndCheckTheConsensus(t *testing.T) {
+	t.Parallel()
+
+	jobCalled := false
+	sr := createStartRoundSubround()
+	sr.Job = func() bool {
+		jobCalled = true
+		return true
+	}
+	sr.Check = func() bool {
+		return jobCalled
+	}
+
+	assert.True(t, sr.StartWork())
+}
+
+func TestSubround_CheckWorkShouldNotCallTheJob(t *testing.T) {
+	t.Parallel()
+
+	jobCalled := false
+	sr := createStartRoundSubround()
+	sr.Job = func() bool {
+		jobCalled = true
+		return true
+	}
+	sr.Check = func() bool {
+		return true
+	}
+
+	assert.True(t, sr.CheckWork())
+	assert.False(t, jobCalled)
+
+	sr.Check = nil
+	assert.False(t, sr.CheckWork())
+}
+
+func TestSubround_CancelWorkShouldCancelTheRoundAndExtend(t *testing.T) {
+	t.Parallel()
+
+	extendedSubround := -2
+	sr := createStartRoundSubround()
+	sr.Extend = func(subroundId int) {
+		extendedSubround = subroundId
+	}
+
+	sr.CancelWork()
+
+	assert.True(t, sr.RoundCanceled)
+	assert.Equal(t, bls.SrStartRound, extendedSubround)
+}
+
+func TestSubround_CancelWorkWithoutExtendShouldNotCancelTheRound(t *testing.T) {
+	t.Parallel()
+
+	sr := createStartRoundSubround()
+	sr.Extend = nil
+
+	sr.CancelWork()
+
+	assert.False(t, sr.RoundCanceled)
+}
+
 func TestSubround_Previous(t *testing.T) {
 	t.Parallel()
 
@@ -65,8 +65,10 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler      consensus.P2PAntifloodHandler
+	poolAdder             PoolAdder
+	doubleSigningDetector process.DoubleSigningDetector
+	roundTraceRecorder    consensus.RoundTraceRecorder
 
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
@@ -95,6 +97,8 @@ type WorkerArgs struct {
 	PoolAdder                PoolAdder
 	SignatureSize            int
 	PublicKeySize            int
+	DoubleSigningDetector    process.DoubleSigningDetector
+	RoundTraceRecorder       consensus.RoundTraceRecorder
 }
 
 // NewWorker creates a new Worker object
@@ -139,6 +143,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		doubleSigningDetector:    args.DoubleSigningDetector,
+		roundTraceRecorder:       args.RoundTraceRecorder,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -227,6 +233,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.DoubleSigningDetector) {
+		return ErrNilDoubleSigningDetector
+	}
+	if check.IfNil(args.RoundTraceRecorder) {
+		return ErrNilRoundTraceRecorder
+	}
 
 	return nil
 }
@@ -354,6 +366,7 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	wrk.updateNetworkShardingVals(message, cnsMsg)
+	wrk.roundTraceRecorder.ReceivedMessage(cnsMsg.RoundIndex, wrk.consensusService.GetStringValue(msgType), cnsMsg.PubKey)
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -437,6 +450,7 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 	}
 
 	wrk.processReceivedHeaderMetric(cnsMsg)
+	wrk.doubleSigningDetector.ReceivedHeader(header, headerHash)
 
 	errNotCritical := wrk.forkDetector.AddHeader(header, headerHash, process.BHProposed, nil, nil)
 	if errNotCritical != nil {
@@ -450,6 +464,8 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 }
 
 func (wrk *Worker) doJobOnMessageWithSignature(cnsMsg *consensus.Message) {
+	wrk.doubleSigningDetector.ReceivedSignatureShare(cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+
 	wrk.mutDisplayHashConsensusMessage.Lock()
 	defer wrk.mutDisplayHashConsensusMessage.Unlock()
 
@@ -575,6 +591,10 @@ func (wrk *Worker) Extend(subroundId int) {
 	wrk.consensusState.ExtendedCalled = true
 	log.Debug("extend function is called",
 		"subround", wrk.consensusService.GetSubroundName(subroundId))
+	wrk.roundTraceRecorder.RoundFailed(
+		wrk.consensusState.RoundIndex,
+		fmt.Sprintf("subround %s has been extended as it did not finish in time", wrk.consensusService.GetSubroundName(subroundId)),
+	)
 
 	wrk.DisplayStatistics()
 
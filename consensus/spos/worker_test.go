@@ -95,6 +95,8 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		PoolAdder:                poolAdder,
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
+		DoubleSigningDetector:    &mock.DoubleSigningDetectorStub{},
+		RoundTraceRecorder:       &mock.RoundTraceRecorderStub{},
 	}
 
 	return workerArgs
@@ -346,6 +348,28 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerDoubleSigningDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.DoubleSigningDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilDoubleSigningDetector, err)
+}
+
+func TestWorker_NewWorkerRoundTraceRecorderNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.RoundTraceRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundTraceRecorder, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1240,6 +1264,92 @@ func TestWorker_ExecuteBlockHeaderMessagesShouldNotExecuteWhenStartRoundIsNotFin
 	assert.NotNil(t, wrk.ReceivedMessages()[msgType][0])
 }
 
+func TestWorker_ProcessReceivedMessageWithHeaderShouldNotifyDoubleSigningDetector(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+
+	var receivedHeaderHash []byte
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return hdr
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	workerArgs.DoubleSigningDetector = &mock.DoubleSigningDetectorStub{
+		ReceivedHeaderCalled: func(header data.HeaderHandler, headerHash []byte) {
+			receivedHeaderHash = headerHash
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hdrHash, receivedHeaderHash)
+}
+
+func TestWorker_DoJobOnMessageWithSignatureShouldNotifyDoubleSigningDetector(t *testing.T) {
+	t.Parallel()
+
+	var receivedPubKey, receivedHeaderHash, receivedSignatureShare []byte
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.DoubleSigningDetector = &mock.DoubleSigningDetectorStub{
+		ReceivedSignatureShareCalled: func(pubKey []byte, headerHash []byte, signatureShare []byte) {
+			receivedPubKey = pubKey
+			receivedHeaderHash = headerHash
+			receivedSignatureShare = signatureShare
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		[]byte("header hash"),
+		[]byte("signature share"),
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	wrk.DoJobOnMessageWithSignature(cnsMsg)
+
+	assert.Equal(t, cnsMsg.PubKey, receivedPubKey)
+	assert.Equal(t, []byte("header hash"), receivedHeaderHash)
+	assert.Equal(t, []byte("signature share"), receivedSignatureShare)
+}
+
 func TestWorker_ExecuteSignatureMessagesShouldNotExecuteWhenBlockIsNotFinished(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -1546,3 +1656,76 @@ func TestWorker_ProcessReceivedMessageWrongHeaderShouldErr(t *testing.T) {
 	err := wrk.ProcessReceivedMessage(msg, "")
 	assert.True(t, errors.Is(err, spos.ErrInvalidHeader))
 }
+
+func TestWorker_ProcessReceivedMessageShouldRecordTheMessageInTheRoundTrace(t *testing.T) {
+	t.Parallel()
+
+	recordedRound := int64(-1)
+	var recordedMessageType string
+	var recordedPubKey []byte
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return hdr
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	workerArgs.RoundTraceRecorder = &mock.RoundTraceRecorderStub{
+		ReceivedMessageCalled: func(round int64, messageType string, pubKey []byte) {
+			recordedRound = round
+			recordedMessageType = messageType
+			recordedPubKey = pubKey
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), recordedRound)
+	assert.Equal(t, bls.BlockHeaderStringValue, recordedMessageType)
+	assert.Equal(t, cnsMsg.PubKey, recordedPubKey)
+}
+
+func TestWorker_ExtendShouldRecordTheFailureInTheRoundTrace(t *testing.T) {
+	t.Parallel()
+
+	var recordedReason string
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.RoundTraceRecorder = &mock.RoundTraceRecorderStub{
+		RoundFailedCalled: func(round int64, reason string) {
+			recordedReason = reason
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	wrk.Extend(bls.SrSignature)
+
+	assert.Contains(t, recordedReason, "(SIGNATURE)")
+}
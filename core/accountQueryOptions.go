@@ -0,0 +1,14 @@
+package core
+
+// AccountQueryOptions holds the options used to select the block whose state is used when querying accounts.
+// If neither the nonce nor the hash is set, the current state is used
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsHistorical returns true if the options select a specific block instead of the current state
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
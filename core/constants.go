@@ -401,6 +401,8 @@ const (
 	IndexerOrder
 	// NetStatisticsOrder defines the order in which netStatistic component is notified of a start of epoch event
 	NetStatisticsOrder
+	// GovernanceOrder defines the order in which the governance config changes are applied on a start of epoch event
+	GovernanceOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -481,9 +483,72 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionMultiESDTTransfer is the key for the elrond standard digital token multi transfer built-in function
+const BuiltInFunctionMultiESDTTransfer = "MultiESDTTransfer"
+
+// BuiltInFunctionESDTLocalMint is the key for the elrond standard digital token local mint built-in function
+const BuiltInFunctionESDTLocalMint = "ESDTLocalMint"
+
+// BuiltInFunctionESDTLocalBurn is the key for the elrond standard digital token local burn built-in function
+const BuiltInFunctionESDTLocalBurn = "ESDTLocalBurn"
+
+// BuiltInFunctionSetESDTRole is the key for the elrond standard digital token set role built-in function
+const BuiltInFunctionSetESDTRole = "ESDTSetRole"
+
+// BuiltInFunctionUnSetESDTRole is the key for the elrond standard digital token unset role built-in function
+const BuiltInFunctionUnSetESDTRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionESDTSetTokenType is the key for the elrond standard digital token set token type built-in function
+const BuiltInFunctionESDTSetTokenType = "ESDTSetTokenType"
+
+// BuiltInFunctionSetGuardian is the key for the set guardian built-in function
+const BuiltInFunctionSetGuardian = "SetGuardian"
+
+// BuiltInFunctionRemoveGuardian is the key for the remove guardian built-in function
+const BuiltInFunctionRemoveGuardian = "RemoveGuardian"
+
+// GuardiansKeyIdentifier is the key identifier used to store the guardians of an account in its data trie
+const GuardiansKeyIdentifier = "guardians"
+
+// BuiltInFunctionSetMultisig is the key for the set multisig built-in function
+const BuiltInFunctionSetMultisig = "SetMultisig"
+
+// MultisigKeyIdentifier is the key identifier used to store the multisig configuration of an account in its data trie
+const MultisigKeyIdentifier = "multisig"
+
+// ESDTRoleLocalMint is the constant string for the local role of mint for ESDT tokens
+const ESDTRoleLocalMint = "ESDTRoleLocalMint"
+
+// ESDTRoleLocalBurn is the constant string for the local role of burn for ESDT tokens
+const ESDTRoleLocalBurn = "ESDTRoleLocalBurn"
+
+// ESDTRoleNFTCreate is the constant string for the local role of create for ESDT non fungible tokens
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the constant string for the local role of adding quantity for existing ESDT semi fungible tokens
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the constant string for the local role of burn for ESDT non fungible tokens
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
+// MultiRelayedTransaction is the key for the relayed transaction carrying multiple inner user transactions
+const MultiRelayedTransaction = "multiRelayedTx"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -508,6 +573,15 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// ESDTRoleIdentifier is the key prefix for esdt role identifier
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key prefix for esdt latest nonce identifier
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
+// MaxRoyalty defines the maximum royalty, in hundredths of percent, which can be set on a non fungible token
+const MaxRoyalty = uint32(10000)
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
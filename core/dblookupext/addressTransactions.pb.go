@@ -0,0 +1,1038 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressTransactions.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AddressTransactionEntry struct {
+	TxHash     []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Type       int32  `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Epoch      uint32 `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	BlockNonce uint64 `protobuf:"varint,4,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Round      uint64 `protobuf:"varint,5,opt,name=Round,proto3" json:"Round,omitempty"`
+	BlockHash  []byte `protobuf:"bytes,6,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+}
+
+func (m *AddressTransactionEntry) Reset()      { *m = AddressTransactionEntry{} }
+func (*AddressTransactionEntry) ProtoMessage() {}
+func (*AddressTransactionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{0}
+}
+func (m *AddressTransactionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransactionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransactionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransactionEntry.Merge(m, src)
+}
+func (m *AddressTransactionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransactionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransactionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransactionEntry proto.InternalMessageInfo
+
+func (m *AddressTransactionEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressTransactionEntry) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *AddressTransactionEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressTransactionEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AddressTransactionEntry) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AddressTransactionEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type AddressTransactionsChunk struct {
+	Entries []*AddressTransactionEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (m *AddressTransactionsChunk) Reset()      { *m = AddressTransactionsChunk{} }
+func (*AddressTransactionsChunk) ProtoMessage() {}
+func (*AddressTransactionsChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{1}
+}
+func (m *AddressTransactionsChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransactionsChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransactionsChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransactionsChunk.Merge(m, src)
+}
+func (m *AddressTransactionsChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransactionsChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransactionsChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransactionsChunk proto.InternalMessageInfo
+
+func (m *AddressTransactionsChunk) GetEntries() []*AddressTransactionEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type AddressTransactionsInfo struct {
+	NumEntries uint64 `protobuf:"varint,1,opt,name=NumEntries,proto3" json:"NumEntries,omitempty"`
+}
+
+func (m *AddressTransactionsInfo) Reset()      { *m = AddressTransactionsInfo{} }
+func (*AddressTransactionsInfo) ProtoMessage() {}
+func (*AddressTransactionsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{2}
+}
+func (m *AddressTransactionsInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransactionsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransactionsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransactionsInfo.Merge(m, src)
+}
+func (m *AddressTransactionsInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransactionsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransactionsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransactionsInfo proto.InternalMessageInfo
+
+func (m *AddressTransactionsInfo) GetNumEntries() uint64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AddressTransactionEntry)(nil), "proto.AddressTransactionEntry")
+	proto.RegisterType((*AddressTransactionsChunk)(nil), "proto.AddressTransactionsChunk")
+	proto.RegisterType((*AddressTransactionsInfo)(nil), "proto.AddressTransactionsInfo")
+}
+
+func init() { proto.RegisterFile("addressTransactions.proto", fileDescriptor_f4213e982049533d) }
+
+var fileDescriptor_f4213e982049533d = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbf, 0x4e, 0x2a, 0x41,
+	0x14, 0xc6, 0xf7, 0x5c, 0x58, 0x6e, 0xee, 0x70, 0x6d, 0x26, 0x46, 0x47, 0x63, 0x4e, 0x36, 0x54,
+	0xdb, 0x08, 0x89, 0x36, 0x5a, 0x8a, 0x21, 0xd1, 0x86, 0x62, 0xb3, 0x95, 0xdd, 0xfe, 0x83, 0x25,
+	0xc0, 0x9c, 0xcd, 0xce, 0x6e, 0x02, 0x9d, 0x8f, 0xe0, 0x63, 0xd8, 0xf9, 0x1a, 0x96, 0x94, 0x94,
+	0x32, 0x34, 0x96, 0x3c, 0x82, 0x61, 0x56, 0x83, 0x09, 0x5a, 0xed, 0xf9, 0x7d, 0x7b, 0xfe, 0x7c,
+	0xdf, 0xb0, 0x93, 0x20, 0x8e, 0xf3, 0x44, 0x29, 0x3f, 0x0f, 0xa4, 0x0a, 0xa2, 0x62, 0x44, 0x52,
+	0xb5, 0xb3, 0x9c, 0x0a, 0xe2, 0xb6, 0xf9, 0x9c, 0x9e, 0x0f, 0x47, 0x45, 0x5a, 0x86, 0xed, 0x88,
+	0xa6, 0x9d, 0x21, 0x0d, 0xa9, 0x63, 0xe4, 0xb0, 0x1c, 0x18, 0x32, 0x60, 0xaa, 0x6a, 0xaa, 0xf5,
+	0x02, 0xec, 0xf8, 0x66, 0x6f, 0x67, 0x4f, 0x16, 0xf9, 0x9c, 0x1f, 0xb1, 0x86, 0x3f, 0xbb, 0x0b,
+	0x54, 0x2a, 0xc0, 0x01, 0xf7, 0xbf, 0xf7, 0x49, 0x9c, 0xb3, 0xba, 0x3f, 0xcf, 0x12, 0xf1, 0xc7,
+	0x01, 0xd7, 0xf6, 0x4c, 0xcd, 0x0f, 0x99, 0xdd, 0xcb, 0x28, 0x4a, 0x45, 0xcd, 0x01, 0xf7, 0xc0,
+	0xab, 0x80, 0x23, 0x63, 0xdd, 0x09, 0x45, 0xe3, 0x3e, 0xc9, 0x28, 0x11, 0x75, 0x07, 0xdc, 0xba,
+	0xf7, 0x4d, 0xd9, 0x4e, 0x79, 0x54, 0xca, 0x58, 0xd8, 0xe6, 0x57, 0x05, 0xfc, 0x8c, 0xfd, 0x33,
+	0x3d, 0xe6, 0x74, 0xc3, 0x9c, 0xde, 0x09, 0x2d, 0x9f, 0x89, 0x7d, 0xc3, 0xea, 0x36, 0x2d, 0xe5,
+	0x98, 0x5f, 0xb1, 0xbf, 0x5b, 0xeb, 0xa3, 0x44, 0x09, 0x70, 0x6a, 0x6e, 0xf3, 0x02, 0xab, 0x98,
+	0xed, 0x5f, 0x22, 0x7a, 0x5f, 0xed, 0xad, 0xeb, 0x9f, 0x9e, 0x41, 0xdd, 0xcb, 0x01, 0x6d, 0x43,
+	0xf4, 0xcb, 0xe9, 0x6e, 0xaf, 0x09, 0xb1, 0x53, 0xba, 0xbd, 0xc5, 0x0a, 0xad, 0xe5, 0x0a, 0xad,
+	0xcd, 0x0a, 0xe1, 0x51, 0x23, 0x3c, 0x6b, 0x84, 0x57, 0x8d, 0xb0, 0xd0, 0x08, 0x4b, 0x8d, 0xf0,
+	0xa6, 0x11, 0xde, 0x35, 0x5a, 0x1b, 0x8d, 0xf0, 0xb4, 0x46, 0x6b, 0xb1, 0x46, 0x6b, 0xb9, 0x46,
+	0xeb, 0xa1, 0x19, 0x87, 0x13, 0xa2, 0x71, 0x99, 0x25, 0xb3, 0x22, 0x6c, 0x18, 0xa7, 0x97, 0x1f,
+	0x03, 0x00, 0xee, 0x00, 0x07, 0x7e, 0xe3, 0x01, 0x00, 0x00,
+}
+
+func (this *AddressTransactionEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransactionEntry)
+	if !ok {
+		that2, ok := that.(AddressTransactionEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	return true
+}
+func (this *AddressTransactionsChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransactionsChunk)
+	if !ok {
+		that2, ok := that.(AddressTransactionsChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressTransactionsInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransactionsInfo)
+	if !ok {
+		that2, ok := that.(AddressTransactionsInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	return true
+}
+func (this *AddressTransactionEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&dblookupext.AddressTransactionEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressTransactionsChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AddressTransactionsChunk{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressTransactionsInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AddressTransactionsInfo{")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressTransactions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressTransactionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransactionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransactionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Round != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransactionsChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransactionsChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransactionsChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAddressTransactions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransactionsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransactionsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransactionsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressTransactions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressTransactions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressTransactionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Type))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Epoch))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.BlockNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Round))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressTransactionsChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAddressTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressTransactionsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.NumEntries))
+	}
+	return n
+}
+
+func sovAddressTransactions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressTransactions(x uint64) (n int) {
+	return sovAddressTransactions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressTransactionEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransactionEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressTransactionsChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*AddressTransactionEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "AddressTransactionEntry", "AddressTransactionEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&AddressTransactionsChunk{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressTransactionsInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransactionsInfo{`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressTransactions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressTransactionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransactionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransactionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransactionsChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransactionsChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransactionsChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AddressTransactionEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransactionsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransactionsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransactionsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressTransactions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressTransactions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressTransactions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressTransactions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressTransactions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressTransactions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressTransactions = fmt.Errorf("proto: unexpected end of group")
+)
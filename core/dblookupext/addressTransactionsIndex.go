@@ -0,0 +1,454 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressTransactions.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	addressTransactionsChunkSize = 100
+
+	// DefaultAddressTransactionsPageSize is the number of entries returned when the query does not specify a size
+	DefaultAddressTransactionsPageSize = 20
+	// MaxAddressTransactionsPageSize is the maximum number of entries that can be returned by a query
+	MaxAddressTransactionsPageSize = 100
+	// maxAddressTransactionsScannedEntries limits the number of entries read from the storage for a single query, so that
+	// a very selective filter does not end up in reading the whole history of an address
+	maxAddressTransactionsScannedEntries = 10000
+
+	// addressesByBlockPrefix prefixes the keys holding the addresses touched by a block, it makes them longer than both
+	// the address and the chunk keys so they can not collide
+	addressesByBlockPrefix = "addressesByBlock_"
+)
+
+// AddressTransactionsQuery holds the filters and the paging cursor used when fetching the entries of an address.
+// The entries are returned from the newest to the oldest one. An empty cursor starts from the newest entry
+type AddressTransactionsQuery struct {
+	FromNonce uint64
+	ToNonce   uint64
+	FromEpoch uint32
+	ToEpoch   uint32
+	Cursor    string
+	Size      int
+}
+
+// AddressTransactionsResult holds a page of entries and the cursor that should be used for fetching the next page.
+// An empty next cursor signals that there are no more entries to be fetched
+type AddressTransactionsResult struct {
+	Entries    []*AddressTransactionEntry
+	NextCursor string
+}
+
+// addressTransactionsIndex stores, for each address of the current shard, the ordered list of transactions, smart
+// contract results and rewards that had the address as sender or receiver. The list is split in fixed size chunks
+// keyed by address and chunk index, while the number of entries is kept under the address key. The addresses touched
+// by each block are kept under the block hash, so the entries of a block can be removed when the block is reverted
+type addressTransactionsIndex struct {
+	mutIndex         sync.RWMutex
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+}
+
+func newAddressTransactionsIndex(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	shardCoordinator sharding.Coordinator,
+) *addressTransactionsIndex {
+	return &addressTransactionsIndex{
+		storer:           storer,
+		marshalizer:      marshalizer,
+		shardCoordinator: shardCoordinator,
+	}
+}
+
+func (ati *addressTransactionsIndex) recordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	pools ...map[string]data.TransactionHandler,
+) {
+	entriesByAddress, orderedAddresses := ati.groupEntriesByAddress(blockHeaderHash, blockHeader, body, pools)
+
+	ati.mutIndex.Lock()
+	defer ati.mutIndex.Unlock()
+
+	touchedAddresses := &batch.Batch{Data: make([][]byte, 0, len(orderedAddresses))}
+	for _, address := range orderedAddresses {
+		touchedAddresses.Data = append(touchedAddresses.Data, []byte(address))
+		err := ati.appendEntries([]byte(address), blockHeader.GetNonce(), entriesByAddress[address])
+		if err != nil {
+			log.Warn("addressTransactionsIndex.appendEntries()", "address", []byte(address), "error", err)
+		}
+	}
+
+	if len(touchedAddresses.Data) == 0 {
+		return
+	}
+
+	err := ati.putTouchedAddresses(blockHeaderHash, touchedAddresses)
+	if err != nil {
+		log.Warn("addressTransactionsIndex.putTouchedAddresses()", "block hash", blockHeaderHash, "error", err)
+	}
+}
+
+// revertBlock removes the entries recorded for a block which was rolled back. Since the blocks are committed in nonce
+// order, the entries of the reverted block, and of any later one, are the last entries of each touched address
+func (ati *addressTransactionsIndex) revertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) {
+	ati.mutIndex.Lock()
+	defer ati.mutIndex.Unlock()
+
+	touchedAddresses, err := ati.getTouchedAddresses(blockHeaderHash)
+	if err != nil {
+		log.Debug("addressTransactionsIndex.revertBlock: nothing recorded for block", "block hash", blockHeaderHash)
+		return
+	}
+
+	for _, address := range touchedAddresses.Data {
+		err = ati.removeEntriesFromNonce(address, blockHeader.GetNonce())
+		if err != nil {
+			log.Warn("addressTransactionsIndex.removeEntriesFromNonce()", "address", address, "error", err)
+		}
+	}
+
+	err = ati.storer.Remove(addressesByBlockKey(blockHeaderHash))
+	if err != nil {
+		log.Warn("addressTransactionsIndex.revertBlock: cannot remove the touched addresses", "block hash", blockHeaderHash, "error", err)
+	}
+}
+
+func (ati *addressTransactionsIndex) groupEntriesByAddress(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	pools []map[string]data.TransactionHandler,
+) (map[string][]*AddressTransactionEntry, []string) {
+	entriesByAddress := make(map[string][]*AddressTransactionEntry)
+	orderedAddresses := make([]string, 0)
+
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock || miniblock.Type == block.ReceiptBlock {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			tx, ok := findTransaction(txHash, pools)
+			if !ok {
+				continue
+			}
+
+			entry := &AddressTransactionEntry{
+				TxHash:     txHash,
+				Type:       int32(miniblock.Type),
+				Epoch:      blockHeader.GetEpoch(),
+				BlockNonce: blockHeader.GetNonce(),
+				Round:      blockHeader.GetRound(),
+				BlockHash:  blockHeaderHash,
+			}
+
+			for _, address := range ati.addressesInSelfShard(tx) {
+				_, exists := entriesByAddress[address]
+				if !exists {
+					orderedAddresses = append(orderedAddresses, address)
+				}
+				entriesByAddress[address] = append(entriesByAddress[address], entry)
+			}
+		}
+	}
+
+	return entriesByAddress, orderedAddresses
+}
+
+func findTransaction(txHash []byte, pools []map[string]data.TransactionHandler) (data.TransactionHandler, bool) {
+	for _, pool := range pools {
+		tx, ok := pool[string(txHash)]
+		if ok && tx != nil {
+			return tx, true
+		}
+	}
+
+	return nil, false
+}
+
+func (ati *addressTransactionsIndex) addressesInSelfShard(tx data.TransactionHandler) []string {
+	addresses := make([]string, 0, 2)
+	for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+		if len(address) == 0 {
+			continue
+		}
+		if ati.shardCoordinator.ComputeId(address) != ati.shardCoordinator.SelfId() {
+			continue
+		}
+		if len(addresses) > 0 && addresses[0] == string(address) {
+			continue
+		}
+
+		addresses = append(addresses, string(address))
+	}
+
+	return addresses
+}
+
+func (ati *addressTransactionsIndex) appendEntries(address []byte, blockNonce uint64, entries []*AddressTransactionEntry) error {
+	// the entries recorded by a previous processing of this block, or by blocks from a fork that was not reverted
+	// through revertBlock, are dropped so that each transaction is listed only once
+	err := ati.removeEntriesFromNonce(address, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	info, err := ati.getInfo(address)
+	if err != nil {
+		return err
+	}
+
+	numEntries := info.NumEntries
+	tailIndex := uint64(0)
+	tail := &AddressTransactionsChunk{}
+	if numEntries > 0 {
+		tailIndex = (numEntries - 1) / addressTransactionsChunkSize
+		tail, err = ati.getChunk(address, tailIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		if numEntries%addressTransactionsChunkSize == 0 && numEntries > 0 {
+			err = ati.putChunk(address, tailIndex, tail)
+			if err != nil {
+				return err
+			}
+
+			tailIndex = numEntries / addressTransactionsChunkSize
+			tail = &AddressTransactionsChunk{}
+		}
+
+		tail.Entries = append(tail.Entries, entry)
+		numEntries++
+	}
+
+	err = ati.putChunk(address, tailIndex, tail)
+	if err != nil {
+		return err
+	}
+
+	info.NumEntries = numEntries
+	return ati.putInfo(address, info)
+}
+
+// removeEntriesFromNonce removes, starting with the last chunk, all the entries recorded in blocks with a nonce
+// greater or equal than the provided one
+func (ati *addressTransactionsIndex) removeEntriesFromNonce(address []byte, nonce uint64) error {
+	info, err := ati.getInfo(address)
+	if err != nil {
+		return err
+	}
+
+	initialNumEntries := info.NumEntries
+	for info.NumEntries > 0 {
+		tailIndex := (info.NumEntries - 1) / addressTransactionsChunkSize
+		tail, errGet := ati.getChunk(address, tailIndex)
+		if errGet != nil {
+			return errGet
+		}
+
+		numKept := len(tail.Entries)
+		for numKept > 0 && tail.Entries[numKept-1].BlockNonce >= nonce {
+			numKept--
+		}
+		numRemoved := len(tail.Entries) - numKept
+		if numRemoved == 0 {
+			break
+		}
+
+		info.NumEntries -= uint64(numRemoved)
+		if numKept > 0 {
+			tail.Entries = tail.Entries[:numKept]
+			err = ati.putChunk(address, tailIndex, tail)
+			if err != nil {
+				return err
+			}
+			break
+		}
+
+		err = ati.storer.Remove(chunkKey(address, tailIndex))
+		if err != nil {
+			return err
+		}
+	}
+
+	if info.NumEntries == initialNumEntries {
+		return nil
+	}
+
+	return ati.putInfo(address, info)
+}
+
+func (ati *addressTransactionsIndex) getTransactions(address []byte, query AddressTransactionsQuery) (*AddressTransactionsResult, error) {
+	size := query.Size
+	if size <= 0 {
+		size = DefaultAddressTransactionsPageSize
+	}
+	if size > MaxAddressTransactionsPageSize {
+		size = MaxAddressTransactionsPageSize
+	}
+
+	ati.mutIndex.RLock()
+	defer ati.mutIndex.RUnlock()
+
+	info, err := ati.getInfo(address)
+	if err != nil {
+		return nil, err
+	}
+
+	position := info.NumEntries
+	if len(query.Cursor) > 0 {
+		position, err = strconv.ParseUint(query.Cursor, 10, 64)
+		if err != nil || position > info.NumEntries {
+			return nil, ErrInvalidAddressTransactionsCursor
+		}
+	}
+
+	result := &AddressTransactionsResult{
+		Entries: make([]*AddressTransactionEntry, 0, size),
+	}
+
+	var chunk *AddressTransactionsChunk
+	loadedChunkIndex := uint64(0)
+	numScanned := 0
+	for position > 0 && len(result.Entries) < size && numScanned < maxAddressTransactionsScannedEntries {
+		position--
+		numScanned++
+
+		chunkIndex := position / addressTransactionsChunkSize
+		if chunk == nil || chunkIndex != loadedChunkIndex {
+			chunk, err = ati.getChunk(address, chunkIndex)
+			if err != nil {
+				return nil, err
+			}
+			loadedChunkIndex = chunkIndex
+		}
+
+		positionInChunk := int(position % addressTransactionsChunkSize)
+		if positionInChunk >= len(chunk.Entries) {
+			continue
+		}
+
+		entry := chunk.Entries[positionInChunk]
+		isOlderThanRequested := entry.BlockNonce < query.FromNonce || entry.Epoch < query.FromEpoch
+		if isOlderThanRequested {
+			// entries are recorded in block order, all the remaining ones are older
+			position = 0
+			break
+		}
+
+		isNewerThanRequested := entry.BlockNonce > query.ToNonce || entry.Epoch > query.ToEpoch
+		if isNewerThanRequested {
+			continue
+		}
+
+		result.Entries = append(result.Entries, entry)
+	}
+
+	if position > 0 {
+		result.NextCursor = strconv.FormatUint(position, 10)
+	}
+
+	return result, nil
+}
+
+func (ati *addressTransactionsIndex) getInfo(address []byte) (*AddressTransactionsInfo, error) {
+	info := &AddressTransactionsInfo{}
+	rawBytes, err := ati.storer.Get(address)
+	if err != nil {
+		// nothing recorded yet for this address
+		return info, nil
+	}
+
+	err = ati.marshalizer.Unmarshal(info, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (ati *addressTransactionsIndex) putInfo(address []byte, info *AddressTransactionsInfo) error {
+	rawBytes, err := ati.marshalizer.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(address, rawBytes)
+}
+
+func (ati *addressTransactionsIndex) getChunk(address []byte, chunkIndex uint64) (*AddressTransactionsChunk, error) {
+	rawBytes, err := ati.storer.Get(chunkKey(address, chunkIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &AddressTransactionsChunk{}
+	err = ati.marshalizer.Unmarshal(chunk, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (ati *addressTransactionsIndex) putChunk(address []byte, chunkIndex uint64, chunk *AddressTransactionsChunk) error {
+	rawBytes, err := ati.marshalizer.Marshal(chunk)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(chunkKey(address, chunkIndex), rawBytes)
+}
+
+func (ati *addressTransactionsIndex) getTouchedAddresses(blockHeaderHash []byte) (*batch.Batch, error) {
+	rawBytes, err := ati.storer.Get(addressesByBlockKey(blockHeaderHash))
+	if err != nil {
+		return nil, err
+	}
+
+	touchedAddresses := &batch.Batch{}
+	err = ati.marshalizer.Unmarshal(touchedAddresses, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return touchedAddresses, nil
+}
+
+func (ati *addressTransactionsIndex) putTouchedAddresses(blockHeaderHash []byte, touchedAddresses *batch.Batch) error {
+	rawBytes, err := ati.marshalizer.Marshal(touchedAddresses)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(addressesByBlockKey(blockHeaderHash), rawBytes)
+}
+
+func addressesByBlockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(addressesByBlockPrefix), blockHeaderHash...)
+}
+
+func chunkKey(address []byte, chunkIndex uint64) []byte {
+	key := make([]byte, len(address)+8)
+	copy(key, address)
+	binary.BigEndian.PutUint64(key[len(address):], chunkIndex)
+
+	return key
+}
@@ -0,0 +1,271 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+// with 2 shards, addresses ending in an even byte belong to shard 0 and those ending in an odd byte to shard 1
+var (
+	aliceShard0 = []byte("alice0")
+	bobShard0   = []byte("bob000")
+	carolShard1 = []byte("carol1")
+)
+
+func createAddressTransactionsIndex() *addressTransactionsIndex {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+	return newAddressTransactionsIndex(
+		genericmocks.NewStorerMock("AddressTransactions", 0),
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+	)
+}
+
+func maxQuery() AddressTransactionsQuery {
+	return AddressTransactionsQuery{
+		ToNonce: ^uint64(0),
+		ToEpoch: ^uint32(0),
+	}
+}
+
+func recordTransfers(index *addressTransactionsIndex, nonce uint64, epoch uint32, numTxs int) {
+	txs := make(map[string]data.TransactionHandler)
+	miniblock := &block.MiniBlock{Type: block.TxBlock}
+	for i := 0; i < numTxs; i++ {
+		txHash := fmt.Sprintf("tx_%d_%d", nonce, i)
+		txs[txHash] = &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0}
+		miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+	}
+
+	header := &block.Header{Nonce: nonce, Epoch: epoch, Round: nonce}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{miniblock}}
+	index.recordBlock([]byte(fmt.Sprintf("block_%d", nonce)), header, body, txs)
+}
+
+func TestAddressTransactionsIndex_RecordBlockShouldIndexSelfShardAddresses(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+
+	txs := map[string]data.TransactionHandler{
+		"intra":       &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0},
+		"cross":       &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1},
+		"self":        &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: aliceShard0},
+		"reward":      &rewardTx.RewardTx{RcvAddr: bobShard0},
+		"peerChanges": &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0},
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("intra"), []byte("cross"), []byte("self"), []byte("missing")}},
+			{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}},
+			{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peerChanges")}},
+		},
+	}
+	index.recordBlock([]byte("block"), &block.Header{Nonce: 7, Epoch: 2, Round: 8}, body, txs)
+
+	result, err := index.getTransactions(aliceShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 3)
+	require.Equal(t, []byte("self"), result.Entries[0].TxHash)
+	require.Equal(t, []byte("cross"), result.Entries[1].TxHash)
+	require.Equal(t, []byte("intra"), result.Entries[2].TxHash)
+	require.Equal(t, uint64(7), result.Entries[2].BlockNonce)
+	require.Equal(t, uint32(2), result.Entries[2].Epoch)
+	require.Equal(t, uint64(8), result.Entries[2].Round)
+	require.Equal(t, []byte("block"), result.Entries[2].BlockHash)
+	require.Equal(t, "", result.NextCursor)
+
+	result, err = index.getTransactions(bobShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 2)
+	require.Equal(t, []byte("reward"), result.Entries[0].TxHash)
+	require.Equal(t, int32(block.RewardsBlock), result.Entries[0].Type)
+
+	result, err = index.getTransactions(carolShard1, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 0)
+}
+
+func TestAddressTransactionsIndex_RecordBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	recordTransfers(index, 1, 0, 3)
+	// the second block spans two chunks, so the duplicates are not only in the last chunk
+	recordTransfers(index, 2, 0, addressTransactionsChunkSize)
+	recordTransfers(index, 2, 0, addressTransactionsChunkSize)
+
+	query := maxQuery()
+	query.Size = MaxAddressTransactionsPageSize
+	result, err := index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Len(t, result.Entries, addressTransactionsChunkSize)
+	require.NotEmpty(t, result.NextCursor)
+
+	query.Cursor = result.NextCursor
+	result, err = index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 3)
+	require.Equal(t, uint64(1), result.Entries[0].BlockNonce)
+	require.Equal(t, "", result.NextCursor)
+}
+
+func TestAddressTransactionsIndex_RevertBlockShouldRemoveItsEntries(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	recordTransfers(index, 1, 0, 2)
+
+	txs := map[string]data.TransactionHandler{
+		"tx_1_1": &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0},
+		"forked": &transaction.Transaction{SndAddr: bobShard0, RcvAddr: carolShard1},
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("forked")}}}}
+	forkHeader := &block.Header{Nonce: 2}
+	index.recordBlock([]byte("forkBlock"), forkHeader, body, txs)
+
+	result, err := index.getTransactions(bobShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 3)
+
+	index.revertBlock([]byte("forkBlock"), forkHeader)
+
+	result, err = index.getTransactions(bobShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 2)
+	require.Equal(t, uint64(1), result.Entries[0].BlockNonce)
+
+	_, err = index.getTouchedAddresses([]byte("forkBlock"))
+	require.NotNil(t, err)
+
+	// reverting an unknown block does nothing
+	index.revertBlock([]byte("unknown"), &block.Header{Nonce: 1})
+	result, err = index.getTransactions(aliceShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 2)
+}
+
+func TestAddressTransactionsIndex_RevertBlockShouldRemoveWholeChunks(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	recordTransfers(index, 1, 0, 10)
+	recordTransfers(index, 2, 0, 2*addressTransactionsChunkSize)
+
+	index.revertBlock([]byte("block_2"), &block.Header{Nonce: 2})
+
+	info, err := index.getInfo(aliceShard0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), info.NumEntries)
+	_, err = index.getChunk(aliceShard0, 1)
+	require.NotNil(t, err)
+
+	recordTransfers(index, 2, 0, 1)
+	result, err := index.getTransactions(aliceShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 11)
+	require.Equal(t, uint64(2), result.Entries[0].BlockNonce)
+}
+
+func TestAddressTransactionsIndex_PagingAcrossChunks(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	numBlocks := 5
+	txsPerBlock := 60
+	for nonce := 1; nonce <= numBlocks; nonce++ {
+		recordTransfers(index, uint64(nonce), 0, txsPerBlock)
+	}
+
+	query := maxQuery()
+	query.Size = MaxAddressTransactionsPageSize
+	seen := make(map[string]struct{})
+	numPages := 0
+	for {
+		result, err := index.getTransactions(aliceShard0, query)
+		require.Nil(t, err)
+		numPages++
+
+		for _, entry := range result.Entries {
+			_, exists := seen[string(entry.TxHash)]
+			require.False(t, exists)
+			seen[string(entry.TxHash)] = struct{}{}
+		}
+
+		if result.NextCursor == "" {
+			break
+		}
+		query.Cursor = result.NextCursor
+	}
+
+	require.Equal(t, numBlocks*txsPerBlock, len(seen))
+	require.Equal(t, 3, numPages)
+}
+
+func TestAddressTransactionsIndex_FiltersAndPageSize(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		recordTransfers(index, nonce, uint32(nonce/4), 1)
+	}
+
+	query := maxQuery()
+	query.FromNonce = 3
+	query.ToNonce = 6
+	result, err := index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 4)
+	require.Equal(t, uint64(6), result.Entries[0].BlockNonce)
+	require.Equal(t, uint64(3), result.Entries[3].BlockNonce)
+	require.Equal(t, "", result.NextCursor)
+
+	query = maxQuery()
+	query.FromEpoch = 1
+	query.ToEpoch = 1
+	result, err = index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 4)
+	for _, entry := range result.Entries {
+		require.Equal(t, uint32(1), entry.Epoch)
+	}
+
+	query = maxQuery()
+	query.Size = 3
+	result, err = index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 3)
+	require.Equal(t, "7", result.NextCursor)
+
+	query.Cursor = result.NextCursor
+	result, err = index.getTransactions(aliceShard0, query)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), result.Entries[0].BlockNonce)
+}
+
+func TestAddressTransactionsIndex_InvalidCursorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndex()
+	recordTransfers(index, 1, 0, 2)
+
+	query := maxQuery()
+	query.Cursor = "abc"
+	result, err := index.getTransactions(aliceShard0, query)
+	require.Nil(t, result)
+	require.Equal(t, ErrInvalidAddressTransactionsCursor, err)
+
+	query.Cursor = "3"
+	result, err = index.getTransactions(aliceShard0, query)
+	require.Nil(t, result)
+	require.Equal(t, ErrInvalidAddressTransactionsCursor, err)
+}
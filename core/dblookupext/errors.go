@@ -8,6 +8,24 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+// ErrAddressTransactionsIndexNotEnabled signals that the per-address transactions index is not enabled
+var ErrAddressTransactionsIndexNotEnabled = errors.New("address transactions index is not enabled")
+
+// ErrInvalidAddressTransactionsCursor signals that an invalid paging cursor has been provided
+var ErrInvalidAddressTransactionsCursor = errors.New("invalid address transactions cursor")
+
+// ErrEventsIndexNotEnabled signals that the events index is not enabled
+var ErrEventsIndexNotEnabled = errors.New("events index is not enabled")
+
+// ErrInvalidEventsCursor signals that an invalid paging cursor has been provided
+var ErrInvalidEventsCursor = errors.New("invalid events cursor")
+
+// ErrEmptyEventIdentifier signals that an empty event identifier has been provided
+var ErrEmptyEventIdentifier = errors.New("empty event identifier")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
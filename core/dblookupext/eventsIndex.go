@@ -0,0 +1,483 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. events.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	eventsChunkSize = 100
+
+	// DefaultEventsPageSize is the number of events returned when the query does not specify a size
+	DefaultEventsPageSize = 20
+	// MaxEventsPageSize is the maximum number of events that can be returned by a query
+	MaxEventsPageSize = 100
+	// maxEventsScannedEntries limits the number of entries read from the storage for a single query
+	maxEventsScannedEntries = 10000
+
+	eventsKeyByIdentifier      = byte(0)
+	eventsKeyByIdentifierTopic = byte(1)
+
+	// eventsKeysByBlockPrefix prefixes the keys holding the events keys touched by a block, it makes them longer than
+	// both the events and the chunk keys so they can not collide
+	eventsKeysByBlockPrefix = "eventsKeysByBlock_"
+)
+
+// EventsQuery holds the filters and the paging cursor used when fetching the events emitted by an address.
+// The identifier is mandatory, while the topic, if provided, is matched against the first topic of the events.
+// The events are returned from the newest to the oldest one. An empty cursor starts from the newest event
+type EventsQuery struct {
+	Identifier []byte
+	Topic      []byte
+	FromNonce  uint64
+	ToNonce    uint64
+	Cursor     string
+	Size       int
+}
+
+// EventsResult holds a page of events and the cursor that should be used for fetching the next page.
+// An empty next cursor signals that there are no more events to be fetched
+type EventsResult struct {
+	Events     []*EventEntry
+	NextCursor string
+}
+
+// eventsIndex stores, for each (emitting address, identifier) and (emitting address, identifier, first topic) pair,
+// the ordered list of smart contract events generated by the transactions and smart contract results executed in
+// the current shard. The events are read, when the block is committed, from the transaction logs storer. As in the
+// case of the address transactions index, each list is split in fixed size chunks and the keys touched by each block
+// are kept under the block hash, so the events of a block can be removed when the block is reverted
+type eventsIndex struct {
+	mutIndex    sync.RWMutex
+	selfShardID uint32
+	storer      storage.Storer
+	logsStorer  storage.Storer
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+func newEventsIndex(
+	selfShardID uint32,
+	storer storage.Storer,
+	logsStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) *eventsIndex {
+	return &eventsIndex{
+		selfShardID: selfShardID,
+		storer:      storer,
+		logsStorer:  logsStorer,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}
+}
+
+func (ei *eventsIndex) recordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, body *block.Body) {
+	entriesByKey, orderedKeys := ei.groupEventsByKey(blockHeaderHash, blockHeader, body)
+
+	ei.mutIndex.Lock()
+	defer ei.mutIndex.Unlock()
+
+	touchedKeys := &batch.Batch{Data: make([][]byte, 0, len(orderedKeys))}
+	for _, key := range orderedKeys {
+		touchedKeys.Data = append(touchedKeys.Data, []byte(key))
+		err := ei.appendEntries([]byte(key), blockHeader.GetNonce(), entriesByKey[key])
+		if err != nil {
+			log.Warn("eventsIndex.appendEntries()", "key", []byte(key), "error", err)
+		}
+	}
+
+	if len(touchedKeys.Data) == 0 {
+		return
+	}
+
+	err := ei.putTouchedKeys(blockHeaderHash, touchedKeys)
+	if err != nil {
+		log.Warn("eventsIndex.putTouchedKeys()", "block hash", blockHeaderHash, "error", err)
+	}
+}
+
+// revertBlock removes the events recorded for a block which was rolled back. As for the address transactions index,
+// the events of the reverted block, and of any later one, are the last entries of each touched key
+func (ei *eventsIndex) revertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) {
+	ei.mutIndex.Lock()
+	defer ei.mutIndex.Unlock()
+
+	touchedKeys, err := ei.getTouchedKeys(blockHeaderHash)
+	if err != nil {
+		log.Debug("eventsIndex.revertBlock: nothing recorded for block", "block hash", blockHeaderHash)
+		return
+	}
+
+	for _, key := range touchedKeys.Data {
+		err = ei.removeEntriesFromNonce(key, blockHeader.GetNonce())
+		if err != nil {
+			log.Warn("eventsIndex.removeEntriesFromNonce()", "key", key, "error", err)
+		}
+	}
+
+	err = ei.storer.Remove(eventsKeysByBlockKey(blockHeaderHash))
+	if err != nil {
+		log.Warn("eventsIndex.revertBlock: cannot remove the touched keys", "block hash", blockHeaderHash, "error", err)
+	}
+}
+
+func (ei *eventsIndex) groupEventsByKey(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+) (map[string][]*EventEntry, []string) {
+	entriesByKey := make(map[string][]*EventEntry)
+	orderedKeys := make([]string, 0)
+	addEntry := func(key []byte, entry *EventEntry) {
+		_, exists := entriesByKey[string(key)]
+		if !exists {
+			orderedKeys = append(orderedKeys, string(key))
+		}
+		entriesByKey[string(key)] = append(entriesByKey[string(key)], entry)
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		// the logs are generated only where the transactions and the smart contract results are executed
+		isExecutedInSelfShard := miniblock.ReceiverShardID == ei.selfShardID
+		isTxOrScrMiniblock := miniblock.Type == block.TxBlock || miniblock.Type == block.SmartContractResultBlock
+		if !isExecutedInSelfShard || !isTxOrScrMiniblock {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			txLog, ok := ei.getLog(txHash)
+			if !ok {
+				continue
+			}
+
+			for eventIndex, event := range txLog.Events {
+				if event == nil {
+					continue
+				}
+
+				address := event.Address
+				if len(address) == 0 {
+					address = txLog.Address
+				}
+
+				entry := &EventEntry{
+					TxHash:     txHash,
+					EventIndex: uint32(eventIndex),
+					Address:    address,
+					Identifier: event.Identifier,
+					Topics:     event.Topics,
+					Data:       event.Data,
+					Epoch:      blockHeader.GetEpoch(),
+					BlockNonce: blockHeader.GetNonce(),
+					Round:      blockHeader.GetRound(),
+					BlockHash:  blockHeaderHash,
+				}
+
+				addEntry(ei.computeKey(address, event.Identifier, nil), entry)
+				if len(event.Topics) > 0 {
+					addEntry(ei.computeKey(address, event.Identifier, event.Topics[0]), entry)
+				}
+			}
+		}
+	}
+
+	return entriesByKey, orderedKeys
+}
+
+func (ei *eventsIndex) getLog(txHash []byte) (*transaction.Log, bool) {
+	rawBytes, err := ei.logsStorer.Get(txHash)
+	if err != nil {
+		// most of the transactions do not generate logs
+		return nil, false
+	}
+
+	txLog := &transaction.Log{}
+	err = ei.marshalizer.Unmarshal(txLog, rawBytes)
+	if err != nil {
+		log.Debug("eventsIndex.getLog()", "txHash", txHash, "error", err)
+		return nil, false
+	}
+
+	return txLog, true
+}
+
+// computeKey returns the hash of the length-prefixed components, so that all the keys have the same size and
+// different (address, identifier, topic) combinations can not collide when concatenated
+func (ei *eventsIndex) computeKey(address []byte, identifier []byte, topic []byte) []byte {
+	kind := eventsKeyByIdentifier
+	if topic != nil {
+		kind = eventsKeyByIdentifierTopic
+	}
+
+	buff := make([]byte, 0, 1+12+len(address)+len(identifier)+len(topic))
+	buff = append(buff, kind)
+	for _, component := range [][]byte{address, identifier, topic} {
+		lengthBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(lengthBytes, uint32(len(component)))
+		buff = append(buff, lengthBytes...)
+		buff = append(buff, component...)
+	}
+
+	return ei.hasher.Compute(string(buff))
+}
+
+func (ei *eventsIndex) appendEntries(key []byte, blockNonce uint64, entries []*EventEntry) error {
+	// the events recorded by a previous processing of this block, or by blocks from a fork that was not reverted
+	// through revertBlock, are dropped so that each event is listed only once
+	err := ei.removeEntriesFromNonce(key, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	info, err := ei.getInfo(key)
+	if err != nil {
+		return err
+	}
+
+	numEntries := info.NumEntries
+	tailIndex := uint64(0)
+	tail := &EventsChunk{}
+	if numEntries > 0 {
+		tailIndex = (numEntries - 1) / eventsChunkSize
+		tail, err = ei.getChunk(key, tailIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		if numEntries%eventsChunkSize == 0 && numEntries > 0 {
+			err = ei.putChunk(key, tailIndex, tail)
+			if err != nil {
+				return err
+			}
+
+			tailIndex = numEntries / eventsChunkSize
+			tail = &EventsChunk{}
+		}
+
+		tail.Entries = append(tail.Entries, entry)
+		numEntries++
+	}
+
+	err = ei.putChunk(key, tailIndex, tail)
+	if err != nil {
+		return err
+	}
+
+	info.NumEntries = numEntries
+	return ei.putInfo(key, info)
+}
+
+// removeEntriesFromNonce removes, starting with the last chunk, all the events recorded in blocks with a nonce
+// greater or equal than the provided one
+func (ei *eventsIndex) removeEntriesFromNonce(key []byte, nonce uint64) error {
+	info, err := ei.getInfo(key)
+	if err != nil {
+		return err
+	}
+
+	initialNumEntries := info.NumEntries
+	for info.NumEntries > 0 {
+		tailIndex := (info.NumEntries - 1) / eventsChunkSize
+		tail, errGet := ei.getChunk(key, tailIndex)
+		if errGet != nil {
+			return errGet
+		}
+
+		numKept := len(tail.Entries)
+		for numKept > 0 && tail.Entries[numKept-1].BlockNonce >= nonce {
+			numKept--
+		}
+		numRemoved := len(tail.Entries) - numKept
+		if numRemoved == 0 {
+			break
+		}
+
+		info.NumEntries -= uint64(numRemoved)
+		if numKept > 0 {
+			tail.Entries = tail.Entries[:numKept]
+			err = ei.putChunk(key, tailIndex, tail)
+			if err != nil {
+				return err
+			}
+			break
+		}
+
+		err = ei.storer.Remove(chunkKey(key, tailIndex))
+		if err != nil {
+			return err
+		}
+	}
+
+	if info.NumEntries == initialNumEntries {
+		return nil
+	}
+
+	return ei.putInfo(key, info)
+}
+
+func (ei *eventsIndex) getEvents(address []byte, query EventsQuery) (*EventsResult, error) {
+	if len(query.Identifier) == 0 {
+		return nil, ErrEmptyEventIdentifier
+	}
+
+	size := query.Size
+	if size <= 0 {
+		size = DefaultEventsPageSize
+	}
+	if size > MaxEventsPageSize {
+		size = MaxEventsPageSize
+	}
+
+	key := ei.computeKey(address, query.Identifier, query.Topic)
+
+	ei.mutIndex.RLock()
+	defer ei.mutIndex.RUnlock()
+
+	info, err := ei.getInfo(key)
+	if err != nil {
+		return nil, err
+	}
+
+	position := info.NumEntries
+	if len(query.Cursor) > 0 {
+		position, err = strconv.ParseUint(query.Cursor, 10, 64)
+		if err != nil || position > info.NumEntries {
+			return nil, ErrInvalidEventsCursor
+		}
+	}
+
+	result := &EventsResult{
+		Events: make([]*EventEntry, 0, size),
+	}
+
+	var chunk *EventsChunk
+	loadedChunkIndex := uint64(0)
+	numScanned := 0
+	for position > 0 && len(result.Events) < size && numScanned < maxEventsScannedEntries {
+		position--
+		numScanned++
+
+		chunkIndex := position / eventsChunkSize
+		if chunk == nil || chunkIndex != loadedChunkIndex {
+			chunk, err = ei.getChunk(key, chunkIndex)
+			if err != nil {
+				return nil, err
+			}
+			loadedChunkIndex = chunkIndex
+		}
+
+		positionInChunk := int(position % eventsChunkSize)
+		if positionInChunk >= len(chunk.Entries) {
+			continue
+		}
+
+		entry := chunk.Entries[positionInChunk]
+		if entry.BlockNonce < query.FromNonce {
+			// entries are recorded in block order, all the remaining ones are older
+			position = 0
+			break
+		}
+		if entry.BlockNonce > query.ToNonce {
+			continue
+		}
+
+		result.Events = append(result.Events, entry)
+	}
+
+	if position > 0 {
+		result.NextCursor = strconv.FormatUint(position, 10)
+	}
+
+	return result, nil
+}
+
+func (ei *eventsIndex) getInfo(key []byte) (*EventsInfo, error) {
+	info := &EventsInfo{}
+	rawBytes, err := ei.storer.Get(key)
+	if err != nil {
+		// nothing recorded yet for this key
+		return info, nil
+	}
+
+	err = ei.marshalizer.Unmarshal(info, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (ei *eventsIndex) putInfo(key []byte, info *EventsInfo) error {
+	rawBytes, err := ei.marshalizer.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(key, rawBytes)
+}
+
+func (ei *eventsIndex) getChunk(key []byte, chunkIndex uint64) (*EventsChunk, error) {
+	rawBytes, err := ei.storer.Get(chunkKey(key, chunkIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &EventsChunk{}
+	err = ei.marshalizer.Unmarshal(chunk, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (ei *eventsIndex) putChunk(key []byte, chunkIndex uint64, chunk *EventsChunk) error {
+	rawBytes, err := ei.marshalizer.Marshal(chunk)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(chunkKey(key, chunkIndex), rawBytes)
+}
+
+func (ei *eventsIndex) getTouchedKeys(blockHeaderHash []byte) (*batch.Batch, error) {
+	rawBytes, err := ei.storer.Get(eventsKeysByBlockKey(blockHeaderHash))
+	if err != nil {
+		return nil, err
+	}
+
+	touchedKeys := &batch.Batch{}
+	err = ei.marshalizer.Unmarshal(touchedKeys, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return touchedKeys, nil
+}
+
+func (ei *eventsIndex) putTouchedKeys(blockHeaderHash []byte, touchedKeys *batch.Batch) error {
+	rawBytes, err := ei.marshalizer.Marshal(touchedKeys)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(eventsKeysByBlockKey(blockHeaderHash), rawBytes)
+}
+
+func eventsKeysByBlockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(eventsKeysByBlockPrefix), blockHeaderHash...)
+}
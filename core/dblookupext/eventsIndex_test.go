@@ -0,0 +1,274 @@
+package dblookupext
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contractA = []byte("contractA")
+	contractB = []byte("contractB")
+)
+
+func createEventsIndex() (*eventsIndex, storage.Storer) {
+	logsStorer := genericmocks.NewStorerMock("TxLogs", 0)
+	index := newEventsIndex(
+		0,
+		genericmocks.NewStorerMock("Events", 0),
+		logsStorer,
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+	)
+
+	return index, logsStorer
+}
+
+func putLog(logsStorer storage.Storer, txHash string, txLog *transaction.Log) {
+	marshalizer := &mock.MarshalizerMock{}
+	rawBytes, _ := marshalizer.Marshal(txLog)
+	_ = logsStorer.Put([]byte(txHash), rawBytes)
+}
+
+func allEventsQuery(identifier string) EventsQuery {
+	return EventsQuery{
+		Identifier: []byte(identifier),
+		ToNonce:    math.MaxUint64,
+	}
+}
+
+func recordTransferEvents(index *eventsIndex, logsStorer storage.Storer, nonce uint64, numTxs int) {
+	miniblock := &block.MiniBlock{Type: block.TxBlock}
+	for i := 0; i < numTxs; i++ {
+		txHash := fmt.Sprintf("tx_%d_%d", nonce, i)
+		putLog(logsStorer, txHash, &transaction.Log{
+			Address: contractA,
+			Events: []*transaction.Event{
+				{Address: contractA, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("alice")}},
+			},
+		})
+		miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+	}
+
+	header := &block.Header{Nonce: nonce, Round: nonce}
+	index.recordBlock([]byte(fmt.Sprintf("block_%d", nonce)), header, &block.Body{MiniBlocks: []*block.MiniBlock{miniblock}})
+}
+
+func TestEventsIndex_RecordBlockShouldIndexByAddressIdentifierAndTopic(t *testing.T) {
+	t.Parallel()
+
+	index, logsStorer := createEventsIndex()
+	putLog(logsStorer, "tx1", &transaction.Log{
+		Address: contractA,
+		Events: []*transaction.Event{
+			{Address: contractA, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("alice"), []byte("bob")}, Data: []byte("1")},
+			{Address: contractB, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("bob")}},
+			{Identifier: []byte("deposit")},
+		},
+	})
+	putLog(logsStorer, "scr1", &transaction.Log{
+		Address: contractA,
+		Events: []*transaction.Event{
+			{Address: contractA, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("bob")}},
+		},
+	})
+	putLog(logsStorer, "crossTx", &transaction.Log{
+		Address: contractA,
+		Events:  []*transaction.Event{{Address: contractA, Identifier: []byte("transfer")}},
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1"), []byte("noLog")}},
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr1")}},
+			{Type: block.TxBlock, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("crossTx")}},
+		},
+	}
+	index.recordBlock([]byte("block"), &block.Header{Nonce: 7, Epoch: 2, Round: 8}, body)
+
+	result, err := index.getEvents(contractA, allEventsQuery("transfer"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 2)
+	require.Equal(t, []byte("scr1"), result.Events[0].TxHash)
+	require.Equal(t, []byte("tx1"), result.Events[1].TxHash)
+	require.Equal(t, uint32(0), result.Events[1].EventIndex)
+	require.Equal(t, []byte("1"), result.Events[1].Data)
+	require.Equal(t, [][]byte{[]byte("alice"), []byte("bob")}, result.Events[1].Topics)
+	require.Equal(t, uint64(7), result.Events[1].BlockNonce)
+	require.Equal(t, uint32(2), result.Events[1].Epoch)
+	require.Equal(t, uint64(8), result.Events[1].Round)
+	require.Equal(t, []byte("block"), result.Events[1].BlockHash)
+	require.Equal(t, "", result.NextCursor)
+
+	query := allEventsQuery("transfer")
+	query.Topic = []byte("alice")
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, []byte("tx1"), result.Events[0].TxHash)
+
+	// only the first topic is indexed
+	query.Topic = []byte("bob")
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, []byte("scr1"), result.Events[0].TxHash)
+
+	result, err = index.getEvents(contractB, allEventsQuery("transfer"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, uint32(1), result.Events[0].EventIndex)
+
+	// events without an address are attributed to the address of the log
+	result, err = index.getEvents(contractA, allEventsQuery("deposit"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, uint32(2), result.Events[0].EventIndex)
+
+	result, err = index.getEvents(contractA, allEventsQuery("missing"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 0)
+}
+
+func TestEventsIndex_RecordBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index, logsStorer := createEventsIndex()
+	recordTransferEvents(index, logsStorer, 1, 3)
+	// the second block spans two chunks, so the duplicates are not only in the last chunk
+	recordTransferEvents(index, logsStorer, 2, eventsChunkSize)
+	recordTransferEvents(index, logsStorer, 2, eventsChunkSize)
+
+	query := allEventsQuery("transfer")
+	query.Size = MaxEventsPageSize
+	result, err := index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, eventsChunkSize)
+	require.NotEmpty(t, result.NextCursor)
+
+	query.Cursor = result.NextCursor
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, 3)
+	require.Equal(t, uint64(1), result.Events[0].BlockNonce)
+	require.Equal(t, "", result.NextCursor)
+}
+
+func TestEventsIndex_RevertBlockShouldRemoveItsEvents(t *testing.T) {
+	t.Parallel()
+
+	index, logsStorer := createEventsIndex()
+	recordTransferEvents(index, logsStorer, 1, 2)
+	recordTransferEvents(index, logsStorer, 2, eventsChunkSize)
+
+	query := allEventsQuery("transfer")
+	query.Topic = []byte("alice")
+	index.revertBlock([]byte("block_2"), &block.Header{Nonce: 2})
+
+	for _, q := range []EventsQuery{allEventsQuery("transfer"), query} {
+		result, err := index.getEvents(contractA, q)
+		require.Nil(t, err)
+		require.Len(t, result.Events, 2)
+		require.Equal(t, uint64(1), result.Events[0].BlockNonce)
+	}
+
+	_, err := index.getTouchedKeys([]byte("block_2"))
+	require.NotNil(t, err)
+
+	// reverting an unknown block does nothing
+	index.revertBlock([]byte("unknown"), &block.Header{Nonce: 1})
+	result, err := index.getEvents(contractA, allEventsQuery("transfer"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 2)
+}
+
+func TestEventsIndex_PagingAndFilters(t *testing.T) {
+	t.Parallel()
+
+	index, logsStorer := createEventsIndex()
+	numBlocks := 5
+	eventsPerBlock := 60
+	for nonce := 1; nonce <= numBlocks; nonce++ {
+		recordTransferEvents(index, logsStorer, uint64(nonce), eventsPerBlock)
+	}
+
+	query := allEventsQuery("transfer")
+	query.Topic = []byte("alice")
+	query.Size = MaxEventsPageSize
+	seen := make(map[string]struct{})
+	numPages := 0
+	for {
+		result, err := index.getEvents(contractA, query)
+		require.Nil(t, err)
+		numPages++
+
+		for _, event := range result.Events {
+			_, exists := seen[string(event.TxHash)]
+			require.False(t, exists)
+			seen[string(event.TxHash)] = struct{}{}
+		}
+
+		if result.NextCursor == "" {
+			break
+		}
+		query.Cursor = result.NextCursor
+	}
+	require.Equal(t, numBlocks*eventsPerBlock, len(seen))
+	require.Equal(t, 3, numPages)
+
+	query = allEventsQuery("transfer")
+	query.FromNonce = 5
+	query.Size = MaxEventsPageSize
+	result, err := index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, eventsPerBlock)
+	require.Equal(t, uint64(5), result.Events[len(result.Events)-1].BlockNonce)
+	require.Equal(t, "", result.NextCursor)
+
+	query = allEventsQuery("transfer")
+	query.ToNonce = 1
+	query.Size = 10
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, err)
+	require.Len(t, result.Events, 10)
+	require.Equal(t, uint64(1), result.Events[0].BlockNonce)
+}
+
+func TestEventsIndex_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index, logsStorer := createEventsIndex()
+	recordTransferEvents(index, logsStorer, 1, 2)
+
+	result, err := index.getEvents(contractA, EventsQuery{})
+	require.Nil(t, result)
+	require.Equal(t, ErrEmptyEventIdentifier, err)
+
+	query := allEventsQuery("transfer")
+	query.Cursor = "abc"
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, result)
+	require.Equal(t, ErrInvalidEventsCursor, err)
+
+	query.Cursor = "3"
+	result, err = index.getEvents(contractA, query)
+	require.Nil(t, result)
+	require.Equal(t, ErrInvalidEventsCursor, err)
+}
+
+func TestEventsIndex_ComputeKeyShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	index, _ := createEventsIndex()
+
+	require.NotEqual(t, index.computeKey([]byte("ab"), []byte("c"), nil), index.computeKey([]byte("a"), []byte("bc"), nil))
+	require.NotEqual(t, index.computeKey([]byte("a"), []byte("b"), nil), index.computeKey([]byte("a"), []byte("b"), []byte{}))
+	require.Equal(t, index.computeKey([]byte("a"), []byte("b"), []byte("c")), index.computeKey([]byte("a"), []byte("b"), []byte("c")))
+}
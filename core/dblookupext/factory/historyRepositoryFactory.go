@@ -8,16 +8,18 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
 // new instances
 type ArgsHistoryRepositoryFactory struct {
-	SelfShardID uint32
-	Config      config.DbLookupExtensionsConfig
-	Store       dataRetriever.StorageService
-	Marshalizer marshal.Marshalizer
-	Hasher      hashing.Hasher
+	SelfShardID      uint32
+	Config           config.DbLookupExtensionsConfig
+	Store            dataRetriever.StorageService
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -26,6 +28,7 @@ type historyRepositoryFactory struct {
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -39,6 +42,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Store) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, dblookupext.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -46,6 +52,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -63,7 +70,16 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		ShardCoordinator:            hpf.shardCoordinator,
+	}
+	if hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled {
+		historyRepArgs.AddressTransactionsStorer = hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
 	}
+	if hpf.dbLookupExtensionsConfig.EventsIndexEnabled {
+		historyRepArgs.EventsStorer = hpf.store.GetStorer(dataRetriever.EventsUnit)
+		historyRepArgs.TxLogsStorer = hpf.store.GetStorer(dataRetriever.TxLogsUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext/factory"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -34,6 +35,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, core.ErrNilHasher, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, dblookupext.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -65,12 +72,67 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithAddressTransactionsIndex(t *testing.T) {
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &mock.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	_, err = repository.GetAddressTransactions([]byte("address"), dblookupext.AddressTransactionsQuery{})
+	require.Equal(t, dblookupext.ErrAddressTransactionsIndexNotEnabled, err)
+	require.NotContains(t, requestedUnits, dataRetriever.AddressTransactionsUnit)
+
+	args.Config.AddressTransactionsIndexEnabled = true
+	hrf, _ = factory.NewHistoryRepositoryFactory(args)
+	repository, err = hrf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, repository)
+	require.Contains(t, requestedUnits, dataRetriever.AddressTransactionsUnit)
+}
+
+func TestHistoryRepositoryFactory_CreateWithEventsIndex(t *testing.T) {
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &mock.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	_, err = repository.GetEvents([]byte("address"), dblookupext.EventsQuery{Identifier: []byte("transfer")})
+	require.Equal(t, dblookupext.ErrEventsIndexNotEnabled, err)
+	require.NotContains(t, requestedUnits, dataRetriever.EventsUnit)
+	require.NotContains(t, requestedUnits, dataRetriever.TxLogsUnit)
+
+	args.Config.EventsIndexEnabled = true
+	hrf, _ = factory.NewHistoryRepositoryFactory(args)
+	repository, err = hrf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, repository)
+	require.Contains(t, requestedUnits, dataRetriever.EventsUnit)
+	require.Contains(t, requestedUnits, dataRetriever.TxLogsUnit)
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
-		SelfShardID: 0,
-		Config:      config.DbLookupExtensionsConfig{},
-		Store:       &mock.ChainStorerMock{},
-		Marshalizer: &mock.MarshalizerMock{},
-		Hasher:      &mock.HasherMock{},
+		SelfShardID:      0,
+		Config:           config.DbLookupExtensionsConfig{},
+		Store:            &mock.ChainStorerMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
@@ -29,8 +30,14 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
-	Marshalizer                 marshal.Marshalizer
-	Hasher                      hashing.Hasher
+	// AddressTransactionsStorer is optional, the per-address transactions index is disabled when it is nil
+	AddressTransactionsStorer storage.Storer
+	// EventsStorer is optional, the events index is disabled when it is nil. If set, TxLogsStorer is required
+	EventsStorer     storage.Storer
+	TxLogsStorer     storage.Storer
+	ShardCoordinator sharding.Coordinator
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
 }
 
 type historyRepository struct {
@@ -39,6 +46,8 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	addressTransactionsIndex   *addressTransactionsIndex
+	eventsIndex                *eventsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -82,6 +91,22 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		return nil, core.ErrNilStore
 	}
 
+	var addressTxsIndex *addressTransactionsIndex
+	if !check.IfNil(arguments.AddressTransactionsStorer) {
+		if check.IfNil(arguments.ShardCoordinator) {
+			return nil, ErrNilShardCoordinator
+		}
+		addressTxsIndex = newAddressTransactionsIndex(arguments.AddressTransactionsStorer, arguments.Marshalizer, arguments.ShardCoordinator)
+	}
+
+	var eventsIdx *eventsIndex
+	if !check.IfNil(arguments.EventsStorer) {
+		if check.IfNil(arguments.TxLogsStorer) {
+			return nil, core.ErrNilStore
+		}
+		eventsIdx = newEventsIndex(arguments.SelfShardID, arguments.EventsStorer, arguments.TxLogsStorer, arguments.Marshalizer, arguments.Hasher)
+	}
+
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
@@ -99,6 +124,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		addressTransactionsIndex:                     addressTxsIndex,
+		eventsIndex:                                  eventsIdx,
 	}, nil
 }
 
@@ -110,6 +137,7 @@ func (hr *historyRepository) RecordBlock(
 	blockBody data.BodyHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
+	transactionsFromPool map[string]data.TransactionHandler,
 ) error {
 	hr.recordBlockMutex.Lock()
 	defer hr.recordBlockMutex.Unlock()
@@ -144,6 +172,34 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	if hr.addressTransactionsIndex != nil {
+		hr.addressTransactionsIndex.recordBlock(blockHeaderHash, blockHeader, body, transactionsFromPool, scrResultsFromPool)
+	}
+
+	if hr.eventsIndex != nil {
+		hr.eventsIndex.recordBlock(blockHeaderHash, blockHeader, body)
+	}
+
+	return nil
+}
+
+// RevertBlock removes from the per-address transactions and the events indexes the entries recorded for a block which
+// was rolled back. The miniblocks metadata is not reverted, it is overwritten when the transactions are included in
+// another block
+func (hr *historyRepository) RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, _ data.BodyHandler) error {
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	log.Debug("RevertBlock()", "nonce", blockHeader.GetNonce(), "blockHeaderHash", blockHeaderHash)
+
+	if hr.addressTransactionsIndex != nil {
+		hr.addressTransactionsIndex.revertBlock(blockHeaderHash, blockHeader)
+	}
+
+	if hr.eventsIndex != nil {
+		hr.eventsIndex.revertBlock(blockHeaderHash, blockHeader)
+	}
+
 	return nil
 }
 
@@ -264,6 +320,26 @@ func (hr *historyRepository) GetEpochByHash(hash []byte) (uint32, error) {
 	return hr.epochByHashIndex.getEpochByHash(hash)
 }
 
+// GetAddressTransactions returns a page of the transactions, smart contract results and rewards that had the provided
+// address as sender or receiver, ordered from the newest to the oldest one
+func (hr *historyRepository) GetAddressTransactions(address []byte, query AddressTransactionsQuery) (*AddressTransactionsResult, error) {
+	if hr.addressTransactionsIndex == nil {
+		return nil, ErrAddressTransactionsIndexNotEnabled
+	}
+
+	return hr.addressTransactionsIndex.getTransactions(address, query)
+}
+
+// GetEvents returns a page of the smart contract events emitted by the provided address, matching the identifier
+// and, optionally, the first topic, ordered from the newest to the oldest one
+func (hr *historyRepository) GetEvents(address []byte, query EventsQuery) (*EventsResult, error) {
+	if hr.eventsIndex == nil {
+		return nil, ErrEventsIndexNotEnabled
+	}
+
+	return hr.eventsIndex.getEvents(address, query)
+}
+
 // OnNotarizedBlocks notifies the history repository about notarized blocks
 func (hr *historyRepository) OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
 	for i, headerHandler := range headers {
@@ -427,6 +503,11 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// IsAddressTransactionsIndexEnabled returns true if the per-address transactions index is enabled
+func (hr *historyRepository) IsAddressTransactionsIndexEnabled() bool {
+	return hr.addressTransactionsIndex != nil
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
@@ -8,6 +8,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/require"
 )
@@ -65,12 +68,123 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsStorer = genericmocks.NewStorerMock("AddressTransactions", 0)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, ErrNilShardCoordinator, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.EventsStorer = genericmocks.NewStorerMock("Events", 0)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
 	require.NotNil(t, repo)
 }
 
+func TestHistoryRepository_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	result, err := repo.GetEvents(contractA, allEventsQuery("transfer"))
+	require.Nil(t, result)
+	require.Equal(t, ErrEventsIndexNotEnabled, err)
+
+	args.EventsStorer = genericmocks.NewStorerMock("Events", 0)
+	args.TxLogsStorer = genericmocks.NewStorerMock("TxLogs", 0)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	putLog(args.TxLogsStorer, "tx", &transaction.Log{
+		Address: contractA,
+		Events:  []*transaction.Event{{Address: contractA, Identifier: []byte("transfer")}},
+	})
+	err = repo.RecordBlock([]byte("block"), &block.Header{Nonce: 1}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}},
+		},
+	}, nil, nil, nil)
+	require.Nil(t, err)
+
+	result, err = repo.GetEvents(contractA, allEventsQuery("transfer"))
+	require.Nil(t, err)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, []byte("tx"), result.Events[0].TxHash)
+}
+
+func TestHistoryRepository_GetAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	result, err := repo.GetAddressTransactions(aliceShard0, maxQuery())
+	require.Nil(t, result)
+	require.Equal(t, ErrAddressTransactionsIndexNotEnabled, err)
+
+	args.AddressTransactionsStorer = genericmocks.NewStorerMock("AddressTransactions", 0)
+	args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(2, 0)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	txs := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: carolShard1, RcvAddr: aliceShard0},
+	}
+	err = repo.RecordBlock([]byte("block"), &block.Header{Nonce: 1}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}},
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+		},
+	}, scrs, nil, txs)
+	require.Nil(t, err)
+
+	result, err = repo.GetAddressTransactions(aliceShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 2)
+	require.Equal(t, []byte("scr"), result.Entries[0].TxHash)
+	require.Equal(t, []byte("tx"), result.Entries[1].TxHash)
+}
+
+func TestHistoryRepository_RevertBlockShouldRemoveAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, _ := NewHistoryRepository(args)
+	require.False(t, repo.IsAddressTransactionsIndexEnabled())
+	require.Nil(t, repo.RevertBlock([]byte("block"), &block.Header{Nonce: 1}, &block.Body{}))
+
+	args.AddressTransactionsStorer = genericmocks.NewStorerMock("AddressTransactions", 0)
+	args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(2, 0)
+	repo, _ = NewHistoryRepository(args)
+	require.True(t, repo.IsAddressTransactionsIndexEnabled())
+
+	txs := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1},
+	}
+	header := &block.Header{Nonce: 1}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}}}}
+	err := repo.RecordBlock([]byte("block"), header, body, nil, nil, txs)
+	require.Nil(t, err)
+
+	err = repo.RevertBlock([]byte("block"), header, body)
+	require.Nil(t, err)
+
+	result, err := repo.GetAddressTransactions(aliceShard0, maxQuery())
+	require.Nil(t, err)
+	require.Len(t, result.Entries, 0)
+}
+
 func TestHistoryRepository_RecordBlock(t *testing.T) {
 	t.Parallel()
 
@@ -97,7 +211,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericmocks.StorerMock).GetCurrentEpochData().Len())
@@ -131,7 +245,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil,
+		nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -169,7 +283,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -227,7 +341,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -427,7 +541,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -435,7 +549,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -482,7 +596,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -539,7 +653,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -552,7 +666,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -641,7 +755,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil,
+				}, nil, nil, nil,
 			)
 		}
 
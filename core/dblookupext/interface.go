@@ -17,12 +17,17 @@ type HistoryRepository interface {
 		blockBody data.BodyHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
+		transactionsFromPool map[string]data.TransactionHandler,
 	) error
 
+	RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetAddressTransactions(address []byte, query AddressTransactionsQuery) (*AddressTransactionsResult, error)
+	GetEvents(address []byte, query EventsQuery) (*EventsResult, error)
+	IsAddressTransactionsIndexEnabled() bool
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
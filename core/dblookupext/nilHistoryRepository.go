@@ -13,7 +13,12 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (nhr *nilHistoryRepository) RevertBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler) error {
 	return nil
 }
 
@@ -31,6 +36,11 @@ func (nhr *nilHistoryRepository) GetEpochByHash(_ []byte) (uint32, error) {
 	return 0, nil
 }
 
+// IsAddressTransactionsIndexEnabled returns false
+func (nhr *nilHistoryRepository) IsAddressTransactionsIndexEnabled() bool {
+	return false
+}
+
 // IsEnabled returns false
 func (nhr *nilHistoryRepository) IsEnabled() bool {
 	return false
@@ -41,6 +51,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetAddressTransactions returns the index not enabled error
+func (nhr *nilHistoryRepository) GetAddressTransactions(_ []byte, _ AddressTransactionsQuery) (*AddressTransactionsResult, error) {
+	return nil, ErrAddressTransactionsIndexNotEnabled
+}
+
+// GetEvents returns the index not enabled error
+func (nhr *nilHistoryRepository) GetEvents(_ []byte, _ EventsQuery) (*EventsResult, error) {
+	return nil, ErrEventsIndexNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -0,0 +1,36 @@
+package core
+
+// ESDTType defines the possible types in case of ESDT tokens
+type ESDTType uint32
+
+const (
+	// Fungible defines the token type for ESDT fungible tokens
+	Fungible ESDTType = iota
+	// NonFungible defines the token type for ESDT non fungible tokens
+	NonFungible
+	// SemiFungible defines the token type for ESDT semi fungible tokens
+	SemiFungible
+)
+
+// FungibleESDT defines the string for the token type of fungible ESDT
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT defines the string for the token type of non fungible ESDT
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT defines the string for the token type of semi fungible ESDT
+const SemiFungibleESDT = "SemiFungibleESDT"
+
+// String returns the string-ified version of ESDTType
+func (t ESDTType) String() string {
+	switch t {
+	case Fungible:
+		return FungibleESDT
+	case NonFungible:
+		return NonFungibleESDT
+	case SemiFungible:
+		return SemiFungibleESDT
+	default:
+		return "unknown"
+	}
+}
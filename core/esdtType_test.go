@@ -0,0 +1,16 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestESDTType_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, FungibleESDT, Fungible.String())
+	assert.Equal(t, NonFungibleESDT, NonFungible.String())
+	assert.Equal(t, SemiFungibleESDT, SemiFungible.String())
+	assert.Equal(t, "unknown", ESDTType(37).String())
+}
@@ -132,6 +132,20 @@ func (g *gasScheduleNotifier) EpochConfirmed(epoch uint32) {
 	}
 }
 
+// ChangeGasSchedule replaces the latest gas schedule and notifies all the registered handlers. It is called when a
+// governance proposal changing the gas schedule is executed
+func (g *gasScheduleNotifier) ChangeGasSchedule(gasSchedule map[string]map[string]uint64) {
+	g.mutNotifier.Lock()
+	defer g.mutNotifier.Unlock()
+
+	log.Debug("gasScheduleNotifier.ChangeGasSchedule", "num handlers", len(g.handlers))
+
+	g.lastGasSchedule = gasSchedule
+	for _, handler := range g.handlers {
+		handler.GasScheduleChange(g.lastGasSchedule)
+	}
+}
+
 // LatestGasSchedule returns the latest gas schedule
 func (g *gasScheduleNotifier) LatestGasSchedule() map[string]map[string]uint64 {
 	g.mutNotifier.RLock()
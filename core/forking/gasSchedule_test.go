@@ -180,3 +180,25 @@ func TestGasScheduleNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
 	assert.True(t, end.Sub(start) >= handlerWait)
 }
+
+func TestGasScheduleNotifier_ChangeGasScheduleShouldNotifyHandlers(t *testing.T) {
+	t.Parallel()
+
+	args := createGasScheduleNotifierArgs()
+	g, _ := NewGasScheduleNotifier(args)
+
+	newGasSchedule := map[string]map[string]uint64{
+		"BuiltInCost": {"ESDTTransfer": 1},
+	}
+	var receivedGasSchedule map[string]map[string]uint64
+	handler := &mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasSchedule map[string]map[string]uint64) {
+			receivedGasSchedule = gasSchedule
+		},
+	}
+	g.RegisterNotifyHandler(handler)
+
+	g.ChangeGasSchedule(newGasSchedule)
+	assert.Equal(t, newGasSchedule, receivedGasSchedule)
+	assert.Equal(t, newGasSchedule, g.LatestGasSchedule())
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"path/filepath"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -36,17 +38,7 @@ func prepareGeneralInfo(tpsBenchmark statistics.TPSBenchmark) bytes.Buffer {
 	var buff bytes.Buffer
 
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, metachainTpsDocID, tpsIndex, "\n"))
-	generalInfo := TPS{
-		LiveTPS:               tpsBenchmark.LiveTPS(),
-		PeakTPS:               tpsBenchmark.PeakTPS(),
-		NrOfShards:            tpsBenchmark.NrOfShards(),
-		BlockNumber:           tpsBenchmark.BlockNumber(),
-		RoundNumber:           tpsBenchmark.RoundNumber(),
-		RoundTime:             tpsBenchmark.RoundTime(),
-		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
-		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
-		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
-	}
+	generalInfo := prepareGeneralTPS(tpsBenchmark)
 
 	serializedInfo, err := json.Marshal(generalInfo)
 	if err != nil {
@@ -73,8 +65,36 @@ func serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s%d", "_type" : "%s" } }%s`,
 		shardTpsDocIDPrefix, shardInfo.ShardID(), tpsIndex, "\n"))
 
+	shardTPS := prepareShardTPS(shardInfo)
+
+	serializedInfo, err := json.Marshal(shardTPS)
+	if err != nil {
+		log.Debug("indexer: could not serialize tps info, will skip indexing tps this shard")
+		return nil, nil
+	}
+	// append a newline foreach element in the bulk we create
+	serializedInfo = append(serializedInfo, "\n"...)
+
+	return serializedInfo, meta
+}
+
+func prepareGeneralTPS(tpsBenchmark statistics.TPSBenchmark) *TPS {
+	return &TPS{
+		LiveTPS:               tpsBenchmark.LiveTPS(),
+		PeakTPS:               tpsBenchmark.PeakTPS(),
+		NrOfShards:            tpsBenchmark.NrOfShards(),
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
+	}
+}
+
+func prepareShardTPS(shardInfo statistics.ShardStatistic) *TPS {
 	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
-	shardTPS := TPS{
+	return &TPS{
 		ShardID:               shardInfo.ShardID(),
 		LiveTPS:               shardInfo.LiveTPS(),
 		PeakTPS:               shardInfo.PeakTPS(),
@@ -84,16 +104,62 @@ func serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
 		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
 	}
+}
 
-	serializedInfo, err := json.Marshal(shardTPS)
-	if err != nil {
-		log.Debug("indexer: could not serialize tps info, will skip indexing tps this shard")
-		return nil, nil
+func (cm *commonProcessor) getAlteredUserAccounts(accountsDB state.AccountsAdapter, addresses map[string]struct{}) []state.UserAccountHandler {
+	userAccounts := make([]state.UserAccountHandler, 0)
+	for address := range addresses {
+		addressBytes, err := cm.addressPubkeyConverter.Decode(address)
+		if err != nil {
+			log.Warn("cannot decode address", "address", address, "error", err)
+			continue
+		}
+
+		if cm.shardCoordinator.ComputeId(addressBytes) != cm.shardCoordinator.SelfId() {
+			continue
+		}
+
+		account, err := accountsDB.LoadAccount(addressBytes)
+		if err != nil {
+			log.Warn("cannot load account", "address bytes", addressBytes, "error", err)
+			continue
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			log.Warn("cannot cast AccountHandler to type UserAccountHandler")
+			continue
+		}
+
+		userAccounts = append(userAccounts, userAccount)
 	}
-	// append a newline foreach element in the bulk we create
-	serializedInfo = append(serializedInfo, "\n"...)
 
-	return serializedInfo, meta
+	return userAccounts
+}
+
+func prepareAccountsHistory(accountsInfoMap map[string]*AccountInfo, timestamp int64) map[string]*AccountBalanceHistory {
+	accountsMap := make(map[string]*AccountBalanceHistory)
+	for address, userAccount := range accountsInfoMap {
+		acc := &AccountBalanceHistory{
+			Address:   address,
+			Balance:   userAccount.Balance,
+			Timestamp: timestamp,
+		}
+		addressKey := fmt.Sprintf("%s_%d", address, timestamp)
+		accountsMap[addressKey] = acc
+	}
+
+	return accountsMap
+}
+
+func computeBalanceAsFloat(balance *big.Int, dividerForDenomination float64, balancePrecision float64) float64 {
+	balanceBigFloat := big.NewFloat(0).SetInt(balance)
+	balanceFloat64, _ := balanceBigFloat.Float64()
+
+	bal := balanceFloat64 / dividerForDenomination
+	balanceFloatWithDecimals := math.Round(bal*balancePrecision) / balancePrecision
+
+	return core.MaxFloat64(balanceFloatWithDecimals, 0)
 }
 
 func (cm *commonProcessor) buildTransaction(
@@ -458,7 +524,8 @@ func prepareSerializedDataForATransaction(
 }
 
 func isRelayedTx(tx *Transaction) bool {
-	return strings.HasPrefix(string(tx.Data), "relayedTx") && len(tx.SmartContractResults) > 0
+	isRelayed := strings.HasPrefix(string(tx.Data), "relayedTx") || strings.HasPrefix(string(tx.Data), "multiRelayedTx")
+	return isRelayed && len(tx.SmartContractResults) > 0
 }
 
 func prepareSerializedAccountInfo(address string, account *AccountInfo) ([]byte, []byte, error) {
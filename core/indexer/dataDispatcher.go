@@ -26,19 +26,23 @@ const (
 )
 
 type dataDispatcher struct {
-	backOffTime   time.Duration
-	chanWorkItems chan workItems.WorkItemHandler
-	cancelFunc    func()
+	backOffTime     time.Duration
+	maxNumOfRetries uint32
+	chanWorkItems   chan workItems.WorkItemHandler
+	cancelFunc      func()
 }
 
-// NewDataDispatcher creates a new dataDispatcher instance, capable of saving sequentially data in elasticsearch database
-func NewDataDispatcher(cacheSize int) (*dataDispatcher, error) {
+// NewDataDispatcher creates a new dataDispatcher instance, capable of saving sequentially data in an output driver.
+// An item failing with an error other than back off is dropped after maxNumOfRetries retries, 0 meaning it is
+// retried until it is saved
+func NewDataDispatcher(cacheSize int, maxNumOfRetries uint32) (*dataDispatcher, error) {
 	if cacheSize < 0 {
 		return nil, ErrNegativeCacheSize
 	}
 
 	dd := &dataDispatcher{
-		chanWorkItems: make(chan workItems.WorkItemHandler, cacheSize),
+		maxNumOfRetries: maxNumOfRetries,
+		chanWorkItems:   make(chan workItems.WorkItemHandler, cacheSize),
 	}
 
 	return dd, nil
@@ -59,7 +63,7 @@ func (d *dataDispatcher) startWorker(ctx context.Context) {
 			log.Debug("dispatcher's go routine is stopping...")
 			return
 		case wi := <-d.chanWorkItems:
-			d.doWork(wi)
+			d.doWork(ctx, wi)
 		}
 	}
 }
@@ -83,7 +87,8 @@ func (d *dataDispatcher) Add(item workItems.WorkItemHandler) {
 	d.chanWorkItems <- item
 }
 
-func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
+func (d *dataDispatcher) doWork(ctx context.Context, wi workItems.WorkItemHandler) {
+	numRetries := uint32(0)
 	for {
 		err := wi.Save()
 		if errors.Is(err, ErrBackOff) {
@@ -91,22 +96,44 @@ func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
 				"received back off:", err.Error())
 
 			d.increaseBackOffTime()
-			time.Sleep(d.backOffTime)
+			if !waitOrStop(ctx, d.backOffTime) {
+				return
+			}
 
 			continue
 		}
 
 		d.backOffTime = 0
-		if err != nil {
-			log.Warn("dataDispatcher.doWork could not index item (will retry)", "error", err.Error())
-			time.Sleep(durationBetweenErrorRetry)
+		if err == nil {
+			return
+		}
 
-			continue
+		if d.maxNumOfRetries > 0 && numRetries >= d.maxNumOfRetries {
+			log.Error("dataDispatcher.doWork could not index item, dropping it",
+				"num retries", numRetries, "error", err.Error())
+			return
 		}
 
-		return
+		numRetries++
+		log.Warn("dataDispatcher.doWork could not index item (will retry)", "error", err.Error())
+		if !waitOrStop(ctx, durationBetweenErrorRetry) {
+			return
+		}
 	}
+}
 
+// waitOrStop waits the provided duration and returns false if the dispatcher was closed in the meantime
+func waitOrStop(ctx context.Context, duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		log.Debug("dataDispatcher.doWork is stopping while retrying an item")
+		return false
+	case <-timer.C:
+		return true
+	}
 }
 
 func (d *dataDispatcher) increaseBackOffTime() {
@@ -16,7 +16,7 @@ import (
 func TestNewDataDispatcher_InvalidCacheSize(t *testing.T) {
 	t.Parallel()
 
-	dataDist, err := NewDataDispatcher(-1)
+	dataDist, err := NewDataDispatcher(-1, 0)
 
 	require.Nil(t, dataDist)
 	require.Equal(t, ErrNegativeCacheSize, err)
@@ -25,7 +25,7 @@ func TestNewDataDispatcher_InvalidCacheSize(t *testing.T) {
 func TestNewDataDispatcher(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(100, 0)
 	require.NoError(t, err)
 	require.NotNil(t, dispatcher)
 }
@@ -33,7 +33,7 @@ func TestNewDataDispatcher(t *testing.T) {
 func TestDataDispatcher_StartIndexDataClose(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(100, 0)
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -59,7 +59,7 @@ func TestDataDispatcher_StartIndexDataClose(t *testing.T) {
 func TestDataDispatcher_Add(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(100, 0)
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -95,7 +95,7 @@ func TestDataDispatcher_Add(t *testing.T) {
 func TestDataDispatcher_AddWithErrorShouldRetryTheReprocessing(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(100, 0)
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -127,3 +127,65 @@ func TestDataDispatcher_AddWithErrorShouldRetryTheReprocessing(t *testing.T) {
 	err = dispatcher.Close()
 	require.NoError(t, err)
 }
+
+func TestDataDispatcher_AddWithErrorShouldDropTheItemAfterMaxNumOfRetries(t *testing.T) {
+	t.Parallel()
+
+	dispatcher, err := NewDataDispatcher(100, 1)
+	require.NoError(t, err)
+	dispatcher.StartIndexData()
+
+	failingCalledCount := uint32(0)
+	failingProc := &mock.ElasticProcessorStub{
+		SaveRoundsInfoCalled: func(infos []workItems.RoundInfo) error {
+			atomic.AddUint32(&failingCalledCount, 1)
+			return errors.New("generic error")
+		},
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	nextProc := &mock.ElasticProcessorStub{
+		SaveRoundsInfoCalled: func(infos []workItems.RoundInfo) error {
+			wg.Done()
+			return nil
+		},
+	}
+
+	dispatcher.Add(workItems.NewItemRounds(failingProc, []workItems.RoundInfo{}))
+	dispatcher.Add(workItems.NewItemRounds(nextProc, []workItems.RoundInfo{}))
+	wg.Wait()
+
+	require.Equal(t, uint32(2), atomic.LoadUint32(&failingCalledCount))
+
+	err = dispatcher.Close()
+	require.NoError(t, err)
+}
+
+func TestDataDispatcher_CloseShouldStopRetrying(t *testing.T) {
+	t.Parallel()
+
+	dispatcher, err := NewDataDispatcher(100, 0)
+	require.NoError(t, err)
+	dispatcher.StartIndexData()
+
+	calledCount := uint32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	elasticProc := &mock.ElasticProcessorStub{
+		SaveRoundsInfoCalled: func(infos []workItems.RoundInfo) error {
+			if atomic.AddUint32(&calledCount, 1) == 1 {
+				wg.Done()
+			}
+			return errors.New("generic error")
+		},
+	}
+
+	dispatcher.Add(workItems.NewItemRounds(elasticProc, []workItems.RoundInfo{}))
+	wg.Wait()
+
+	err = dispatcher.Close()
+	require.NoError(t, err)
+
+	time.Sleep(durationBetweenErrorRetry + time.Second)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&calledCount))
+}
@@ -15,12 +15,12 @@ import (
 )
 
 type dataIndexer struct {
-	isNilIndexer     bool
-	dispatcher       DispatcherHandler
-	coordinator      sharding.NodesCoordinator
-	elasticProcessor ElasticProcessor
-	options          *Options
-	marshalizer      marshal.Marshalizer
+	isNilIndexer  bool
+	dispatchers   []DispatcherHandler
+	coordinator   sharding.NodesCoordinator
+	outputDrivers []OutputDriver
+	options       *Options
+	marshalizer   marshal.Marshalizer
 }
 
 // NewDataIndexer will create a new data indexer
@@ -31,12 +31,12 @@ func NewDataIndexer(arguments ArgDataIndexer) (Indexer, error) {
 	}
 
 	dataIndexerObj := &dataIndexer{
-		isNilIndexer:     false,
-		dispatcher:       arguments.DataDispatcher,
-		coordinator:      arguments.NodesCoordinator,
-		elasticProcessor: arguments.ElasticProcessor,
-		marshalizer:      arguments.Marshalizer,
-		options:          arguments.Options,
+		isNilIndexer:  false,
+		dispatchers:   arguments.DataDispatchers,
+		coordinator:   arguments.NodesCoordinator,
+		outputDrivers: arguments.OutputDrivers,
+		marshalizer:   arguments.Marshalizer,
+		options:       arguments.Options,
 	}
 
 	if arguments.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -47,11 +47,22 @@ func NewDataIndexer(arguments ArgDataIndexer) (Indexer, error) {
 }
 
 func checkIndexerArgs(arguments ArgDataIndexer) error {
-	if check.IfNil(arguments.DataDispatcher) {
-		return ErrNilDataDispatcher
+	if len(arguments.OutputDrivers) == 0 {
+		return ErrNoOutputDriver
 	}
-	if check.IfNil(arguments.ElasticProcessor) {
-		return ErrNilElasticProcessor
+	for _, driver := range arguments.OutputDrivers {
+		if check.IfNil(driver) {
+			return ErrNilOutputDriver
+		}
+	}
+	// each output driver has its own dispatcher so a failing driver does not block the others
+	if len(arguments.DataDispatchers) != len(arguments.OutputDrivers) {
+		return ErrWrongNumberOfDataDispatchers
+	}
+	for _, dispatcher := range arguments.DataDispatchers {
+		if check.IfNil(dispatcher) {
+			return ErrNilDataDispatcher
+		}
 	}
 	if check.IfNil(arguments.NodesCoordinator) {
 		return core.ErrNilNodesCoordinator
@@ -95,58 +106,85 @@ func (di *dataIndexer) SaveBlock(
 	notarizedHeadersHashes []string,
 	headerHash []byte,
 ) {
-	wi := workItems.NewItemBlock(
-		di.elasticProcessor,
-		di.marshalizer,
-		bodyHandler,
-		headerHandler,
-		txPool,
-		signersIndexes,
-		notarizedHeadersHashes,
-		headerHash,
-	)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemBlock(
+			driver,
+			di.marshalizer,
+			bodyHandler,
+			headerHandler,
+			txPool,
+			signersIndexes,
+			notarizedHeadersHashes,
+			headerHash,
+		)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
-// Close will stop goroutine that index data in database
+// Close will stop goroutine that index data in database and will release the output drivers
 func (di *dataIndexer) Close() error {
-	return di.dispatcher.Close()
+	var err error
+	for _, dispatcher := range di.dispatchers {
+		errClose := dispatcher.Close()
+		if errClose != nil {
+			log.Warn("indexer: cannot close data dispatcher", "error", errClose.Error())
+			err = errClose
+		}
+	}
+
+	for _, driver := range di.outputDrivers {
+		errClose := driver.Close()
+		if errClose != nil {
+			log.Warn("indexer: cannot close output driver", "error", errClose.Error())
+			err = errClose
+		}
+	}
+
+	return err
 }
 
 // RevertIndexedBlock will remove from database block and miniblocks
 func (di *dataIndexer) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
-	wi := workItems.NewItemRemoveBlock(
-		di.elasticProcessor,
-		body,
-		header,
-	)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemRemoveBlock(
+			driver,
+			body,
+			header,
+		)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // SaveRoundsInfo will save data about a slice of rounds in elasticsearch
 func (di *dataIndexer) SaveRoundsInfo(roundsInfo []workItems.RoundInfo) {
-	wi := workItems.NewItemRounds(di.elasticProcessor, roundsInfo)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemRounds(driver, roundsInfo)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // SaveValidatorsRating will save all validators rating info to elasticsearch
 func (di *dataIndexer) SaveValidatorsRating(indexID string, validatorsRatingInfo []workItems.ValidatorRatingInfo) {
-	wi := workItems.NewItemRating(
-		di.elasticProcessor,
-		indexID,
-		validatorsRatingInfo,
-	)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemRating(
+			driver,
+			indexID,
+			validatorsRatingInfo,
+		)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // SaveValidatorsPubKeys will save all validators public keys to elasticsearch
 func (di *dataIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
-	wi := workItems.NewItemValidators(
-		di.elasticProcessor,
-		epoch,
-		validatorsPubKeys,
-	)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemValidators(
+			driver,
+			epoch,
+			validatorsPubKeys,
+		)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // UpdateTPS updates the tps and statistics into elasticsearch index
@@ -156,19 +194,25 @@ func (di *dataIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 		return
 	}
 
-	wi := workItems.NewItemTpsBenchmark(di.elasticProcessor, tpsBenchmark)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemTpsBenchmark(driver, tpsBenchmark)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // SaveAccounts will save the provided accounts
 func (di *dataIndexer) SaveAccounts(accounts []state.UserAccountHandler) {
-	wi := workItems.NewItemAccounts(di.elasticProcessor, accounts)
-	di.dispatcher.Add(wi)
+	for i, driver := range di.outputDrivers {
+		wi := workItems.NewItemAccounts(driver, accounts)
+		di.dispatchers[i].Add(wi)
+	}
 }
 
 // SetTxLogsProcessor will set tx logs processor
 func (di *dataIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
-	di.elasticProcessor.SetTxLogsProcessor(txLogsProc)
+	for _, driver := range di.outputDrivers {
+		driver.SetTxLogsProcessor(txLogsProc)
+	}
 }
 
 // IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
@@ -18,8 +18,8 @@ type ArgDataIndexer struct {
 	EpochStartNotifier sharding.EpochStartEventNotifier
 	NodesCoordinator   sharding.NodesCoordinator
 	Options            *Options
-	DataDispatcher     DispatcherHandler
-	ElasticProcessor   ElasticProcessor
+	DataDispatchers    []DispatcherHandler
+	OutputDrivers      []OutputDriver
 }
 
 //ArgElasticProcessor is struct that is used to store all components that are needed to an elastic indexer
@@ -39,3 +39,17 @@ type ArgElasticProcessor struct {
 	IsInImportDBMode         bool
 	ShardCoordinator         sharding.Coordinator
 }
+
+// ArgOutputDriver is struct that is used to store all components that are needed to create a non-elastic output driver
+type ArgOutputDriver struct {
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	EnabledIndexes           map[string]struct{}
+	AccountsDB               state.AccountsAdapter
+	Denomination             int
+	TransactionFeeCalculator process.TransactionFeeCalculator
+	IsInImportDBMode         bool
+	ShardCoordinator         sharding.Coordinator
+}
@@ -48,8 +48,8 @@ func NewDataIndexerArguments() ArgDataIndexer {
 		Options:            &Options{},
 		NodesCoordinator:   &mock.NodesCoordinatorMock{},
 		EpochStartNotifier: &mock.EpochStartNotifierStub{},
-		DataDispatcher:     &mock.DispatcherMock{},
-		ElasticProcessor:   &mock.ElasticProcessorStub{},
+		DataDispatchers:    []DispatcherHandler{&mock.DispatcherMock{}},
+		OutputDrivers:      []OutputDriver{&mock.ElasticProcessorStub{}},
 		ShardCoordinator:   &mock.ShardCoordinatorMock{},
 	}
 }
@@ -65,20 +65,38 @@ func TestDataIndexer_NewIndexerWithNilNodesCoordinatorShouldErr(t *testing.T) {
 
 func TestDataIndexer_NewIndexerWithNilDataDispatcherShouldErr(t *testing.T) {
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = nil
+	arguments.DataDispatchers = []DispatcherHandler{nil}
 	ei, err := NewDataIndexer(arguments)
 
 	require.Nil(t, ei)
 	require.Equal(t, ErrNilDataDispatcher, err)
 }
 
-func TestDataIndexer_NewIndexerWithNilElasticProcessorShouldErr(t *testing.T) {
+func TestDataIndexer_NewIndexerWithWrongNumberOfDataDispatchersShouldErr(t *testing.T) {
 	arguments := NewDataIndexerArguments()
-	arguments.ElasticProcessor = nil
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{}, &mock.DispatcherMock{}}
 	ei, err := NewDataIndexer(arguments)
 
 	require.Nil(t, ei)
-	require.Equal(t, ErrNilElasticProcessor, err)
+	require.Equal(t, ErrWrongNumberOfDataDispatchers, err)
+}
+
+func TestDataIndexer_NewIndexerWithNoOutputDriverShouldErr(t *testing.T) {
+	arguments := NewDataIndexerArguments()
+	arguments.OutputDrivers = nil
+	ei, err := NewDataIndexer(arguments)
+
+	require.Nil(t, ei)
+	require.Equal(t, ErrNoOutputDriver, err)
+}
+
+func TestDataIndexer_NewIndexerWithNilOutputDriverShouldErr(t *testing.T) {
+	arguments := NewDataIndexerArguments()
+	arguments.OutputDrivers = []OutputDriver{&mock.ElasticProcessorStub{}, nil}
+	ei, err := NewDataIndexer(arguments)
+
+	require.Nil(t, ei)
+	require.Equal(t, ErrNilOutputDriver, err)
 }
 
 func TestDataIndexer_NewIndexerWithNilMarshalizerShouldErr(t *testing.T) {
@@ -114,11 +132,11 @@ func TestDataIndexer_UpdateTPS(t *testing.T) {
 
 	called := false
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 	ei, err := NewDataIndexer(arguments)
 	require.Nil(t, err)
 	_ = ei.Close()
@@ -147,11 +165,11 @@ func TestDataIndexer_SaveBlock(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 	ei, _ := NewDataIndexer(arguments)
 
 	ei.SaveBlock(&dataBlock.Body{MiniBlocks: []*dataBlock.MiniBlock{}}, nil,
@@ -163,11 +181,11 @@ func TestDataIndexer_SaveRoundInfo(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 
 	arguments.Marshalizer = &mock.MarshalizerMock{Fail: true}
 	ei, _ := NewDataIndexer(arguments)
@@ -181,11 +199,11 @@ func TestDataIndexer_SaveValidatorsPubKeys(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 	ei, _ := NewDataIndexer(arguments)
 
 	valPubKey := make(map[uint32][][]byte)
@@ -202,11 +220,11 @@ func TestDataIndexer_SaveValidatorsRating(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 	ei, _ := NewDataIndexer(arguments)
 
 	ei.SaveValidatorsRating("ID", []workItems.ValidatorRatingInfo{
@@ -219,11 +237,11 @@ func TestDataIndexer_RevertIndexedBlock(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.DataDispatcher = &mock.DispatcherMock{
+	arguments.DataDispatchers = []DispatcherHandler{&mock.DispatcherMock{
 		AddCalled: func(item workItems.WorkItemHandler) {
 			called = true
 		},
-	}
+	}}
 	ei, _ := NewDataIndexer(arguments)
 
 	ei.RevertIndexedBlock(&dataBlock.Header{}, &dataBlock.Body{})
@@ -234,17 +252,61 @@ func TestDataIndexer_SetTxLogsProcessor(t *testing.T) {
 	called := false
 
 	arguments := NewDataIndexerArguments()
-	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
+	arguments.OutputDrivers = []OutputDriver{&mock.ElasticProcessorStub{
 		SetTxLogsProcessorCalled: func(txLogsProc process.TransactionLogProcessorDatabase) {
 			called = true
 		},
-	}
+	}}
 	ei, _ := NewDataIndexer(arguments)
 
 	ei.SetTxLogsProcessor(disabled.NewNilTxLogsProcessor())
 	require.True(t, called)
 }
 
+func TestDataIndexer_MultipleOutputDriversShouldReceiveTheSameItems(t *testing.T) {
+	numAddedItems := make([]int, 2)
+	numClosedDrivers := 0
+	numClosedDispatchers := 0
+
+	closeDriver := func() error {
+		numClosedDrivers++
+		return nil
+	}
+	closeDispatcher := func() error {
+		numClosedDispatchers++
+		return nil
+	}
+	arguments := NewDataIndexerArguments()
+	arguments.DataDispatchers = []DispatcherHandler{
+		&mock.DispatcherMock{
+			AddCalled: func(item workItems.WorkItemHandler) {
+				numAddedItems[0]++
+			},
+			CloseCalled: closeDispatcher,
+		},
+		&mock.DispatcherMock{
+			AddCalled: func(item workItems.WorkItemHandler) {
+				numAddedItems[1]++
+			},
+			CloseCalled: closeDispatcher,
+		},
+	}
+	arguments.OutputDrivers = []OutputDriver{
+		&mock.ElasticProcessorStub{CloseCalled: closeDriver},
+		&mock.ElasticProcessorStub{CloseCalled: closeDriver},
+	}
+	ei, _ := NewDataIndexer(arguments)
+
+	ei.SaveBlock(&dataBlock.Body{}, &dataBlock.Header{}, nil, nil, nil, nil)
+	ei.RevertIndexedBlock(&dataBlock.Header{}, &dataBlock.Body{})
+	require.Equal(t, []int{2, 2}, numAddedItems)
+
+	err := ei.Close()
+	require.Nil(t, err)
+	require.Equal(t, 2, numClosedDrivers)
+	require.Equal(t, 2, numClosedDispatchers)
+}
+
 func TestDataIndexer_EpochChange(t *testing.T) {
 	getEligibleValidatorsCalled := false
 
@@ -347,7 +409,7 @@ func TestDataIndexer(t *testing.T) {
 func testCreateIndexer(t *testing.T) {
 	indexTemplates, indexPolicies := getIndexTemplateAndPolicies()
 
-	dispatcher, _ := NewDataDispatcher(100)
+	dispatcher, _ := NewDataDispatcher(100, 0)
 	dbClient, _ := NewElasticClient(elasticsearch.Config{
 		Addresses: []string{"http://localhost:9200"},
 		Username:  "",
@@ -369,8 +431,8 @@ func testCreateIndexer(t *testing.T) {
 		Options:            &Options{},
 		Marshalizer:        &marshal.JsonMarshalizer{},
 		EpochStartNotifier: &mock.EpochStartNotifierStub{},
-		DataDispatcher:     dispatcher,
-		ElasticProcessor:   elasticIndexer,
+		DataDispatchers:    []DispatcherHandler{dispatcher},
+		OutputDrivers:      []OutputDriver{elasticIndexer},
 	})
 	if err != nil {
 		fmt.Println(err)
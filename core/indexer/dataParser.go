@@ -27,6 +27,26 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 	notarizedHeadersHashes []string,
 	sizeTxs int,
 ) ([]byte, []byte, error) {
+	elasticBlock, headerHash, err := dp.prepareBlock(header, signersIndexes, body, notarizedHeadersHashes, sizeTxs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedBlock, err := json.Marshal(elasticBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return serializedBlock, headerHash, nil
+}
+
+func (dp *dataParser) prepareBlock(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	sizeTxs int,
+) (*Block, []byte, error) {
 	headerBytes, err := dp.marshalizer.Marshal(header)
 	if err != nil {
 		return nil, nil, err
@@ -57,7 +77,7 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 	}
 
 	headerHash := dp.hasher.Compute(string(headerBytes))
-	elasticBlock := Block{
+	elasticBlock := &Block{
 		Nonce:                 header.GetNonce(),
 		Round:                 header.GetRound(),
 		Epoch:                 header.GetEpoch(),
@@ -77,12 +97,7 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 		SearchOrder:           computeBlockSearchOrder(header),
 	}
 
-	serializedBlock, err := json.Marshal(elasticBlock)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return serializedBlock, headerHash, nil
+	return elasticBlock, headerHash, nil
 }
 
 func (dp *dataParser) getMiniblocks(header data.HeaderHandler, body *block.Body) []*Miniblock {
@@ -129,6 +144,34 @@ func (dp *dataParser) getMiniblocks(header data.HeaderHandler, body *block.Body)
 	return miniblocks
 }
 
+// getMiniblocksHashesToRemove returns the hex encoded hashes of the miniblocks indexed when the provided block was
+// saved, without the cross shard miniblocks that have the current shard as destination
+func (dp *dataParser) getMiniblocksHashesToRemove(header data.HeaderHandler, body *block.Body) []string {
+	encodedMiniblocksHashes := make([]string, 0)
+	selfShardID := header.GetShardID()
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		isDstMe := selfShardID == miniblock.ReceiverShardID
+		isCrossShard := miniblock.ReceiverShardID != miniblock.SenderShardID
+		if isDstMe && isCrossShard {
+			continue
+		}
+
+		miniblockHash, err := core.CalculateHash(dp.marshalizer, dp.hasher, miniblock)
+		if err != nil {
+			log.Debug("indexer.RemoveMiniblocks cannot calculate miniblock hash",
+				"error", err.Error())
+			continue
+		}
+		encodedMiniblocksHashes = append(encodedMiniblocksHashes, hex.EncodeToString(miniblockHash))
+	}
+
+	return encodedMiniblocksHashes
+}
+
 func serializeRoundInfo(info workItems.RoundInfo) ([]byte, []byte) {
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%d_%d", "_type" : "%s" } }%s`,
 		info.ShardId, info.Index, "_doc", "\n"))
@@ -0,0 +1,452 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const (
+	// operationIndex writes the document, replacing any existing document with the same ID
+	operationIndex = "index"
+	// operationInsert writes the document only if there is no document with the same ID
+	operationInsert = "insert"
+	// operationUpsert overwrites the provided fields of an existing document or writes the whole document if missing
+	operationUpsert = "upsert"
+	// operationDelete removes the document with the provided ID
+	operationDelete = "delete"
+)
+
+// outputDocument holds a document together with the operation that needs to be applied on the output storage
+type outputDocument struct {
+	ID        string
+	Operation string
+	Document  json.RawMessage
+	Fields    map[string]interface{}
+}
+
+// documentsWriter defines the storage specific part of a documents based output driver
+type documentsWriter interface {
+	writeDocuments(index string, documents []*outputDocument) error
+	close() error
+}
+
+// documentsDriver is an output driver that converts the work items in documents, using the same document IDs and the
+// same update semantics as the elasticsearch indexer, and hands them to a documents writer
+type documentsDriver struct {
+	*txDatabaseProcessor
+
+	writer                 documentsWriter
+	parser                 *dataParser
+	enabledIndexes         map[string]struct{}
+	accountsDB             state.AccountsAdapter
+	dividerForDenomination float64
+	balancePrecision       float64
+}
+
+func newDocumentsDriver(args ArgOutputDriver, writer documentsWriter) (*documentsDriver, error) {
+	err := checkArgOutputDriver(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dd := &documentsDriver{
+		writer: writer,
+		parser: &dataParser{
+			hasher:      args.Hasher,
+			marshalizer: args.Marshalizer,
+		},
+		enabledIndexes:         args.EnabledIndexes,
+		accountsDB:             args.AccountsDB,
+		balancePrecision:       math.Pow(10, float64(numDecimalsInFloatBalance)),
+		dividerForDenomination: math.Pow(10, float64(core.MaxInt(args.Denomination, 0))),
+	}
+
+	dd.txDatabaseProcessor = newTxDatabaseProcessor(
+		args.Hasher,
+		args.Marshalizer,
+		args.AddressPubkeyConverter,
+		args.ValidatorPubkeyConverter,
+		args.TransactionFeeCalculator,
+		args.IsInImportDBMode,
+		args.ShardCoordinator,
+	)
+
+	return dd, nil
+}
+
+func checkArgOutputDriver(args ArgOutputDriver) error {
+	if check.IfNil(args.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.AccountsDB) {
+		return ErrNilAccountsDB
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+
+	return nil
+}
+
+// SaveHeader will prepare and save information about a header
+func (dd *documentsDriver) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) error {
+	if !dd.isIndexEnabled(blockIndex) {
+		return nil
+	}
+
+	elasticBlock, headerHash, err := dd.parser.prepareBlock(header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if err != nil {
+		return err
+	}
+
+	doc, err := newOutputDocument(hex.EncodeToString(headerHash), operationIndex, elasticBlock)
+	if err != nil {
+		return err
+	}
+
+	return dd.writer.writeDocuments(blockIndex, []*outputDocument{doc})
+}
+
+// RemoveHeader will remove a block
+func (dd *documentsDriver) RemoveHeader(header data.HeaderHandler) error {
+	headerHash, err := core.CalculateHash(dd.marshalizer, dd.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	return dd.writer.writeDocuments(blockIndex, newDeleteDocuments([]string{hex.EncodeToString(headerHash)}))
+}
+
+// RemoveMiniblocks will remove all miniblocks that are in header
+func (dd *documentsDriver) RemoveMiniblocks(header data.HeaderHandler, body *block.Body) error {
+	if body == nil || len(header.GetMiniBlockHeadersHashes()) == 0 {
+		return nil
+	}
+
+	encodedMiniblocksHashes := dd.parser.getMiniblocksHashesToRemove(header, body)
+	if len(encodedMiniblocksHashes) == 0 {
+		return nil
+	}
+
+	return dd.writer.writeDocuments(miniblocksIndex, newDeleteDocuments(encodedMiniblocksHashes))
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (dd *documentsDriver) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	dd.txLogsProcessor = txLogsProc
+}
+
+// SaveMiniblocks will prepare and save information about miniblocks. A miniblock that was already saved by the other
+// shard will only have its sender or receiver block hash updated
+func (dd *documentsDriver) SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error) {
+	if !dd.isIndexEnabled(miniblocksIndex) {
+		return make(map[string]bool), nil
+	}
+
+	miniblocks := dd.parser.getMiniblocks(header, body)
+	if len(miniblocks) == 0 {
+		return make(map[string]bool), nil
+	}
+
+	docs := make([]*outputDocument, 0, len(miniblocks))
+	for _, mb := range miniblocks {
+		doc, err := newOutputDocument(mb.Hash, operationUpsert, mb)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize miniblock, will skip indexing",
+				"mb hash", mb.Hash)
+			continue
+		}
+
+		if header.GetShardID() == mb.SenderShardID {
+			doc.Fields = map[string]interface{}{"senderBlockHash": mb.SenderBlockHash}
+		} else {
+			doc.Fields = map[string]interface{}{"receiverBlockHash": mb.ReceiverBlockHash}
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return make(map[string]bool), dd.writer.writeDocuments(miniblocksIndex, docs)
+}
+
+// SaveTransactions will prepare and save information about transactions
+func (dd *documentsDriver) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+	_ map[string]bool,
+) error {
+	if !dd.isIndexEnabled(txIndex) {
+		return nil
+	}
+
+	txs, alteredAccounts := dd.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	docs := make([]*outputDocument, 0, len(txs))
+	for _, tx := range txs {
+		doc, err := prepareTransactionDocument(tx, selfShardID)
+		if err != nil {
+			log.Warn("error preparing transaction for indexing", "tx hash", tx.Hash, "error", err)
+			return err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	err := dd.writer.writeDocuments(txIndex, docs)
+	if err != nil {
+		return err
+	}
+
+	return dd.indexAlteredAccounts(alteredAccounts)
+}
+
+func prepareTransactionDocument(tx *Transaction, selfShardID uint32) (*outputDocument, error) {
+	if isIntraShardOrInvalid(tx, selfShardID) {
+		// if transaction is intra-shard, the document can be re-written at forks
+		return newOutputDocument(tx.Hash, operationIndex, tx)
+	}
+
+	if !isCrossShardDstMe(tx, selfShardID) {
+		// if transaction is cross-shard and current shard ID is source, do not overwrite the destination's results
+		return newOutputDocument(tx.Hash, operationInsert, tx)
+	}
+
+	doc, err := newOutputDocument(tx.Hash, operationUpsert, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Fields = map[string]interface{}{
+		"status":        tx.Status,
+		"miniBlockHash": tx.MBHash,
+		"log":           tx.Log,
+		"scResults":     tx.SmartContractResults,
+		"timestamp":     tx.Timestamp,
+		"gasUsed":       tx.GasUsed,
+		"fee":           tx.Fee,
+	}
+
+	return doc, nil
+}
+
+// SaveShardStatistics will prepare and save information about a shard statistics
+func (dd *documentsDriver) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) error {
+	if !dd.isIndexEnabled(tpsIndex) {
+		return nil
+	}
+
+	docs := make([]*outputDocument, 0)
+	doc, err := newOutputDocument(metachainTpsDocID, operationIndex, prepareGeneralTPS(tpsBenchmark))
+	if err != nil {
+		log.Debug("indexer: could not serialize tps info, will skip indexing tps this round")
+	} else {
+		docs = append(docs, doc)
+	}
+
+	for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+		id := fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID())
+		doc, err = newOutputDocument(id, operationIndex, prepareShardTPS(shardInfo))
+		if err != nil {
+			log.Debug("indexer: could not serialize tps info, will skip indexing tps this shard")
+			continue
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return dd.writer.writeDocuments(tpsIndex, docs)
+}
+
+// SaveValidatorsRating will save validators rating
+func (dd *documentsDriver) SaveValidatorsRating(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error {
+	if !dd.isIndexEnabled(ratingIndex) {
+		return nil
+	}
+
+	doc, err := newOutputDocument(index, operationIndex, &ValidatorsRatingInfo{ValidatorsInfos: validatorsRatingInfo})
+	if err != nil {
+		log.Debug("indexer: marshal", "error", "could not marshal validators rating")
+		return err
+	}
+
+	return dd.writer.writeDocuments(ratingIndex, []*outputDocument{doc})
+}
+
+// SaveShardValidatorsPubKeys will prepare and save information about a shard validators public keys
+func (dd *documentsDriver) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error {
+	if !dd.isIndexEnabled(validatorsIndex) {
+		return nil
+	}
+
+	shardValPubKeys := &ValidatorsPublicKeys{
+		PublicKeys: make([]string, 0, len(shardValidatorsPubKeys)),
+	}
+	for _, validatorPk := range shardValidatorsPubKeys {
+		strValidatorPk := dd.validatorPubkeyConverter.Encode(validatorPk)
+		shardValPubKeys.PublicKeys = append(shardValPubKeys.PublicKeys, strValidatorPk)
+	}
+
+	doc, err := newOutputDocument(fmt.Sprintf("%d_%d", shardID, epoch), operationIndex, shardValPubKeys)
+	if err != nil {
+		log.Debug("indexer: marshal", "error", "could not marshal validators public keys")
+		return err
+	}
+
+	return dd.writer.writeDocuments(validatorsIndex, []*outputDocument{doc})
+}
+
+// SaveRoundsInfo will prepare and save information about a slice of rounds
+func (dd *documentsDriver) SaveRoundsInfo(infos []workItems.RoundInfo) error {
+	if !dd.isIndexEnabled(roundIndex) {
+		return nil
+	}
+
+	docs := make([]*outputDocument, 0, len(infos))
+	for _, info := range infos {
+		doc, err := newOutputDocument(fmt.Sprintf("%d_%d", info.ShardId, info.Index), operationIndex, info)
+		if err != nil {
+			log.Warn("indexer: could not serialize round info, will skip indexing this round info",
+				"error", err.Error())
+			continue
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return dd.writer.writeDocuments(roundIndex, docs)
+}
+
+func (dd *documentsDriver) indexAlteredAccounts(accounts map[string]struct{}) error {
+	if !dd.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	accountsToIndex := dd.getAlteredUserAccounts(dd.accountsDB, accounts)
+	if len(accountsToIndex) == 0 {
+		log.Debug("no account to index from provided transactions")
+		return nil
+	}
+
+	return dd.SaveAccounts(accountsToIndex)
+}
+
+// SaveAccounts will prepare and save information about provided accounts
+func (dd *documentsDriver) SaveAccounts(accounts []state.UserAccountHandler) error {
+	if !dd.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	accountsMap := make(map[string]*AccountInfo)
+	docs := make([]*outputDocument, 0, len(accounts))
+	for _, userAccount := range accounts {
+		acc := &AccountInfo{
+			Nonce:      userAccount.GetNonce(),
+			Balance:    userAccount.GetBalance().String(),
+			BalanceNum: computeBalanceAsFloat(userAccount.GetBalance(), dd.dividerForDenomination, dd.balancePrecision),
+		}
+		address := dd.addressPubkeyConverter.Encode(userAccount.AddressBytes())
+		accountsMap[address] = acc
+
+		doc, err := newOutputDocument(address, operationIndex, acc)
+		if err != nil {
+			return err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	err := dd.writer.writeDocuments(accountsIndex, docs)
+	if err != nil {
+		return err
+	}
+
+	return dd.saveAccountsHistory(accountsMap)
+}
+
+func (dd *documentsDriver) saveAccountsHistory(accountsInfoMap map[string]*AccountInfo) error {
+	if !dd.isIndexEnabled(accountsHistoryIndex) {
+		return nil
+	}
+
+	accountsMap := prepareAccountsHistory(accountsInfoMap, time.Now().Unix())
+	docs := make([]*outputDocument, 0, len(accountsMap))
+	for id, acc := range accountsMap {
+		doc, err := newOutputDocument(id, operationIndex, acc)
+		if err != nil {
+			return err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return dd.writer.writeDocuments(accountsHistoryIndex, docs)
+}
+
+func (dd *documentsDriver) isIndexEnabled(index string) bool {
+	_, isEnabled := dd.enabledIndexes[index]
+	return isEnabled
+}
+
+// Close will release the resources held by the underlying writer
+func (dd *documentsDriver) Close() error {
+	return dd.writer.close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dd *documentsDriver) IsInterfaceNil() bool {
+	return dd == nil
+}
+
+func newOutputDocument(id string, operation string, object interface{}) (*outputDocument, error) {
+	serializedObject, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outputDocument{
+		ID:        id,
+		Operation: operation,
+		Document:  serializedObject,
+	}, nil
+}
+
+func newDeleteDocuments(ids []string) []*outputDocument {
+	docs := make([]*outputDocument, 0, len(ids))
+	for _, id := range ids {
+		docs = append(docs, &outputDocument{
+			ID:        id,
+			Operation: operationDelete,
+		})
+	}
+
+	return docs
+}
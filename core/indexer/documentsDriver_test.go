@@ -0,0 +1,222 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type documentsWriterStub struct {
+	documents map[string][]*outputDocument
+	closed    bool
+}
+
+func newDocumentsWriterStub() *documentsWriterStub {
+	return &documentsWriterStub{
+		documents: make(map[string][]*outputDocument),
+	}
+}
+
+func (dws *documentsWriterStub) writeDocuments(index string, documents []*outputDocument) error {
+	dws.documents[index] = append(dws.documents[index], documents...)
+	return nil
+}
+
+func (dws *documentsWriterStub) close() error {
+	dws.closed = true
+	return nil
+}
+
+func createMockArgOutputDriver() ArgOutputDriver {
+	return ArgOutputDriver{
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                   &mock.HasherMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		EnabledIndexes: map[string]struct{}{
+			blockIndex: {}, txIndex: {}, miniblocksIndex: {}, tpsIndex: {}, validatorsIndex: {}, roundIndex: {}, accountsIndex: {}, ratingIndex: {}, accountsHistoryIndex: {},
+		},
+		AccountsDB:               &mock.AccountsStub{},
+		TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+	}
+}
+
+func TestNewDocumentsDriver_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutputDriver()
+	args.Marshalizer = nil
+	driver, err := newDocumentsDriver(args, newDocumentsWriterStub())
+	assert.Nil(t, driver)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgOutputDriver()
+	args.Hasher = nil
+	driver, err = newDocumentsDriver(args, newDocumentsWriterStub())
+	assert.Nil(t, driver)
+	assert.Equal(t, core.ErrNilHasher, err)
+
+	args = createMockArgOutputDriver()
+	args.AddressPubkeyConverter = nil
+	driver, err = newDocumentsDriver(args, newDocumentsWriterStub())
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgOutputDriver()
+	args.AccountsDB = nil
+	driver, err = newDocumentsDriver(args, newDocumentsWriterStub())
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrNilAccountsDB, err)
+
+	args = createMockArgOutputDriver()
+	args.ShardCoordinator = nil
+	driver, err = newDocumentsDriver(args, newDocumentsWriterStub())
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrNilShardCoordinator, err)
+}
+
+func TestDocumentsDriver_SaveHeaderAndRemoveHeader(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	args := createMockArgOutputDriver()
+	driver, _ := newDocumentsDriver(args, writer)
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 1}
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+
+	err := driver.SaveHeader(header, []uint64{0}, &dataBlock.Body{}, nil, 0)
+	require.Nil(t, err)
+	err = driver.RemoveHeader(header)
+	require.Nil(t, err)
+
+	docs := writer.documents[blockIndex]
+	require.Equal(t, 2, len(docs))
+	assert.Equal(t, hex.EncodeToString(headerHash), docs[0].ID)
+	assert.Equal(t, operationIndex, docs[0].Operation)
+	assert.Equal(t, hex.EncodeToString(headerHash), docs[1].ID)
+	assert.Equal(t, operationDelete, docs[1].Operation)
+}
+
+func TestDocumentsDriver_SaveMiniblocksShouldUpsertBlockHashes(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	driver, _ := newDocumentsDriver(createMockArgOutputDriver(), writer)
+
+	header := &dataBlock.Header{ShardID: 1}
+	body := &dataBlock.Body{
+		MiniBlocks: dataBlock.MiniBlockSlice{
+			{SenderShardID: 1, ReceiverShardID: 0},
+			{SenderShardID: 0, ReceiverShardID: 1},
+		},
+	}
+
+	_, err := driver.SaveMiniblocks(header, body)
+	require.Nil(t, err)
+
+	docs := writer.documents[miniblocksIndex]
+	require.Equal(t, 2, len(docs))
+	assert.Equal(t, operationUpsert, docs[0].Operation)
+	assert.Contains(t, docs[0].Fields, "senderBlockHash")
+	assert.Equal(t, operationUpsert, docs[1].Operation)
+	assert.Contains(t, docs[1].Fields, "receiverBlockHash")
+}
+
+func TestDocumentsDriver_RemoveMiniblocksShouldSkipCrossShardDestinationMe(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	args := createMockArgOutputDriver()
+	driver, _ := newDocumentsDriver(args, writer)
+
+	mbSrcMe := &dataBlock.MiniBlock{SenderShardID: 1, ReceiverShardID: 0}
+	mbDstMe := &dataBlock.MiniBlock{SenderShardID: 0, ReceiverShardID: 1}
+	mbHashSrcMe, _ := core.CalculateHash(args.Marshalizer, args.Hasher, mbSrcMe)
+
+	header := &dataBlock.Header{
+		ShardID:          1,
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: []byte("hash1")}, {Hash: []byte("hash2")}},
+	}
+	body := &dataBlock.Body{MiniBlocks: dataBlock.MiniBlockSlice{mbSrcMe, mbDstMe}}
+
+	err := driver.RemoveMiniblocks(header, body)
+	require.Nil(t, err)
+
+	docs := writer.documents[miniblocksIndex]
+	require.Equal(t, 1, len(docs))
+	assert.Equal(t, hex.EncodeToString(mbHashSrcMe), docs[0].ID)
+	assert.Equal(t, operationDelete, docs[0].Operation)
+}
+
+func TestPrepareTransactionDocument(t *testing.T) {
+	t.Parallel()
+
+	selfShardID := uint32(1)
+
+	doc, err := prepareTransactionDocument(&Transaction{Hash: "intra", SenderShard: 1, ReceiverShard: 1}, selfShardID)
+	require.Nil(t, err)
+	assert.Equal(t, operationIndex, doc.Operation)
+
+	doc, err = prepareTransactionDocument(&Transaction{Hash: "src", SenderShard: 1, ReceiverShard: 0}, selfShardID)
+	require.Nil(t, err)
+	assert.Equal(t, operationInsert, doc.Operation)
+
+	doc, err = prepareTransactionDocument(&Transaction{Hash: "dst", SenderShard: 0, ReceiverShard: 1, Status: "success"}, selfShardID)
+	require.Nil(t, err)
+	assert.Equal(t, "dst", doc.ID)
+	assert.Equal(t, operationUpsert, doc.Operation)
+	assert.Equal(t, "success", doc.Fields["status"])
+}
+
+func TestDocumentsDriver_SaveRoundsInfoAndValidators(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	driver, _ := newDocumentsDriver(createMockArgOutputDriver(), writer)
+
+	err := driver.SaveRoundsInfo([]workItems.RoundInfo{{Index: 10, ShardId: 2}})
+	require.Nil(t, err)
+	err = driver.SaveShardValidatorsPubKeys(2, 5, [][]byte{[]byte("key")})
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(writer.documents[roundIndex]))
+	assert.Equal(t, "2_10", writer.documents[roundIndex][0].ID)
+	require.Equal(t, 1, len(writer.documents[validatorsIndex]))
+	assert.Equal(t, "2_5", writer.documents[validatorsIndex][0].ID)
+}
+
+func TestDocumentsDriver_DisabledIndexShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	args := createMockArgOutputDriver()
+	args.EnabledIndexes = map[string]struct{}{}
+	driver, _ := newDocumentsDriver(args, writer)
+
+	err := driver.SaveRoundsInfo([]workItems.RoundInfo{{Index: 10}})
+	require.Nil(t, err)
+	err = driver.SaveHeader(&dataBlock.Header{}, nil, &dataBlock.Body{}, nil, 0)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, len(writer.documents))
+}
+
+func TestDocumentsDriver_CloseShouldCloseWriter(t *testing.T) {
+	t.Parallel()
+
+	writer := newDocumentsWriterStub()
+	driver, _ := newDocumentsDriver(createMockArgOutputDriver(), writer)
+
+	err := driver.Close()
+	require.Nil(t, err)
+	assert.True(t, writer.closed)
+}
@@ -292,28 +292,7 @@ func (ei *elasticProcessor) RemoveMiniblocks(header data.HeaderHandler, body *bl
 		return nil
 	}
 
-	encodedMiniblocksHashes := make([]string, 0)
-	selfShardID := header.GetShardID()
-	for _, miniblock := range body.MiniBlocks {
-		if miniblock.Type == block.PeerBlock {
-			continue
-		}
-
-		isDstMe := selfShardID == miniblock.ReceiverShardID
-		isCrossShard := miniblock.ReceiverShardID != miniblock.SenderShardID
-		if isDstMe && isCrossShard {
-			continue
-		}
-
-		miniblockHash, err := core.CalculateHash(ei.marshalizer, ei.hasher, miniblock)
-		if err != nil {
-			log.Debug("indexer.RemoveMiniblocks cannot calculate miniblock hash",
-				"error", err.Error())
-			continue
-		}
-		encodedMiniblocksHashes = append(encodedMiniblocksHashes, hex.EncodeToString(miniblockHash))
-
-	}
+	encodedMiniblocksHashes := ei.parser.getMiniblocksHashesToRemove(header, body)
 
 	return ei.elasticClient.DoBulkRemove(miniblocksIndex, encodedMiniblocksHashes)
 }
@@ -497,33 +476,7 @@ func (ei *elasticProcessor) indexAlteredAccounts(accounts map[string]struct{}) e
 		return nil
 	}
 
-	accountsToIndex := make([]state.UserAccountHandler, 0)
-	for address := range accounts {
-		addressBytes, err := ei.addressPubkeyConverter.Decode(address)
-		if err != nil {
-			log.Warn("cannot decode address", "address", address, "error", err)
-			continue
-		}
-
-		if ei.shardCoordinator.ComputeId(addressBytes) != ei.shardCoordinator.SelfId() {
-			continue
-		}
-
-		account, err := ei.accountsDB.LoadAccount(addressBytes)
-		if err != nil {
-			log.Warn("cannot load account", "address bytes", addressBytes, "error", err)
-			continue
-		}
-
-		userAccount, ok := account.(state.UserAccountHandler)
-		if !ok {
-			log.Warn("cannot cast AccountHandler to type UserAccountHandler")
-			continue
-		}
-
-		accountsToIndex = append(accountsToIndex, userAccount)
-	}
-
+	accountsToIndex := ei.getAlteredUserAccounts(ei.accountsDB, accounts)
 	if len(accountsToIndex) == 0 {
 		log.Debug("no account to index from provided transactions")
 		return nil
@@ -571,17 +524,7 @@ func (ei *elasticProcessor) saveAccountsHistory(accountsInfoMap map[string]*Acco
 		return nil
 	}
 
-	currentTimestamp := time.Now().Unix()
-	accountsMap := make(map[string]*AccountBalanceHistory)
-	for address, userAccount := range accountsInfoMap {
-		acc := &AccountBalanceHistory{
-			Address:   address,
-			Balance:   userAccount.Balance,
-			Timestamp: currentTimestamp,
-		}
-		addressKey := fmt.Sprintf("%s_%d", address, currentTimestamp)
-		accountsMap[addressKey] = acc
-	}
+	accountsMap := prepareAccountsHistory(accountsInfoMap, time.Now().Unix())
 
 	buffSlice, err := serializeAccountsHistory(accountsMap)
 	if err != nil {
@@ -605,13 +548,12 @@ func (ei *elasticProcessor) isIndexEnabled(index string) bool {
 }
 
 func (ei *elasticProcessor) computeBalanceAsFloat(balance *big.Int) float64 {
-	balanceBigFloat := big.NewFloat(0).SetInt(balance)
-	balanceFloat64, _ := balanceBigFloat.Float64()
-
-	bal := balanceFloat64 / ei.dividerForDenomination
-	balanceFloatWithDecimals := math.Round(bal*ei.balancePrecision) / ei.balancePrecision
+	return computeBalanceAsFloat(balance, ei.dividerForDenomination, ei.balancePrecision)
+}
 
-	return core.MaxFloat64(balanceFloatWithDecimals, 0)
+// Close does nothing as the elasticsearch client does not hold any resources that need to be released
+func (ei *elasticProcessor) Close() error {
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -19,9 +19,27 @@ var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
 // ErrNilDataDispatcher signals that an operation has been attempted to or with a nil data dispatcher implementation
 var ErrNilDataDispatcher = errors.New("nil data dispatcher")
 
+// ErrWrongNumberOfDataDispatchers signals that the number of data dispatchers does not match the number of output drivers
+var ErrWrongNumberOfDataDispatchers = errors.New("wrong number of data dispatchers")
+
 // ErrNilElasticProcessor signals that an operation has been attempted to or with a nil elastic processor implementation
 var ErrNilElasticProcessor = errors.New("nil elastic processor")
 
+// ErrNoOutputDriver signals that no output driver has been provided
+var ErrNoOutputDriver = errors.New("no output driver provided")
+
+// ErrNilOutputDriver signals that an operation has been attempted to or with a nil output driver implementation
+var ErrNilOutputDriver = errors.New("nil output driver")
+
+// ErrEmptyOutputFilePath signals that an empty file path has been provided for the json file output driver
+var ErrEmptyOutputFilePath = errors.New("empty output file path")
+
+// ErrEmptyDataSourceName signals that an empty data source name has been provided for the sql output driver
+var ErrEmptyDataSourceName = errors.New("empty data source name")
+
+// ErrUnknownDocumentOperation signals that an unknown operation has been requested on a document
+var ErrUnknownDocumentOperation = errors.New("unknown document operation")
+
 // ErrNilDatabaseClient signals that an operation has been attempted to or with a nil database client implementation
 var ErrNilDatabaseClient = errors.New("nil database client")
 
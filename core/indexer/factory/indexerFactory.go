@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
@@ -15,9 +16,13 @@ import (
 	"github.com/elastic/go-elasticsearch/v7"
 )
 
+var log = logger.GetOrCreate("core/indexer/factory")
+
 const (
 	withKibanaFolder = "withKibana"
 	noKibanaFolder   = "noKibana"
+
+	maxNumOfRetriesForOutputDrivers = 10
 )
 
 // ArgsIndexerFactory holds all dependencies required by the data indexer factory in order to create
@@ -42,6 +47,16 @@ type ArgsIndexerFactory struct {
 	AccountsDB               state.AccountsAdapter
 	TransactionFeeCalculator process.TransactionFeeCalculator
 	IsInImportDBMode         bool
+	JSONFileOutput           ArgsOutput
+	SQLOutput                ArgsOutput
+}
+
+// ArgsOutput holds the configuration of an indexer output that can be used besides elastic search
+type ArgsOutput struct {
+	Enabled bool
+	// Destination is the file path for the json file output or the data source name for the sql output
+	Destination    string
+	EnabledIndexes []string
 }
 
 // NewIndexer will create a new instance of Indexer
@@ -51,35 +66,155 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 		return nil, err
 	}
 
-	if !args.Enabled {
+	if !args.Enabled && !args.JSONFileOutput.Enabled && !args.SQLOutput.Enabled {
 		return indexer.NewNilIndexer(), nil
 	}
 
-	elasticProcessor, err := createElasticProcessor(args)
+	outputDrivers, err := createOutputDrivers(args)
 	if err != nil {
 		return nil, err
 	}
 
-	dispatcher, err := indexer.NewDataDispatcher(args.IndexerCacheSize)
+	dispatchers, err := createDataDispatchers(args, len(outputDrivers))
 	if err != nil {
+		closeOutputDrivers(outputDrivers)
 		return nil, err
 	}
 
-	dispatcher.StartIndexData()
-
 	arguments := indexer.ArgDataIndexer{
 		Marshalizer:        args.Marshalizer,
 		Options:            args.Options,
 		NodesCoordinator:   args.NodesCoordinator,
 		EpochStartNotifier: args.EpochStartNotifier,
 		ShardCoordinator:   args.ShardCoordinator,
-		ElasticProcessor:   elasticProcessor,
-		DataDispatcher:     dispatcher,
+		OutputDrivers:      outputDrivers,
+		DataDispatchers:    dispatchers,
 	}
 
 	return indexer.NewDataIndexer(arguments)
 }
 
+// createDataDispatchers creates one started dispatcher for each output driver so a driver which fails to save its
+// items does not block the other ones
+func createDataDispatchers(args *ArgsIndexerFactory, numOutputDrivers int) ([]indexer.DispatcherHandler, error) {
+	dispatchers := make([]indexer.DispatcherHandler, 0, numOutputDrivers)
+	for i := 0; i < numOutputDrivers; i++ {
+		// the elastic search driver, when enabled, is the first one and retries a failed item until it is saved. The
+		// other drivers drop the item after a few retries so a persistent failure does not stall their queue
+		maxNumOfRetries := uint32(maxNumOfRetriesForOutputDrivers)
+		if args.Enabled && i == 0 {
+			maxNumOfRetries = 0
+		}
+
+		dispatcher, err := indexer.NewDataDispatcher(args.IndexerCacheSize, maxNumOfRetries)
+		if err != nil {
+			closeDataDispatchers(dispatchers)
+			return nil, err
+		}
+
+		dispatcher.StartIndexData()
+		dispatchers = append(dispatchers, dispatcher)
+	}
+
+	return dispatchers, nil
+}
+
+func closeDataDispatchers(dispatchers []indexer.DispatcherHandler) {
+	for _, dispatcher := range dispatchers {
+		err := dispatcher.Close()
+		if err != nil {
+			log.Warn("cannot close indexer data dispatcher", "error", err.Error())
+		}
+	}
+}
+
+func createOutputDrivers(args *ArgsIndexerFactory) ([]indexer.OutputDriver, error) {
+	outputDrivers := make([]indexer.OutputDriver, 0)
+	if args.Enabled {
+		elasticProcessor, err := createElasticProcessor(args)
+		if err != nil {
+			return nil, err
+		}
+
+		outputDrivers = append(outputDrivers, elasticProcessor)
+	}
+
+	if args.JSONFileOutput.Enabled {
+		driverArgs, err := createArgOutputDriver(args, args.JSONFileOutput.EnabledIndexes)
+		if err != nil {
+			closeOutputDrivers(outputDrivers)
+			return nil, err
+		}
+
+		jsonFileDriver, err := indexer.NewJSONFileOutputDriver(driverArgs, args.JSONFileOutput.Destination)
+		if err != nil {
+			closeOutputDrivers(outputDrivers)
+			return nil, err
+		}
+
+		outputDrivers = append(outputDrivers, jsonFileDriver)
+	}
+
+	if args.SQLOutput.Enabled {
+		driverArgs, err := createArgOutputDriver(args, args.SQLOutput.EnabledIndexes)
+		if err != nil {
+			closeOutputDrivers(outputDrivers)
+			return nil, err
+		}
+
+		sqlDriver, err := indexer.NewSQLOutputDriver(driverArgs, args.SQLOutput.Destination)
+		if err != nil {
+			closeOutputDrivers(outputDrivers)
+			return nil, err
+		}
+
+		outputDrivers = append(outputDrivers, sqlDriver)
+	}
+
+	return outputDrivers, nil
+}
+
+func closeOutputDrivers(outputDrivers []indexer.OutputDriver) {
+	for _, driver := range outputDrivers {
+		err := driver.Close()
+		if err != nil {
+			log.Warn("cannot close indexer output driver", "error", err.Error())
+		}
+	}
+}
+
+func createArgOutputDriver(args *ArgsIndexerFactory, enabledIndexes []string) (indexer.ArgOutputDriver, error) {
+	enabledIndexesMap, err := createEnabledIndexesMap(enabledIndexes)
+	if err != nil {
+		return indexer.ArgOutputDriver{}, err
+	}
+
+	return indexer.ArgOutputDriver{
+		Marshalizer:              args.Marshalizer,
+		Hasher:                   args.Hasher,
+		AddressPubkeyConverter:   args.AddressPubkeyConverter,
+		ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		EnabledIndexes:           enabledIndexesMap,
+		AccountsDB:               args.AccountsDB,
+		Denomination:             args.Denomination,
+		TransactionFeeCalculator: args.TransactionFeeCalculator,
+		IsInImportDBMode:         args.IsInImportDBMode,
+		ShardCoordinator:         args.ShardCoordinator,
+	}, nil
+}
+
+func createEnabledIndexesMap(enabledIndexes []string) (map[string]struct{}, error) {
+	enabledIndexesMap := make(map[string]struct{})
+	for _, index := range enabledIndexes {
+		enabledIndexesMap[index] = struct{}{}
+	}
+	if len(enabledIndexesMap) == 0 {
+		return nil, indexer.ErrEmptyEnabledIndexes
+	}
+
+	return enabledIndexesMap, nil
+}
+
 func createDatabaseClient(url, userName, password string) (indexer.DatabaseClientHandler, error) {
 	return indexer.NewElasticClient(elasticsearch.Config{
 		Addresses: []string{url},
@@ -106,12 +241,9 @@ func createElasticProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor,
 		return nil, err
 	}
 
-	enabledIndexesMap := make(map[string]struct{})
-	for _, index := range args.EnabledIndexes {
-		enabledIndexesMap[index] = struct{}{}
-	}
-	if len(enabledIndexesMap) == 0 {
-		return nil, indexer.ErrEmptyEnabledIndexes
+	enabledIndexesMap, err := createEnabledIndexesMap(args.EnabledIndexes)
+	if err != nil {
+		return nil, err
 	}
 
 	esIndexerArgs := indexer.ArgElasticProcessor{
@@ -144,7 +276,7 @@ func checkDataIndexerParams(arguments *ArgsIndexerFactory) error {
 	if check.IfNil(arguments.ValidatorPubkeyConverter) {
 		return fmt.Errorf("%w when setting ValidatorPubkeyConverter in indexer", indexer.ErrNilPubkeyConverter)
 	}
-	if arguments.Url == "" {
+	if arguments.Enabled && arguments.Url == "" {
 		return core.ErrNilUrl
 	}
 	if check.IfNil(arguments.Marshalizer) {
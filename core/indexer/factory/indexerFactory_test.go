@@ -2,8 +2,11 @@ package factory
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -178,3 +181,44 @@ func TestIndexerFactoryCreate_ElasticIndexer(t *testing.T) {
 	err = elasticIndexer.Close()
 	require.NoError(t, err)
 }
+
+func TestIndexerFactoryCreate_OnlyJSONFileOutputShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "indexerFactory")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockIndexerFactoryArgs()
+	args.Enabled = false
+	args.Url = ""
+	args.JSONFileOutput = ArgsOutput{
+		Enabled:        true,
+		Destination:    filepath.Join(dir, "indexer.json"),
+		EnabledIndexes: []string{"blocks"},
+	}
+
+	dataIndexer, err := NewIndexer(args)
+	require.NoError(t, err)
+	require.False(t, dataIndexer.IsNilIndexer())
+
+	err = dataIndexer.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerFactoryCreate_SQLOutputWithoutEnabledIndexesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerFactoryArgs()
+	args.Enabled = false
+	args.SQLOutput = ArgsOutput{
+		Enabled:     true,
+		Destination: "indexer.db",
+	}
+
+	dataIndexer, err := NewIndexer(args)
+	require.Nil(t, dataIndexer)
+	require.Equal(t, indexer.ErrEmptyEnabledIndexes, err)
+}
@@ -47,6 +47,12 @@ type DispatcherHandler interface {
 
 // ElasticProcessor defines the interface for the elastic search indexer
 type ElasticProcessor interface {
+	OutputDriver
+}
+
+// OutputDriver defines the interface for a component that persists the indexed data into an external storage.
+// All drivers receive the same work items from the data dispatcher
+type OutputDriver interface {
 	SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) error
 	SaveHeader(header data.HeaderHandler, signersIndexes []uint64, body *block.Body, notarizedHeadersHashes []string, txsSize int) error
 	RemoveHeader(header data.HeaderHandler) error
@@ -58,6 +64,7 @@ type ElasticProcessor interface {
 	SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error
 	SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase)
 	SaveAccounts(accounts []state.UserAccountHandler) error
+	Close() error
 	IsInterfaceNil() bool
 }
 
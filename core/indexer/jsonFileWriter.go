@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// jsonFileEntry is the representation of a document operation as a line in the output file
+type jsonFileEntry struct {
+	Index     string                 `json:"index"`
+	ID        string                 `json:"id"`
+	Operation string                 `json:"operation"`
+	Document  json.RawMessage        `json:"document,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+type jsonFileWriter struct {
+	mut    sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// NewJSONFileOutputDriver creates an output driver that appends all the indexed documents, as newline delimited JSON
+// entries, in the provided file. Each entry holds the operation that should be applied so the file can be replayed
+func NewJSONFileOutputDriver(args ArgOutputDriver, filePath string) (OutputDriver, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyOutputFilePath
+	}
+
+	writer, err := newJSONFileWriter(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := newDocumentsDriver(args, writer)
+	if err != nil {
+		_ = writer.close()
+		return nil, err
+	}
+
+	return driver, nil
+}
+
+func newJSONFileWriter(filePath string) (*jsonFileWriter, error) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonFileWriter{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+func (jfw *jsonFileWriter) writeDocuments(index string, documents []*outputDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	jfw.mut.Lock()
+	defer jfw.mut.Unlock()
+
+	encoder := json.NewEncoder(jfw.writer)
+	for _, doc := range documents {
+		entry := &jsonFileEntry{
+			Index:     index,
+			ID:        doc.ID,
+			Operation: doc.Operation,
+			Document:  doc.Document,
+			Fields:    doc.Fields,
+		}
+
+		err := encoder.Encode(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return jfw.writer.Flush()
+}
+
+func (jfw *jsonFileWriter) close() error {
+	jfw.mut.Lock()
+	defer jfw.mut.Unlock()
+
+	err := jfw.writer.Flush()
+	if err != nil {
+		log.Warn("indexer: cannot flush the json output file", "error", err.Error())
+	}
+
+	return jfw.file.Close()
+}
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "indexer")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+func readJSONFileEntries(t *testing.T, filePath string) []*jsonFileEntry {
+	file, err := os.Open(filePath)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	entries := make([]*jsonFileEntry, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := &jsonFileEntry{}
+		err = json.Unmarshal(scanner.Bytes(), entry)
+		require.Nil(t, err)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewJSONFileOutputDriver_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driver, err := NewJSONFileOutputDriver(createMockArgOutputDriver(), "")
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrEmptyOutputFilePath, err)
+}
+
+func TestNewJSONFileOutputDriver_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutputDriver()
+	args.AccountsDB = nil
+	driver, err := NewJSONFileOutputDriver(args, filepath.Join(createTempDir(t), "indexer.json"))
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrNilAccountsDB, err)
+}
+
+func TestJSONFileOutputDriver_ShouldAppendEntries(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(createTempDir(t), "indexer.json")
+	driver, err := NewJSONFileOutputDriver(createMockArgOutputDriver(), filePath)
+	require.Nil(t, err)
+
+	err = driver.SaveRoundsInfo([]workItems.RoundInfo{{Index: 1, ShardId: 0}, {Index: 2, ShardId: 0}})
+	require.Nil(t, err)
+	err = driver.Close()
+	require.Nil(t, err)
+
+	driver, err = NewJSONFileOutputDriver(createMockArgOutputDriver(), filePath)
+	require.Nil(t, err)
+	err = driver.SaveShardValidatorsPubKeys(0, 1, [][]byte{[]byte("key")})
+	require.Nil(t, err)
+	err = driver.Close()
+	require.Nil(t, err)
+
+	entries := readJSONFileEntries(t, filePath)
+	require.Equal(t, 3, len(entries))
+	assert.Equal(t, roundIndex, entries[0].Index)
+	assert.Equal(t, "0_1", entries[0].ID)
+	assert.Equal(t, operationIndex, entries[0].Operation)
+	assert.Equal(t, "0_2", entries[1].ID)
+	assert.Equal(t, validatorsIndex, entries[2].Index)
+	assert.Equal(t, "0_1", entries[2].ID)
+
+	roundInfo := workItems.RoundInfo{}
+	err = json.Unmarshal(entries[1].Document, &roundInfo)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), roundInfo.Index)
+}
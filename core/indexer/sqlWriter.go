@@ -0,0 +1,146 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	// registers the sqlite3 driver used by the sql output driver
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const sqlDriverName = "sqlite3"
+
+type sqlWriter struct {
+	mut sync.Mutex
+	db  *sql.DB
+}
+
+// NewSQLOutputDriver creates an output driver that stores the indexed documents in a SQLite database. Each enabled
+// index has its own table that holds the JSON document of every entry, keyed by the same ID used in elasticsearch
+func NewSQLOutputDriver(args ArgOutputDriver, dataSourceName string) (OutputDriver, error) {
+	if len(dataSourceName) == 0 {
+		return nil, ErrEmptyDataSourceName
+	}
+
+	writer, err := newSQLWriter(dataSourceName, args.EnabledIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := newDocumentsDriver(args, writer)
+	if err != nil {
+		_ = writer.close()
+		return nil, err
+	}
+
+	return driver, nil
+}
+
+func newSQLWriter(dataSourceName string, enabledIndexes map[string]struct{}) (*sqlWriter, error) {
+	db, err := sql.Open(sqlDriverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range enabledIndexes {
+		query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS "%s" (id TEXT PRIMARY KEY, document TEXT NOT NULL)`, index)
+		_, err = db.Exec(query)
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	return &sqlWriter{
+		db: db,
+	}, nil
+}
+
+func (sw *sqlWriter) writeDocuments(index string, documents []*outputDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	sw.mut.Lock()
+	defer sw.mut.Unlock()
+
+	dbTx, err := sw.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range documents {
+		err = applyDocument(dbTx, index, doc)
+		if err != nil {
+			_ = dbTx.Rollback()
+			return err
+		}
+	}
+
+	return dbTx.Commit()
+}
+
+func applyDocument(dbTx *sql.Tx, index string, doc *outputDocument) error {
+	var err error
+	switch doc.Operation {
+	case operationIndex:
+		query := fmt.Sprintf(`INSERT INTO "%s" (id, document) VALUES (?, ?) `+
+			`ON CONFLICT(id) DO UPDATE SET document = excluded.document`, index)
+		_, err = dbTx.Exec(query, doc.ID, string(doc.Document))
+	case operationInsert:
+		query := fmt.Sprintf(`INSERT INTO "%s" (id, document) VALUES (?, ?) ON CONFLICT(id) DO NOTHING`, index)
+		_, err = dbTx.Exec(query, doc.ID, string(doc.Document))
+	case operationUpsert:
+		err = upsertDocument(dbTx, index, doc)
+	case operationDelete:
+		query := fmt.Sprintf(`DELETE FROM "%s" WHERE id = ?`, index)
+		_, err = dbTx.Exec(query, doc.ID)
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnknownDocumentOperation, doc.Operation)
+	}
+
+	return err
+}
+
+func upsertDocument(dbTx *sql.Tx, index string, doc *outputDocument) error {
+	var existing string
+	query := fmt.Sprintf(`SELECT document FROM "%s" WHERE id = ?`, index)
+	err := dbTx.QueryRow(query, doc.ID).Scan(&existing)
+	if err == sql.ErrNoRows {
+		query = fmt.Sprintf(`INSERT INTO "%s" (id, document) VALUES (?, ?)`, index)
+		_, err = dbTx.Exec(query, doc.ID, string(doc.Document))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	existingFields := make(map[string]interface{})
+	err = json.Unmarshal([]byte(existing), &existingFields)
+	if err != nil {
+		return err
+	}
+
+	for field, value := range doc.Fields {
+		existingFields[field] = value
+	}
+
+	merged, err := json.Marshal(existingFields)
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(`UPDATE "%s" SET document = ? WHERE id = ?`, index)
+	_, err = dbTx.Exec(query, string(merged), doc.ID)
+
+	return err
+}
+
+func (sw *sqlWriter) close() error {
+	sw.mut.Lock()
+	defer sw.mut.Unlock()
+
+	return sw.db.Close()
+}
@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readSQLDocument(t *testing.T, writer *sqlWriter, index string, id string) map[string]interface{} {
+	var document string
+	err := writer.db.QueryRow(fmt.Sprintf(`SELECT document FROM "%s" WHERE id = ?`, index), id).Scan(&document)
+	if err != nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	err = json.Unmarshal([]byte(document), &fields)
+	require.Nil(t, err)
+
+	return fields
+}
+
+func TestNewSQLOutputDriver_EmptyDataSourceNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driver, err := NewSQLOutputDriver(createMockArgOutputDriver(), "")
+	assert.Nil(t, driver)
+	assert.Equal(t, ErrEmptyDataSourceName, err)
+}
+
+func TestSQLWriter_Operations(t *testing.T) {
+	t.Parallel()
+
+	writer, err := newSQLWriter(filepath.Join(createTempDir(t), "indexer.db"), map[string]struct{}{txIndex: {}})
+	require.Nil(t, err)
+	defer func() {
+		_ = writer.close()
+	}()
+
+	doc, _ := newOutputDocument("hash", operationInsert, &Transaction{Status: "pending", Fee: "1"})
+	err = writer.writeDocuments(txIndex, []*outputDocument{doc})
+	require.Nil(t, err)
+
+	doc, _ = newOutputDocument("hash", operationInsert, &Transaction{Status: "other"})
+	err = writer.writeDocuments(txIndex, []*outputDocument{doc})
+	require.Nil(t, err)
+	assert.Equal(t, "pending", readSQLDocument(t, writer, txIndex, "hash")["status"])
+
+	doc, _ = newOutputDocument("hash", operationUpsert, &Transaction{})
+	doc.Fields = map[string]interface{}{"status": "success"}
+	err = writer.writeDocuments(txIndex, []*outputDocument{doc})
+	require.Nil(t, err)
+	fields := readSQLDocument(t, writer, txIndex, "hash")
+	assert.Equal(t, "success", fields["status"])
+	assert.Equal(t, "1", fields["fee"])
+
+	doc, _ = newOutputDocument("hash", operationIndex, &Transaction{Status: "invalid"})
+	err = writer.writeDocuments(txIndex, []*outputDocument{doc})
+	require.Nil(t, err)
+	fields = readSQLDocument(t, writer, txIndex, "hash")
+	assert.Equal(t, "invalid", fields["status"])
+	assert.Equal(t, "", fields["fee"])
+
+	err = writer.writeDocuments(txIndex, newDeleteDocuments([]string{"hash"}))
+	require.Nil(t, err)
+	assert.Nil(t, readSQLDocument(t, writer, txIndex, "hash"))
+
+	err = writer.writeDocuments(txIndex, []*outputDocument{{ID: "hash", Operation: "unknown"}})
+	assert.True(t, errors.Is(err, ErrUnknownDocumentOperation))
+}
+
+func TestSQLOutputDriver_SaveAndRevertMiniblocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgOutputDriver()
+	driver, err := NewSQLOutputDriver(args, filepath.Join(createTempDir(t), "indexer.db"))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+	writer := driver.(*documentsDriver).writer.(*sqlWriter)
+
+	mb := &dataBlock.MiniBlock{SenderShardID: 0, ReceiverShardID: 1}
+	mbHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, mb)
+	encodedMbHash := hex.EncodeToString(mbHash)
+	body := &dataBlock.Body{MiniBlocks: dataBlock.MiniBlockSlice{mb}}
+
+	srcHeader := &dataBlock.Header{ShardID: 0, Nonce: 1, MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: mbHash}}}
+	srcHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, srcHeader)
+	dstHeader := &dataBlock.Header{ShardID: 1, Nonce: 2, MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: mbHash}}}
+	dstHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, dstHeader)
+
+	_, err = driver.SaveMiniblocks(dstHeader, body)
+	require.Nil(t, err)
+	_, err = driver.SaveMiniblocks(srcHeader, body)
+	require.Nil(t, err)
+
+	fields := readSQLDocument(t, writer, miniblocksIndex, encodedMbHash)
+	assert.Equal(t, hex.EncodeToString(srcHeaderHash), fields["senderBlockHash"])
+	assert.Equal(t, hex.EncodeToString(dstHeaderHash), fields["receiverBlockHash"])
+
+	// reverting the destination block must not remove the miniblock saved by the source shard
+	err = driver.RemoveMiniblocks(dstHeader, body)
+	require.Nil(t, err)
+	assert.NotNil(t, readSQLDocument(t, writer, miniblocksIndex, encodedMbHash))
+
+	err = driver.RemoveMiniblocks(srcHeader, body)
+	require.Nil(t, err)
+	assert.Nil(t, readSQLDocument(t, writer, miniblocksIndex, encodedMbHash))
+}
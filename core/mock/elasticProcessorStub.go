@@ -22,6 +22,7 @@ type ElasticProcessorStub struct {
 	SaveShardValidatorsPubKeysCalled func(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error
 	SetTxLogsProcessorCalled         func(txLogsProc process.TransactionLogProcessorDatabase)
 	SaveAccountsCalled               func(acc []state.UserAccountHandler) error
+	CloseCalled                      func() error
 }
 
 // SaveShardStatistics -
@@ -112,6 +113,15 @@ func (eim *ElasticProcessorStub) SaveAccounts(acc []state.UserAccountHandler) er
 	return nil
 }
 
+// Close -
+func (eim *ElasticProcessorStub) Close() error {
+	if eim.CloseCalled != nil {
+		return eim.CloseCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (eim *ElasticProcessorStub) IsInterfaceNil() bool {
 	return eim == nil
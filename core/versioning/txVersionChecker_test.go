@@ -36,6 +36,37 @@ func TestTxVersionChecker_IsSignedWithHash(t *testing.T) {
 	require.True(t, res)
 }
 
+func TestTxVersionChecker_IsGuardedTransactionOptionsZeroShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	tx := &transaction.Transaction{
+		Options: 0,
+		Version: minTxVersion + 1,
+	}
+	tvc := NewTxVersionChecker(minTxVersion)
+
+	res := tvc.IsGuardedTransaction(tx)
+	require.False(t, res)
+}
+
+func TestTxVersionChecker_IsGuardedTransaction(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	tx := &transaction.Transaction{
+		Options: MaskGuardedTransaction | MaskSignedWithHash,
+		Version: minTxVersion + 1,
+	}
+	tvc := NewTxVersionChecker(minTxVersion)
+
+	require.True(t, tvc.IsGuardedTransaction(tx))
+	require.True(t, tvc.IsSignedWithHash(tx))
+
+	tx.Version = minTxVersion
+	require.False(t, tvc.IsGuardedTransaction(tx))
+}
+
 func TestTxVersionChecker_CheckTxVersionShouldReturnErrorOptionsNotZero(t *testing.T) {
 	minTxVersion := uint32(1)
 	tx := &transaction.Transaction{
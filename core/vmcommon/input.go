@@ -82,6 +82,22 @@ type VMInput struct {
 
 	// ESDTTokenName is the name of the token which was transferred by the transaction to the SC
 	ESDTTokenName []byte
+
+	// ESDTTokenNonce is the nonce of the non fungible or semi fungible token which was transferred
+	// by the transaction to the SC. It is 0 for fungible tokens
+	ESDTTokenNonce uint64
+
+	// ESDTTransfers holds all the tokens transferred to the SC by a multi transfer transaction
+	ESDTTransfers []*ESDTTransfer
+}
+
+// ESDTTransfer defines the token and the value of a single transfer from a multi transfer transaction
+type ESDTTransfer struct {
+	// ESDTValue is the value (amount of tokens) transferred
+	ESDTValue *big.Int
+
+	// ESDTTokenName is the name of the token which was transferred
+	ESDTTokenName []byte
 }
 
 // ContractCreateInput VM input when creating a new contract.
@@ -29,7 +29,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// PeerAction type represents the possible events that a node can trigger for the metachain to notarize
 type PeerAction int32
 
 const (
@@ -69,9 +68,6 @@ func (PeerAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_87b91ab531130b2b, []int{0}
 }
 
-// PeerData holds information about actions taken by a peer:
-//  - a peer can register with an amount to become a validator
-//  - a peer can choose to deregister and get back the deposited value
 type PeerData struct {
 	Address     []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	PublicKey   []byte        `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
@@ -143,7 +139,6 @@ func (m *PeerData) GetValueChange() *math_big.Int {
 	return nil
 }
 
-// ShardData holds the block information sent by the shards to the metachain
 type ShardData struct {
 	HeaderHash            []byte            `protobuf:"bytes,2,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
 	ShardMiniBlockHeaders []MiniBlockHeader `protobuf:"bytes,3,rep,name=ShardMiniBlockHeaders,proto3" json:"ShardMiniBlockHeaders"`
@@ -287,7 +282,6 @@ func (m *ShardData) GetTxCount() uint32 {
 	return 0
 }
 
-// EpochStartShardData hold the last finalized headers hash and state root hash
 type EpochStartShardData struct {
 	ShardID                 uint32            `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
 	Epoch                   uint32            `protobuf:"varint,9,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
@@ -391,7 +385,6 @@ func (m *EpochStartShardData) GetPendingMiniBlockHeaders() []MiniBlockHeader {
 	return nil
 }
 
-// Economics holds the block information for total supply and rewards
 type Economics struct {
 	TotalSupply                      *math_big.Int `protobuf:"bytes,1,opt,name=TotalSupply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalSupply,omitempty"`
 	TotalToDistribute                *math_big.Int `protobuf:"bytes,2,opt,name=TotalToDistribute,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalToDistribute,omitempty"`
@@ -487,16 +480,63 @@ func (m *Economics) GetPrevEpochStartHash() []byte {
 	return nil
 }
 
-// EpochStart holds the block information for end-of-epoch
+type GovernanceConfigChange struct {
+	ActionType      []byte   `protobuf:"bytes,1,opt,name=ActionType,proto3" json:"ActionType,omitempty"`
+	ActionArguments [][]byte `protobuf:"bytes,2,rep,name=ActionArguments,proto3" json:"ActionArguments,omitempty"`
+}
+
+func (m *GovernanceConfigChange) Reset()      { *m = GovernanceConfigChange{} }
+func (*GovernanceConfigChange) ProtoMessage() {}
+func (*GovernanceConfigChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{4}
+}
+func (m *GovernanceConfigChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceConfigChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GovernanceConfigChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceConfigChange.Merge(m, src)
+}
+func (m *GovernanceConfigChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceConfigChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceConfigChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceConfigChange proto.InternalMessageInfo
+
+func (m *GovernanceConfigChange) GetActionType() []byte {
+	if m != nil {
+		return m.ActionType
+	}
+	return nil
+}
+
+func (m *GovernanceConfigChange) GetActionArguments() [][]byte {
+	if m != nil {
+		return m.ActionArguments
+	}
+	return nil
+}
+
 type EpochStart struct {
-	LastFinalizedHeaders []EpochStartShardData `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
-	Economics            Economics             `protobuf:"bytes,2,opt,name=Economics,proto3" json:"Economics"`
+	LastFinalizedHeaders    []EpochStartShardData    `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
+	Economics               Economics                `protobuf:"bytes,2,opt,name=Economics,proto3" json:"Economics"`
+	GovernanceConfigChanges []GovernanceConfigChange `protobuf:"bytes,3,rep,name=GovernanceConfigChanges,proto3" json:"GovernanceConfigChanges"`
 }
 
 func (m *EpochStart) Reset()      { *m = EpochStart{} }
 func (*EpochStart) ProtoMessage() {}
 func (*EpochStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{4}
+	return fileDescriptor_87b91ab531130b2b, []int{5}
 }
 func (m *EpochStart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +575,13 @@ func (m *EpochStart) GetEconomics() Economics {
 	return Economics{}
 }
 
-// MetaBlock holds the data that will be saved to the metachain each round
+func (m *EpochStart) GetGovernanceConfigChanges() []GovernanceConfigChange {
+	if m != nil {
+		return m.GovernanceConfigChanges
+	}
+	return nil
+}
+
 type MetaBlock struct {
 	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 	Epoch                  uint32            `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
@@ -567,7 +613,7 @@ type MetaBlock struct {
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
 func (*MetaBlock) ProtoMessage() {}
 func (*MetaBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{5}
+	return fileDescriptor_87b91ab531130b2b, []int{6}
 }
 func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,6 +819,7 @@ func init() {
 	proto.RegisterType((*ShardData)(nil), "proto.ShardData")
 	proto.RegisterType((*EpochStartShardData)(nil), "proto.EpochStartShardData")
 	proto.RegisterType((*Economics)(nil), "proto.Economics")
+	proto.RegisterType((*GovernanceConfigChange)(nil), "proto.GovernanceConfigChange")
 	proto.RegisterType((*EpochStart)(nil), "proto.EpochStart")
 	proto.RegisterType((*MetaBlock)(nil), "proto.MetaBlock")
 }
@@ -780,86 +827,90 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x17, 0x2d, 0xcb, 0xb6, 0x46, 0x92, 0x2d, 0x6f, 0x1c, 0x87, 0xcf, 0x78, 0x8f, 0x11, 0x84,
+	0x77, 0x50, 0x0b, 0xc4, 0x6e, 0xdd, 0xa0, 0x3d, 0xf4, 0x50, 0xf8, 0x6f, 0xa3, 0x26, 0x31, 0x04,
+	0xca, 0xf5, 0xa1, 0x40, 0x0f, 0x2b, 0x72, 0x4c, 0x2d, 0x4c, 0xed, 0xaa, 0xe4, 0xd2, 0xae, 0x0b,
+	0x14, 0xe8, 0x47, 0x68, 0xbf, 0x43, 0x0f, 0x41, 0xfb, 0x45, 0x72, 0xcc, 0x31, 0xa7, 0xa6, 0x51,
+	0x2e, 0x3d, 0xa6, 0x40, 0x81, 0x5e, 0x0b, 0x2e, 0x49, 0x91, 0xa6, 0xe9, 0x26, 0x07, 0xe5, 0x24,
+	0xcd, 0x6f, 0xfe, 0x61, 0x67, 0x67, 0x66, 0x7f, 0x84, 0x95, 0x11, 0x4a, 0xba, 0xeb, 0x0a, 0xeb,
+	0x6c, 0x73, 0xec, 0x09, 0x29, 0x48, 0x45, 0xfd, 0x6c, 0xdc, 0x73, 0x98, 0x1c, 0x06, 0x83, 0x4d,
+	0x4b, 0x8c, 0xb6, 0x1c, 0xe1, 0x88, 0x2d, 0x05, 0x0f, 0x82, 0x53, 0x25, 0x29, 0x41, 0xfd, 0x8b,
+	0xbc, 0x36, 0x6a, 0x83, 0x34, 0x44, 0xfb, 0x2f, 0x0d, 0x96, 0x7a, 0x88, 0xde, 0x3e, 0x95, 0x94,
+	0xe8, 0xb0, 0xb8, 0x63, 0xdb, 0x1e, 0xfa, 0xbe, 0xae, 0xb5, 0xb4, 0x4e, 0xdd, 0x4c, 0x44, 0xf2,
+	0x5f, 0xa8, 0xf6, 0x82, 0x81, 0xcb, 0xac, 0x87, 0x78, 0xa9, 0xcf, 0x29, 0x5d, 0x0a, 0x90, 0xf7,
+	0x60, 0x61, 0xc7, 0x92, 0x4c, 0x70, 0xbd, 0xdc, 0xd2, 0x3a, 0xcb, 0xdb, 0xab, 0x51, 0xf0, 0xcd,
+	0x30, 0x70, 0xa4, 0x30, 0x63, 0x83, 0x30, 0xd0, 0x31, 0x1b, 0x61, 0x5f, 0xd2, 0xd1, 0x58, 0x9f,
+	0x6f, 0x69, 0x9d, 0x79, 0x33, 0x05, 0x88, 0x03, 0xb5, 0x13, 0xea, 0x06, 0xb8, 0x37, 0xa4, 0xdc,
+	0x41, 0xbd, 0x12, 0x26, 0xda, 0x3d, 0xf8, 0xe5, 0xc5, 0xdd, 0x9d, 0x11, 0x95, 0xc3, 0xad, 0x01,
+	0x73, 0x36, 0xbb, 0x5c, 0x7e, 0x9a, 0x39, 0xef, 0x81, 0xeb, 0x09, 0x6e, 0x1f, 0xa1, 0xbc, 0x10,
+	0xde, 0xd9, 0x16, 0x2a, 0xe9, 0x9e, 0x23, 0xb6, 0x6c, 0x2a, 0xe9, 0xe6, 0x2e, 0x73, 0xba, 0x5c,
+	0xee, 0x51, 0x5f, 0xa2, 0x67, 0x66, 0x23, 0xb7, 0x7f, 0xad, 0x40, 0xb5, 0x3f, 0xa4, 0x9e, 0xad,
+	0xce, 0x6d, 0x00, 0x3c, 0x40, 0x6a, 0xa3, 0xf7, 0x80, 0xfa, 0xc3, 0xf8, 0x78, 0x19, 0x84, 0x98,
+	0x70, 0x5b, 0x19, 0x3f, 0x66, 0x9c, 0xa9, 0xfa, 0x47, 0x3a, 0x5f, 0x2f, 0xb7, 0xca, 0x9d, 0xda,
+	0xf6, 0x7a, 0x7c, 0xdc, 0x9c, 0x7a, 0x77, 0xfe, 0xe9, 0x6f, 0x77, 0x4b, 0x66, 0xb1, 0x2b, 0x69,
+	0x43, 0xbd, 0xe7, 0xe1, 0xb9, 0x49, 0xb9, 0xdd, 0x47, 0xb4, 0x55, 0x2d, 0xea, 0xe6, 0x15, 0x8c,
+	0xfc, 0x1f, 0x1a, 0xbd, 0x60, 0xf0, 0x10, 0x2f, 0xfd, 0x5d, 0x26, 0x47, 0x74, 0x1c, 0x15, 0xc4,
+	0xbc, 0x0a, 0x86, 0x25, 0xed, 0x33, 0x87, 0x53, 0x19, 0x78, 0xa8, 0x2f, 0x44, 0x77, 0x33, 0x05,
+	0xc8, 0x1a, 0x54, 0x4c, 0x11, 0x70, 0x5b, 0x5f, 0x52, 0xc5, 0x8e, 0x04, 0xb2, 0x01, 0x4b, 0x61,
+	0x26, 0x75, 0xde, 0xaa, 0x72, 0x99, 0xca, 0xa1, 0xc7, 0x91, 0xe0, 0x16, 0xea, 0x10, 0x79, 0x28,
+	0x81, 0x08, 0x58, 0xd9, 0xb1, 0xac, 0x60, 0x14, 0xb8, 0x54, 0xa2, 0x7d, 0x88, 0xe8, 0xeb, 0xf5,
+	0x59, 0x5e, 0x4f, 0x3e, 0x3a, 0x39, 0x83, 0xc6, 0x3e, 0x9e, 0xa3, 0x2b, 0xc6, 0xe8, 0xa9, 0x74,
+	0xcb, 0xb3, 0x4c, 0x77, 0x35, 0x36, 0xd9, 0x86, 0xb5, 0xa3, 0x60, 0xd4, 0x43, 0x6e, 0x33, 0xee,
+	0x4c, 0xef, 0xca, 0xd7, 0x6b, 0x2d, 0xad, 0xd3, 0x30, 0x0b, 0x75, 0xe4, 0x3e, 0xdc, 0x7e, 0x44,
+	0x7d, 0xd9, 0xe5, 0x96, 0x1b, 0xd8, 0x68, 0x3f, 0x46, 0x49, 0xa3, 0xba, 0x35, 0x54, 0xdd, 0x8a,
+	0x95, 0xe1, 0x8c, 0xa9, 0x86, 0xe8, 0xee, 0xab, 0x19, 0x6b, 0x98, 0x89, 0x18, 0x6a, 0x8e, 0xbf,
+	0xdd, 0x13, 0x01, 0x97, 0xfa, 0x62, 0xa4, 0x89, 0xc5, 0xf6, 0x9f, 0x73, 0x70, 0xeb, 0x60, 0x2c,
+	0xac, 0x61, 0x5f, 0x52, 0x4f, 0xa6, 0x7d, 0x7b, 0x73, 0xac, 0x35, 0xa8, 0x28, 0x07, 0x75, 0xb9,
+	0x0d, 0x33, 0x12, 0xd2, 0x5e, 0x58, 0xcc, 0xf6, 0xc2, 0xf4, 0xbe, 0x97, 0xb2, 0xf7, 0xfd, 0xa6,
+	0x99, 0xd8, 0x80, 0x25, 0x53, 0x08, 0xa9, 0xb4, 0xe5, 0xa8, 0x83, 0x12, 0x39, 0xac, 0xcc, 0x21,
+	0xf3, 0x7c, 0x99, 0xd4, 0x2c, 0x59, 0x5b, 0x71, 0x93, 0x17, 0x2b, 0x93, 0x7a, 0x1e, 0x32, 0xce,
+	0xfc, 0x21, 0xda, 0x53, 0x45, 0xdc, 0xf5, 0xc5, 0x4a, 0x72, 0x02, 0x77, 0xf2, 0x57, 0x93, 0x4c,
+	0xe7, 0xc2, 0x5b, 0x4c, 0xe7, 0x4d, 0xce, 0xed, 0x27, 0x0b, 0x50, 0x3d, 0xb0, 0x04, 0x17, 0x23,
+	0x66, 0xf9, 0xe1, 0x62, 0x3a, 0x16, 0x92, 0xba, 0xfd, 0x60, 0x3c, 0x76, 0x2f, 0x75, 0x6d, 0x96,
+	0xad, 0x98, 0x8d, 0x4c, 0x7c, 0x58, 0x55, 0xe2, 0xb1, 0xd8, 0x67, 0xbe, 0xf4, 0xd8, 0x20, 0x90,
+	0xa8, 0xcf, 0xcd, 0x32, 0xdd, 0xf5, 0xf8, 0xe4, 0x1b, 0x68, 0x2a, 0xf0, 0x08, 0x2f, 0xdc, 0xcb,
+	0xc7, 0x8c, 0x4b, 0xb4, 0xf5, 0xf2, 0x2c, 0x73, 0x5e, 0x0b, 0x1f, 0xae, 0x13, 0x13, 0x2f, 0xa8,
+	0x67, 0xfb, 0x3d, 0xf4, 0x32, 0xcd, 0x31, 0xb3, 0x75, 0x92, 0x8b, 0x4e, 0x7e, 0xd2, 0xa0, 0x15,
+	0x63, 0x87, 0xc2, 0xeb, 0x85, 0x2d, 0x61, 0x09, 0xb7, 0x1f, 0xf8, 0x92, 0x32, 0x4e, 0x07, 0xcc,
+	0x65, 0xf2, 0x72, 0xb6, 0x0f, 0xce, 0x1b, 0xd3, 0x11, 0x0b, 0xaa, 0x47, 0xc2, 0xc6, 0x9e, 0xc7,
+	0xac, 0x78, 0x73, 0xcf, 0x2a, 0x77, 0x1a, 0x97, 0x7c, 0x00, 0xb7, 0xc2, 0xd5, 0x9e, 0xee, 0x8f,
+	0xec, 0x0a, 0x28, 0x52, 0x91, 0x4d, 0x20, 0x57, 0x61, 0x35, 0xe4, 0x4b, 0x6a, 0x0a, 0x0b, 0x34,
+	0xed, 0x01, 0xac, 0x7f, 0x2e, 0xce, 0xd1, 0xe3, 0x94, 0x5b, 0xb8, 0x27, 0xf8, 0x29, 0x73, 0xa2,
+	0x67, 0x36, 0x5c, 0x22, 0xd1, 0xbb, 0x7f, 0x7c, 0x39, 0xc6, 0x98, 0x53, 0x64, 0x10, 0xd2, 0x81,
+	0x95, 0x48, 0xda, 0xf1, 0x9c, 0x60, 0x84, 0x5c, 0xfa, 0xfa, 0x5c, 0xab, 0xdc, 0xa9, 0x9b, 0x79,
+	0xb8, 0xfd, 0xb7, 0x06, 0x90, 0xa6, 0x25, 0xc7, 0xb0, 0x16, 0xaf, 0x03, 0xea, 0xb2, 0xef, 0xd0,
+	0x4e, 0x46, 0x5e, 0x53, 0x23, 0xbf, 0x11, 0x8f, 0x7c, 0xc1, 0xce, 0x8c, 0xc7, 0xbe, 0xd0, 0x9b,
+	0xdc, 0xcf, 0x8c, 0xbc, 0x1a, 0xba, 0xda, 0x76, 0x33, 0x09, 0x95, 0xe0, 0x71, 0x80, 0xd4, 0x90,
+	0x7c, 0x0d, 0x77, 0x8a, 0x8f, 0x9f, 0xf0, 0x83, 0xff, 0xc5, 0x31, 0x8a, 0xad, 0x92, 0x45, 0x74,
+	0x43, 0x8c, 0xf6, 0x8b, 0x2a, 0x54, 0xd3, 0x75, 0x37, 0x5d, 0xd6, 0x5a, 0x76, 0x59, 0x4f, 0xd7,
+	0xfd, 0x5c, 0xe1, 0xba, 0x2f, 0x67, 0xd7, 0xfd, 0xbf, 0x33, 0xb0, 0xfb, 0x31, 0x2f, 0xea, 0xf2,
+	0x53, 0xa1, 0x57, 0x5a, 0xe5, 0x4c, 0x09, 0xf2, 0x35, 0x4c, 0x0d, 0xc9, 0x87, 0x11, 0x89, 0x54,
+	0x4e, 0xd1, 0xd6, 0x5d, 0xc9, 0x50, 0xc0, 0x8c, 0xcf, 0xd4, 0xec, 0x2a, 0x6b, 0x59, 0xcc, 0xb3,
+	0x96, 0x0e, 0xac, 0x3c, 0x52, 0x97, 0x92, 0xda, 0x44, 0xfd, 0x97, 0x87, 0xaf, 0x73, 0xa4, 0x6a,
+	0x11, 0x47, 0xca, 0xf2, 0x1d, 0xc8, 0xf1, 0x9d, 0x3c, 0x13, 0xab, 0x15, 0x30, 0xb1, 0xf0, 0xb5,
+	0x4b, 0xf4, 0xf5, 0xf8, 0xb5, 0xcb, 0xea, 0x92, 0x97, 0xb0, 0x91, 0x7b, 0x09, 0x3f, 0x86, 0xf5,
+	0x13, 0xea, 0x32, 0x9b, 0x4a, 0xe1, 0xf5, 0x25, 0x95, 0xfe, 0xd4, 0x52, 0xb1, 0x19, 0xf3, 0x06,
+	0x2d, 0x79, 0x00, 0xcd, 0x6b, 0xcf, 0x59, 0xf3, 0x2d, 0x9e, 0xb3, 0x66, 0x11, 0xcf, 0x34, 0xd1,
+	0x42, 0x36, 0x96, 0xbe, 0xca, 0xbb, 0x1a, 0x9d, 0x2e, 0x8b, 0x91, 0x4f, 0xb2, 0xb3, 0xa5, 0x13,
+	0xd5, 0xf8, 0xab, 0xd7, 0x66, 0x28, 0x4e, 0x91, 0x1d, 0x43, 0x1d, 0x16, 0xf7, 0x86, 0x94, 0xf1,
+	0xee, 0xbe, 0x7e, 0x2b, 0xfa, 0x60, 0x88, 0xc5, 0xf0, 0x02, 0xfb, 0xe2, 0x54, 0x5e, 0x50, 0x0f,
+	0x4f, 0xd0, 0xf3, 0xc3, 0x6f, 0x83, 0xb5, 0xe8, 0x02, 0x73, 0x70, 0x11, 0xb1, 0xbc, 0xfd, 0x4e,
+	0x89, 0xe5, 0xf7, 0xb0, 0x9e, 0x83, 0xba, 0x3c, 0x9a, 0x9e, 0xf5, 0x59, 0xe6, 0xbd, 0x21, 0xc9,
+	0x75, 0x5e, 0x7b, 0xe7, 0x1d, 0xf2, 0xda, 0x11, 0x2c, 0xef, 0xe3, 0x79, 0xf6, 0x8c, 0xfa, 0x2c,
+	0xb3, 0xe5, 0x82, 0x67, 0x29, 0xec, 0x7f, 0xae, 0x50, 0x58, 0x35, 0x24, 0xe8, 0xa3, 0x77, 0x8e,
+	0xb6, 0xbe, 0x11, 0x0f, 0x49, 0x2c, 0xbf, 0xff, 0xb3, 0x06, 0x90, 0x7e, 0x2a, 0x92, 0x55, 0x68,
+	0x74, 0xf9, 0x79, 0x38, 0x17, 0x11, 0xd0, 0x2c, 0x91, 0x35, 0x68, 0x86, 0x06, 0x26, 0x3a, 0x21,
+	0x69, 0xa1, 0x0a, 0xd5, 0x42, 0xc3, 0x10, 0xfd, 0x92, 0xfb, 0x92, 0x9e, 0x31, 0xee, 0x34, 0xe7,
+	0xc8, 0x3a, 0x10, 0xb5, 0x71, 0xd0, 0xcb, 0x9a, 0x96, 0xc9, 0x72, 0x94, 0xe1, 0x0b, 0xca, 0x5c,
+	0xb4, 0x9b, 0xf3, 0xa4, 0x09, 0xf5, 0xc8, 0x35, 0x46, 0x2a, 0x64, 0x05, 0x6a, 0x21, 0xd2, 0x77,
+	0x69, 0xc8, 0x2f, 0x9b, 0x0b, 0x09, 0x60, 0x86, 0x8b, 0xf1, 0x0c, 0x9b, 0x8b, 0xbb, 0x9f, 0x3d,
+	0x7b, 0x69, 0x94, 0x9e, 0xbf, 0x34, 0x4a, 0xaf, 0x5f, 0x1a, 0xda, 0x0f, 0x13, 0x43, 0x7b, 0x32,
+	0x31, 0xb4, 0xa7, 0x13, 0x43, 0x7b, 0x36, 0x31, 0xb4, 0xe7, 0x13, 0x43, 0xfb, 0x7d, 0x62, 0x68,
+	0x7f, 0x4c, 0x8c, 0xd2, 0xeb, 0x89, 0xa1, 0xfd, 0xf8, 0xca, 0x28, 0x3d, 0x7b, 0x65, 0x94, 0x9e,
+	0xbf, 0x32, 0x4a, 0x5f, 0x55, 0xd4, 0x17, 0xf7, 0x60, 0x41, 0x4d, 0xd4, 0x47, 0xff, 0x0c, 0x00,
+	0x4a, 0xe0, 0xb8, 0xd6, 0xc8, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -1098,6 +1149,38 @@ func (this *Economics) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GovernanceConfigChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GovernanceConfigChange)
+	if !ok {
+		that2, ok := that.(GovernanceConfigChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ActionType, that1.ActionType) {
+		return false
+	}
+	if len(this.ActionArguments) != len(that1.ActionArguments) {
+		return false
+	}
+	for i := range this.ActionArguments {
+		if !bytes.Equal(this.ActionArguments[i], that1.ActionArguments[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *EpochStart) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1128,6 +1211,14 @@ func (this *EpochStart) Equal(that interface{}) bool {
 	if !this.Economics.Equal(&that1.Economics) {
 		return false
 	}
+	if len(this.GovernanceConfigChanges) != len(that1.GovernanceConfigChanges) {
+		return false
+	}
+	for i := range this.GovernanceConfigChanges {
+		if !this.GovernanceConfigChanges[i].Equal(&that1.GovernanceConfigChanges[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MetaBlock) Equal(that interface{}) bool {
@@ -1337,11 +1428,22 @@ func (this *Economics) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *EpochStart) GoString() string {
+func (this *GovernanceConfigChange) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&block.GovernanceConfigChange{")
+	s = append(s, "ActionType: "+fmt.Sprintf("%#v", this.ActionType)+",\n")
+	s = append(s, "ActionArguments: "+fmt.Sprintf("%#v", this.ActionArguments)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochStart) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
 	s = append(s, "&block.EpochStart{")
 	if this.LastFinalizedHeaders != nil {
 		vs := make([]EpochStartShardData, len(this.LastFinalizedHeaders))
@@ -1351,6 +1453,13 @@ func (this *EpochStart) GoString() string {
 		s = append(s, "LastFinalizedHeaders: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
 	s = append(s, "Economics: "+strings.Replace(this.Economics.GoString(), `&`, ``, 1)+",\n")
+	if this.GovernanceConfigChanges != nil {
+		vs := make([]GovernanceConfigChange, len(this.GovernanceConfigChanges))
+		for i := range vs {
+			vs[i] = this.GovernanceConfigChanges[i]
+		}
+		s = append(s, "GovernanceConfigChanges: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1782,6 +1891,45 @@ func (m *Economics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GovernanceConfigChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceConfigChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceConfigChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionArguments) > 0 {
+		for iNdEx := len(m.ActionArguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActionArguments[iNdEx])
+			copy(dAtA[i:], m.ActionArguments[iNdEx])
+			i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.ActionArguments[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActionType) > 0 {
+		i -= len(m.ActionType)
+		copy(dAtA[i:], m.ActionType)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.ActionType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochStart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1802,6 +1950,20 @@ func (m *EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernanceConfigChanges) > 0 {
+		for iNdEx := len(m.GovernanceConfigChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceConfigChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Economics.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2270,6 +2432,25 @@ func (m *Economics) Size() (n int) {
 	return n
 }
 
+func (m *GovernanceConfigChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionType)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	if len(m.ActionArguments) > 0 {
+		for _, b := range m.ActionArguments {
+			l = len(b)
+			n += 1 + l + sovMetaBlock(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EpochStart) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2284,6 +2465,12 @@ func (m *EpochStart) Size() (n int) {
 	}
 	l = m.Economics.Size()
 	n += 1 + l + sovMetaBlock(uint64(l))
+	if len(m.GovernanceConfigChanges) > 0 {
+		for _, e := range m.GovernanceConfigChanges {
+			l = e.Size()
+			n += 1 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2487,6 +2674,17 @@ func (this *Economics) String() string {
 	}, "")
 	return s
 }
+func (this *GovernanceConfigChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GovernanceConfigChange{`,
+		`ActionType:` + fmt.Sprintf("%v", this.ActionType) + `,`,
+		`ActionArguments:` + fmt.Sprintf("%v", this.ActionArguments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EpochStart) String() string {
 	if this == nil {
 		return "nil"
@@ -2496,9 +2694,15 @@ func (this *EpochStart) String() string {
 		repeatedStringForLastFinalizedHeaders += strings.Replace(strings.Replace(f.String(), "EpochStartShardData", "EpochStartShardData", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForLastFinalizedHeaders += "}"
+	repeatedStringForGovernanceConfigChanges := "[]GovernanceConfigChange{"
+	for _, f := range this.GovernanceConfigChanges {
+		repeatedStringForGovernanceConfigChanges += strings.Replace(strings.Replace(f.String(), "GovernanceConfigChange", "GovernanceConfigChange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGovernanceConfigChanges += "}"
 	s := strings.Join([]string{`&EpochStart{`,
 		`LastFinalizedHeaders:` + repeatedStringForLastFinalizedHeaders + `,`,
 		`Economics:` + strings.Replace(strings.Replace(this.Economics.String(), "Economics", "Economics", 1), `&`, ``, 1) + `,`,
+		`GovernanceConfigChanges:` + repeatedStringForGovernanceConfigChanges + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3837,6 +4041,125 @@ func (m *Economics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GovernanceConfigChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetaBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceConfigChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceConfigChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionType = append(m.ActionType[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActionType == nil {
+				m.ActionType = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionArguments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionArguments = append(m.ActionArguments, make([]byte, postIndex-iNdEx))
+			copy(m.ActionArguments[len(m.ActionArguments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetaBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochStart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3933,6 +4256,40 @@ func (m *EpochStart) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceConfigChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceConfigChanges = append(m.GovernanceConfigChanges, GovernanceConfigChange{})
+			if err := m.GovernanceConfigChanges[len(m.GovernanceConfigChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -166,6 +166,7 @@ type Trie interface {
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
 	GetAllHashes() ([][]byte, error)
+	GetProof(key []byte) ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterPruningBufferingMode()
 	ExitPruningBufferingMode()
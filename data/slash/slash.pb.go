@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slash.proto
+
+package slash
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProofType int32
+
+const (
+	DoubleProposal  ProofType = 0
+	DoubleSignature ProofType = 1
+)
+
+var ProofType_name = map[int32]string{
+	0: "DoubleProposal",
+	1: "DoubleSignature",
+}
+
+var ProofType_value = map[string]int32{
+	"DoubleProposal":  0,
+	"DoubleSignature": 1,
+}
+
+func (ProofType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c4604f407d65f7b, []int{0}
+}
+
+type SignedHeader struct {
+	Header    []byte `protobuf:"bytes,1,opt,name=Header,proto3" json:"header"`
+	Signature []byte `protobuf:"bytes,2,opt,name=Signature,proto3" json:"signature"`
+}
+
+func (m *SignedHeader) Reset()      { *m = SignedHeader{} }
+func (*SignedHeader) ProtoMessage() {}
+func (*SignedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4604f407d65f7b, []int{0}
+}
+func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SignedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedHeader.Merge(m, src)
+}
+func (m *SignedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedHeader proto.InternalMessageInfo
+
+func (m *SignedHeader) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type DoubleSigningProof struct {
+	Type    ProofType     `protobuf:"varint,1,opt,name=Type,proto3,enum=proto.ProofType" json:"type"`
+	PubKey  []byte        `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"pubKey"`
+	ShardID uint32        `protobuf:"varint,3,opt,name=ShardID,proto3" json:"shardID"`
+	Round   uint64        `protobuf:"varint,4,opt,name=Round,proto3" json:"round"`
+	First   *SignedHeader `protobuf:"bytes,5,opt,name=First,proto3" json:"first"`
+	Second  *SignedHeader `protobuf:"bytes,6,opt,name=Second,proto3" json:"second"`
+}
+
+func (m *DoubleSigningProof) Reset()      { *m = DoubleSigningProof{} }
+func (*DoubleSigningProof) ProtoMessage() {}
+func (*DoubleSigningProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4604f407d65f7b, []int{1}
+}
+func (m *DoubleSigningProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSigningProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DoubleSigningProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSigningProof.Merge(m, src)
+}
+func (m *DoubleSigningProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSigningProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSigningProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSigningProof proto.InternalMessageInfo
+
+func (m *DoubleSigningProof) GetType() ProofType {
+	if m != nil {
+		return m.Type
+	}
+	return DoubleProposal
+}
+
+func (m *DoubleSigningProof) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *DoubleSigningProof) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *DoubleSigningProof) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *DoubleSigningProof) GetFirst() *SignedHeader {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *DoubleSigningProof) GetSecond() *SignedHeader {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.ProofType", ProofType_name, ProofType_value)
+	proto.RegisterType((*SignedHeader)(nil), "proto.SignedHeader")
+	proto.RegisterType((*DoubleSigningProof)(nil), "proto.DoubleSigningProof")
+}
+
+func init() { proto.RegisterFile("slash.proto", fileDescriptor_7c4604f407d65f7b) }
+
+var fileDescriptor_7c4604f407d65f7b = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x8f, 0x94, 0x40,
+	0x1c, 0xc5, 0x99, 0x15, 0x38, 0x99, 0xbd, 0x3b, 0x37, 0x73, 0x0d, 0xb1, 0x18, 0xc8, 0x26, 0x26,
+	0x44, 0x23, 0x97, 0x9c, 0x97, 0x58, 0x9a, 0x90, 0x8b, 0xd1, 0xd8, 0x6c, 0x66, 0xad, 0x6c, 0x0c,
+	0x1c, 0xb3, 0x40, 0xb2, 0x32, 0x64, 0x80, 0x62, 0x3b, 0x3f, 0x82, 0xdf, 0xc0, 0xd6, 0x8f, 0x62,
+	0xb9, 0xe5, 0x56, 0xc4, 0x9d, 0x6d, 0x0c, 0xd5, 0x7d, 0x04, 0xc3, 0x7f, 0x58, 0xd7, 0xc6, 0x8a,
+	0x99, 0xdf, 0x7b, 0x2f, 0x3c, 0x5e, 0xc0, 0xd3, 0x7a, 0x1d, 0xd7, 0x79, 0x58, 0x49, 0xd1, 0x08,
+	0x62, 0xc1, 0xe3, 0xe9, 0xcb, 0xac, 0x68, 0xf2, 0x36, 0x09, 0xef, 0xc5, 0x97, 0xeb, 0x4c, 0x64,
+	0xe2, 0x1a, 0x70, 0xd2, 0xae, 0xe0, 0x06, 0x17, 0x38, 0xe9, 0xd4, 0xfc, 0x33, 0x3e, 0x5f, 0x16,
+	0x59, 0xc9, 0xd3, 0x77, 0x3c, 0x4e, 0xb9, 0x24, 0x73, 0x6c, 0xeb, 0x93, 0x8b, 0x7c, 0x14, 0x9c,
+	0x47, 0xb8, 0xef, 0x3c, 0x3b, 0x07, 0xc2, 0x46, 0x85, 0xbc, 0xc0, 0xce, 0x90, 0x89, 0x9b, 0x56,
+	0x72, 0x77, 0x02, 0xb6, 0x8b, 0xbe, 0xf3, 0x9c, 0xfa, 0x08, 0xd9, 0x49, 0x9f, 0x7f, 0x9f, 0x60,
+	0x72, 0x27, 0xda, 0x64, 0xcd, 0x07, 0x56, 0x94, 0xd9, 0x42, 0x0a, 0xb1, 0x22, 0x21, 0x36, 0x3f,
+	0x6e, 0x2a, 0x0e, 0x6f, 0xb9, 0xbc, 0x99, 0xe9, 0x36, 0x21, 0x68, 0x03, 0x8f, 0x1e, 0xf7, 0x9d,
+	0x67, 0x36, 0x9b, 0x8a, 0x33, 0xf0, 0x0d, 0xbd, 0x16, 0x6d, 0xf2, 0x81, 0x6f, 0xdc, 0xc9, 0xa9,
+	0x57, 0x05, 0x84, 0x8d, 0x0a, 0x79, 0x86, 0xcf, 0x96, 0x79, 0x2c, 0xd3, 0xf7, 0x77, 0xee, 0x23,
+	0x1f, 0x05, 0x17, 0xd1, 0xb4, 0xef, 0xbc, 0xb3, 0x5a, 0x23, 0x76, 0xd4, 0x88, 0x87, 0x2d, 0x26,
+	0xda, 0x32, 0x75, 0x4d, 0x1f, 0x05, 0x66, 0xe4, 0xf4, 0x9d, 0x67, 0xc9, 0x01, 0x30, 0xcd, 0xc9,
+	0x2d, 0xb6, 0xde, 0x16, 0xb2, 0x6e, 0x5c, 0xcb, 0x47, 0xc1, 0xf4, 0xe6, 0x6a, 0x2c, 0xf7, 0xef,
+	0x4e, 0x3a, 0xb5, 0x1a, 0x5c, 0x4c, 0x9b, 0xc9, 0x6b, 0x6c, 0x2f, 0xf9, 0xbd, 0x28, 0x53, 0xd7,
+	0xfe, 0x7f, 0x0c, 0x6a, 0xd7, 0x60, 0x63, 0xa3, 0xfd, 0xf9, 0x2d, 0x76, 0xfe, 0x7e, 0x37, 0x21,
+	0xf8, 0x52, 0xaf, 0xb5, 0x90, 0xa2, 0x12, 0x75, 0xbc, 0x9e, 0x19, 0xe4, 0x0a, 0x3f, 0x39, 0x2d,
+	0x08, 0xab, 0xce, 0x50, 0xf4, 0x66, 0xbb, 0xa7, 0xc6, 0x6e, 0x4f, 0x8d, 0x87, 0x3d, 0x45, 0x5f,
+	0x15, 0x45, 0x3f, 0x14, 0x45, 0x3f, 0x15, 0x45, 0x5b, 0x45, 0xd1, 0x4e, 0x51, 0xf4, 0x4b, 0x51,
+	0xf4, 0x5b, 0x51, 0xe3, 0x41, 0x51, 0xf4, 0xed, 0x40, 0x8d, 0xed, 0x81, 0x1a, 0xbb, 0x03, 0x35,
+	0x3e, 0x59, 0xf0, 0xd7, 0x24, 0x36, 0xd4, 0x7b, 0xf5, 0x67, 0x00, 0x2c, 0x0a, 0x84, 0x15, 0x45,
+	0x02, 0x00, 0x00,
+}
+
+func (x ProofType) String() string {
+	s, ok := ProofType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SignedHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignedHeader)
+	if !ok {
+		that2, ok := that.(SignedHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Header, that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *DoubleSigningProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DoubleSigningProof)
+	if !ok {
+		that2, ok := that.(DoubleSigningProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !this.First.Equal(that1.First) {
+		return false
+	}
+	if !this.Second.Equal(that1.Second) {
+		return false
+	}
+	return true
+}
+func (this *SignedHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&slash.SignedHeader{")
+	s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DoubleSigningProof) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&slash.DoubleSigningProof{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	if this.First != nil {
+		s = append(s, "First: "+fmt.Sprintf("%#v", this.First)+",\n")
+	}
+	if this.Second != nil {
+		s = append(s, "Second: "+fmt.Sprintf("%#v", this.Second)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSlash(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SignedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSigningProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSigningProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSigningProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Second != nil {
+		{
+			size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlash(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.First != nil {
+		{
+			size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlash(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Round != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	return n
+}
+
+func (m *DoubleSigningProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSlash(uint64(m.Type))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovSlash(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovSlash(uint64(m.Round))
+	}
+	if m.First != nil {
+		l = m.First.Size()
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	if m.Second != nil {
+		l = m.Second.Size()
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	return n
+}
+
+func sovSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlash(x uint64) (n int) {
+	return sovSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SignedHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignedHeader{`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DoubleSigningProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DoubleSigningProof{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`First:` + strings.Replace(this.First.String(), "SignedHeader", "SignedHeader", 1) + `,`,
+		`Second:` + strings.Replace(this.Second.String(), "SignedHeader", "SignedHeader", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSlash(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SignedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoubleSigningProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleSigningProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleSigningProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.First == nil {
+				m.First = &SignedHeader{}
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Second == nil {
+				m.Second = &SignedHeader{}
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlash = fmt.Errorf("proto: unexpected end of group")
+)
@@ -803,6 +803,14 @@ func (adb *AccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (m
 	return allTries, nil
 }
 
+// GetTrie returns a new trie instance, recreated from the given root hash, that does not affect the current state
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie.Recreate(rootHash)
+}
+
 // Journalize adds a new object to entries list.
 func (adb *AccountsDB) journalize(entry JournalEntry) {
 	if check.IfNil(entry) {
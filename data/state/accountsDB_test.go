@@ -998,6 +998,24 @@ func TestAccountsDB_GetAllLeaves(t *testing.T) {
 	assert.True(t, getAllLeavesCalled)
 }
 
+func TestAccountsDB_GetTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedTrie := &mock.TrieStub{}
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return recreatedTrie, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	tr, err := adb.GetTrie(rootHash)
+	assert.Nil(t, err)
+	assert.True(t, tr == recreatedTrie)
+}
+
 func getTestAccountsDbAndTrie(marshalizer marshal.Marshalizer, hsh hashing.Hasher) (*state.AccountsDB, data.Trie) {
 	accFactory := factory.NewAccountCreator()
 	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
@@ -115,3 +115,7 @@ var ErrNilMapOfHashes = errors.New("nil map of hashes")
 
 // ErrInvalidRootHash signals that the provided root hash is invalid
 var ErrInvalidRootHash = errors.New("invalid root hash")
+
+// ErrStateNotAvailable signals that the state for the requested root hash can not be recreated, most likely because
+// it was pruned
+var ErrStateNotAvailable = errors.New("the state for the requested root hash is not available, it might have been pruned")
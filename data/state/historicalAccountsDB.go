@@ -0,0 +1,215 @@
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var _ AccountsAdapter = (*HistoricalAccountsDB)(nil)
+
+// NewAccountsDBFromRootHash creates a new AccountsDB instance over the state identified by the given root hash.
+// The returned instance should only be used for reading, as the changes made on it are never committed
+func NewAccountsDBFromRootHash(
+	accounts AccountsAdapter,
+	rootHash []byte,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	accountFactory AccountFactory,
+) (*AccountsDB, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	tr, err := accounts.GetTrie(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w, root hash %s: %s", ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+	if check.IfNil(tr) {
+		return nil, ErrNilTrie
+	}
+
+	adb, err := NewAccountsDB(tr, hasher, marshalizer, accountFactory)
+	if err != nil {
+		return nil, err
+	}
+	// reverting to the empty journal should restore the state from which the instance was created
+	adb.lastRootHash = rootHash
+
+	return adb, nil
+}
+
+// HistoricalAccountsDB wraps an accounts adapter and is able to temporarily serve, instead of it, the state
+// recreated from an older root hash. It should be used only by the components that read the state, such as the
+// smart contract query service
+type HistoricalAccountsDB struct {
+	accounts       AccountsAdapter
+	hasher         hashing.Hasher
+	marshalizer    marshal.Marshalizer
+	accountFactory AccountFactory
+
+	mutHistorical      sync.RWMutex
+	historicalAccounts AccountsAdapter
+}
+
+// NewHistoricalAccountsDB creates a new HistoricalAccountsDB instance
+func NewHistoricalAccountsDB(
+	accounts AccountsAdapter,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	accountFactory AccountFactory,
+) (*HistoricalAccountsDB, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+
+	return &HistoricalAccountsDB{
+		accounts:       accounts,
+		hasher:         hasher,
+		marshalizer:    marshalizer,
+		accountFactory: accountFactory,
+	}, nil
+}
+
+// SelectRootHash makes all the subsequent calls use the state identified by the given root hash
+func (hadb *HistoricalAccountsDB) SelectRootHash(rootHash []byte) error {
+	historicalAccounts, err := NewAccountsDBFromRootHash(hadb.accounts, rootHash, hadb.hasher, hadb.marshalizer, hadb.accountFactory)
+	if err != nil {
+		return err
+	}
+
+	hadb.mutHistorical.Lock()
+	hadb.historicalAccounts = historicalAccounts
+	hadb.mutHistorical.Unlock()
+
+	return nil
+}
+
+// ResetRootHash makes all the subsequent calls use the wrapped accounts adapter
+func (hadb *HistoricalAccountsDB) ResetRootHash() {
+	hadb.mutHistorical.Lock()
+	hadb.historicalAccounts = nil
+	hadb.mutHistorical.Unlock()
+}
+
+func (hadb *HistoricalAccountsDB) getActiveAccounts() AccountsAdapter {
+	hadb.mutHistorical.RLock()
+	defer hadb.mutHistorical.RUnlock()
+
+	if check.IfNil(hadb.historicalAccounts) {
+		return hadb.accounts
+	}
+
+	return hadb.historicalAccounts
+}
+
+// GetExistingAccount returns the existing account from the active state
+func (hadb *HistoricalAccountsDB) GetExistingAccount(address []byte) (AccountHandler, error) {
+	return hadb.getActiveAccounts().GetExistingAccount(address)
+}
+
+// LoadAccount loads the account from the active state
+func (hadb *HistoricalAccountsDB) LoadAccount(address []byte) (AccountHandler, error) {
+	return hadb.getActiveAccounts().LoadAccount(address)
+}
+
+// SaveAccount saves the account in the active state
+func (hadb *HistoricalAccountsDB) SaveAccount(account AccountHandler) error {
+	return hadb.getActiveAccounts().SaveAccount(account)
+}
+
+// RemoveAccount removes the account from the active state
+func (hadb *HistoricalAccountsDB) RemoveAccount(address []byte) error {
+	return hadb.getActiveAccounts().RemoveAccount(address)
+}
+
+// Commit commits the active state
+func (hadb *HistoricalAccountsDB) Commit() ([]byte, error) {
+	return hadb.getActiveAccounts().Commit()
+}
+
+// JournalLen returns the journal length of the active state
+func (hadb *HistoricalAccountsDB) JournalLen() int {
+	return hadb.getActiveAccounts().JournalLen()
+}
+
+// RevertToSnapshot reverts the active state to the given snapshot
+func (hadb *HistoricalAccountsDB) RevertToSnapshot(snapshot int) error {
+	return hadb.getActiveAccounts().RevertToSnapshot(snapshot)
+}
+
+// GetNumCheckpoints returns the number of checkpoints of the wrapped accounts adapter
+func (hadb *HistoricalAccountsDB) GetNumCheckpoints() uint32 {
+	return hadb.accounts.GetNumCheckpoints()
+}
+
+// RootHash returns the root hash of the active state
+func (hadb *HistoricalAccountsDB) RootHash() ([]byte, error) {
+	return hadb.getActiveAccounts().RootHash()
+}
+
+// RecreateTrie recreates the trie of the active state
+func (hadb *HistoricalAccountsDB) RecreateTrie(rootHash []byte) error {
+	return hadb.getActiveAccounts().RecreateTrie(rootHash)
+}
+
+// PruneTrie calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) PruneTrie(rootHash []byte, identifier data.TriePruningIdentifier) {
+	hadb.accounts.PruneTrie(rootHash, identifier)
+}
+
+// CancelPrune calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) CancelPrune(rootHash []byte, identifier data.TriePruningIdentifier) {
+	hadb.accounts.CancelPrune(rootHash, identifier)
+}
+
+// SnapshotState calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) SnapshotState(rootHash []byte, ctx context.Context) {
+	hadb.accounts.SnapshotState(rootHash, ctx)
+}
+
+// SetStateCheckpoint calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) SetStateCheckpoint(rootHash []byte, ctx context.Context) {
+	hadb.accounts.SetStateCheckpoint(rootHash, ctx)
+}
+
+// IsPruningEnabled calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) IsPruningEnabled() bool {
+	return hadb.accounts.IsPruningEnabled()
+}
+
+// GetAllLeaves calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
+	return hadb.accounts.GetAllLeaves(rootHash, ctx)
+}
+
+// RecreateAllTries calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error) {
+	return hadb.accounts.RecreateAllTries(rootHash, ctx)
+}
+
+// GetTrie calls the wrapped accounts adapter's function with the same name
+func (hadb *HistoricalAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return hadb.accounts.GetTrie(rootHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hadb *HistoricalAccountsDB) IsInterfaceNil() bool {
+	return hadb == nil
+}
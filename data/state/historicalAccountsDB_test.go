@@ -0,0 +1,122 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistoricalAccountsDB_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(nil, mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilAccountsAdapter, err)
+}
+
+func TestNewHistoricalAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(generateAccountDBFromTrie(&mock.TrieStub{}), nil, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilHasher, err)
+}
+
+func TestNewHistoricalAccountsDB_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(generateAccountDBFromTrie(&mock.TrieStub{}), mock.HasherMock{}, nil, factory.NewAccountCreator())
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilMarshalizer, err)
+}
+
+func TestNewHistoricalAccountsDB_NilAccountFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hadb, err := state.NewHistoricalAccountsDB(generateAccountDBFromTrie(&mock.TrieStub{}), mock.HasherMock{}, &mock.MarshalizerMock{}, nil)
+	assert.Nil(t, hadb)
+	assert.Equal(t, state.ErrNilAccountFactory, err)
+}
+
+func TestHistoricalAccountsDB_SelectRootHashStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := generateAccountDBFromTrie(&mock.TrieStub{
+		RecreateCalled: func(_ []byte) (data.Trie, error) {
+			return nil, errors.New("trie not found")
+		},
+	})
+	hadb, _ := state.NewHistoricalAccountsDB(accounts, mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+
+	err := hadb.SelectRootHash([]byte("root hash"))
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+}
+
+func TestHistoricalAccountsDB_SelectAndResetRootHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	address := make([]byte, 32)
+
+	saveBalance := func(value int64) []byte {
+		account, _ := adb.LoadAccount(address)
+		userAccount := account.(state.UserAccountHandler)
+		_ = userAccount.AddToBalance(big.NewInt(value))
+		_ = adb.SaveAccount(userAccount)
+		rootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		return rootHash
+	}
+	getBalance := func(accounts state.AccountsAdapter) *big.Int {
+		account, err := accounts.GetExistingAccount(address)
+		require.Nil(t, err)
+
+		return account.(state.UserAccountHandler).GetBalance()
+	}
+
+	oldRootHash := saveBalance(10)
+	_ = saveBalance(20)
+
+	hadb, _ := state.NewHistoricalAccountsDB(adb, mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+	assert.Equal(t, big.NewInt(30), getBalance(hadb))
+
+	err := hadb.SelectRootHash(oldRootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), getBalance(hadb))
+
+	hadb.ResetRootHash()
+	assert.Equal(t, big.NewInt(30), getBalance(hadb))
+}
+
+func TestNewAccountsDBFromRootHash_RevertToEmptyJournalShouldRestoreTheSelectedState(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	address := make([]byte, 32)
+	account, _ := adb.LoadAccount(address)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(account)
+	rootHash, _ := adb.Commit()
+
+	accountsFromRootHash, err := state.NewAccountsDBFromRootHash(adb, rootHash, mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	account, _ = accountsFromRootHash.LoadAccount(address)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = accountsFromRootHash.SaveAccount(account)
+
+	err = accountsFromRootHash.RevertToSnapshot(0)
+	assert.Nil(t, err)
+
+	account, err = accountsFromRootHash.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), account.(state.UserAccountHandler).GetBalance())
+}
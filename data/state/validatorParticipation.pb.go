@@ -0,0 +1,932 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: validatorParticipation.proto
+
+package state
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorParticipation struct {
+	Epoch               uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"epoch"`
+	ShardId             uint32 `protobuf:"varint,2,opt,name=ShardId,proto3" json:"shardId"`
+	NumSelected         uint32 `protobuf:"varint,3,opt,name=NumSelected,proto3" json:"numSelected"`
+	NumProposed         uint32 `protobuf:"varint,4,opt,name=NumProposed,proto3" json:"numProposed"`
+	NumMissedProposals  uint32 `protobuf:"varint,5,opt,name=NumMissedProposals,proto3" json:"numMissedProposals"`
+	NumSignatures       uint32 `protobuf:"varint,6,opt,name=NumSignatures,proto3" json:"numSignatures"`
+	NumMissedSignatures uint32 `protobuf:"varint,7,opt,name=NumMissedSignatures,proto3" json:"numMissedSignatures"`
+	RatingDelta         int64  `protobuf:"varint,8,opt,name=RatingDelta,proto3" json:"ratingDelta"`
+	TempRating          uint32 `protobuf:"varint,9,opt,name=TempRating,proto3" json:"tempRating"`
+}
+
+func (m *ValidatorParticipation) Reset()      { *m = ValidatorParticipation{} }
+func (*ValidatorParticipation) ProtoMessage() {}
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4caf6e411e9652, []int{0}
+}
+func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipation.Merge(m, src)
+}
+func (m *ValidatorParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipation proto.InternalMessageInfo
+
+func (m *ValidatorParticipation) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetNumSelected() uint32 {
+	if m != nil {
+		return m.NumSelected
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetNumProposed() uint32 {
+	if m != nil {
+		return m.NumProposed
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetNumMissedProposals() uint32 {
+	if m != nil {
+		return m.NumMissedProposals
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetNumSignatures() uint32 {
+	if m != nil {
+		return m.NumSignatures
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetNumMissedSignatures() uint32 {
+	if m != nil {
+		return m.NumMissedSignatures
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetRatingDelta() int64 {
+	if m != nil {
+		return m.RatingDelta
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetTempRating() uint32 {
+	if m != nil {
+		return m.TempRating
+	}
+	return 0
+}
+
+type ValidatorParticipationHistory struct {
+	Epochs []*ValidatorParticipation `protobuf:"bytes,1,rep,name=Epochs,proto3" json:"epochs"`
+}
+
+func (m *ValidatorParticipationHistory) Reset()      { *m = ValidatorParticipationHistory{} }
+func (*ValidatorParticipationHistory) ProtoMessage() {}
+func (*ValidatorParticipationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4caf6e411e9652, []int{1}
+}
+func (m *ValidatorParticipationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorParticipationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationHistory.Merge(m, src)
+}
+func (m *ValidatorParticipationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipationHistory proto.InternalMessageInfo
+
+func (m *ValidatorParticipationHistory) GetEpochs() []*ValidatorParticipation {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ValidatorParticipation)(nil), "proto.ValidatorParticipation")
+	proto.RegisterType((*ValidatorParticipationHistory)(nil), "proto.ValidatorParticipationHistory")
+}
+
+func init() { proto.RegisterFile("validatorParticipation.proto", fileDescriptor_be4caf6e411e9652) }
+
+var fileDescriptor_be4caf6e411e9652 = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0x87, 0x63, 0x4a, 0x5a, 0xce, 0x55, 0x41, 0xf8, 0xa4, 0xc3, 0x42, 0x9c, 0x5d, 0x9d, 0x84,
+	0xd4, 0x85, 0x9e, 0x80, 0x81, 0x11, 0x51, 0x01, 0xe2, 0x06, 0x4e, 0x27, 0x1f, 0x62, 0x60, 0x73,
+	0x1b, 0x93, 0x5a, 0x6a, 0xe2, 0x28, 0x76, 0x90, 0xd8, 0xf8, 0x08, 0x7c, 0x0c, 0x3e, 0x0a, 0x63,
+	0xc7, 0x4e, 0x16, 0x75, 0x17, 0xe4, 0xe9, 0x16, 0x76, 0x54, 0xe7, 0xfe, 0xe4, 0xd4, 0x4c, 0x89,
+	0x9f, 0xf7, 0xf7, 0xbc, 0xb6, 0xe5, 0x17, 0x3e, 0xf9, 0xc6, 0x17, 0x32, 0xe1, 0x46, 0x95, 0x67,
+	0xbc, 0x34, 0x72, 0x26, 0x0b, 0x6e, 0xa4, 0xca, 0xc7, 0x45, 0xa9, 0x8c, 0x42, 0x71, 0xf8, 0x3c,
+	0x7e, 0x96, 0x4a, 0x33, 0xaf, 0xa6, 0xe3, 0x99, 0xca, 0x8e, 0x53, 0x95, 0xaa, 0xe3, 0x80, 0xa7,
+	0xd5, 0xd7, 0xb0, 0x0a, 0x8b, 0xf0, 0x57, 0x5b, 0x47, 0xff, 0x3a, 0xf0, 0xe0, 0x73, 0x6b, 0x5b,
+	0x44, 0x61, 0xfc, 0xae, 0x50, 0xb3, 0x39, 0x06, 0x43, 0x30, 0x1a, 0x4c, 0xf6, 0xbc, 0xa5, 0xb1,
+	0xd8, 0x02, 0x56, 0x73, 0xf4, 0x14, 0xf6, 0xce, 0xe7, 0xbc, 0x4c, 0x4e, 0x12, 0x7c, 0x27, 0x44,
+	0xfa, 0xde, 0xd2, 0x9e, 0xae, 0x11, 0xbb, 0xaa, 0xa1, 0xe7, 0xb0, 0x7f, 0x5a, 0x65, 0xe7, 0x62,
+	0x21, 0x66, 0x46, 0x24, 0xb8, 0x13, 0xa2, 0x0f, 0xbc, 0xa5, 0xfd, 0xfc, 0x06, 0xb3, 0x66, 0xe6,
+	0x52, 0x39, 0x2b, 0x55, 0xa1, 0xb4, 0x48, 0xf0, 0xdd, 0x5b, 0xca, 0x15, 0x66, 0xcd, 0x0c, 0x7a,
+	0x0f, 0xd1, 0x69, 0x95, 0x7d, 0x94, 0x5a, 0x8b, 0xa4, 0x86, 0x7c, 0xa1, 0x71, 0x1c, 0xcc, 0x03,
+	0x6f, 0x29, 0xca, 0x77, 0xaa, 0xac, 0xc5, 0x40, 0xaf, 0xe0, 0x60, 0x7b, 0x12, 0x99, 0xe6, 0xdc,
+	0x54, 0xa5, 0xd0, 0xb8, 0x1b, 0x5a, 0x3c, 0xf4, 0x96, 0x0e, 0xf2, 0x66, 0x81, 0xdd, 0xce, 0xa1,
+	0x13, 0xb8, 0x7f, 0xdd, 0xae, 0xa1, 0xf7, 0x82, 0xfe, 0xc8, 0x5b, 0xba, 0x9f, 0xef, 0x96, 0x59,
+	0x9b, 0xb3, 0xbd, 0x3e, 0xe3, 0x46, 0xe6, 0xe9, 0x5b, 0xb1, 0x30, 0x1c, 0xdf, 0x1b, 0x82, 0x51,
+	0xa7, 0xbe, 0x7e, 0x79, 0x83, 0x59, 0x33, 0x83, 0xc6, 0x10, 0x7e, 0x12, 0x59, 0x51, 0x23, 0xbc,
+	0x17, 0x36, 0xbd, 0xef, 0x2d, 0x85, 0xe6, 0x9a, 0xb2, 0x46, 0xe2, 0x68, 0x0a, 0x0f, 0xdb, 0x9f,
+	0xfd, 0x83, 0xd4, 0x46, 0x95, 0xdf, 0xd1, 0x1b, 0xd8, 0x0d, 0xaf, 0xac, 0x31, 0x18, 0x76, 0x46,
+	0xfd, 0x17, 0x87, 0xf5, 0xc0, 0x8c, 0xdb, 0xad, 0x09, 0xf4, 0x96, 0x76, 0xc3, 0x74, 0x68, 0x76,
+	0x29, 0x4e, 0x5e, 0x2f, 0xd7, 0x24, 0x5a, 0xad, 0x49, 0x74, 0xb1, 0x26, 0xe0, 0x87, 0x23, 0xe0,
+	0x97, 0x23, 0xe0, 0xb7, 0x23, 0x60, 0xe9, 0x08, 0x58, 0x39, 0x02, 0xfe, 0x38, 0x02, 0xfe, 0x3a,
+	0x12, 0x5d, 0x38, 0x02, 0x7e, 0x6e, 0x48, 0xb4, 0xdc, 0x90, 0x68, 0xb5, 0x21, 0xd1, 0x97, 0x58,
+	0x1b, 0x6e, 0xc4, 0xb4, 0x1b, 0xb6, 0x7c, 0xf9, 0x7f, 0x00, 0x5e, 0x08, 0xa5, 0xf2, 0xf9, 0x02,
+	0x00, 0x00,
+}
+
+func (this *ValidatorParticipation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorParticipation)
+	if !ok {
+		that2, ok := that.(ValidatorParticipation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.NumSelected != that1.NumSelected {
+		return false
+	}
+	if this.NumProposed != that1.NumProposed {
+		return false
+	}
+	if this.NumMissedProposals != that1.NumMissedProposals {
+		return false
+	}
+	if this.NumSignatures != that1.NumSignatures {
+		return false
+	}
+	if this.NumMissedSignatures != that1.NumMissedSignatures {
+		return false
+	}
+	if this.RatingDelta != that1.RatingDelta {
+		return false
+	}
+	if this.TempRating != that1.TempRating {
+		return false
+	}
+	return true
+}
+func (this *ValidatorParticipationHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorParticipationHistory)
+	if !ok {
+		that2, ok := that.(ValidatorParticipationHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Epochs) != len(that1.Epochs) {
+		return false
+	}
+	for i := range this.Epochs {
+		if !this.Epochs[i].Equal(that1.Epochs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorParticipation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&state.ValidatorParticipation{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "NumSelected: "+fmt.Sprintf("%#v", this.NumSelected)+",\n")
+	s = append(s, "NumProposed: "+fmt.Sprintf("%#v", this.NumProposed)+",\n")
+	s = append(s, "NumMissedProposals: "+fmt.Sprintf("%#v", this.NumMissedProposals)+",\n")
+	s = append(s, "NumSignatures: "+fmt.Sprintf("%#v", this.NumSignatures)+",\n")
+	s = append(s, "NumMissedSignatures: "+fmt.Sprintf("%#v", this.NumMissedSignatures)+",\n")
+	s = append(s, "RatingDelta: "+fmt.Sprintf("%#v", this.RatingDelta)+",\n")
+	s = append(s, "TempRating: "+fmt.Sprintf("%#v", this.TempRating)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorParticipationHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&state.ValidatorParticipationHistory{")
+	if this.Epochs != nil {
+		s = append(s, "Epochs: "+fmt.Sprintf("%#v", this.Epochs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringValidatorParticipation(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TempRating != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.TempRating))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RatingDelta != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.RatingDelta))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NumMissedSignatures != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.NumMissedSignatures))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumSignatures != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.NumSignatures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumMissedProposals != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.NumMissedProposals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumProposed != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.NumProposed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumSelected != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.NumSelected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorParticipation(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorParticipation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorParticipation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorParticipation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.Epoch))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.ShardId))
+	}
+	if m.NumSelected != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.NumSelected))
+	}
+	if m.NumProposed != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.NumProposed))
+	}
+	if m.NumMissedProposals != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.NumMissedProposals))
+	}
+	if m.NumSignatures != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.NumSignatures))
+	}
+	if m.NumMissedSignatures != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.NumMissedSignatures))
+	}
+	if m.RatingDelta != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.RatingDelta))
+	}
+	if m.TempRating != 0 {
+		n += 1 + sovValidatorParticipation(uint64(m.TempRating))
+	}
+	return n
+}
+
+func (m *ValidatorParticipationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovValidatorParticipation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovValidatorParticipation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorParticipation(x uint64) (n int) {
+	return sovValidatorParticipation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValidatorParticipation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorParticipation{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`NumSelected:` + fmt.Sprintf("%v", this.NumSelected) + `,`,
+		`NumProposed:` + fmt.Sprintf("%v", this.NumProposed) + `,`,
+		`NumMissedProposals:` + fmt.Sprintf("%v", this.NumMissedProposals) + `,`,
+		`NumSignatures:` + fmt.Sprintf("%v", this.NumSignatures) + `,`,
+		`NumMissedSignatures:` + fmt.Sprintf("%v", this.NumMissedSignatures) + `,`,
+		`RatingDelta:` + fmt.Sprintf("%v", this.RatingDelta) + `,`,
+		`TempRating:` + fmt.Sprintf("%v", this.TempRating) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorParticipationHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEpochs := "[]*ValidatorParticipation{"
+	for _, f := range this.Epochs {
+		repeatedStringForEpochs += strings.Replace(f.String(), "ValidatorParticipation", "ValidatorParticipation", 1) + ","
+	}
+	repeatedStringForEpochs += "}"
+	s := strings.Join([]string{`&ValidatorParticipationHistory{`,
+		`Epochs:` + repeatedStringForEpochs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringValidatorParticipation(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSelected", wireType)
+			}
+			m.NumSelected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSelected |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProposed", wireType)
+			}
+			m.NumProposed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProposed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissedProposals", wireType)
+			}
+			m.NumMissedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMissedProposals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSignatures", wireType)
+			}
+			m.NumSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSignatures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissedSignatures", wireType)
+			}
+			m.NumMissedSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMissedSignatures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingDelta", wireType)
+			}
+			m.RatingDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatingDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TempRating", wireType)
+			}
+			m.TempRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TempRating |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &ValidatorParticipation{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorParticipation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorParticipation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorParticipation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorParticipation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorParticipation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorParticipation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorParticipation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorParticipation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package transaction
+
+// ApiGasPriceSuggestion is the data transfer object which holds the gas prices suggested from the analysis of the
+// recent blocks of the current shard, together with the data the suggestion was computed from. The block and pool
+// fill values are ratios between 0 and 1
+type ApiGasPriceSuggestion struct {
+	MinimumGasPrice       uint64  `json:"minimumGasPrice"`
+	MedianGasPrice        uint64  `json:"medianGasPrice"`
+	FastGasPrice          uint64  `json:"fastGasPrice"`
+	NetworkMinGasPrice    uint64  `json:"networkMinGasPrice"`
+	NumBlocks             uint32  `json:"numBlocks"`
+	FirstBlockNonce       uint64  `json:"firstBlockNonce"`
+	LastBlockNonce        uint64  `json:"lastBlockNonce"`
+	NumTransactions       uint64  `json:"numTransactions"`
+	AverageBlockFill      float64 `json:"averageBlockFill"`
+	LastBlockFill         float64 `json:"lastBlockFill"`
+	PoolNumBytes          uint64  `json:"poolNumBytes"`
+	PoolNumBytesThreshold uint32  `json:"poolNumBytesThreshold"`
+	PoolFill              float64 `json:"poolFill"`
+}
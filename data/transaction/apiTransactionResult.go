@@ -50,6 +50,39 @@ type SimulationResults struct {
 	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*ReceiptApi             `json:"receipts,omitempty"`
 	Hash       string                             `json:"hash,omitempty"`
+	StateDiff  []*AccountStateDiff                `json:"stateDiff,omitempty"`
+}
+
+// AccountOverride holds the values that replace, for the duration of a simulation, the ones of an account. The fields
+// that are not provided keep their current values. The balance is a base 10 number, while the code, the code metadata
+// and the storage keys and values are hex encoded
+type AccountOverride struct {
+	Balance      string            `json:"balance,omitempty"`
+	Nonce        *uint64           `json:"nonce,omitempty"`
+	Code         string            `json:"code,omitempty"`
+	CodeMetadata string            `json:"codeMetadata,omitempty"`
+	Storage      map[string]string `json:"storage,omitempty"`
+}
+
+// AccountStateDiff holds the changes made on an account by a simulated transaction. The code hashes and the storage
+// keys and values are hex encoded
+type AccountStateDiff struct {
+	Address        string         `json:"address"`
+	BalanceBefore  string         `json:"balanceBefore"`
+	BalanceAfter   string         `json:"balanceAfter"`
+	NonceBefore    uint64         `json:"nonceBefore"`
+	NonceAfter     uint64         `json:"nonceAfter"`
+	CodeHashBefore string         `json:"codeHashBefore,omitempty"`
+	CodeHashAfter  string         `json:"codeHashAfter,omitempty"`
+	Storage        []*StorageDiff `json:"storage,omitempty"`
+}
+
+// StorageDiff holds the value of a storage key before and after a simulated transaction. An empty value signals a
+// missing key
+type StorageDiff struct {
+	Key    string `json:"key"`
+	Before string `json:"before"`
+	After  string `json:"after"`
 }
 
 // ApiSmartContractResult represents a smart contract result with changed fields' types in order to make it friendly for API's json
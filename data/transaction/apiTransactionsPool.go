@@ -0,0 +1,42 @@
+package transaction
+
+// ApiPoolTransaction represents a transaction held in the transactions pool, with changed fields' types in order to
+// make it friendly for API's json
+type ApiPoolTransaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+// ApiTransactionsPool is the data transfer object which holds the transactions sent from the accounts of the
+// current shard that are waiting in the pool
+type ApiTransactionsPool struct {
+	NumSenders   uint64                `json:"numSenders"`
+	Transactions []*ApiPoolTransaction `json:"transactions"`
+}
+
+// ApiNonceGap represents a range of nonces, both ends included, missing from the queue of a sender
+type ApiNonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ApiPoolSender is the data transfer object which holds the transactions of a sender waiting in the pool, sorted by
+// nonce, together with the data that decides whether they can be selected in a block. A nonce gap against the
+// account nonce blocks the selection of all the sender's transactions
+type ApiPoolSender struct {
+	Sender              string                `json:"sender"`
+	AccountNonce        uint64                `json:"accountNonce"`
+	AccountNonceKnown   bool                  `json:"accountNonceKnown"`
+	Score               uint32                `json:"score"`
+	NumFailedSelections uint64                `json:"numFailedSelections"`
+	IsInGracePeriod     bool                  `json:"isInGracePeriod"`
+	QueuedNonces        []uint64              `json:"queuedNonces"`
+	NonceGaps           []ApiNonceGap         `json:"nonceGaps"`
+	Transactions        []*ApiPoolTransaction `json:"transactions"`
+}
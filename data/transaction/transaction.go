@@ -78,6 +78,9 @@ func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalize
 		Version:          tx.Version,
 		Options:          tx.Options,
 	}
+	if len(tx.GuardianAddr) > 0 {
+		ftx.GuardianAddr = encoder.Encode(tx.GuardianAddr)
+	}
 
 	return marshalizer.Marshal(ftx)
 }
@@ -207,3 +207,33 @@ func TestTransaction_GetDataForSigningShouldWork(t *testing.T) {
 	assert.True(t, marshalizerWasCalled)
 	assert.Equal(t, 2, numEncodeCalled)
 }
+
+func TestTransaction_GetDataForSigningWithGuardianShouldContainGuardian(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Value:             big.NewInt(0),
+		GuardianAddr:      []byte("guardian"),
+		GuardianSignature: []byte("guardian signature"),
+	}
+
+	var marshalizedTx *transaction.FrontendTransaction
+	_, err := tx.GetDataForSigning(
+		&mock.PubkeyConverterStub{
+			EncodeCalled: func(pkBytes []byte) string {
+				return string(pkBytes)
+			},
+		},
+		&mock.MarshalizerStub{
+			MarshalCalled: func(obj interface{}) (bytes []byte, err error) {
+				marshalizedTx = obj.(*transaction.FrontendTransaction)
+
+				return make([]byte, 0), nil
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "guardian", marshalizedTx.GuardianAddr)
+	assert.Equal(t, "", marshalizedTx.GuardianSignature)
+}
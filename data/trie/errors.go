@@ -96,3 +96,6 @@ var ErrContextClosing = errors.New("context closing")
 
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
+
+// ErrInvalidProof signals that the provided merkle proof is not valid for the given root hash and key
+var ErrInvalidProof = errors.New("invalid merkle proof")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -653,6 +654,135 @@ func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	return hashes, nil
 }
 
+// GetProof returns the serialized nodes that form the path from the root to the given key. If the key is not
+// present in the trie, the returned nodes prove its absence
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	if tr.root == nil {
+		return proof, nil
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		var encNode []byte
+		encNode, err = getEncodedCollapsedNode(currentNode)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if errors.Is(err, ErrNodeNotFound) {
+			return proof, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if currentNode == nil {
+			return proof, nil
+		}
+	}
+}
+
+func getEncodedCollapsedNode(n node) ([]byte, error) {
+	collapsed, err := n.getCollapsed()
+	if err != nil {
+		return nil, err
+	}
+
+	return collapsed.getEncodedNode()
+}
+
+// VerifyProof checks the given proof against the root hash. It returns the value stored under the given key if the
+// proof is an inclusion proof, or a nil value if the proof shows that the key is not present in the trie
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(proof) == 0 {
+		if emptyTrie(rootHash) {
+			return nil, nil
+		}
+		return nil, ErrInvalidProof
+	}
+
+	hexKey := keyBytesToHex(key)
+	expectedHash := rootHash
+	for i, encNode := range proof {
+		isLastNode := i == len(proof)-1
+		if !bytes.Equal(hasher.Compute(string(encNode)), expectedHash) {
+			return nil, fmt.Errorf("%w: hash mismatch for node at index %d", ErrInvalidProof, i)
+		}
+
+		n, err := decodeNode(encNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		var nextHash []byte
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if !isLastNode {
+				return nil, fmt.Errorf("%w: leaf node found before the end of the proof", ErrInvalidProof)
+			}
+			if bytes.Equal(currentNode.Key, hexKey) {
+				return currentNode.Value, nil
+			}
+			return nil, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, currentNode.Key) {
+				return proofOfAbsence(isLastNode)
+			}
+			hexKey = hexKey[len(currentNode.Key):]
+			nextHash = currentNode.EncodedChild
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[firstByte]) >= len(currentNode.EncodedChildren) {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidProof, ErrChildPosOutOfRange.Error())
+			}
+			nextHash = currentNode.EncodedChildren[hexKey[firstByte]]
+			hexKey = hexKey[1:]
+			if len(nextHash) == 0 {
+				return proofOfAbsence(isLastNode)
+			}
+		default:
+			return nil, ErrInvalidNode
+		}
+
+		if isLastNode {
+			return nil, fmt.Errorf("%w: the proof ends before reaching a leaf", ErrInvalidProof)
+		}
+		expectedHash = nextHash
+	}
+
+	return nil, ErrInvalidProof
+}
+
+func proofOfAbsence(isLastNode bool) ([]byte, error) {
+	if !isLastNode {
+		return nil, fmt.Errorf("%w: the proof continues after the key path ended", ErrInvalidProof)
+	}
+
+	return nil, nil
+}
+
 // IsPruningEnabled returns true if state pruning is enabled
 func (tr *patriciaMerkleTrie) IsPruningEnabled() bool {
 	return tr.trieStorage.IsPruningEnabled()
@@ -3,6 +3,7 @@ package trie_test
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -659,6 +660,140 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetProofEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof))
+
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	value, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProofForExistingKeys(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	leaves := map[string][]byte{
+		"doe":  []byte("reindeer"),
+		"dog":  []byte("puppy"),
+		"ddog": []byte("cat"),
+	}
+	for key, expectedValue := range leaves {
+		proof, err := tr.GetProof([]byte(key))
+		assert.Nil(t, err)
+		assert.True(t, len(proof) > 0)
+
+		value, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProofForMissingKey(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	for _, key := range []string{"dogs", "do", "cat", "dde"} {
+		proof, err := tr.GetProof([]byte(key))
+		assert.Nil(t, err)
+		assert.True(t, len(proof) > 0)
+
+		value, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Nil(t, value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofOnDirtyTrieShouldMatchRootHash(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+
+	rootHash, _ := tr.Root()
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), value)
+}
+
+func TestVerifyProof_TamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+	lastNode := proof[len(proof)-1]
+	tamperedNode := make([]byte, len(lastNode))
+	copy(tamperedNode, lastNode)
+	tamperedNode[0]++
+	proof[len(proof)-1] = tamperedNode
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	assert.Nil(t, value)
+}
+
+func TestVerifyProof_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof([]byte("wrong root hash"), []byte("dog"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	assert.Nil(t, value)
+}
+
+func TestVerifyProof_TruncatedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	assert.Nil(t, value)
+}
+
+func TestVerifyProof_NilMarshalizerOrHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	_, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, nil, hasher)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	_, err = trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, marshalizer, nil)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
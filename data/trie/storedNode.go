@@ -0,0 +1,70 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const (
+	// BranchNodeType is the type name of a decoded branch node
+	BranchNodeType = "branch"
+	// ExtensionNodeType is the type name of a decoded extension node
+	ExtensionNodeType = "extension"
+	// LeafNodeType is the type name of a decoded leaf node
+	LeafNodeType = "leaf"
+)
+
+// StoredNodeData holds the contents of a trie node as it was saved in the storage
+type StoredNodeData struct {
+	Type           string
+	Key            []byte
+	Value          []byte
+	ChildrenHashes [][]byte
+}
+
+// DecodeStoredNode decodes a trie node as it was saved in the storage. The children of the branch and
+// extension nodes are not loaded, only their hashes are returned
+func DecodeStoredNode(encNode []byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*StoredNodeData, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	n, err := decodeNode(encNode, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	switch decodedNode := n.(type) {
+	case *branchNode:
+		childrenHashes := make([][]byte, 0, nrOfChildren)
+		for _, childHash := range decodedNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+			childrenHashes = append(childrenHashes, childHash)
+		}
+
+		return &StoredNodeData{
+			Type:           BranchNodeType,
+			ChildrenHashes: childrenHashes,
+		}, nil
+	case *extensionNode:
+		return &StoredNodeData{
+			Type:           ExtensionNodeType,
+			Key:            decodedNode.Key,
+			ChildrenHashes: [][]byte{decodedNode.EncodedChild},
+		}, nil
+	case *leafNode:
+		return &StoredNodeData{
+			Type:  LeafNodeType,
+			Key:   decodedNode.Key,
+			Value: decodedNode.Value,
+		}, nil
+	default:
+		return nil, ErrInvalidNode
+	}
+}
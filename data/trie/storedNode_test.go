@@ -0,0 +1,66 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStoredNode_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marsh, hasher := getTestMarshalizerAndHasher()
+
+	nodeData, err := DecodeStoredNode([]byte("enc"), nil, hasher)
+	assert.Nil(t, nodeData)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	nodeData, err = DecodeStoredNode([]byte("enc"), marsh, nil)
+	assert.Nil(t, nodeData)
+	assert.Equal(t, ErrNilHasher, err)
+
+	nodeData, err = DecodeStoredNode(nil, marsh, hasher)
+	assert.Nil(t, nodeData)
+	assert.Equal(t, ErrInvalidEncoding, err)
+}
+
+func TestDecodeStoredNode_BranchNode(t *testing.T) {
+	t.Parallel()
+
+	_, collapsedBn := getBnAndCollapsedBn(getTestMarshalizerAndHasher())
+	encNode, _ := collapsedBn.getEncodedNode()
+
+	nodeData, err := DecodeStoredNode(encNode, collapsedBn.marsh, collapsedBn.hasher)
+	require.Nil(t, err)
+	assert.Equal(t, BranchNodeType, nodeData.Type)
+	expectedHashes := [][]byte{collapsedBn.EncodedChildren[2], collapsedBn.EncodedChildren[6], collapsedBn.EncodedChildren[13]}
+	assert.Equal(t, expectedHashes, nodeData.ChildrenHashes)
+}
+
+func TestDecodeStoredNode_ExtensionNode(t *testing.T) {
+	t.Parallel()
+
+	_, collapsedEn := getEnAndCollapsedEn()
+	encNode, _ := collapsedEn.getEncodedNode()
+
+	nodeData, err := DecodeStoredNode(encNode, collapsedEn.marsh, collapsedEn.hasher)
+	require.Nil(t, err)
+	assert.Equal(t, ExtensionNodeType, nodeData.Type)
+	assert.Equal(t, collapsedEn.Key, nodeData.Key)
+	assert.Equal(t, [][]byte{collapsedEn.EncodedChild}, nodeData.ChildrenHashes)
+}
+
+func TestDecodeStoredNode_LeafNode(t *testing.T) {
+	t.Parallel()
+
+	ln := getLn(getTestMarshalizerAndHasher())
+	encNode, _ := ln.getEncodedNode()
+
+	nodeData, err := DecodeStoredNode(encNode, ln.marsh, ln.hasher)
+	require.Nil(t, err)
+	assert.Equal(t, LeafNodeType, nodeData.Type)
+	assert.Equal(t, ln.Key, nodeData.Key)
+	assert.Equal(t, ln.Value, nodeData.Value)
+	assert.Equal(t, 0, len(nodeData.ChildrenHashes))
+}
@@ -0,0 +1,19 @@
+package vm
+
+// GovernanceProposalApi holds the governance proposal details returned by the API
+type GovernanceProposalApi struct {
+	Reference       string   `json:"reference"`
+	Issuer          string   `json:"issuer"`
+	GitHubCommit    string   `json:"gitHubCommit"`
+	StartVoteNonce  uint64   `json:"startVoteNonce"`
+	EndVoteNonce    uint64   `json:"endVoteNonce"`
+	Yes             int32    `json:"yes"`
+	No              int32    `json:"no"`
+	Veto            int32    `json:"veto"`
+	DontCare        int32    `json:"dontCare"`
+	Status          string   `json:"status"`
+	ActionType      string   `json:"actionType,omitempty"`
+	ActionArguments []string `json:"actionArguments,omitempty"`
+	ExecutionEpoch  uint32   `json:"executionEpoch"`
+	ExecutionError  string   `json:"executionError,omitempty"`
+}
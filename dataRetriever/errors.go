@@ -196,3 +196,12 @@ var ErrNilGracefullyCloseChannel = errors.New("nil gracefully close channel")
 
 // ErrNilSmartContractsPool signals that a nil smart contracts pool has been provided
 var ErrNilSmartContractsPool = errors.New("nil smart contracts pool")
+
+// ErrNilTxPoolStorer signals that a nil storer for the transactions pool has been provided
+var ErrNilTxPoolStorer = errors.New("nil transactions pool storer")
+
+// ErrInvalidTxPoolSnapshotInterval signals that an invalid interval between the snapshots of the transactions pool has been provided
+var ErrInvalidTxPoolSnapshotInterval = errors.New("invalid transactions pool snapshot interval")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"time"
+
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -9,19 +11,24 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
 var log = logger.GetOrCreate("dataRetriever/factory")
 
-// ArgsDataPool holds the arguments needed for NewDataPoolFromConfig function
+// ArgsDataPool holds the arguments needed for NewDataPoolFromConfig function. The transactions pool is persisted only
+// when a TxPoolStorer is provided
 type ArgsDataPool struct {
 	Config           *config.Config
 	EconomicsData    process.EconomicsDataHandler
 	ShardCoordinator sharding.Coordinator
+	TxPoolStorer     storage.Storer
+	Marshalizer      marshal.Marshalizer
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -51,6 +58,22 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		return nil, err
 	}
 
+	var txPoolHandler dataRetriever.ShardedDataCacherNotifier = txPool
+	if !check.IfNil(args.TxPoolStorer) {
+		txPoolHandler, err = txpool.NewPersistentTxPool(txpool.ArgPersistentTxPool{
+			TxPool:           txPool,
+			Storer:           args.TxPoolStorer,
+			Marshalizer:      args.Marshalizer,
+			ShardCoordinator: args.ShardCoordinator,
+			EconomicsData:    args.EconomicsData,
+			SnapshotInterval: time.Duration(mainConfig.TxPoolPersistence.SnapshotIntervalInSeconds) * time.Second,
+		})
+		if err != nil {
+			log.Error("error creating persistent txpool")
+			return nil, err
+		}
+	}
+
 	uTxPool, err := shardedData.NewShardedData(dataRetriever.UnsignedTxPoolName, factory.GetCacherFromConfig(mainConfig.UnsignedTransactionDataPool))
 	if err != nil {
 		log.Error("error creating smart contract result pool")
@@ -103,7 +126,7 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	}
 
 	return dataPool.NewDataPool(
-		txPool,
+		txPoolHandler,
 		uTxPool,
 		rewardTxPool,
 		hdrPool,
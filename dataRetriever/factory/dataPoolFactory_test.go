@@ -18,6 +18,27 @@ func TestNewDataPoolFromConfig(t *testing.T) {
 	require.NotNil(t, holder)
 }
 
+func TestNewDataPoolFromConfig_WithTxPoolStorerShouldPersistTheTxPool(t *testing.T) {
+	args := getGoodArgs()
+	holder, _ := NewDataPoolFromConfig(args)
+	_, isPersisted := holder.Transactions().(dataRetriever.TxPoolPersister)
+	require.False(t, isPersisted)
+
+	args = getGoodArgs()
+	args.Config.TxPoolPersistence.SnapshotIntervalInSeconds = 60
+	args.TxPoolStorer = &mock.StorerStub{}
+	args.Marshalizer = &mock.MarshalizerMock{}
+	holder, err := NewDataPoolFromConfig(args)
+	require.Nil(t, err)
+	_, isPersisted = holder.Transactions().(dataRetriever.TxPoolPersister)
+	require.True(t, isPersisted)
+
+	args.Marshalizer = nil
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+}
+
 func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	args := getGoodArgs()
 	args.Config = nil
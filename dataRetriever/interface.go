@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/counting"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -85,6 +86,14 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// AddressTransactionsUnit is the per-address transactions history storage unit identifier
+	AddressTransactionsUnit UnitType = 17
+	// EventsUnit is the smart contract events index storage unit identifier
+	EventsUnit UnitType = 18
+	// TxPoolUnit is the persisted transactions pool storage unit identifier
+	TxPoolUnit UnitType = 19
+	// ValidatorParticipationUnit is the validators participation history storage unit identifier
+	ValidatorParticipationUnit UnitType = 20
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -230,6 +239,14 @@ type PeerListCreator interface {
 	IsInterfaceNil() bool
 }
 
+// TxPoolPersister defines the component which keeps the transactions pool in a storage unit, so the pending
+// transactions can be restored after a restart
+type TxPoolPersister interface {
+	LoadFromStorage(accounts state.AccountsAdapter) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ShardedDataCacherNotifier defines what a sharded-data structure can perform
 type ShardedDataCacherNotifier interface {
 	RegisterOnAdded(func(key []byte, value interface{}))
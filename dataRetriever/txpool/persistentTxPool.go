@@ -0,0 +1,337 @@
+package txpool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ dataRetriever.ShardedDataCacherNotifier = (*persistentTxPool)(nil)
+var _ dataRetriever.TxPoolPersister = (*persistentTxPool)(nil)
+
+// ArgPersistentTxPool is the argument for the persistent transactions pool constructor
+type ArgPersistentTxPool struct {
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	EconomicsData    process.EconomicsDataHandler
+	SnapshotInterval time.Duration
+}
+
+// persistentTxPool keeps a copy of the transactions sent from the self shard in a storage unit. The copy is written
+// through on each add and remove and is periodically reconciled with the content of the pool, as the pool also
+// evicts transactions on its own
+type persistentTxPool struct {
+	dataRetriever.ShardedDataCacherNotifier
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+	economicsData    process.EconomicsDataHandler
+	snapshotInterval time.Duration
+	mutSnapshot      sync.Mutex
+	mutLoop          sync.Mutex
+	cancelFunc       func()
+}
+
+// NewPersistentTxPool creates a transactions pool which survives restarts
+func NewPersistentTxPool(args ArgPersistentTxPool) (*persistentTxPool, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, dataRetriever.ErrNilTxDataPool
+	}
+	if check.IfNil(args.Storer) {
+		return nil, dataRetriever.ErrNilTxPoolStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, dataRetriever.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EconomicsData) {
+		return nil, dataRetriever.ErrNilEconomicsData
+	}
+	if args.SnapshotInterval <= 0 {
+		return nil, dataRetriever.ErrInvalidTxPoolSnapshotInterval
+	}
+
+	ptp := &persistentTxPool{
+		ShardedDataCacherNotifier: args.TxPool,
+		storer:                    args.Storer,
+		marshalizer:               args.Marshalizer,
+		shardCoordinator:          args.ShardCoordinator,
+		economicsData:             args.EconomicsData,
+		snapshotInterval:          args.SnapshotInterval,
+	}
+	args.TxPool.RegisterOnAdded(ptp.onAdded)
+
+	return ptp, nil
+}
+
+func (ptp *persistentTxPool) onAdded(key []byte, value interface{}) {
+	wrappedTx, ok := value.(*txcache.WrappedTransaction)
+	if !ok || wrappedTx.SenderShardID != ptp.shardCoordinator.SelfId() {
+		return
+	}
+	// the added transaction might have been already discarded by the cache, as when it was replaced by fee
+	cacheID := process.ShardCacherIdentifier(wrappedTx.SenderShardID, wrappedTx.ReceiverShardID)
+	if !ptp.ShardedDataCacherNotifier.ShardDataStore(cacheID).Has(key) {
+		return
+	}
+
+	ptp.persistTx(key, wrappedTx.Tx)
+}
+
+func (ptp *persistentTxPool) persistTx(txHash []byte, tx data.TransactionHandler) {
+	buff, err := ptp.marshalizer.Marshal(tx)
+	if err != nil {
+		log.Debug("persistentTxPool.persistTx: marshal", "txHash", txHash, "error", err)
+		return
+	}
+
+	err = ptp.storer.Put(txHash, buff)
+	if err != nil {
+		log.Debug("persistentTxPool.persistTx: put", "txHash", txHash, "error", err)
+	}
+}
+
+func (ptp *persistentTxPool) removePersistedTx(txHash []byte) {
+	err := ptp.storer.Remove(txHash)
+	if err != nil {
+		log.Debug("persistentTxPool.removePersistedTx", "txHash", txHash, "error", err)
+	}
+}
+
+// RemoveData removes the transaction from the pool and from the storage
+func (ptp *persistentTxPool) RemoveData(key []byte, cacheID string) {
+	ptp.ShardedDataCacherNotifier.RemoveData(key, cacheID)
+	ptp.removePersistedTx(key)
+}
+
+// RemoveSetOfDataFromPool removes a bunch of transactions from the pool and from the storage
+func (ptp *persistentTxPool) RemoveSetOfDataFromPool(keys [][]byte, cacheID string) {
+	ptp.ShardedDataCacherNotifier.RemoveSetOfDataFromPool(keys, cacheID)
+	for _, key := range keys {
+		ptp.removePersistedTx(key)
+	}
+}
+
+// RemoveDataFromAllShards removes the transaction from the pool (it searches in all shards) and from the storage
+func (ptp *persistentTxPool) RemoveDataFromAllShards(key []byte) {
+	ptp.ShardedDataCacherNotifier.RemoveDataFromAllShards(key)
+	ptp.removePersistedTx(key)
+}
+
+// LoadFromStorage adds back in the pool the persisted transactions which can still be executed against the provided
+// accounts state, removing the other ones from the storage, and then starts the periodic snapshots of the pool. It
+// should be called once, after the state of the last committed block was loaded
+func (ptp *persistentTxPool) LoadFromStorage(accounts state.AccountsAdapter) error {
+	if check.IfNil(accounts) {
+		return dataRetriever.ErrNilAccountsAdapter
+	}
+
+	ptp.mutSnapshot.Lock()
+	numLoaded, numDiscarded := ptp.loadFromStorage(accounts)
+	ptp.mutSnapshot.Unlock()
+
+	log.Info("persistentTxPool.LoadFromStorage", "num loaded txs", numLoaded, "num discarded txs", numDiscarded)
+
+	ptp.startSnapshots()
+
+	return nil
+}
+
+func (ptp *persistentTxPool) loadFromStorage(accounts state.AccountsAdapter) (int, int) {
+	persistedTxs := make(map[string][]byte)
+	ptp.storer.RangeKeys(func(key []byte, value []byte) bool {
+		persistedTxs[string(key)] = value
+		return true
+	})
+
+	numLoaded := 0
+	for txHash, buff := range persistedTxs {
+		tx, cacheID, err := ptp.createReloadedTx(buff, accounts)
+		if err != nil {
+			log.Trace("persistentTxPool: discarded persisted tx", "txHash", []byte(txHash), "error", err)
+			ptp.removePersistedTx([]byte(txHash))
+			continue
+		}
+
+		ptp.ShardedDataCacherNotifier.AddData([]byte(txHash), tx, len(buff), cacheID)
+		numLoaded++
+	}
+
+	return numLoaded, len(persistedTxs) - numLoaded
+}
+
+func (ptp *persistentTxPool) createReloadedTx(buff []byte, accounts state.AccountsAdapter) (*transaction.Transaction, string, error) {
+	tx := &transaction.Transaction{}
+	err := ptp.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, "", err
+	}
+
+	selfShardID := ptp.shardCoordinator.SelfId()
+	if ptp.shardCoordinator.ComputeId(tx.SndAddr) != selfShardID {
+		return nil, "", process.ErrShardIdMissmatch
+	}
+
+	err = ptp.checkReloadedTx(tx, accounts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cacheID := process.ShardCacherIdentifier(selfShardID, ptp.shardCoordinator.ComputeId(tx.RcvAddr))
+
+	return tx, cacheID, nil
+}
+
+// checkReloadedTx verifies that a reloaded transaction was not executed in the meantime and that its sender can still
+// pay for it
+func (ptp *persistentTxPool) checkReloadedTx(tx *transaction.Transaction, accounts state.AccountsAdapter) error {
+	accountHandler, err := accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	if tx.Nonce < account.GetNonce() {
+		return process.ErrLowerNonceInTransaction
+	}
+
+	cost := big.NewInt(0).Set(ptp.economicsData.ComputeTxFee(tx))
+	if tx.Value != nil {
+		cost.Add(cost, tx.Value)
+	}
+	if account.GetBalance().Cmp(cost) < 0 {
+		return fmt.Errorf("%w, has: %s, wanted: %s",
+			process.ErrInsufficientFunds,
+			account.GetBalance().String(),
+			cost.String(),
+		)
+	}
+
+	return nil
+}
+
+func (ptp *persistentTxPool) startSnapshots() {
+	ptp.mutLoop.Lock()
+	defer ptp.mutLoop.Unlock()
+
+	if ptp.cancelFunc != nil {
+		return
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	ptp.cancelFunc = cancelFunc
+
+	go ptp.snapshotLoop(ctx)
+}
+
+func (ptp *persistentTxPool) snapshotLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("persistentTxPool's go routine is stopping...")
+			return
+		case <-time.After(ptp.snapshotInterval):
+		}
+
+		ptp.snapshot()
+	}
+}
+
+// snapshot writes in the storage the transactions from the pool which are missing there and removes the persisted
+// transactions which are no longer in the pool
+func (ptp *persistentTxPool) snapshot() {
+	ptp.mutSnapshot.Lock()
+	defer ptp.mutSnapshot.Unlock()
+
+	selfShardID := ptp.shardCoordinator.SelfId()
+	cache := ptp.ShardedDataCacherNotifier.ShardDataStore(process.ShardCacherIdentifier(selfShardID, selfShardID))
+
+	txHashesInPool := make(map[string]struct{})
+	numAdded := 0
+	for _, txHash := range cache.Keys() {
+		value, ok := cache.Peek(txHash)
+		if !ok {
+			continue
+		}
+		tx, ok := value.(data.TransactionHandler)
+		if !ok {
+			continue
+		}
+
+		txHashesInPool[string(txHash)] = struct{}{}
+		if ptp.storer.Has(txHash) == nil {
+			continue
+		}
+
+		ptp.persistTx(txHash, tx)
+		numAdded++
+	}
+
+	staleTxHashes := make([][]byte, 0)
+	ptp.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		_, found := txHashesInPool[string(key)]
+		if !found {
+			staleTxHashes = append(staleTxHashes, key)
+		}
+		return true
+	})
+
+	numRemoved := 0
+	for _, txHash := range staleTxHashes {
+		// the transaction might have been added after the pool was traversed
+		_, found := ptp.ShardedDataCacherNotifier.SearchFirstData(txHash)
+		if found {
+			continue
+		}
+
+		ptp.removePersistedTx(txHash)
+		numRemoved++
+	}
+
+	log.Debug("persistentTxPool.snapshot",
+		"num txs in pool", len(txHashesInPool),
+		"num added txs", numAdded,
+		"num removed txs", numRemoved,
+	)
+}
+
+// Close stops the periodic snapshots, after doing a last one
+func (ptp *persistentTxPool) Close() error {
+	ptp.mutLoop.Lock()
+	defer ptp.mutLoop.Unlock()
+
+	if ptp.cancelFunc == nil {
+		return nil
+	}
+
+	ptp.cancelFunc()
+	ptp.cancelFunc = nil
+	ptp.snapshot()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ptp *persistentTxPool) IsInterfaceNil() bool {
+	return ptp == nil
+}
@@ -0,0 +1,245 @@
+package txpool
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgPersistentTxPool(t *testing.T) ArgPersistentTxPool {
+	txPool, err := newTxPoolToTest()
+	require.Nil(t, err)
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.SetNoShards(4)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if strings.HasPrefix(string(address), "other") {
+			return 1
+		}
+		return 0
+	}
+
+	cacher, _ := lrucache.NewCache(10)
+	storer, _ := storageUnit.NewStorageUnit(cacher, memorydb.New())
+
+	return ArgPersistentTxPool{
+		TxPool:           txPool,
+		Storer:           storer,
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: shardCoordinator,
+		EconomicsData: &economicsmocks.EconomicsHandlerStub{
+			ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				// the processing part of the gas is cheaper, as with a gas price modifier
+				fee := big.NewInt(0).SetUint64(tx.GetGasLimit() * tx.GetGasPrice())
+				return fee.Div(fee, big.NewInt(2))
+			},
+		},
+		SnapshotInterval: time.Minute,
+	}
+}
+
+func Test_NewPersistentTxPool(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	args.TxPool = nil
+	pool, err := NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrNilTxDataPool, err)
+
+	args = createArgPersistentTxPool(t)
+	args.Storer = nil
+	pool, err = NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrNilTxPoolStorer, err)
+
+	args = createArgPersistentTxPool(t)
+	args.Marshalizer = nil
+	pool, err = NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+
+	args = createArgPersistentTxPool(t)
+	args.ShardCoordinator = nil
+	pool, err = NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrNilShardCoordinator, err)
+
+	args = createArgPersistentTxPool(t)
+	args.EconomicsData = nil
+	pool, err = NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrNilEconomicsData, err)
+
+	args = createArgPersistentTxPool(t)
+	args.SnapshotInterval = 0
+	pool, err = NewPersistentTxPool(args)
+	require.True(t, check.IfNil(pool))
+	require.Equal(t, dataRetriever.ErrInvalidTxPoolSnapshotInterval, err)
+
+	args = createArgPersistentTxPool(t)
+	pool, err = NewPersistentTxPool(args)
+	require.False(t, check.IfNil(pool))
+	require.Nil(t, err)
+	require.Implements(t, (*dataRetriever.ShardedDataCacherNotifier)(nil), pool)
+}
+
+func Test_PersistentTxPool_ShouldWriteThroughOnAddAndRemove(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	storer := args.Storer
+	pool, _ := NewPersistentTxPool(args)
+
+	pool.AddData([]byte("hash-a"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-b"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-c"), createTx("alice", 44), 0, "0_1")
+	pool.AddData([]byte("hash-d"), createTx("otherBob", 7), 0, "1_0")
+
+	require.Nil(t, storer.Has([]byte("hash-a")))
+	require.Nil(t, storer.Has([]byte("hash-b")))
+	require.Nil(t, storer.Has([]byte("hash-c")))
+	require.NotNil(t, storer.Has([]byte("hash-d")), "transactions from other shards should not be persisted")
+
+	pool.RemoveData([]byte("hash-a"), "0")
+	require.NotNil(t, storer.Has([]byte("hash-a")))
+
+	pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-b")}, "0")
+	require.NotNil(t, storer.Has([]byte("hash-b")))
+
+	pool.RemoveDataFromAllShards([]byte("hash-c"))
+	require.NotNil(t, storer.Has([]byte("hash-c")))
+
+	_, ok := pool.SearchFirstData([]byte("hash-d"))
+	require.True(t, ok)
+}
+
+func Test_PersistentTxPool_ShouldNotPersistTransactionsDiscardedByTheCache(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	storer := args.Storer
+	pool, _ := NewPersistentTxPool(args)
+
+	pool.onAdded([]byte("hash-a"), &txcache.WrappedTransaction{
+		Tx:              createTx("alice", 42),
+		TxHash:          []byte("hash-a"),
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+	})
+
+	require.NotNil(t, storer.Has([]byte("hash-a")))
+}
+
+func Test_PersistentTxPool_LoadFromStorageShouldReloadOnlyValidTransactions(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	storer := args.Storer
+	marshalizer := args.Marshalizer
+
+	persistTx := func(txHash string, tx *transaction.Transaction) {
+		buff, _ := marshalizer.Marshal(tx)
+		_ = storer.Put([]byte(txHash), buff)
+	}
+	persistTx("valid", &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Nonce: 5, Value: big.NewInt(10), GasLimit: 10, GasPrice: 1})
+	persistTx("valid-future-nonce", &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("otherBob"), Nonce: 6, Value: big.NewInt(10), GasLimit: 10, GasPrice: 1})
+	persistTx("executed", &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Nonce: 4, Value: big.NewInt(10), GasLimit: 10, GasPrice: 1})
+	persistTx("too-expensive", &transaction.Transaction{SndAddr: []byte("carol"), RcvAddr: []byte("bob"), Nonce: 0, Value: big.NewInt(96), GasLimit: 10, GasPrice: 1})
+	persistTx("affordable-with-fee", &transaction.Transaction{SndAddr: []byte("carol"), RcvAddr: []byte("bob"), Nonce: 1, Value: big.NewInt(95), GasLimit: 10, GasPrice: 1})
+	persistTx("unknown-sender", &transaction.Transaction{SndAddr: []byte("dave"), RcvAddr: []byte("bob"), Nonce: 0, Value: big.NewInt(0)})
+	persistTx("other-shard", &transaction.Transaction{SndAddr: []byte("otherEve"), RcvAddr: []byte("bob"), Nonce: 0, Value: big.NewInt(0)})
+	_ = storer.Put([]byte("garbage"), []byte("not a transaction"))
+
+	alice, _ := state.NewUserAccount([]byte("alice"))
+	alice.Nonce = 5
+	alice.Balance = big.NewInt(100)
+	carol, _ := state.NewUserAccount([]byte("carol"))
+	carol.Balance = big.NewInt(100)
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			switch string(address) {
+			case "alice":
+				return alice, nil
+			case "carol":
+				return carol, nil
+			default:
+				return nil, state.ErrAccNotFound
+			}
+		},
+	}
+
+	pool, _ := NewPersistentTxPool(args)
+	err := pool.LoadFromStorage(nil)
+	require.Equal(t, dataRetriever.ErrNilAccountsAdapter, err)
+
+	err = pool.LoadFromStorage(accounts)
+	require.Nil(t, err)
+	defer func() {
+		_ = pool.Close()
+	}()
+
+	require.Equal(t, int64(3), pool.GetCounts().GetTotal())
+	for _, reloaded := range []string{"valid", "valid-future-nonce", "affordable-with-fee"} {
+		_, ok := pool.SearchFirstData([]byte(reloaded))
+		require.True(t, ok, reloaded)
+		require.Nil(t, storer.Has([]byte(reloaded)), reloaded)
+	}
+	for _, discarded := range []string{"executed", "too-expensive", "unknown-sender", "other-shard", "garbage"} {
+		require.NotNil(t, storer.Has([]byte(discarded)), discarded)
+	}
+}
+
+func Test_PersistentTxPool_SnapshotShouldReconcileTheStorageWithThePool(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	storer := args.Storer
+	pool, _ := NewPersistentTxPool(args)
+
+	pool.AddData([]byte("hash-a"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-b"), createTx("alice", 43), 0, "0_1")
+	_ = storer.Put([]byte("hash-stale"), []byte("stale"))
+	_ = storer.Remove([]byte("hash-b"))
+
+	pool.snapshot()
+
+	require.Nil(t, storer.Has([]byte("hash-a")))
+	require.Nil(t, storer.Has([]byte("hash-b")))
+	require.NotNil(t, storer.Has([]byte("hash-stale")))
+
+	pool.Clear()
+	pool.snapshot()
+
+	require.NotNil(t, storer.Has([]byte("hash-a")))
+	require.NotNil(t, storer.Has([]byte("hash-b")))
+}
+
+func Test_PersistentTxPool_CloseShouldSnapshotOnlyIfLoaded(t *testing.T) {
+	args := createArgPersistentTxPool(t)
+	storer := args.Storer
+	_ = storer.Put([]byte("hash-a"), []byte("not yet loaded"))
+	pool, _ := NewPersistentTxPool(args)
+
+	require.Nil(t, pool.Close())
+	require.Nil(t, storer.Has([]byte("hash-a")))
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, errors.New("missing account")
+		},
+	}
+	require.Nil(t, pool.LoadFromStorage(accounts))
+	pool.AddData([]byte("hash-b"), createTx("alice", 42), 0, "0")
+	pool.ShardDataStore("0").Remove([]byte("hash-b"))
+	require.Nil(t, storer.Has([]byte("hash-b")))
+
+	require.Nil(t, pool.Close())
+	require.NotNil(t, storer.Has([]byte("hash-b")))
+	require.Nil(t, pool.Close())
+}
@@ -96,7 +96,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, MinGasPriceBumpPercentageForReplacement: 10}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -116,6 +116,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.MinGasPriceBumpPercentageForReplacement))
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -0,0 +1,55 @@
+package consensusTrace
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.RoundTraceRecorder = (*disabledRoundTraceRecorder)(nil)
+
+type disabledRoundTraceRecorder struct {
+}
+
+// NewDisabledRoundTraceRecorder returns a disabled instance of the round trace recorder
+func NewDisabledRoundTraceRecorder() *disabledRoundTraceRecorder {
+	return &disabledRoundTraceRecorder{}
+}
+
+// StartRound does nothing
+func (drtr *disabledRoundTraceRecorder) StartRound(_ int64, _ time.Time) {
+}
+
+// SetLeader does nothing
+func (drtr *disabledRoundTraceRecorder) SetLeader(_ int64, _ []byte, _ bool, _ int) {
+}
+
+// ReceivedMessage does nothing
+func (drtr *disabledRoundTraceRecorder) ReceivedMessage(_ int64, _ string, _ []byte) {
+}
+
+// SentMessage does nothing
+func (drtr *disabledRoundTraceRecorder) SentMessage(_ int64, _ string) {
+}
+
+// SignaturesProgress does nothing
+func (drtr *disabledRoundTraceRecorder) SignaturesProgress(_ int64, _ []byte, _ int, _ int) {
+}
+
+// RoundFailed does nothing
+func (drtr *disabledRoundTraceRecorder) RoundFailed(_ int64, _ string) {
+}
+
+// RoundCompleted does nothing
+func (drtr *disabledRoundTraceRecorder) RoundCompleted(_ int64, _ uint64) {
+}
+
+// Close does nothing
+func (drtr *disabledRoundTraceRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drtr *disabledRoundTraceRecorder) IsInterfaceNil() bool {
+	return drtr == nil
+}
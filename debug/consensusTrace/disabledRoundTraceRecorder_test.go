@@ -0,0 +1,25 @@
+package consensusTrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRoundTraceRecorder(t *testing.T) {
+	t.Parallel()
+
+	drtr := NewDisabledRoundTraceRecorder()
+	assert.False(t, check.IfNil(drtr))
+
+	drtr.StartRound(0, time.Time{})
+	drtr.SetLeader(0, nil, false, 0)
+	drtr.ReceivedMessage(0, "", nil)
+	drtr.SentMessage(0, "")
+	drtr.SignaturesProgress(0, nil, 0, 0)
+	drtr.RoundFailed(0, "")
+	drtr.RoundCompleted(0, 0)
+	assert.Nil(t, drtr.Close())
+}
@@ -0,0 +1,137 @@
+package consensusTrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const maxLineSize = 64 * 1024 * 1024
+
+// LoadRoundTraces reads the round traces from the provided trace file or from all the trace files found in the provided
+// directory, keeping only the rounds between fromRound and toRound, inclusive. The traces are sorted by round
+func LoadRoundTraces(path string, fromRound int64, toRound int64) ([]*RoundTrace, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = ListTraceFiles(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	traces := make([]*RoundTrace, 0)
+	for _, file := range files {
+		fileTraces, errLoad := loadRoundTracesFromFile(file, fromRound, toRound)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		traces = append(traces, fileTraces...)
+	}
+
+	sort.SliceStable(traces, func(i, j int) bool {
+		return traces[i].Round < traces[j].Round
+	})
+
+	return traces, nil
+}
+
+func loadRoundTracesFromFile(path string, fromRound int64, toRound int64) ([]*RoundTrace, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := file.Close()
+		log.LogIfError(errClose, "step", "closing consensus trace file")
+	}()
+
+	traces := make([]*RoundTrace, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		trace := &RoundTrace{}
+		err = json.Unmarshal(line, trace)
+		if err != nil {
+			return nil, fmt.Errorf("%w in file %s, line %d", err, path, lineNumber)
+		}
+		if trace.Round < fromRound || trace.Round > toRound {
+			continue
+		}
+
+		traces = append(traces, trace)
+	}
+
+	return traces, scanner.Err()
+}
+
+// DisplayRoundTrace writes the timeline of the round in a human readable form
+func DisplayRoundTrace(writer io.Writer, trace *RoundTrace) error {
+	lines := make([]string, 0, len(trace.Events)+1)
+
+	leader := "unknown"
+	if len(trace.Leader) > 0 {
+		leader = core.GetTrimmedPk(trace.Leader)
+	}
+	if trace.IsSelfLeader {
+		leader += " (self)"
+	}
+	startTime := time.Unix(0, trace.StartTimeMs*int64(time.Millisecond)).UTC()
+	lines = append(lines, fmt.Sprintf("round %d, shard %s, started at %s, leader %s, consensus group size %d: %s",
+		trace.Round,
+		core.GetShardIDString(trace.ShardID),
+		startTime.Format("2006-01-02 15:04:05.000"),
+		leader,
+		trace.ConsensusGroupSize,
+		trace.Status(),
+	))
+
+	for _, event := range trace.Events {
+		lines = append(lines, fmt.Sprintf("  %+9.3fs  %-10s %s", float64(event.OffsetMs)/1000, event.Type, describeEvent(event)))
+	}
+
+	_, err := fmt.Fprintln(writer, strings.Join(lines, "\n"))
+
+	return err
+}
+
+func describeEvent(event *Event) string {
+	description := ""
+	switch event.Type {
+	case EventReceived:
+		description = fmt.Sprintf("%s from %s", event.MessageType, core.GetTrimmedPk(event.PubKey))
+	case EventSent:
+		description = event.MessageType
+	case EventSignatures:
+		description = fmt.Sprintf("%d/%d, bitmap %s", event.NumSignatures, event.Threshold, event.Bitmap)
+	case EventFailure:
+		description = event.Reason
+	case EventCompleted:
+		description = fmt.Sprintf("block with nonce %d committed", event.Nonce)
+	}
+
+	if event.Round != 0 {
+		description += fmt.Sprintf(" [for round %d]", event.Round)
+	}
+
+	return description
+}
@@ -0,0 +1,95 @@
+package consensusTrace
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTraceFile(t *testing.T, dir string, fileName string, lines ...string) string {
+	path := filepath.Join(dir, fileName)
+	err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), os.ModePerm)
+	require.Nil(t, err)
+
+	return path
+}
+
+func TestLoadRoundTraces_ShouldFilterAndSortTheRounds(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	writeTraceFile(t, dir, "consensus-trace-b.json", `{"round":4,"events":[]}`, `{"round":5,"events":[]}`)
+	file := writeTraceFile(t, dir, "consensus-trace-a.json", `{"round":2,"events":[]}`, "", `{"round":3,"events":[]}`)
+	writeTraceFile(t, dir, "other.json", `{"round":1,"events":[]}`)
+
+	traces, err := LoadRoundTraces(dir, 3, 4)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(traces))
+	assert.Equal(t, int64(3), traces[0].Round)
+	assert.Equal(t, int64(4), traces[1].Round)
+
+	traces, err = LoadRoundTraces(file, 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(traces))
+	assert.Equal(t, int64(2), traces[0].Round)
+}
+
+func TestLoadRoundTraces_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	file := writeTraceFile(t, dir, "consensus-trace-a.json", `{"round":2,"events":[]}`, "not a trace")
+
+	traces, err := LoadRoundTraces(file, 0, 100)
+	assert.Nil(t, traces)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+
+	traces, err = LoadRoundTraces(filepath.Join(dir, "missing"), 0, 100)
+	assert.Nil(t, traces)
+	assert.NotNil(t, err)
+}
+
+func TestDisplayRoundTrace(t *testing.T) {
+	t.Parallel()
+
+	trace := &RoundTrace{
+		Round:              10,
+		ShardID:            1,
+		Leader:             "6c6561646572",
+		IsSelfLeader:       true,
+		ConsensusGroupSize: 63,
+		Events: []*Event{
+			{OffsetMs: 150, Type: EventSent, MessageType: "(BLOCK_BODY_AND_HEADER)"},
+			{OffsetMs: 1200, Type: EventSignatures, NumSignatures: 2, Threshold: 43, Bitmap: "03"},
+			{OffsetMs: 5200, Type: EventFailure, Reason: "round has been canceled"},
+			{OffsetMs: 5300, Type: EventReceived, MessageType: "(BLOCK_HEADER)", PubKey: "6c6561646572", Round: 11},
+		},
+	}
+
+	buff := &bytes.Buffer{}
+	err := DisplayRoundTrace(buff, trace)
+	require.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 5, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "round 10, shard 1, "))
+	assert.True(t, strings.HasSuffix(lines[0], "(self), consensus group size 63: FAILED"))
+	assert.Contains(t, lines[1], "+0.150s")
+	assert.Contains(t, lines[1], "(BLOCK_BODY_AND_HEADER)")
+	assert.Contains(t, lines[2], "2/43, bitmap 03")
+	assert.Contains(t, lines[3], "round has been canceled")
+	assert.Contains(t, lines[4], "[for round 11]")
+}
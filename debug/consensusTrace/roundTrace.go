@@ -0,0 +1,59 @@
+package consensusTrace
+
+// EventType defines the kind of an event recorded in the timeline of a consensus round
+type EventType string
+
+const (
+	// EventReceived marks a consensus message received from the network
+	EventReceived EventType = "received"
+	// EventSent marks a consensus message sent by the node
+	EventSent EventType = "sent"
+	// EventSignatures marks the progress of the signatures collected by the leader
+	EventSignatures EventType = "signatures"
+	// EventFailure marks a reason for which the round could not produce a block
+	EventFailure EventType = "failure"
+	// EventCompleted marks the commit of the block produced in the round
+	EventCompleted EventType = "completed"
+)
+
+// Event is an entry in the timeline of a consensus round
+type Event struct {
+	OffsetMs      int64     `json:"offsetMs"`
+	Type          EventType `json:"type"`
+	Round         int64     `json:"round,omitempty"`
+	MessageType   string    `json:"msg,omitempty"`
+	PubKey        string    `json:"pubKey,omitempty"`
+	NumSignatures int       `json:"signatures,omitempty"`
+	Threshold     int       `json:"threshold,omitempty"`
+	Bitmap        string    `json:"bitmap,omitempty"`
+	Reason        string    `json:"reason,omitempty"`
+	Nonce         uint64    `json:"nonce,omitempty"`
+}
+
+// RoundTrace holds the timeline of a consensus round. It is written as a single json line in the trace files.
+// The events offsets are in milliseconds, relative to the round start, and the events round is set only for the
+// messages belonging to other rounds than the traced one
+type RoundTrace struct {
+	Round              int64    `json:"round"`
+	ShardID            uint32   `json:"shard"`
+	StartTimeMs        int64    `json:"startMs"`
+	Leader             string   `json:"leader,omitempty"`
+	IsSelfLeader       bool     `json:"selfLeader,omitempty"`
+	ConsensusGroupSize int      `json:"groupSize,omitempty"`
+	Events             []*Event `json:"events"`
+}
+
+// Status returns the outcome of the round: completed, failed or unfinished if the node did not see either of them
+func (rt *RoundTrace) Status() string {
+	status := "UNFINISHED"
+	for _, event := range rt.Events {
+		switch event.Type {
+		case EventCompleted:
+			return "COMPLETED"
+		case EventFailure:
+			status = "FAILED"
+		}
+	}
+
+	return status
+}
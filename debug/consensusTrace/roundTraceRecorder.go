@@ -0,0 +1,309 @@
+package consensusTrace
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+const (
+	// FilePrefix is the prefix of the trace files
+	FilePrefix = "consensus-trace"
+	// FileExtension is the extension of the trace files
+	FileExtension = "json"
+
+	minRoundsPerFile = 1
+	minNumFiles      = 1
+	fileTimeFormat   = "2006-01-02-15-04-05"
+)
+
+var log = logger.GetOrCreate("debug/consensustrace")
+
+var _ consensus.RoundTraceRecorder = (*roundTraceRecorder)(nil)
+
+// ArgRoundTraceRecorder is the argument for the round trace recorder constructor
+type ArgRoundTraceRecorder struct {
+	Config     config.ConsensusTraceDebugConfig
+	WorkingDir string
+	ShardID    uint32
+	SyncTimer  ntp.SyncTimer
+}
+
+// roundTraceRecorder keeps the timeline of the current round in memory and appends it to the current trace file when
+// the next round starts
+type roundTraceRecorder struct {
+	syncTimer     ntp.SyncTimer
+	shardID       uint32
+	directory     string
+	roundsPerFile int
+	maxNumFiles   int
+
+	mut             sync.Mutex
+	current         *RoundTrace
+	roundStart      time.Time
+	file            *os.File
+	numRoundsInFile int
+	isClosed        bool
+}
+
+// NewRoundTraceRecorder creates a recorder which writes the consensus rounds timelines in rotating files
+func NewRoundTraceRecorder(args ArgRoundTraceRecorder) (*roundTraceRecorder, error) {
+	if check.IfNil(args.SyncTimer) {
+		return nil, debug.ErrNilSyncTimer
+	}
+	if args.Config.RoundsPerFile < minRoundsPerFile {
+		return nil, fmt.Errorf("%w for RoundsPerFile, minimum is %d", debug.ErrInvalidValue, minRoundsPerFile)
+	}
+	if args.Config.MaxNumFiles < minNumFiles {
+		return nil, fmt.Errorf("%w for MaxNumFiles, minimum is %d", debug.ErrInvalidValue, minNumFiles)
+	}
+
+	directory, err := filepath.Abs(filepath.Join(args.WorkingDir, args.Config.FolderPath))
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundTraceRecorder{
+		syncTimer:     args.SyncTimer,
+		shardID:       args.ShardID,
+		directory:     directory,
+		roundsPerFile: args.Config.RoundsPerFile,
+		maxNumFiles:   args.Config.MaxNumFiles,
+	}, nil
+}
+
+// StartRound writes the timeline of the previous round and starts the timeline of the provided round
+func (rtr *roundTraceRecorder) StartRound(round int64, roundTimeStamp time.Time) {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	if rtr.isClosed {
+		return
+	}
+
+	rtr.writeCurrentRound()
+	rtr.current = &RoundTrace{
+		Round:       round,
+		ShardID:     rtr.shardID,
+		StartTimeMs: roundTimeStamp.UnixNano() / int64(time.Millisecond),
+		Events:      make([]*Event, 0),
+	}
+	rtr.roundStart = roundTimeStamp
+}
+
+// SetLeader records the leader and the size of the consensus group of the current round
+func (rtr *roundTraceRecorder) SetLeader(round int64, leader []byte, isSelfLeader bool, consensusGroupSize int) {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	if rtr.current == nil || rtr.current.Round != round {
+		return
+	}
+
+	rtr.current.Leader = hex.EncodeToString(leader)
+	rtr.current.IsSelfLeader = isSelfLeader
+	rtr.current.ConsensusGroupSize = consensusGroupSize
+}
+
+// ReceivedMessage records a consensus message received from the network
+func (rtr *roundTraceRecorder) ReceivedMessage(round int64, messageType string, pubKey []byte) {
+	rtr.addEvent(round, &Event{
+		Type:        EventReceived,
+		MessageType: messageType,
+		PubKey:      hex.EncodeToString(pubKey),
+	})
+}
+
+// SentMessage records a consensus message sent by the node
+func (rtr *roundTraceRecorder) SentMessage(round int64, messageType string) {
+	rtr.addEvent(round, &Event{
+		Type:        EventSent,
+		MessageType: messageType,
+	})
+}
+
+// SignaturesProgress records the signatures collected so far by the leader
+func (rtr *roundTraceRecorder) SignaturesProgress(round int64, bitmap []byte, numSignatures int, threshold int) {
+	rtr.addEvent(round, &Event{
+		Type:          EventSignatures,
+		NumSignatures: numSignatures,
+		Threshold:     threshold,
+		Bitmap:        hex.EncodeToString(bitmap),
+	})
+}
+
+// RoundFailed records a reason for which the round could not produce a block. A reason repeated right after itself is
+// recorded only once, as the consensus checks are retried until the subround times out
+func (rtr *roundTraceRecorder) RoundFailed(round int64, reason string) {
+	rtr.addEvent(round, &Event{
+		Type:   EventFailure,
+		Reason: reason,
+	})
+}
+
+// RoundCompleted records the commit of the block produced in the round
+func (rtr *roundTraceRecorder) RoundCompleted(round int64, nonce uint64) {
+	rtr.addEvent(round, &Event{
+		Type:  EventCompleted,
+		Nonce: nonce,
+	})
+}
+
+func (rtr *roundTraceRecorder) addEvent(round int64, event *Event) {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	if rtr.current == nil {
+		return
+	}
+
+	if round != rtr.current.Round {
+		event.Round = round
+	}
+	if event.Type == EventFailure && rtr.isLastFailure(event) {
+		return
+	}
+
+	event.OffsetMs = rtr.syncTimer.CurrentTime().Sub(rtr.roundStart).Milliseconds()
+	rtr.current.Events = append(rtr.current.Events, event)
+}
+
+func (rtr *roundTraceRecorder) isLastFailure(event *Event) bool {
+	numEvents := len(rtr.current.Events)
+	if numEvents == 0 {
+		return false
+	}
+
+	lastEvent := rtr.current.Events[numEvents-1]
+
+	return lastEvent.Type == EventFailure && lastEvent.Reason == event.Reason && lastEvent.Round == event.Round
+}
+
+func (rtr *roundTraceRecorder) writeCurrentRound() {
+	if rtr.current == nil {
+		return
+	}
+
+	buff, err := json.Marshal(rtr.current)
+	if err != nil {
+		log.Debug("roundTraceRecorder: marshal round trace", "round", rtr.current.Round, "error", err)
+		return
+	}
+
+	if rtr.file == nil || rtr.numRoundsInFile >= rtr.roundsPerFile {
+		err = rtr.createNewFile()
+		if err != nil {
+			log.Debug("roundTraceRecorder: create trace file", "error", err)
+			return
+		}
+	}
+
+	_, err = rtr.file.Write(append(buff, '\n'))
+	if err != nil {
+		log.Debug("roundTraceRecorder: write round trace", "round", rtr.current.Round, "error", err)
+		return
+	}
+	rtr.numRoundsInFile++
+}
+
+// createNewFile closes the current trace file and starts a new one, named after the current time and the first round
+// written in it, so that the files are sorted by their names. The oldest files are removed
+func (rtr *roundTraceRecorder) createNewFile() error {
+	rtr.closeFile()
+
+	fileName := fmt.Sprintf("%s-%s-round-%010d.%s",
+		FilePrefix,
+		time.Now().Format(fileTimeFormat),
+		rtr.current.Round,
+		FileExtension,
+	)
+	file, err := os.OpenFile(
+		filepath.Join(rtr.directory, fileName),
+		os.O_CREATE|os.O_APPEND|os.O_WRONLY,
+		core.FileModeUserReadWrite,
+	)
+	if err != nil {
+		return err
+	}
+
+	rtr.file = file
+	rtr.numRoundsInFile = 0
+	rtr.removeOldFiles()
+
+	return nil
+}
+
+func (rtr *roundTraceRecorder) removeOldFiles() {
+	files, err := ListTraceFiles(rtr.directory)
+	if err != nil {
+		log.Debug("roundTraceRecorder: list trace files", "error", err)
+		return
+	}
+
+	for len(files) > rtr.maxNumFiles {
+		err = os.Remove(files[0])
+		log.LogIfError(err, "step", "removing old consensus trace file")
+		files = files[1:]
+	}
+}
+
+func (rtr *roundTraceRecorder) closeFile() {
+	if rtr.file == nil {
+		return
+	}
+
+	err := rtr.file.Close()
+	log.LogIfError(err, "step", "closing consensus trace file")
+	rtr.file = nil
+}
+
+// Close writes the timeline of the current round and closes the trace file
+func (rtr *roundTraceRecorder) Close() error {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	if rtr.isClosed {
+		return nil
+	}
+
+	rtr.writeCurrentRound()
+	rtr.current = nil
+	rtr.closeFile()
+	rtr.isClosed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtr *roundTraceRecorder) IsInterfaceNil() bool {
+	return rtr == nil
+}
+
+// ListTraceFiles returns the trace files found in the provided directory, from the oldest to the newest
+func ListTraceFiles(directory string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(directory, FilePrefix+"-*."+FileExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
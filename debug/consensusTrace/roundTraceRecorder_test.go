@@ -0,0 +1,171 @@
+package consensusTrace
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/debug/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var roundTimeStamp = time.Unix(1600000000, 0)
+
+func createMockArgRoundTraceRecorder(workingDir string, currentTime *time.Time) ArgRoundTraceRecorder {
+	return ArgRoundTraceRecorder{
+		Config: config.ConsensusTraceDebugConfig{
+			Enabled:       true,
+			FolderPath:    "consensus-trace",
+			RoundsPerFile: 10,
+			MaxNumFiles:   2,
+		},
+		WorkingDir: workingDir,
+		ShardID:    1,
+		SyncTimer: &mock.SyncTimerStub{
+			CurrentTimeCalled: func() time.Time {
+				return *currentTime
+			},
+		},
+	}
+}
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "consensustrace_temp")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func TestNewRoundTraceRecorder(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	currentTime := roundTimeStamp
+
+	args := createMockArgRoundTraceRecorder(dir, &currentTime)
+	args.SyncTimer = nil
+	rtr, err := NewRoundTraceRecorder(args)
+	assert.True(t, check.IfNil(rtr))
+	assert.Equal(t, debug.ErrNilSyncTimer, err)
+
+	args = createMockArgRoundTraceRecorder(dir, &currentTime)
+	args.Config.RoundsPerFile = 0
+	rtr, err = NewRoundTraceRecorder(args)
+	assert.True(t, check.IfNil(rtr))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	args = createMockArgRoundTraceRecorder(dir, &currentTime)
+	args.Config.MaxNumFiles = 0
+	rtr, err = NewRoundTraceRecorder(args)
+	assert.True(t, check.IfNil(rtr))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	args = createMockArgRoundTraceRecorder(dir, &currentTime)
+	rtr, err = NewRoundTraceRecorder(args)
+	assert.False(t, check.IfNil(rtr))
+	assert.Nil(t, err)
+	assert.DirExists(t, filepath.Join(dir, "consensus-trace"))
+}
+
+func TestRoundTraceRecorder_ShouldWriteTheRoundsTimelines(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	currentTime := roundTimeStamp
+	rtr, _ := NewRoundTraceRecorder(createMockArgRoundTraceRecorder(dir, &currentTime))
+
+	// events received before the first round are ignored
+	rtr.ReceivedMessage(9, "(BLOCK_BODY_AND_HEADER)", []byte("leader"))
+
+	rtr.StartRound(10, roundTimeStamp)
+	rtr.SetLeader(10, []byte("leader"), true, 63)
+	currentTime = roundTimeStamp.Add(150 * time.Millisecond)
+	rtr.SentMessage(10, "(BLOCK_BODY_AND_HEADER)")
+	currentTime = roundTimeStamp.Add(1200 * time.Millisecond)
+	rtr.ReceivedMessage(10, "(SIGNATURE)", []byte("validator"))
+	rtr.SignaturesProgress(10, []byte{3}, 2, 43)
+	rtr.RoundCompleted(10, 7)
+
+	rtr.StartRound(11, roundTimeStamp.Add(4*time.Second))
+	currentTime = roundTimeStamp.Add(5 * time.Second)
+	rtr.RoundFailed(11, "node is not synchronized")
+	rtr.RoundFailed(11, "node is not synchronized")
+	rtr.ReceivedMessage(12, "(BLOCK_BODY_AND_HEADER)", []byte("next leader"))
+
+	err := rtr.Close()
+	assert.Nil(t, err)
+
+	// events received after close are ignored
+	rtr.StartRound(12, roundTimeStamp.Add(8*time.Second))
+	rtr.RoundFailed(12, "node is not synchronized")
+
+	traces, err := LoadRoundTraces(filepath.Join(dir, "consensus-trace"), 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(traces))
+
+	expectedFirst := &RoundTrace{
+		Round:              10,
+		ShardID:            1,
+		StartTimeMs:        roundTimeStamp.UnixNano() / int64(time.Millisecond),
+		Leader:             "6c6561646572",
+		IsSelfLeader:       true,
+		ConsensusGroupSize: 63,
+		Events: []*Event{
+			{OffsetMs: 150, Type: EventSent, MessageType: "(BLOCK_BODY_AND_HEADER)"},
+			{OffsetMs: 1200, Type: EventReceived, MessageType: "(SIGNATURE)", PubKey: "76616c696461746f72"},
+			{OffsetMs: 1200, Type: EventSignatures, NumSignatures: 2, Threshold: 43, Bitmap: "03"},
+			{OffsetMs: 1200, Type: EventCompleted, Nonce: 7},
+		},
+	}
+	assert.Equal(t, expectedFirst, traces[0])
+	assert.Equal(t, "COMPLETED", traces[0].Status())
+
+	require.Equal(t, 2, len(traces[1].Events))
+	assert.Equal(t, &Event{OffsetMs: 1000, Type: EventFailure, Reason: "node is not synchronized"}, traces[1].Events[0])
+	assert.Equal(t, int64(12), traces[1].Events[1].Round)
+	assert.Equal(t, "FAILED", traces[1].Status())
+}
+
+func TestRoundTraceRecorder_ShouldRotateTheTraceFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	currentTime := roundTimeStamp
+	args := createMockArgRoundTraceRecorder(dir, &currentTime)
+	args.Config.RoundsPerFile = 2
+	args.Config.MaxNumFiles = 2
+	rtr, _ := NewRoundTraceRecorder(args)
+
+	for round := int64(1); round <= 7; round++ {
+		rtr.StartRound(round, roundTimeStamp)
+	}
+	_ = rtr.Close()
+
+	directory := filepath.Join(dir, "consensus-trace")
+	files, err := ListTraceFiles(directory)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(files))
+
+	traces, err := LoadRoundTraces(directory, 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(traces))
+	assert.Equal(t, int64(5), traces[0].Round)
+	assert.Equal(t, int64(7), traces[2].Round)
+	assert.Equal(t, "UNFINISHED", traces[0].Status())
+}
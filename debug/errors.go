@@ -4,3 +4,6 @@ import "errors"
 
 // ErrInvalidValue signals that the provided value is invalid
 var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
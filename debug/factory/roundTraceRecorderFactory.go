@@ -0,0 +1,15 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+)
+
+// NewRoundTraceRecorderFactory will instantiate a RoundTraceRecorder based on the provided config
+func NewRoundTraceRecorderFactory(args consensusTrace.ArgRoundTraceRecorder) (consensus.RoundTraceRecorder, error) {
+	if !args.Config.Enabled {
+		return consensusTrace.NewDisabledRoundTraceRecorder(), nil
+	}
+
+	return consensusTrace.NewRoundTraceRecorder(args)
+}
@@ -0,0 +1,53 @@
+package factory
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	"github.com/ElrondNetwork/elrond-go/debug/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRoundTraceRecorderFactory_DisabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rtr, err := NewRoundTraceRecorderFactory(
+		consensusTrace.ArgRoundTraceRecorder{
+			Config: config.ConsensusTraceDebugConfig{
+				Enabled: false,
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+	expected := consensusTrace.NewDisabledRoundTraceRecorder()
+	assert.IsType(t, expected, rtr)
+}
+
+func TestNewRoundTraceRecorderFactory_RoundTraceRecorder(t *testing.T) {
+	t.Parallel()
+
+	workingDir, _ := ioutil.TempDir("", "consensustrace_temp")
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := consensusTrace.ArgRoundTraceRecorder{
+		Config: config.ConsensusTraceDebugConfig{
+			Enabled:       true,
+			FolderPath:    "consensus-trace",
+			RoundsPerFile: 1,
+			MaxNumFiles:   1,
+		},
+		WorkingDir: workingDir,
+		SyncTimer:  &mock.SyncTimerStub{},
+	}
+	rtr, err := NewRoundTraceRecorderFactory(args)
+
+	assert.Nil(t, err)
+	expected, _ := consensusTrace.NewRoundTraceRecorder(args)
+	assert.IsType(t, expected, rtr)
+}
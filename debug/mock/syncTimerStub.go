@@ -0,0 +1,43 @@
+package mock
+
+import (
+	"time"
+)
+
+// SyncTimerStub -
+type SyncTimerStub struct {
+	CurrentTimeCalled func() time.Time
+}
+
+// StartSyncingTime -
+func (sts *SyncTimerStub) StartSyncingTime() {
+}
+
+// ClockOffset -
+func (sts *SyncTimerStub) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime -
+func (sts *SyncTimerStub) FormattedCurrentTime() string {
+	return sts.CurrentTime().String()
+}
+
+// CurrentTime -
+func (sts *SyncTimerStub) CurrentTime() time.Time {
+	if sts.CurrentTimeCalled != nil {
+		return sts.CurrentTimeCalled()
+	}
+
+	return time.Now()
+}
+
+// Close -
+func (sts *SyncTimerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (sts *SyncTimerStub) IsInterfaceNil() bool {
+	return sts == nil
+}
@@ -97,6 +97,11 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte, _ context.Context) (map[str
 	return nil, nil
 }
 
+// GetTrie -
+func (a *accountsAdapter) GetTrie(_ []byte) (data.Trie, error) {
+	return nil, nil
+}
+
 // GetNumCheckpoints -
 func (a *accountsAdapter) GetNumCheckpoints() uint32 {
 	return 0
@@ -16,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -46,6 +48,7 @@ type ArgsEpochStartInterceptorContainer struct {
 	MinTransactionVersion     uint32
 	HeaderIntegrityVerifier   process.HeaderIntegrityVerifier
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -66,6 +69,8 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 	sizeCheckDelta := 0
 	validityAttester := disabled.NewValidityAttester()
 	epochStartTrigger := disabled.NewEpochStartTrigger()
+	guardedAccounts := guardian.NewDisabledGuardedAccount()
+	multisigAccounts := multisig.NewDisabledMultisigAccount()
 
 	containerFactoryArgs := interceptorscontainer.MetaInterceptorsContainerFactoryArgs{
 		ShardCoordinator:          args.ShardCoordinator,
@@ -98,8 +103,11 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		MultiRelayedTxEnableEpoch: args.MultiRelayedTxEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
+		GuardedAccounts:           guardedAccounts,
+		MultisigAccounts:          multisigAccounts,
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
@@ -105,6 +105,7 @@ type epochStartBootstrap struct {
 	statusHandler              core.AppStatusHandler
 	headerIntegrityVerifier    process.HeaderIntegrityVerifier
 	enableSignTxWithHashEpoch  uint32
+	multiRelayedTxEnableEpoch  uint32
 	txSignHasher               hashing.Hasher
 	epochNotifier              process.EpochNotifier
 
@@ -221,6 +222,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		headerIntegrityVerifier:    args.HeaderIntegrityVerifier,
 		txSignHasher:               args.TxSignHasher,
 		enableSignTxWithHashEpoch:  args.GeneralConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		multiRelayedTxEnableEpoch:  args.GeneralConfig.GeneralSettings.MultiRelayedTransactionsEnableEpoch,
 		epochNotifier:              args.EpochNotifier,
 	}
 
@@ -511,6 +513,7 @@ func (e *epochStartBootstrap) createSyncers() error {
 		MinTransactionVersion:     e.genesisNodesConfig.GetMinTransactionVersion(),
 		HeaderIntegrityVerifier:   e.headerIntegrityVerifier,
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
+		MultiRelayedTxEnableEpoch: e.multiRelayedTxEnableEpoch,
 		TxSignHasher:              e.txSignHasher,
 		EpochNotifier:             e.epochNotifier,
 	}
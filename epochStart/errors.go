@@ -284,5 +284,32 @@ var ErrUnStakeExecuteError = errors.New("unstake execution error")
 // ErrSystemValidatorSCCall signals that system validator sc call failed
 var ErrSystemValidatorSCCall = errors.New("system validator sc call failed")
 
+// ErrSystemGovernanceSCCall signals that system governance sc call failed
+var ErrSystemGovernanceSCCall = errors.New("system governance sc call failed")
+
+// ErrNilGovernanceActionsHandler signals that a nil governance actions handler was provided
+var ErrNilGovernanceActionsHandler = errors.New("nil governance actions handler")
+
+// ErrNilGasScheduleChanger signals that a nil gas schedule changer was provided
+var ErrNilGasScheduleChanger = errors.New("nil gas schedule changer")
+
+// ErrNilEconomicsFeeSetter signals that a nil economics fee setter was provided
+var ErrNilEconomicsFeeSetter = errors.New("nil economics fee setter")
+
+// ErrNilHardforkTrigger signals that a nil hardfork trigger was provided
+var ErrNilHardforkTrigger = errors.New("nil hardfork trigger")
+
+// ErrInvalidGovernanceActionArguments signals that the arguments of a governance action are invalid
+var ErrInvalidGovernanceActionArguments = errors.New("invalid governance action arguments")
+
+// ErrUnknownGovernanceAction signals that an unknown governance action was provided
+var ErrUnknownGovernanceAction = errors.New("unknown governance action")
+
+// ErrGasScheduleEntryNotFound signals that the gas schedule entry changed by a governance action was not found
+var ErrGasScheduleEntryNotFound = errors.New("gas schedule entry not found")
+
 // ErrOwnerDoesntHaveEligibleNodesInEpoch signals that the owner doesn't have any eligible nodes in epoch
 var ErrOwnerDoesntHaveEligibleNodesInEpoch = errors.New("owner has no eligible nodes in epoch")
+
+// ErrSystemStakingSCCall signals that system staking sc call failed
+var ErrSystemStakingSCCall = errors.New("system staking sc call failed")
@@ -146,6 +146,33 @@ type TransactionCacher interface {
 	IsInterfaceNil() bool
 }
 
+// GovernanceActionsHandler applies the actions of the executed governance proposals which are not contained in the
+// governance system smart contract itself
+type GovernanceActionsHandler interface {
+	ProcessGovernanceAction(actionType string, arguments [][]byte, epoch uint32) error
+	AppliedConfigChanges() []block.GovernanceConfigChange
+	IsInterfaceNil() bool
+}
+
+// GasScheduleChanger is able to provide and change the gas schedule used by the node
+type GasScheduleChanger interface {
+	LatestGasSchedule() map[string]map[string]uint64
+	ChangeGasSchedule(gasSchedule map[string]map[string]uint64)
+	IsInterfaceNil() bool
+}
+
+// EconomicsFeeSetter is able to change the economics fee parameters
+type EconomicsFeeSetter interface {
+	SetFeeParameter(parameter string, value uint64) error
+	IsInterfaceNil() bool
+}
+
+// HardforkTrigger is able to trigger a hardfork
+type HardforkTrigger interface {
+	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
+	IsInterfaceNil() bool
+}
+
 // StakingDataProvider is able to provide staking data from the system smart contracts
 type StakingDataProvider interface {
 	GetTotalStakeEligibleNodes() *big.Int
@@ -0,0 +1,355 @@
+package metachain
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const governanceConversionBase = 10
+
+// ArgsGovernanceActionsHandler defines the arguments needed to create a new governance actions handler
+type ArgsGovernanceActionsHandler struct {
+	GasScheduleNotifier epochStart.GasScheduleChanger
+	EconomicsData       epochStart.EconomicsFeeSetter
+	MetaBlockStorage    storage.Storer
+	Marshalizer         marshal.Marshalizer
+	EpochNotifier       process.EpochNotifier
+}
+
+type governanceActionsHandler struct {
+	gasScheduleNotifier epochStart.GasScheduleChanger
+	economicsData       epochStart.EconomicsFeeSetter
+	metaBlockStorage    storage.Storer
+	marshalizer         marshal.Marshalizer
+	mutHardforkTrigger  sync.RWMutex
+	hardforkTrigger     epochStart.HardforkTrigger
+	mutConfigChanges    sync.RWMutex
+	configChanges       []block.GovernanceConfigChange
+}
+
+// NewGovernanceActionsHandler creates a handler which applies the actions of the executed governance proposals on the
+// gas schedule, on the economics data and on the hardfork trigger. The gas schedule and economics changes are carried
+// by the epoch start metablocks, so every node applies them at the start of the epoch and loads them at node start
+func NewGovernanceActionsHandler(args ArgsGovernanceActionsHandler) (*governanceActionsHandler, error) {
+	if check.IfNil(args.GasScheduleNotifier) {
+		return nil, epochStart.ErrNilGasScheduleChanger
+	}
+	if check.IfNil(args.EconomicsData) {
+		return nil, epochStart.ErrNilEconomicsFeeSetter
+	}
+	if check.IfNil(args.MetaBlockStorage) {
+		return nil, epochStart.ErrNilMetaBlockStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, epochStart.ErrNilEpochNotifier
+	}
+
+	gah := &governanceActionsHandler{
+		gasScheduleNotifier: args.GasScheduleNotifier,
+		economicsData:       args.EconomicsData,
+		metaBlockStorage:    args.MetaBlockStorage,
+		marshalizer:         args.Marshalizer,
+		configChanges:       make([]block.GovernanceConfigChange, 0),
+	}
+	args.EpochNotifier.RegisterNotifyHandler(gah)
+
+	return gah, nil
+}
+
+// SetHardforkTrigger sets the hardfork trigger used by the hardfork proposals. The trigger is created after the
+// block processing components, so it can not be provided in the constructor
+func (gah *governanceActionsHandler) SetHardforkTrigger(hardforkTrigger epochStart.HardforkTrigger) error {
+	if check.IfNil(hardforkTrigger) {
+		return epochStart.ErrNilHardforkTrigger
+	}
+
+	gah.mutHardforkTrigger.Lock()
+	gah.hardforkTrigger = hardforkTrigger
+	gah.mutHardforkTrigger.Unlock()
+
+	return nil
+}
+
+// ProcessGovernanceAction applies the action of an executed governance proposal. The returned error is recorded in
+// the governance system smart contract, so only deterministic failures are returned
+func (gah *governanceActionsHandler) ProcessGovernanceAction(actionType string, arguments [][]byte, epoch uint32) error {
+	log.Debug("governance action executed", "action", actionType, "num arguments", len(arguments), "epoch", epoch)
+
+	if actionType == systemSmartContracts.GovernanceActionHardFork {
+		return gah.triggerHardfork(arguments)
+	}
+
+	err := gah.applyConfigChange(actionType, arguments)
+	if err != nil {
+		return err
+	}
+
+	gah.recordConfigChange(actionType, arguments)
+
+	return nil
+}
+
+func (gah *governanceActionsHandler) applyConfigChange(actionType string, arguments [][]byte) error {
+	switch actionType {
+	case systemSmartContracts.GovernanceActionChangeGasSchedule:
+		return gah.changeGasSchedule(arguments)
+	case systemSmartContracts.GovernanceActionChangeEconomics:
+		return gah.changeEconomics(arguments)
+	default:
+		return fmt.Errorf("%w %s", epochStart.ErrUnknownGovernanceAction, actionType)
+	}
+}
+
+// recordConfigChange keeps only the latest change of each gas schedule entry and economics parameter. The last
+// argument of both actions is the new value, the ones before it select the changed entry
+func (gah *governanceActionsHandler) recordConfigChange(actionType string, arguments [][]byte) {
+	newChange := block.GovernanceConfigChange{
+		ActionType:      []byte(actionType),
+		ActionArguments: arguments,
+	}
+
+	gah.mutConfigChanges.Lock()
+	defer gah.mutConfigChanges.Unlock()
+
+	for i, change := range gah.configChanges {
+		if isSameConfigEntry(change, newChange) {
+			gah.configChanges[i] = newChange
+			return
+		}
+	}
+	gah.configChanges = append(gah.configChanges, newChange)
+}
+
+func isSameConfigEntry(first block.GovernanceConfigChange, second block.GovernanceConfigChange) bool {
+	if !bytes.Equal(first.ActionType, second.ActionType) {
+		return false
+	}
+	if len(first.ActionArguments) != len(second.ActionArguments) || len(first.ActionArguments) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(first.ActionArguments)-1; i++ {
+		if !bytes.Equal(first.ActionArguments[i], second.ActionArguments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AppliedConfigChanges returns all the gas schedule and economics changes applied by the executed governance
+// proposals. The metachain saves them in every epoch start metablock
+func (gah *governanceActionsHandler) AppliedConfigChanges() []block.GovernanceConfigChange {
+	gah.mutConfigChanges.RLock()
+	defer gah.mutConfigChanges.RUnlock()
+
+	configChanges := make([]block.GovernanceConfigChange, len(gah.configChanges))
+	copy(configChanges, gah.configChanges)
+
+	return configChanges
+}
+
+// ApplyConfigChanges applies the gas schedule and economics changes saved in an epoch start metablock. The metablock
+// holds all the changes applied since genesis, so they replace the ones known by the node
+func (gah *governanceActionsHandler) ApplyConfigChanges(configChanges []block.GovernanceConfigChange) {
+	for _, change := range configChanges {
+		err := gah.applyConfigChange(string(change.ActionType), change.ActionArguments)
+		if err != nil {
+			log.Warn("governance: config change not applied",
+				"action", change.ActionType, "num arguments", len(change.ActionArguments), "error", err)
+		}
+	}
+
+	gah.mutConfigChanges.Lock()
+	gah.configChanges = make([]block.GovernanceConfigChange, len(configChanges))
+	copy(gah.configChanges, configChanges)
+	gah.mutConfigChanges.Unlock()
+}
+
+// LoadConfigChanges applies the gas schedule and economics changes saved in the start of epoch metablock of the
+// provided epoch. It is called at node start, as the changes are not part of the node's configuration files
+func (gah *governanceActionsHandler) LoadConfigChanges(epoch uint32) error {
+	if epoch == 0 {
+		return nil
+	}
+
+	epochStartIdentifier := core.EpochStartIdentifier(epoch)
+	metaBlockBytes, err := gah.metaBlockStorage.SearchFirst([]byte(epochStartIdentifier))
+	if err != nil {
+		return fmt.Errorf("%w while loading the governance config changes for epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = gah.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return err
+	}
+
+	gah.ApplyConfigChanges(metaBlock.EpochStart.GovernanceConfigChanges)
+	log.Debug("governance: config changes loaded", "epoch", epoch, "num changes", len(metaBlock.EpochStart.GovernanceConfigChanges))
+
+	return nil
+}
+
+// EpochStartAction applies the governance config changes at the start of the epoch. The metachain receives the epoch
+// start metablock, while the shards receive their own epoch start block and read the metablock from the storage
+func (gah *governanceActionsHandler) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	metaBlock, ok := hdr.(*block.MetaBlock)
+	if ok {
+		gah.ApplyConfigChanges(metaBlock.EpochStart.GovernanceConfigChanges)
+		return
+	}
+
+	err := gah.LoadConfigChanges(hdr.GetEpoch())
+	if err != nil {
+		log.Warn("governance: config changes not applied at epoch start", "epoch", hdr.GetEpoch(), "error", err)
+	}
+}
+
+// EpochStartPrepare does nothing, the changes are applied only when the epoch start block is processed
+func (gah *governanceActionsHandler) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (gah *governanceActionsHandler) NotifyOrder() uint32 {
+	return core.GovernanceOrder
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed. A new gas schedule version replaces the whole gas
+// schedule, so the gas schedule changes applied by governance are set again
+func (gah *governanceActionsHandler) EpochConfirmed(epoch uint32) {
+	for _, change := range gah.AppliedConfigChanges() {
+		if string(change.ActionType) != systemSmartContracts.GovernanceActionChangeGasSchedule {
+			continue
+		}
+
+		err := gah.changeGasSchedule(change.ActionArguments)
+		if err != nil {
+			log.Warn("governance: gas schedule change not applied", "epoch", epoch, "error", err)
+		}
+	}
+}
+
+// changeGasSchedule expects the gas map name, the operation name and the new cost
+func (gah *governanceActionsHandler) changeGasSchedule(arguments [][]byte) error {
+	if len(arguments) != 3 {
+		return fmt.Errorf("%w for %s", epochStart.ErrInvalidGovernanceActionArguments, systemSmartContracts.GovernanceActionChangeGasSchedule)
+	}
+	newCost, err := parseGovernanceUint64(arguments[2])
+	if err != nil {
+		return err
+	}
+
+	gasMapName := string(arguments[0])
+	operation := string(arguments[1])
+	latestGasSchedule := gah.gasScheduleNotifier.LatestGasSchedule()
+	gasMap, ok := latestGasSchedule[gasMapName]
+	if !ok {
+		return fmt.Errorf("%w, gas map %s", epochStart.ErrGasScheduleEntryNotFound, gasMapName)
+	}
+	currentCost, ok := gasMap[operation]
+	if !ok {
+		return fmt.Errorf("%w, operation %s in gas map %s", epochStart.ErrGasScheduleEntryNotFound, operation, gasMapName)
+	}
+	if currentCost == newCost {
+		return nil
+	}
+
+	// the handlers keep references to the notified maps, so the latest gas schedule is copied and not changed in place
+	newGasSchedule := make(map[string]map[string]uint64, len(latestGasSchedule))
+	for name, costs := range latestGasSchedule {
+		newCosts := make(map[string]uint64, len(costs))
+		for op, cost := range costs {
+			newCosts[op] = cost
+		}
+		newGasSchedule[name] = newCosts
+	}
+	newGasSchedule[gasMapName][operation] = newCost
+
+	gah.gasScheduleNotifier.ChangeGasSchedule(newGasSchedule)
+	log.Info("governance: gas schedule changed", "gas map", gasMapName, "operation", operation, "cost", newCost)
+
+	return nil
+}
+
+// changeEconomics expects the fee parameter name and the new value
+func (gah *governanceActionsHandler) changeEconomics(arguments [][]byte) error {
+	if len(arguments) != 2 {
+		return fmt.Errorf("%w for %s", epochStart.ErrInvalidGovernanceActionArguments, systemSmartContracts.GovernanceActionChangeEconomics)
+	}
+	value, err := parseGovernanceUint64(arguments[1])
+	if err != nil {
+		return err
+	}
+
+	return gah.economicsData.SetFeeParameter(string(arguments[0]), value)
+}
+
+// triggerHardfork expects the hardfork epoch and the new software version. The trigger depends on the node's own
+// configuration, so its errors are only logged and are not recorded as a proposal failure
+func (gah *governanceActionsHandler) triggerHardfork(arguments [][]byte) error {
+	if len(arguments) != 2 {
+		return fmt.Errorf("%w for %s", epochStart.ErrInvalidGovernanceActionArguments, systemSmartContracts.GovernanceActionHardFork)
+	}
+	hardforkEpoch, err := parseGovernanceUint64(arguments[0])
+	if err != nil {
+		return err
+	}
+	if hardforkEpoch > uint64(^uint32(0)) {
+		return fmt.Errorf("%w, hardfork epoch %d out of range", epochStart.ErrInvalidGovernanceActionArguments, hardforkEpoch)
+	}
+
+	gah.mutHardforkTrigger.RLock()
+	hardforkTrigger := gah.hardforkTrigger
+	gah.mutHardforkTrigger.RUnlock()
+
+	if check.IfNil(hardforkTrigger) {
+		log.Warn("governance: hardfork proposal executed without a hardfork trigger",
+			"epoch", hardforkEpoch, "version", string(arguments[1]))
+		return nil
+	}
+
+	err = hardforkTrigger.Trigger(uint32(hardforkEpoch), false)
+	if err != nil {
+		log.Warn("governance: hardfork trigger failed",
+			"epoch", hardforkEpoch, "version", string(arguments[1]), "error", err)
+		return nil
+	}
+
+	log.Info("governance: hardfork triggered", "epoch", hardforkEpoch, "version", string(arguments[1]))
+
+	return nil
+}
+
+func parseGovernanceUint64(argument []byte) (uint64, error) {
+	value, err := strconv.ParseUint(string(argument), governanceConversionBase, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w, %s is not a valid value", epochStart.ErrInvalidGovernanceActionArguments, argument)
+	}
+
+	return value, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gah *governanceActionsHandler) IsInterfaceNil() bool {
+	return gah == nil
+}
@@ -0,0 +1,312 @@
+package metachain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGovernanceActionsHandler() ArgsGovernanceActionsHandler {
+	return ArgsGovernanceActionsHandler{
+		GasScheduleNotifier: mock.NewGasScheduleNotifierMock(map[string]map[string]uint64{
+			"BuiltInCost": {"ESDTTransfer": 10, "ClaimDeveloperRewards": 20},
+		}),
+		EconomicsData:    &mock.EconomicsFeeSetterStub{},
+		MetaBlockStorage: mock.NewStorerMock(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+}
+
+func saveEpochStartMetaBlock(t *testing.T, args ArgsGovernanceActionsHandler, metaBlock *block.MetaBlock) {
+	metaBlockBytes, err := args.Marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	err = args.MetaBlockStorage.Put([]byte(core.EpochStartIdentifier(metaBlock.Epoch)), metaBlockBytes)
+	require.Nil(t, err)
+}
+
+func TestNewGovernanceActionsHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	args.GasScheduleNotifier = nil
+	gah, err := NewGovernanceActionsHandler(args)
+	assert.Nil(t, gah)
+	assert.Equal(t, epochStart.ErrNilGasScheduleChanger, err)
+
+	args = createMockArgsGovernanceActionsHandler()
+	args.EconomicsData = nil
+	gah, err = NewGovernanceActionsHandler(args)
+	assert.Nil(t, gah)
+	assert.Equal(t, epochStart.ErrNilEconomicsFeeSetter, err)
+
+	args = createMockArgsGovernanceActionsHandler()
+	args.MetaBlockStorage = nil
+	gah, err = NewGovernanceActionsHandler(args)
+	assert.Nil(t, gah)
+	assert.Equal(t, epochStart.ErrNilMetaBlockStorage, err)
+
+	args = createMockArgsGovernanceActionsHandler()
+	args.Marshalizer = nil
+	gah, err = NewGovernanceActionsHandler(args)
+	assert.Nil(t, gah)
+	assert.Equal(t, epochStart.ErrNilMarshalizer, err)
+
+	args = createMockArgsGovernanceActionsHandler()
+	args.EpochNotifier = nil
+	gah, err = NewGovernanceActionsHandler(args)
+	assert.Nil(t, gah)
+	assert.Equal(t, epochStart.ErrNilEpochNotifier, err)
+
+	gah, err = NewGovernanceActionsHandler(createMockArgsGovernanceActionsHandler())
+	assert.Nil(t, err)
+	assert.False(t, gah.IsInterfaceNil())
+	assert.Equal(t, epochStart.ErrNilHardforkTrigger, gah.SetHardforkTrigger(nil))
+}
+
+func TestGovernanceActionsHandler_ProcessGovernanceActionUnknownActionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gah, _ := NewGovernanceActionsHandler(createMockArgsGovernanceActionsHandler())
+	err := gah.ProcessGovernanceAction("unknown", nil, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrUnknownGovernanceAction))
+}
+
+func TestGovernanceActionsHandler_ProcessGovernanceActionChangeGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	gasScheduleNotifier := args.GasScheduleNotifier.(*mock.GasScheduleNotifierMock)
+	oldGasSchedule := gasScheduleNotifier.LatestGasSchedule()
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	err := gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidGovernanceActionArguments))
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("-1")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidGovernanceActionArguments))
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("MissingCost"), []byte("ESDTTransfer"), []byte("100")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrGasScheduleEntryNotFound))
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("missing"), []byte("100")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrGasScheduleEntryNotFound))
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")}, 0)
+	require.Nil(t, err)
+
+	newGasSchedule := gasScheduleNotifier.LatestGasSchedule()
+	assert.Equal(t, uint64(100), newGasSchedule["BuiltInCost"]["ESDTTransfer"])
+	assert.Equal(t, uint64(20), newGasSchedule["BuiltInCost"]["ClaimDeveloperRewards"])
+	assert.Equal(t, uint64(10), oldGasSchedule["BuiltInCost"]["ESDTTransfer"])
+}
+
+func TestGovernanceActionsHandler_ProcessGovernanceActionChangeEconomics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	setterErr := errors.New("setter error")
+	changedParameter := ""
+	changedValue := uint64(0)
+	args.EconomicsData = &mock.EconomicsFeeSetterStub{
+		SetFeeParameterCalled: func(parameter string, value uint64) error {
+			if parameter == "unknown" {
+				return setterErr
+			}
+			changedParameter = parameter
+			changedValue = value
+			return nil
+		},
+	}
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	err := gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeEconomics,
+		[][]byte{[]byte("MinGasPrice"), []byte("abc")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidGovernanceActionArguments))
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeEconomics,
+		[][]byte{[]byte("unknown"), []byte("5")}, 0)
+	assert.Equal(t, setterErr, err)
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeEconomics,
+		[][]byte{[]byte("MinGasPrice"), []byte("1500")}, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "MinGasPrice", changedParameter)
+	assert.Equal(t, uint64(1500), changedValue)
+}
+
+func TestGovernanceActionsHandler_ProcessGovernanceActionHardFork(t *testing.T) {
+	t.Parallel()
+
+	gah, _ := NewGovernanceActionsHandler(createMockArgsGovernanceActionsHandler())
+	arguments := [][]byte{[]byte("12"), []byte("v1.2.0")}
+
+	err := gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionHardFork, arguments, 0)
+	assert.Nil(t, err)
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionHardFork,
+		[][]byte{[]byte("4294967296"), []byte("v1.2.0")}, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidGovernanceActionArguments))
+
+	triggeredEpoch := uint32(0)
+	_ = gah.SetHardforkTrigger(&mock.HardforkTriggerStub{
+		TriggerCalled: func(epoch uint32, withEarlyEndOfEpoch bool) error {
+			triggeredEpoch = epoch
+			assert.False(t, withEarlyEndOfEpoch)
+			return errors.New("trigger error")
+		},
+	})
+
+	err = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionHardFork, arguments, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(12), triggeredEpoch)
+}
+
+func TestGovernanceActionsHandler_AppliedConfigChangesShouldKeepTheLatestChangeOfEachEntry(t *testing.T) {
+	t.Parallel()
+
+	gah, _ := NewGovernanceActionsHandler(createMockArgsGovernanceActionsHandler())
+	assert.Equal(t, 0, len(gah.AppliedConfigChanges()))
+
+	_ = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")}, 1)
+	_ = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeEconomics,
+		[][]byte{[]byte("MinGasPrice"), []byte("1500")}, 1)
+	_ = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("200")}, 2)
+	_ = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("missing"), []byte("200")}, 2)
+	_ = gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionHardFork,
+		[][]byte{[]byte("12"), []byte("v1.2.0")}, 2)
+
+	expectedConfigChanges := []block.GovernanceConfigChange{
+		{
+			ActionType:      []byte(systemSmartContracts.GovernanceActionChangeGasSchedule),
+			ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("200")},
+		},
+		{
+			ActionType:      []byte(systemSmartContracts.GovernanceActionChangeEconomics),
+			ActionArguments: [][]byte{[]byte("MinGasPrice"), []byte("1500")},
+		},
+	}
+	assert.Equal(t, expectedConfigChanges, gah.AppliedConfigChanges())
+}
+
+func TestGovernanceActionsHandler_LoadConfigChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	gasScheduleNotifier := args.GasScheduleNotifier.(*mock.GasScheduleNotifierMock)
+	changedValue := uint64(0)
+	args.EconomicsData = &mock.EconomicsFeeSetterStub{
+		SetFeeParameterCalled: func(parameter string, value uint64) error {
+			changedValue = value
+			return nil
+		},
+	}
+	configChanges := []block.GovernanceConfigChange{
+		{
+			ActionType:      []byte(systemSmartContracts.GovernanceActionChangeGasSchedule),
+			ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")},
+		},
+		{
+			ActionType:      []byte(systemSmartContracts.GovernanceActionChangeEconomics),
+			ActionArguments: [][]byte{[]byte("MinGasPrice"), []byte("1500")},
+		},
+	}
+	metaBlock := &block.MetaBlock{
+		Epoch:      3,
+		EpochStart: block.EpochStart{GovernanceConfigChanges: configChanges},
+	}
+	saveEpochStartMetaBlock(t, args, metaBlock)
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	err := gah.LoadConfigChanges(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(gah.AppliedConfigChanges()))
+
+	err = gah.LoadConfigChanges(4)
+	assert.NotNil(t, err)
+
+	err = gah.LoadConfigChanges(3)
+	assert.Nil(t, err)
+	assert.Equal(t, configChanges, gah.AppliedConfigChanges())
+	assert.Equal(t, uint64(100), gasScheduleNotifier.LatestGasSchedule()["BuiltInCost"]["ESDTTransfer"])
+	assert.Equal(t, uint64(1500), changedValue)
+}
+
+func createEpochStartMetaBlockWithGasScheduleChange() *block.MetaBlock {
+	return &block.MetaBlock{
+		Epoch: 3,
+		EpochStart: block.EpochStart{
+			GovernanceConfigChanges: []block.GovernanceConfigChange{
+				{
+					ActionType:      []byte(systemSmartContracts.GovernanceActionChangeGasSchedule),
+					ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")},
+				},
+			},
+		},
+	}
+}
+
+func TestGovernanceActionsHandler_EpochStartActionOnMetachainShouldApplyTheMetaBlockChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	gasScheduleNotifier := args.GasScheduleNotifier.(*mock.GasScheduleNotifierMock)
+	metaBlock := createEpochStartMetaBlockWithGasScheduleChange()
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	gah.EpochStartAction(metaBlock)
+	assert.Equal(t, metaBlock.EpochStart.GovernanceConfigChanges, gah.AppliedConfigChanges())
+	assert.Equal(t, uint64(100), gasScheduleNotifier.LatestGasSchedule()["BuiltInCost"]["ESDTTransfer"])
+}
+
+func TestGovernanceActionsHandler_EpochStartActionOnShardShouldApplyTheStoredMetaBlockChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	gasScheduleNotifier := args.GasScheduleNotifier.(*mock.GasScheduleNotifierMock)
+	metaBlock := createEpochStartMetaBlockWithGasScheduleChange()
+	saveEpochStartMetaBlock(t, args, metaBlock)
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	gah.EpochStartAction(&block.Header{Epoch: 2})
+	assert.Equal(t, 0, len(gah.AppliedConfigChanges()))
+
+	gah.EpochStartAction(&block.Header{Epoch: 3})
+	assert.Equal(t, metaBlock.EpochStart.GovernanceConfigChanges, gah.AppliedConfigChanges())
+	assert.Equal(t, uint64(100), gasScheduleNotifier.LatestGasSchedule()["BuiltInCost"]["ESDTTransfer"])
+}
+
+func TestGovernanceActionsHandler_EpochConfirmedShouldReapplyTheGasScheduleChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGovernanceActionsHandler()
+	gasScheduleNotifier := args.GasScheduleNotifier.(*mock.GasScheduleNotifierMock)
+	configFileGasSchedule := gasScheduleNotifier.LatestGasSchedule()
+	gah, _ := NewGovernanceActionsHandler(args)
+
+	err := gah.ProcessGovernanceAction(systemSmartContracts.GovernanceActionChangeGasSchedule,
+		[][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")}, 1)
+	require.Nil(t, err)
+
+	// a new gas schedule version is loaded from the configuration files
+	gasScheduleNotifier.ChangeGasSchedule(configFileGasSchedule)
+	gah.EpochConfirmed(2)
+
+	assert.Equal(t, uint64(100), gasScheduleNotifier.LatestGasSchedule()["BuiltInCost"]["ESDTTransfer"])
+}
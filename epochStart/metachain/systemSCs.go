@@ -42,12 +42,16 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 	SwitchHysteresisForMinNodesEnableEpoch uint32
 	DelegationEnableEpoch                  uint32
 	StakingV2EnableEpoch                   uint32
+	GovernanceEnableEpoch                  uint32
+	SlashingEnableEpoch                    uint32
 	MaxNodesEnableConfig                   []config.MaxNodesChangeConfig
 
 	GenesisNodesConfig  sharding.GenesisNodesSetupHandler
 	EpochNotifier       process.EpochNotifier
 	NodesConfigProvider epochStart.NodesConfigProvider
 	StakingDataProvider epochStart.StakingDataProvider
+
+	GovernanceActionsHandler epochStart.GovernanceActionsHandler
 }
 
 type systemSCProcessor struct {
@@ -62,12 +66,15 @@ type systemSCProcessor struct {
 	genesisNodesConfig        sharding.GenesisNodesSetupHandler
 	nodesConfigProvider       epochStart.NodesConfigProvider
 	stakingDataProvider       epochStart.StakingDataProvider
+	governanceActionsHandler  epochStart.GovernanceActionsHandler
 	endOfEpochCallerAddress   []byte
 	stakingSCAddress          []byte
 	switchEnableEpoch         uint32
 	hystNodesEnableEpoch      uint32
 	delegationEnableEpoch     uint32
 	stakingV2EnableEpoch      uint32
+	governanceEnableEpoch     uint32
+	slashingEnableEpoch       uint32
 	maxNodesEnableConfig      []config.MaxNodesChangeConfig
 	maxNodes                  uint32
 	flagSwitchJailedWaiting   atomic.Flag
@@ -76,6 +83,8 @@ type systemSCProcessor struct {
 	flagSetOwnerEnabled       atomic.Flag
 	flagChangeMaxNodesEnabled atomic.Flag
 	flagStakingV2Enabled      atomic.Flag
+	flagGovernanceEnabled     atomic.Flag
+	flagSlashingEnabled       atomic.Flag
 	mapNumSwitchedPerShard    map[uint32]uint32
 	mapNumSwitchablePerShard  map[uint32]uint32
 }
@@ -141,6 +150,9 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, epochStart.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.GovernanceActionsHandler) {
+		return nil, epochStart.ErrNilGovernanceActionsHandler
+	}
 
 	s := &systemSCProcessor{
 		systemVM:                 args.SystemVM,
@@ -159,9 +171,12 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		hystNodesEnableEpoch:     args.SwitchHysteresisForMinNodesEnableEpoch,
 		delegationEnableEpoch:    args.DelegationEnableEpoch,
 		stakingV2EnableEpoch:     args.StakingV2EnableEpoch,
+		governanceEnableEpoch:    args.GovernanceEnableEpoch,
+		slashingEnableEpoch:      args.SlashingEnableEpoch,
 		stakingDataProvider:      args.StakingDataProvider,
 		nodesConfigProvider:      args.NodesConfigProvider,
 		shardCoordinator:         args.ShardCoordinator,
+		governanceActionsHandler: args.GovernanceActionsHandler,
 	}
 
 	s.maxNodesEnableConfig = make([]config.MaxNodesChangeConfig, len(args.MaxNodesEnableConfig))
@@ -220,6 +235,13 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(
 		}
 	}
 
+	if s.flagSlashingEnabled.IsSet() {
+		err := s.jailSlashedNodes(validatorInfos)
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.flagStakingV2Enabled.IsSet() {
 		err := s.prepareRewardsData(validatorInfos)
 		if err != nil {
@@ -242,9 +264,94 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(
 		}
 	}
 
+	if s.flagGovernanceEnabled.IsSet() {
+		err := s.executeGovernanceProposals(epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeGovernanceProposals executes the passed governance proposals which reached their execution epoch. The
+// actions which are not applied by the governance system SC itself are forwarded to the governance actions handler
+func (s *systemSCProcessor) executeGovernanceProposals(epoch uint32) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  [][]byte{},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "executeProposals",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code %v, message %s", epochStart.ErrSystemGovernanceSCCall, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	err = s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	for _, marshaledProposal := range vmOutput.ReturnData {
+		proposal := &systemSmartContracts.GeneralProposal{}
+		err = s.marshalizer.Unmarshal(proposal, marshaledProposal)
+		if err != nil {
+			return err
+		}
+
+		if string(proposal.ActionType) == systemSmartContracts.GovernanceActionWhiteList {
+			continue
+		}
+
+		// the actions are applied on the node's components, a failure here must not block the epoch start and is
+		// recorded on the proposal instead
+		errAction := s.governanceActionsHandler.ProcessGovernanceAction(string(proposal.ActionType), proposal.ActionArguments, epoch)
+		if errAction == nil {
+			continue
+		}
+
+		log.Warn("systemSCProcessor.executeGovernanceProposals",
+			"proposal", proposal.GitHubCommit,
+			"action", proposal.ActionType,
+			"error", errAction,
+		)
+		err = s.setGovernanceProposalExecutionFailed(proposal.GitHubCommit, errAction)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (s *systemSCProcessor) setGovernanceProposalExecutionFailed(proposalReference []byte, errAction error) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  [][]byte{proposalReference, []byte(errAction.Error())},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "setExecutionFailed",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code %v, message %s", epochStart.ErrSystemGovernanceSCCall, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return s.processSCOutputAccounts(vmOutput)
+}
+
 // ToggleUnStakeUnBond will pause/unPause the unStake/unBond functions on the validator system sc
 func (s *systemSCProcessor) ToggleUnStakeUnBond(value bool) error {
 	if !s.flagStakingV2Enabled.IsSet() {
@@ -661,6 +768,94 @@ func (s *systemSCProcessor) swapJailedWithWaiting(validatorInfos map[uint32][]*s
 	return nil
 }
 
+// jailSlashedNodes removes the keys slashed for double signing from the nodes lists. Each one is replaced by the
+// first node in the staking queue, if any, and is moved in the jailed list even if nobody can take its place
+func (s *systemSCProcessor) jailSlashedNodes(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  [][]byte{},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: s.stakingSCAddress,
+		Function:      "getSlashedNodesToJail",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code %v, message %s", epochStart.ErrSystemStakingSCCall, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	for _, blsKey := range vmOutput.ReturnData {
+		err = s.jailSlashedNode(validatorInfos, blsKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *systemSCProcessor) jailSlashedNode(validatorInfos map[uint32][]*state.ValidatorInfo, blsKey []byte) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  [][]byte{blsKey},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: s.stakingSCAddress,
+		Function:      "switchSlashedWithWaiting",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code %v, message %s", epochStart.ErrSystemStakingSCCall, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	log.Debug("switchSlashedWithWaiting called for",
+		"key", blsKey,
+		"returnMessage", vmOutput.ReturnMessage)
+
+	slashedValidator := getValidatorInfoWithBLSKey(validatorInfos, blsKey)
+	if slashedValidator == nil || !isValidator(slashedValidator) {
+		return s.processSCOutputAccounts(vmOutput)
+	}
+
+	newValidator, err := s.stakingToValidatorStatistics(validatorInfos, slashedValidator, vmOutput)
+	if err != nil {
+		return err
+	}
+	if len(newValidator) != 0 {
+		return nil
+	}
+
+	err = s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	slashedAccount, err := s.getPeerAccount(blsKey)
+	if err != nil {
+		return err
+	}
+
+	slashedAccount.SetListAndIndex(slashedValidator.ShardId, string(core.JailedList), slashedValidator.Index)
+	slashedAccount.ResetAtNewEpoch()
+	err = s.peerAccountsDB.SaveAccount(slashedAccount)
+	if err != nil {
+		return err
+	}
+
+	jailedValidatorInfo := s.validatorInfoCreator.PeerAccountToValidatorInfo(slashedAccount)
+	switchJailedWithNewValidatorInMap(validatorInfos, slashedValidator, jailedValidatorInfo)
+
+	return nil
+}
+
 func (s *systemSCProcessor) stakingToValidatorStatistics(
 	validatorInfos map[uint32][]*state.ValidatorInfo,
 	jailedValidator *state.ValidatorInfo,
@@ -1232,6 +1427,12 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32) {
 	s.flagSetOwnerEnabled.Toggle(epoch == s.stakingV2EnableEpoch)
 	s.flagStakingV2Enabled.Toggle(epoch >= s.stakingV2EnableEpoch)
 	log.Debug("systemSCProcessor: stakingV2", "enabled", epoch >= s.stakingV2EnableEpoch)
+
+	s.flagGovernanceEnabled.Toggle(epoch >= s.governanceEnableEpoch)
+	log.Debug("systemSCProcessor: governance proposals execution", "enabled", s.flagGovernanceEnabled.IsSet())
+
+	s.flagSlashingEnabled.Toggle(epoch >= s.slashingEnableEpoch)
+	log.Debug("systemSCProcessor: jail slashed nodes", "enabled", s.flagSlashingEnabled.IsSet())
 	log.Debug("systemSCProcessor:change of maximum number of nodes and/or shuffling percentage",
 		"enabled", s.flagChangeMaxNodesEnabled.IsSet(),
 		"epoch", epoch,
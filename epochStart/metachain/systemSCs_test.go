@@ -3,6 +3,7 @@ package metachain
 import (
 	"bytes"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math"
@@ -135,6 +136,10 @@ func TestNewSystemSCProcessor(t *testing.T) {
 	args, _ = createFullArgumentsForSystemSCProcessing(100, createMemUnit())
 	args.EpochNotifier = nil
 	checkConstructorWithNilArg(t, args, epochStart.ErrNilEpochStartNotifier)
+
+	args, _ = createFullArgumentsForSystemSCProcessing(100, createMemUnit())
+	args.GovernanceActionsHandler = nil
+	checkConstructorWithNilArg(t, args, epochStart.ErrNilGovernanceActionsHandler)
 }
 
 func checkConstructorWithNilArg(t *testing.T, args ArgsNewEpochStartSystemSCProcessing, expectedErr error) {
@@ -220,6 +225,104 @@ func TestSystemSCProcessor_JailedNodesShouldNotBeSwappedAllAtOnce(t *testing.T)
 	}
 }
 
+func createSlashedEligibleNode(
+	blsKey []byte,
+	stakingSCAcc state.UserAccountHandler,
+	userAccounts state.AccountsAdapter,
+	peerAccounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+) *state.ValidatorInfo {
+	stakedData := &systemSmartContracts.StakedDataV2_0{
+		Staked:        true,
+		Jailed:        true,
+		NumJailed:     1,
+		RewardAddress: []byte("rewardAddress_s"),
+		StakeValue:    big.NewInt(100),
+		SlashValue:    big.NewInt(10),
+	}
+	marshaledData, _ := marshalizer.Marshal(stakedData)
+	_ = stakingSCAcc.DataTrieTracker().SaveKeyValue(blsKey, marshaledData)
+
+	slashedNodes := &systemSmartContracts.SlashedNodesList{BLSKeys: [][]byte{blsKey}}
+	marshaledData, _ = marshalizer.Marshal(slashedNodes)
+	_ = stakingSCAcc.DataTrieTracker().SaveKeyValue([]byte("slashedNodesToJail"), marshaledData)
+	_ = userAccounts.SaveAccount(stakingSCAcc)
+
+	slashedAcc, _ := peerAccounts.LoadAccount(blsKey)
+	peerAcc := slashedAcc.(state.PeerAccountHandler)
+	_ = peerAcc.SetBLSPublicKey(blsKey)
+	peerAcc.SetListAndIndex(0, string(core.EligibleList), 0)
+	_ = peerAccounts.SaveAccount(peerAcc)
+
+	return &state.ValidatorInfo{
+		PublicKey:       blsKey,
+		ShardId:         0,
+		List:            string(core.EligibleList),
+		TempRating:      50,
+		RewardAddress:   []byte("rewardAddress_s"),
+		AccumulatedFees: big.NewInt(0),
+	}
+}
+
+func TestSystemSCProcessor_SlashedNodeShouldLeaveTheEligibleListWhenNobodyToSwap(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(10000, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+	require.NotNil(t, s)
+
+	slashedKey := []byte("slashkey0")
+	stakingScAcc := loadSCAccount(args.UserAccountsDB, vm.StakingSCAddress)
+	slashed := createSlashedEligibleNode(slashedKey, stakingScAcc, args.UserAccountsDB, args.PeerAccountsDB, args.Marshalizer)
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	validatorsInfo[0] = append(validatorsInfo[0], slashed)
+
+	err := s.ProcessSystemSmartContract(validatorsInfo, 0, 0)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(validatorsInfo[0]))
+	assert.Equal(t, slashedKey, validatorsInfo[0][0].PublicKey)
+	assert.Equal(t, string(core.JailedList), validatorsInfo[0][0].List)
+
+	peerAcc, _ := s.getPeerAccount(slashedKey)
+	assert.Equal(t, string(core.JailedList), peerAcc.GetList())
+
+	stakingScAcc = loadSCAccount(args.UserAccountsDB, vm.StakingSCAddress)
+	marshaledData, _ := stakingScAcc.DataTrieTracker().RetrieveValue(slashedKey)
+	stakedData := &systemSmartContracts.StakedDataV2_0{}
+	_ = args.Marshalizer.Unmarshal(stakedData, marshaledData)
+	assert.False(t, stakedData.Staked)
+	assert.True(t, stakedData.Jailed)
+
+	marshaledData, _ = stakingScAcc.DataTrieTracker().RetrieveValue([]byte("slashedNodesToJail"))
+	assert.Equal(t, 0, len(marshaledData))
+}
+
+func TestSystemSCProcessor_SlashedNodeShouldBeSwappedWithWaiting(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(10000, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+	require.NotNil(t, s)
+
+	slashedKey := []byte("slashkey0")
+	stakingScAcc := loadSCAccount(args.UserAccountsDB, vm.StakingSCAddress)
+	_ = createWaitingNodes(1, stakingScAcc, args.UserAccountsDB, args.Marshalizer)
+	slashed := createSlashedEligibleNode(slashedKey, stakingScAcc, args.UserAccountsDB, args.PeerAccountsDB, args.Marshalizer)
+	validatorsInfo := make(map[uint32][]*state.ValidatorInfo)
+	validatorsInfo[0] = append(validatorsInfo[0], slashed)
+
+	err := s.ProcessSystemSmartContract(validatorsInfo, 0, 0)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(validatorsInfo[0]))
+	assert.Equal(t, []byte("waiting_0"), validatorsInfo[0][0].PublicKey)
+	assert.Equal(t, string(core.NewList), validatorsInfo[0][0].List)
+
+	peerAcc, _ := s.getPeerAccount(slashedKey)
+	assert.Equal(t, string(core.JailedList), peerAcc.GetList())
+}
+
 func TestSystemSCProcessor_NobodyToSwapWithStakingV2(t *testing.T) {
 	t.Parallel()
 
@@ -752,18 +855,19 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 	epochNotifier := forking.NewGenericEpochNotifier()
 
 	argsValidatorsProcessor := peer.ArgValidatorStatisticsProcessor{
-		Marshalizer:         marshalizer,
-		NodesCoordinator:    &mock.NodesCoordinatorStub{},
-		ShardCoordinator:    &mock.ShardCoordinatorStub{},
-		DataPool:            &testscommon.PoolsHolderStub{},
-		StorageService:      &mock.ChainStorerStub{},
-		PubkeyConv:          &mock.PubkeyConverterMock{},
-		PeerAdapter:         peerAccountsDB,
-		Rater:               &mock.RaterStub{},
-		RewardsHandler:      &mock.RewardsHandlerStub{},
-		NodesSetup:          &mock.NodesSetupStub{},
-		MaxComputableRounds: 1,
-		EpochNotifier:       epochNotifier,
+		Marshalizer:           marshalizer,
+		NodesCoordinator:      &mock.NodesCoordinatorStub{},
+		ShardCoordinator:      &mock.ShardCoordinatorStub{},
+		DataPool:              &testscommon.PoolsHolderStub{},
+		StorageService:        &mock.ChainStorerStub{},
+		PubkeyConv:            &mock.PubkeyConverterMock{},
+		PeerAdapter:           peerAccountsDB,
+		Rater:                 &mock.RaterStub{},
+		RewardsHandler:        &mock.RewardsHandlerStub{},
+		NodesSetup:            &mock.NodesSetupStub{},
+		MaxComputableRounds:   1,
+		EpochNotifier:         epochNotifier,
+		ParticipationRecorder: peer.NewDisabledValidatorParticipationRecorder(),
 	}
 	vCreator, _ := peer.NewValidatorStatisticsProcessor(argsValidatorsProcessor)
 
@@ -792,6 +896,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		ArgBlockChainHook:   argsHook,
 		Economics:           createEconomicsData(),
 		MessageSignVerifier: signVerifer,
+		ProofVerifier:       &disabled.DoubleSigningProofVerifier{},
 		GasSchedule:         mock.NewGasScheduleNotifierMock(gasSchedule),
 		NodesConfigProvider: nodesSetup,
 		Hasher:              hasher,
@@ -869,7 +974,8 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 				return 63
 			},
 		},
-		ShardCoordinator: shardCoordinator,
+		ShardCoordinator:         shardCoordinator,
+		GovernanceActionsHandler: &mock.GovernanceActionsHandlerStub{},
 	}
 	return args, metaVmFactory.SystemSmartContractContainer()
 }
@@ -1417,3 +1523,109 @@ func TestSystemSCProcessor_TogglePauseUnPause(t *testing.T) {
 	value, _ = validatorSC.DataTrie().Get([]byte("unStakeUnBondPause"))
 	assert.True(t, value[0] == 0)
 }
+
+func TestSystemSCProcessor_ProcessSystemSmartContractExecutesGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	marshaledWhiteList, _ := args.Marshalizer.Marshal(&systemSmartContracts.GeneralProposal{
+		ActionType:      []byte(systemSmartContracts.GovernanceActionWhiteList),
+		ActionArguments: [][]byte{[]byte("address")},
+	})
+	marshaledGasChange, _ := args.Marshalizer.Marshal(&systemSmartContracts.GeneralProposal{
+		ActionType:      []byte(systemSmartContracts.GovernanceActionChangeGasSchedule),
+		ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")},
+	})
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if !bytes.Equal(vm.GovernanceSCAddress, input.RecipientAddr) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+			require.Equal(t, "executeProposals", input.Function)
+			require.Equal(t, vm.EndOfEpochAddress, input.CallerAddr)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledWhiteList, marshaledGasChange},
+			}, nil
+		},
+	}
+	processedActions := make([]string, 0)
+	args.GovernanceActionsHandler = &mock.GovernanceActionsHandlerStub{
+		ProcessGovernanceActionCalled: func(actionType string, arguments [][]byte, epoch uint32) error {
+			processedActions = append(processedActions, actionType)
+			assert.Equal(t, uint32(7), epoch)
+			assert.Equal(t, 3, len(arguments))
+			return nil
+		},
+	}
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo), 0, 7)
+	require.Nil(t, err)
+	assert.Equal(t, []string{systemSmartContracts.GovernanceActionChangeGasSchedule}, processedActions)
+
+	s.flagGovernanceEnabled.Unset()
+	processedActions = make([]string, 0)
+	err = s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo), 0, 7)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(processedActions))
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractRecordsFailedGovernanceActions(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	marshaledGasChange, _ := args.Marshalizer.Marshal(&systemSmartContracts.GeneralProposal{
+		GitHubCommit:    []byte("commit"),
+		ActionType:      []byte(systemSmartContracts.GovernanceActionChangeGasSchedule),
+		ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("missing"), []byte("100")},
+	})
+	var failedCallArguments [][]byte
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if !bytes.Equal(vm.GovernanceSCAddress, input.RecipientAddr) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+			if input.Function == "setExecutionFailed" {
+				require.Equal(t, vm.EndOfEpochAddress, input.CallerAddr)
+				failedCallArguments = input.Arguments
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledGasChange},
+			}, nil
+		},
+	}
+	errAction := errors.New("action error")
+	args.GovernanceActionsHandler = &mock.GovernanceActionsHandlerStub{
+		ProcessGovernanceActionCalled: func(actionType string, arguments [][]byte, epoch uint32) error {
+			return errAction
+		},
+	}
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo), 0, 7)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("commit"), []byte(errAction.Error())}, failedCallArguments)
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractGovernanceCallFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if !bytes.Equal(vm.GovernanceSCAddress, input.RecipientAddr) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			}
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo), 0, 0)
+	assert.True(t, errors.Is(err, epochStart.ErrSystemGovernanceSCCall))
+}
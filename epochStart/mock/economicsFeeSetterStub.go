@@ -0,0 +1,20 @@
+package mock
+
+// EconomicsFeeSetterStub -
+type EconomicsFeeSetterStub struct {
+	SetFeeParameterCalled func(parameter string, value uint64) error
+}
+
+// SetFeeParameter -
+func (efss *EconomicsFeeSetterStub) SetFeeParameter(parameter string, value uint64) error {
+	if efss.SetFeeParameterCalled != nil {
+		return efss.SetFeeParameterCalled(parameter, value)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (efss *EconomicsFeeSetterStub) IsInterfaceNil() bool {
+	return efss == nil
+}
@@ -25,6 +25,11 @@ func (g *GasScheduleNotifierMock) LatestGasSchedule() map[string]map[string]uint
 	return g.GasSchedule
 }
 
+// ChangeGasSchedule -
+func (g *GasScheduleNotifierMock) ChangeGasSchedule(gasSchedule map[string]map[string]uint64) {
+	g.GasSchedule = gasSchedule
+}
+
 // UnRegisterAll -
 func (g *GasScheduleNotifierMock) UnRegisterAll() {
 }
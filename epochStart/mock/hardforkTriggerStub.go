@@ -0,0 +1,20 @@
+package mock
+
+// HardforkTriggerStub -
+type HardforkTriggerStub struct {
+	TriggerCalled func(epoch uint32, withEarlyEndOfEpoch bool) error
+}
+
+// Trigger -
+func (hts *HardforkTriggerStub) Trigger(epoch uint32, withEarlyEndOfEpoch bool) error {
+	if hts.TriggerCalled != nil {
+		return hts.TriggerCalled(epoch, withEarlyEndOfEpoch)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (hts *HardforkTriggerStub) IsInterfaceNil() bool {
+	return hts == nil
+}
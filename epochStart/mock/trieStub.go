@@ -27,6 +27,7 @@ type TrieStub struct {
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
@@ -215,3 +216,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
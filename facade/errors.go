@@ -25,3 +25,6 @@ var ErrNilAccountState = errors.New("nil account state")
 
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrNilBlockEventsNotifier signals that a nil block events notifier has been provided
+var ErrNilBlockEventsNotifier = errors.New("nil block events notifier")
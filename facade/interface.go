@@ -3,11 +3,18 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -20,27 +27,49 @@ type NodeHandler interface {
 	StartConsensus() error
 
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	GetUsername(address string, options core.AccountQueryOptions) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error)
 
 	// GetESDTBalance returns the esdt balance and properties from a given account
-	GetESDTBalance(address string, key string) (string, string, error)
+	GetESDTBalance(address string, key string, options core.AccountQueryOptions) (string, string, error)
+
+	// GetESDTNFTTokenData returns the esdt data of the token with the given nonce from a given account
+	GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error)
 
 	// GetAllESDTTokens returns the value of a key from a given account
-	GetAllESDTTokens(address string) ([]string, error)
+	GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error)
+
+	// GetProof returns the merkle proof of the given account
+	GetProof(address string, options core.AccountQueryOptions) (*address.AccountProof, error)
+
+	// GetProofForKey returns the merkle proofs of the given account and of the key from its data trie
+	GetProofForKey(address string, key string, options core.AccountQueryOptions) (*address.AccountProof, error)
+
+	// GetAddressTransactions returns a page of the transactions history of the given account
+	GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*address.AddressTransactions, error)
+
+	// GetEvents returns a page of the smart contract events emitted by the given address
+	GetEvents(address string, query dblookupext.EventsQuery) (*events.Events, error)
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 
+	//SetTransactionGuardian will set the guardian fields on a transaction and return the recomputed hash
+	SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error)
+
+	//SetTransactionSignatures will set the signatures of the multisig signers on a transaction and return the recomputed hash
+	SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error)
+
 	//ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
+	ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error
 
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
@@ -48,9 +77,21 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionsPool returns the transactions sent from the current shard that are waiting in the pool
+	GetTransactionsPool() (*transaction.ApiTransactionsPool, error)
+
+	// GetTransactionsPoolForSender returns the transactions of the given sender waiting in the pool
+	GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error)
+
+	// GetGasPriceSuggestion returns the gas prices suggested from the analysis of the last numBlocks blocks
+	GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error)
+
+	// GetBlockHeaderForAccountQuery returns the header of the block selected by the given options
+	GetBlockHeaderForAccountQuery(options core.AccountQueryOptions) (chainData.HeaderHandler, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
@@ -60,6 +101,13 @@ type NodeHandler interface {
 
 	// ValidatorStatisticsApi return the statistics for all the validators
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+
+	// GetDoubleSigningProofs returns the most recent proofs of double signing built by the node
+	GetDoubleSigningProofs() [][]byte
+
+	// GetValidatorParticipation returns the per epoch history of the consensus participation of a validator
+	GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error)
+
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
@@ -76,6 +124,7 @@ type NodeHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessBundle(txs []*transaction.Transaction, overrides map[string]*transaction.AccountOverride) ([]*transaction.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -85,6 +134,15 @@ type ApiResolver interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*big.Int, error)
+	GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error)
+	IsInterfaceNil() bool
+}
+
+// BlockEventsNotifier defines the component which notifies its subscribers about the finalized blocks
+type BlockEventsNotifier interface {
+	Subscribe(subscriber subscription.Subscriber) error
+	Unsubscribe(subscriber subscription.Subscriber)
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -15,6 +16,7 @@ type ApiResolverStub struct {
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	GetTotalStakedValueHandler        func() (*big.Int, error)
+	GetGovernanceProposalsHandler     func() ([]*vm.GovernanceProposalApi, error)
 }
 
 // ExecuteSCQuery -
@@ -37,6 +39,11 @@ func (ars *ApiResolverStub) GetTotalStakedValue() (*big.Int, error) {
 	return ars.GetTotalStakedValueHandler()
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error) {
+	return ars.GetGovernanceProposalsHandler()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
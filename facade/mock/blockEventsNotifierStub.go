@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+)
+
+// BlockEventsNotifierStub -
+type BlockEventsNotifierStub struct {
+	SubscribeCalled   func(subscriber subscription.Subscriber) error
+	UnsubscribeCalled func(subscriber subscription.Subscriber)
+	CloseCalled       func() error
+}
+
+// Subscribe -
+func (stub *BlockEventsNotifierStub) Subscribe(subscriber subscription.Subscriber) error {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(subscriber)
+	}
+
+	return nil
+}
+
+// Unsubscribe -
+func (stub *BlockEventsNotifierStub) Unsubscribe(subscriber subscription.Subscriber) {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled(subscriber)
+	}
+}
+
+// Close -
+func (stub *BlockEventsNotifierStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockEventsNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
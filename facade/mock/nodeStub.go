@@ -4,8 +4,13 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiEvents "github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -17,45 +22,59 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options core.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction) error
+	ValidateTransactionFieldsForSimulationCalled   func(tx *transaction.Transaction) error
+	SetTransactionGuardianCalled                   func(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error)
+	SetTransactionSignaturesCalled                 func(tx *transaction.Transaction, signaturesHex []string) ([]byte, error)
+	GetTransactionsPoolCalled                      func() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSenderCalled             func(sender string) (*transaction.ApiPoolSender, error)
+	GetGasPriceSuggestionCalled                    func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error)
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
+	GetDoubleSigningProofsCalled                   func() [][]byte
+	GetValidatorParticipationCalled                func(blsKey string) (*state.ValidatorParticipationHistory, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options core.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
-	GetUsernameCalled                              func(address string) (string, error)
-	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
-	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetUsernameCalled                              func(address string, options core.AccountQueryOptions) (string, error)
+	GetESDTBalanceCalled                           func(address string, key string, options core.AccountQueryOptions) (string, string, error)
+	GetAllESDTTokensCalled                         func(address string, options core.AccountQueryOptions) ([]string, error)
+	GetESDTNFTTokenDataCalled                      func(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetProofCalled                                 func(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error)
+	GetAddressTransactionsCalled                   func(address string, query dblookupext.AddressTransactionsQuery) (*apiAddress.AddressTransactions, error)
+	GetEventsCalled                                func(address string, query dblookupext.EventsQuery) (*apiEvents.Events, error)
+	GetProofForKeyCalled                           func(address string, key string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error)
+	GetBlockHeaderForAccountQueryCalled            func(options core.AccountQueryOptions) (chainData.HeaderHandler, error)
 }
 
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, options core.AccountQueryOptions) (string, error) {
 	if ns.GetUsernameCalled != nil {
-		return ns.GetUsernameCalled(address)
+		return ns.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -87,8 +106,8 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -98,7 +117,7 @@ func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiverHex st
 	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
-//ValidateTransaction -
+// ValidateTransaction -
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -108,6 +127,60 @@ func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction
 	return ns.ValidateTransactionForSimulationCalled(tx)
 }
 
+// SetTransactionGuardian -
+func (ns *NodeStub) SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error) {
+	if ns.SetTransactionGuardianCalled != nil {
+		return ns.SetTransactionGuardianCalled(tx, guardian, guardianSignatureHex)
+	}
+
+	return nil, nil
+}
+
+// SetTransactionSignatures -
+func (ns *NodeStub) SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error) {
+	if ns.SetTransactionSignaturesCalled != nil {
+		return ns.SetTransactionSignaturesCalled(tx, signaturesHex)
+	}
+
+	return nil, nil
+}
+
+// ValidateTransactionFieldsForSimulation -
+func (ns *NodeStub) ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error {
+	if ns.ValidateTransactionFieldsForSimulationCalled != nil {
+		return ns.ValidateTransactionFieldsForSimulationCalled(tx)
+	}
+
+	return nil
+}
+
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestion -
+func (ns *NodeStub) GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+	if ns.GetGasPriceSuggestionCalled != nil {
+		return ns.GetGasPriceSuggestionCalled(numBlocks)
+	}
+
+	return nil, nil
+}
+
 // GetTransaction -
 func (ns *NodeStub) GetTransaction(hash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	return ns.GetTransactionHandler(hash, withEvents)
@@ -119,8 +192,8 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetHeartbeats -
@@ -133,6 +206,24 @@ func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResp
 	return ns.ValidatorStatisticsApiCalled()
 }
 
+// GetDoubleSigningProofs -
+func (ns *NodeStub) GetDoubleSigningProofs() [][]byte {
+	if ns.GetDoubleSigningProofsCalled != nil {
+		return ns.GetDoubleSigningProofsCalled()
+	}
+
+	return make([][]byte, 0)
+}
+
+// GetValidatorParticipation -
+func (ns *NodeStub) GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error) {
+	if ns.GetValidatorParticipationCalled != nil {
+		return ns.GetValidatorParticipationCalled(blsKey)
+	}
+
+	return &state.ValidatorParticipationHistory{}, nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return ns.DirectTriggerCalled(epoch, withEarlyEndOfEpoch)
@@ -162,24 +253,78 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 }
 
 // GetESDTBalance -
-func (ns *NodeStub) GetESDTBalance(address string, key string) (string, string, error) {
+func (ns *NodeStub) GetESDTBalance(address string, key string, options core.AccountQueryOptions) (string, string, error) {
 	if ns.GetESDTBalanceCalled != nil {
-		return ns.GetESDTBalanceCalled(address, key)
+		return ns.GetESDTBalanceCalled(address, key, options)
 	}
 
 	return "", "", nil
 }
 
+// GetESDTNFTTokenData -
+func (ns *NodeStub) GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	if ns.GetESDTNFTTokenDataCalled != nil {
+		return ns.GetESDTNFTTokenDataCalled(address, tokenID, nonce, options)
+	}
+
+	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
+}
+
 // GetAllESDTTokens -
-func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
+func (ns *NodeStub) GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error) {
 	if ns.GetAllESDTTokensCalled != nil {
-		return ns.GetAllESDTTokensCalled(address)
+		return ns.GetAllESDTTokensCalled(address, options)
 	}
 
 	return []string{""}, nil
 }
 
+// GetProof -
+func (ns *NodeStub) GetProof(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (ns *NodeStub) GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*apiAddress.AddressTransactions, error) {
+	if ns.GetAddressTransactionsCalled != nil {
+		return ns.GetAddressTransactionsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (ns *NodeStub) GetEvents(address string, query dblookupext.EventsQuery) (*apiEvents.Events, error) {
+	if ns.GetEventsCalled != nil {
+		return ns.GetEventsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetProofForKey -
+func (ns *NodeStub) GetProofForKey(address string, key string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	if ns.GetProofForKeyCalled != nil {
+		return ns.GetProofForKeyCalled(address, key, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
 }
+
+// GetBlockHeaderForAccountQuery -
+func (ns *NodeStub) GetBlockHeaderForAccountQuery(options core.AccountQueryOptions) (chainData.HeaderHandler, error) {
+	if ns.GetBlockHeaderForAccountQueryCalled != nil {
+		return ns.GetBlockHeaderForAccountQueryCalled(options)
+	}
+
+	return nil, nil
+}
@@ -6,7 +6,8 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTxCalled     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessBundleCalled func(txs []*transaction.Transaction, overrides map[string]*transaction.AccountOverride) ([]*transaction.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -18,6 +19,18 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tran
 	return &transaction.SimulationResults{}, nil
 }
 
+// ProcessBundle -
+func (t *TxExecutionSimulatorStub) ProcessBundle(
+	txs []*transaction.Transaction,
+	overrides map[string]*transaction.AccountOverride,
+) ([]*transaction.SimulationResults, error) {
+	if t.ProcessBundleCalled != nil {
+		return t.ProcessBundleCalled(txs, overrides)
+	}
+
+	return make([]*transaction.SimulationResults, len(txs)), nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -11,18 +11,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -43,6 +47,7 @@ const DefaultRestPortOff = "off"
 var _ = address.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
+var _ = subscription.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
 var _ = validator.FacadeHandler(&nodeFacade{})
 var _ = vmValues.FacadeHandler(&nodeFacade{})
@@ -65,6 +70,7 @@ type ArgNodeFacade struct {
 	ApiRoutesConfig        config.ApiRoutesConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
+	BlockEventsNotifier    BlockEventsNotifier
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -81,6 +87,7 @@ type nodeFacade struct {
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
+	blockEventsNotifier    BlockEventsNotifier
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -114,6 +121,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.PeerState) {
 		return nil, ErrNilPeerState
 	}
+	if check.IfNil(arg.BlockEventsNotifier) {
+		return nil, ErrNilBlockEventsNotifier
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -128,6 +138,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		endpointsThrottlers:    throttlersMap,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
+		blockEventsNotifier:    arg.BlockEventsNotifier,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -255,28 +266,53 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+func (nf *nodeFacade) GetUsername(address string, options core.AccountQueryOptions) (string, error) {
+	return nf.node.GetUsername(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTBalance returns the ESDT balance and if it is frozen
-func (nf *nodeFacade) GetESDTBalance(address string, key string) (string, string, error) {
-	return nf.node.GetESDTBalance(address, key)
+func (nf *nodeFacade) GetESDTBalance(address string, key string, options core.AccountQueryOptions) (string, string, error) {
+	return nf.node.GetESDTBalance(address, key, options)
+}
+
+// GetESDTNFTTokenData returns the data of the non fungible or semi fungible token with the given nonce
+func (nf *nodeFacade) GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	return nf.node.GetESDTNFTTokenData(address, tokenID, nonce, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
-func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
-	return nf.node.GetAllESDTTokens(address)
+func (nf *nodeFacade) GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetAllESDTTokens(address, options)
+}
+
+// GetProof returns the merkle proof of the given account
+func (nf *nodeFacade) GetProof(address string, options core.AccountQueryOptions) (*address.AccountProof, error) {
+	return nf.node.GetProof(address, options)
+}
+
+// GetProofForKey returns the merkle proofs of the given account and of the key from its data trie
+func (nf *nodeFacade) GetProofForKey(address string, key string, options core.AccountQueryOptions) (*address.AccountProof, error) {
+	return nf.node.GetProofForKey(address, key, options)
+}
+
+// GetAddressTransactions returns a page of the transactions, smart contract results and rewards involving the given address
+func (nf *nodeFacade) GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*address.AddressTransactions, error) {
+	return nf.node.GetAddressTransactions(address, query)
+}
+
+// GetEvents returns a page of the smart contract events having the given identifier emitted by the given address
+func (nf *nodeFacade) GetEvents(address string, query dblookupext.EventsQuery) (*events.Events, error) {
+	return nf.node.GetEvents(address, query)
 }
 
 // CreateTransaction creates a transaction from all needed fields
@@ -297,6 +333,16 @@ func (nf *nodeFacade) CreateTransaction(
 	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex, chainID, version, options)
 }
 
+// SetTransactionGuardian sets the guardian address and the guardian signature on a created transaction
+func (nf *nodeFacade) SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error) {
+	return nf.node.SetTransactionGuardian(tx, guardian, guardianSignatureHex)
+}
+
+// SetTransactionSignatures sets the signatures of the multisig account signers on a created transaction
+func (nf *nodeFacade) SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error) {
+	return nf.node.SetTransactionSignatures(tx, signaturesHex)
+}
+
 // ValidateTransaction will validate a transaction
 func (nf *nodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
 	return nf.node.ValidateTransaction(tx)
@@ -307,11 +353,26 @@ func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transacti
 	return nf.node.ValidateTransactionForSimulation(tx)
 }
 
+// ValidateTransactionFieldsForSimulation will validate the fields of a transaction for the simulation process
+func (nf *nodeFacade) ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error {
+	return nf.node.ValidateTransactionFieldsForSimulation(tx)
+}
+
 // ValidatorStatisticsApi will return the statistics for all validators
 func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// GetDoubleSigningProofs returns the most recent proofs of double signing built by the node
+func (nf *nodeFacade) GetDoubleSigningProofs() [][]byte {
+	return nf.node.GetDoubleSigningProofs()
+}
+
+// GetValidatorParticipation returns the per epoch history of the consensus participation of the provided validator
+func (nf *nodeFacade) GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error) {
+	return nf.node.GetValidatorParticipation(blsKey)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -322,11 +383,37 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionsBundle will simulate the execution of the transactions, in the given order, on top of the
+// state having the given overrides applied and will return the results of each transaction
+func (nf *nodeFacade) SimulateTransactionsBundle(
+	txs []*transaction.Transaction,
+	overrides map[string]*transaction.AccountOverride,
+) ([]*transaction.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessBundle(txs, overrides)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactionsPool returns the transactions sent from the current shard that are waiting in the pool
+func (nf *nodeFacade) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	return nf.node.GetTransactionsPool()
+}
+
+// GetTransactionsPoolForSender returns the transactions of the given sender waiting in the pool, together with the
+// queued nonces, the nonce gaps and the sender's score
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error) {
+	return nf.node.GetTransactionsPoolForSender(sender)
+}
+
+// GetGasPriceSuggestion returns the gas prices suggested from the analysis of the last numBlocks blocks of the
+// current shard, together with the fill of the blocks and of the transactions pool
+func (nf *nodeFacade) GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+	return nf.node.GetGasPriceSuggestion(numBlocks)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -334,8 +421,8 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (u
 
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -358,8 +445,23 @@ func (nf *nodeFacade) GetTotalStakedValue() (*big.Int, error) {
 	return nf.apiResolver.GetTotalStakedValue()
 }
 
-// ExecuteSCQuery retrieves data from existing SC trie
-func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
+// GetGovernanceProposals will return the governance proposals
+func (nf *nodeFacade) GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error) {
+	return nf.apiResolver.GetGovernanceProposals()
+}
+
+// ExecuteSCQuery retrieves data from existing SC trie. If the options select a block, the query is executed
+// on the state of that block
+func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery, options core.AccountQueryOptions) (*vm.VMOutputApi, error) {
+	if options.IsHistorical() {
+		header, err := nf.node.GetBlockHeaderForAccountQuery(options)
+		if err != nil {
+			return nil, err
+		}
+
+		query.BlockHeader = header
+	}
+
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
 	if err != nil {
 		return nil, err
@@ -426,7 +528,17 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlo
 func (nf *nodeFacade) Close() error {
 	nf.cancelFunc()
 
-	return nil
+	return nf.blockEventsNotifier.Close()
+}
+
+// SubscribeToBlockEvents registers the subscriber for the finalized blocks' notifications
+func (nf *nodeFacade) SubscribeToBlockEvents(subscriber subscription.Subscriber) error {
+	return nf.blockEventsNotifier.Subscribe(subscriber)
+}
+
+// UnsubscribeFromBlockEvents removes the subscriber from the finalized blocks' notifications
+func (nf *nodeFacade) UnsubscribeFromBlockEvents(subscriber subscription.Subscriber) {
+	nf.blockEventsNotifier.Unsubscribe(subscriber)
 }
 
 // GetNumCheckpointsFromAccountState returns the number of checkpoints of the account state
@@ -8,12 +8,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -49,8 +52,9 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &mock.AccountsStub{},
-		PeerState:     &mock.AccountsStub{},
+		AccountsState:       &mock.AccountsStub{},
+		PeerState:           &mock.AccountsStub{},
+		BlockEventsNotifier: &mock.BlockEventsNotifierStub{},
 	}
 }
 
@@ -78,6 +82,17 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilBlockEventsNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.BlockEventsNotifier = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilBlockEventsNotifier, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -140,7 +155,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ core.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -152,7 +167,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, core.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -167,7 +182,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ core.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -179,7 +194,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -191,7 +206,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ core.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -200,7 +215,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, core.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -228,6 +243,59 @@ func TestNodeFacade_GetTransactionWithValidInputsShouldNotReturnError(t *testing
 	assert.Equal(t, testTx, tx)
 }
 
+func TestNodeFacade_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	expectedSuggestion := &transaction.ApiGasPriceSuggestion{MedianGasPrice: 1000}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetGasPriceSuggestionCalled: func(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+			assert.Equal(t, uint32(15), numBlocks)
+			return expectedSuggestion, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	suggestion, err := nf.GetGasPriceSuggestion(15)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSuggestion, suggestion)
+}
+
+func TestNodeFacade_GetDoubleSigningProofs(t *testing.T) {
+	t.Parallel()
+
+	expectedProofs := [][]byte{[]byte("proof")}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetDoubleSigningProofsCalled: func() [][]byte {
+			return expectedProofs
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, expectedProofs, nf.GetDoubleSigningProofs())
+}
+
+func TestNodeFacade_GetValidatorParticipation(t *testing.T) {
+	t.Parallel()
+
+	expectedHistory := &state.ValidatorParticipationHistory{
+		Epochs: []*state.ValidatorParticipation{{Epoch: 2, NumSelected: 5}},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetValidatorParticipationCalled: func(blsKey string) (*state.ValidatorParticipationHistory, error) {
+			assert.Equal(t, "bls key", blsKey)
+			return expectedHistory, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	history, err := nf.GetValidatorParticipation("bls key")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHistory, history)
+}
+
 func TestNodeFacade_SetAndGetTpsBenchmark(t *testing.T) {
 	t.Parallel()
 
@@ -279,7 +347,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ core.AccountQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -288,7 +356,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", core.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -297,7 +365,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 
 	expectedUsername := "username"
 	node := &mock.NodeStub{}
-	node.GetUsernameCalled = func(address string) (string, error) {
+	node.GetUsernameCalled = func(address string, _ core.AccountQueryOptions) (string, error) {
 		return expectedUsername, nil
 	}
 
@@ -305,7 +373,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	username, err := nf.GetUsername("test")
+	username, err := nf.GetUsername("test", core.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUsername, username)
 }
@@ -375,10 +443,56 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	nf, err := NewNodeFacade(arg)
 	require.NoError(t, err)
 
-	_, _ = nf.ExecuteSCQuery(nil)
+	_, _ = nf.ExecuteSCQuery(nil, core.AccountQueryOptions{})
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_ExecuteSCQueryHistoricalShouldSetBlockHeader(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	options := core.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetBlockHeaderForAccountQueryCalled: func(opt core.AccountQueryOptions) (chainData.HeaderHandler, error) {
+			assert.Equal(t, options, opt)
+			return header, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, header, query.BlockHeader)
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	_, err := nf.ExecuteSCQuery(&process.SCQuery{}, options)
+	assert.Nil(t, err)
+}
+
+func TestNodeFacade_ExecuteSCQueryHistoricalBlockNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("block not found")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetBlockHeaderForAccountQueryCalled: func(_ core.AccountQueryOptions) (chainData.HeaderHandler, error) {
+			return nil, expectedErr
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	_, err := nf.ExecuteSCQuery(&process.SCQuery{}, core.AccountQueryOptions{BlockHash: []byte("hash")})
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
@@ -654,3 +768,36 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.NotNil(t, thr)
 	assert.True(t, ok)
 }
+
+func TestNodeFacade_SubscribeAndUnsubscribeFromBlockEventsShouldForwardToNotifier(t *testing.T) {
+	t.Parallel()
+
+	subscribeCalled := false
+	unsubscribeCalled := false
+	closeCalled := false
+	arg := createMockArguments()
+	arg.BlockEventsNotifier = &mock.BlockEventsNotifierStub{
+		SubscribeCalled: func(subscriber subscription.Subscriber) error {
+			subscribeCalled = true
+			return nil
+		},
+		UnsubscribeCalled: func(subscriber subscription.Subscriber) {
+			unsubscribeCalled = true
+		},
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.SubscribeToBlockEvents(nil)
+	assert.Nil(t, err)
+	nf.UnsubscribeFromBlockEvents(nil)
+	err = nf.Close()
+	assert.Nil(t, err)
+
+	assert.True(t, subscribeCalled)
+	assert.True(t, unsubscribeCalled)
+	assert.True(t, closeCalled)
+}
@@ -123,6 +123,12 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		}
 	}
 
+	// the proofs of double signing are always checked against the real signatures as they lead to slashing
+	slashingSigVerifier, err := systemVM.NewMessageSigVerifier(ccf.keyGen, processingSingleSigner)
+	if err != nil {
+		return nil, err
+	}
+
 	cacheConfig := ccf.config.PublicKeyPIDSignature
 	cachePkPIDSignature, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(cacheConfig))
 	if err != nil {
@@ -142,6 +148,7 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		TxSignKeyGen:         txSignKeyGen,
 		InitialPubKeys:       initialPubKeys,
 		MessageSignVerifier:  messageSignVerifier,
+		SlashingSigVerifier:  slashingSigVerifier,
 		PeerSignatureHandler: peerSigHandler,
 	}, nil
 }
@@ -79,10 +79,13 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		return nil, err
 	}
 
+	// the transactions pool storer exists only if the persistence of the transactions pool is enabled
 	dataPoolArgs := dataRetrieverFactory.ArgsDataPool{
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
 		ShardCoordinator: dcf.shardCoordinator,
+		TxPoolStorer:     store.GetStorer(dataRetriever.TxPoolUnit),
+		Marshalizer:      dcf.core.InternalMarshalizer,
 	}
 	datapool, err = dataRetrieverFactory.NewDataPoolFromConfig(dataPoolArgs)
 	if err != nil {
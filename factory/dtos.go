@@ -69,6 +69,7 @@ type CryptoComponents struct {
 	TxSignKeyGen         crypto.KeyGenerator
 	InitialPubKeys       map[uint32][]string
 	MessageSignVerifier  vm.MessageSignVerifier
+	SlashingSigVerifier  vm.MessageSignVerifier
 	PeerSignatureHandler crypto.PeerSignatureHandler
 }
 
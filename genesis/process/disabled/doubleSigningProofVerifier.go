@@ -0,0 +1,21 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// DoubleSigningProofVerifier implements the DoubleSigningProofVerifier interface, it rejects all the proofs as no
+// consensus group exists at genesis
+type DoubleSigningProofVerifier struct {
+}
+
+// VerifyProof returns an error as it is disabled
+func (dspv *DoubleSigningProofVerifier) VerifyProof(_ []byte) (*slash.DoubleSigningProof, error) {
+	return nil, process.ErrInvalidDoubleSigningProof
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (dspv *DoubleSigningProofVerifier) IsInterfaceNil() bool {
+	return dspv == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+// HistoricalAccounts implements the HistoricalAccountsHandler interface but does nothing as it is a disabled component
+type HistoricalAccounts struct {
+}
+
+// SelectRootHash does nothing as it is a disabled component
+func (ha *HistoricalAccounts) SelectRootHash(_ []byte) error {
+	return nil
+}
+
+// ResetRootHash does nothing as it is a disabled component
+func (ha *HistoricalAccounts) ResetRootHash() {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ha *HistoricalAccounts) IsInterfaceNil() bool {
+	return ha == nil
+}
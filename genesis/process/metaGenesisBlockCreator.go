@@ -260,17 +260,20 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		return nil, err
 	}
 	argsNewVMContainerFactory := metachain.ArgsNewVMContainerFactory{
-		ArgBlockChainHook:   argsHook,
-		Economics:           arg.Economics,
-		MessageSignVerifier: pubKeyVerifier,
-		GasSchedule:         arg.GasSchedule,
-		NodesConfigProvider: arg.InitialNodesSetup,
-		Hasher:              arg.Hasher,
-		Marshalizer:         arg.Marshalizer,
-		SystemSCConfig:      &arg.SystemSCConfig,
-		ValidatorAccountsDB: arg.ValidatorAccounts,
-		ChanceComputer:      &disabled.Rater{},
-		EpochNotifier:       epochNotifier,
+		ArgBlockChainHook:         argsHook,
+		Economics:                 arg.Economics,
+		MessageSignVerifier:       pubKeyVerifier,
+		ProofVerifier:             &disabled.DoubleSigningProofVerifier{},
+		GasSchedule:               arg.GasSchedule,
+		NodesConfigProvider:       arg.InitialNodesSetup,
+		Hasher:                    arg.Hasher,
+		Marshalizer:               arg.Marshalizer,
+		SystemSCConfig:            &arg.SystemSCConfig,
+		ValidatorAccountsDB:       arg.ValidatorAccounts,
+		ChanceComputer:            &disabled.Rater{},
+		EpochNotifier:             epochNotifier,
+		ESDTNFTEnableEpoch:        generalConfig.ESDTNFTEnableEpoch,
+		ESDTLocalRolesEnableEpoch: generalConfig.ESDTLocalRolesEnableEpoch,
 	}
 	virtualMachineFactory, err := metachain.NewVMContainerFactory(argsNewVMContainerFactory)
 	if err != nil {
@@ -310,10 +313,12 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           arg.PubkeyConv,
+		ShardCoordinator:          arg.ShardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             epochNotifier,
+		MultiRelayedTxEnableEpoch: generalConfig.MultiRelayedTransactionsEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -427,6 +432,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		arg.Economics,
 		virtualMachineFactory.BlockChainHookImpl(),
 		arg.Blkc,
+		&disabled.HistoricalAccounts{},
 	)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
@@ -45,6 +47,7 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		BuiltInFunctionsEnableEpoch:            0,
 		SCDeployEnableEpoch:                    unreachableEpoch,
 		RelayedTransactionsEnableEpoch:         0,
+		MultiRelayedTransactionsEnableEpoch:    unreachableEpoch,
 		PenalizedTooMuchGasEnableEpoch:         0,
 		AheadOfTimeGasUsageEnableEpoch:         unreachableEpoch,
 		BelowSignedThresholdEnableEpoch:        unreachableEpoch,
@@ -53,6 +56,11 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		TransactionSignedWithTxHashEnableEpoch: unreachableEpoch,
 		SwitchHysteresisForMinNodesEnableEpoch: unreachableEpoch,
 		SwitchJailWaitingEnableEpoch:           unreachableEpoch,
+		ESDTNFTEnableEpoch:                     unreachableEpoch,
+		ESDTMultiTransferEnableEpoch:           unreachableEpoch,
+		ESDTLocalRolesEnableEpoch:              unreachableEpoch,
+		GuardedAccountsEnableEpoch:             unreachableEpoch,
+		MultisigAccountsEnableEpoch:            unreachableEpoch,
 	}
 }
 
@@ -253,12 +261,27 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 }
 
 func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	// guardians and multisig accounts can not be set in the genesis block
+	guardedAccounts := guardian.NewDisabledGuardedAccount()
+
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          arg.GasSchedule,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasSchedule:                  arg.GasSchedule,
+		MapDNSAddresses:              make(map[string]struct{}),
+		EnableUserNameChange:         false,
+		Marshalizer:                  arg.Marshalizer,
+		Accounts:                     arg.Accounts,
+		ShardCoordinator:             arg.ShardCoordinator,
+		GuardedAccounts:              guardedAccounts,
+		MultisigAccounts:             multisig.NewDisabledMultisigAccount(),
+		EpochNotifier:                epochNotifier,
+		ESDTNFTEnableEpoch:           generalConfig.ESDTNFTEnableEpoch,
+		ESDTMultiTransferEnableEpoch: generalConfig.ESDTMultiTransferEnableEpoch,
+		ESDTLocalRolesEnableEpoch:    generalConfig.ESDTLocalRolesEnableEpoch,
+		GuardedAccountsEnableEpoch:   generalConfig.GuardedAccountsEnableEpoch,
+		MultisigAccountsEnableEpoch:  generalConfig.MultisigAccountsEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -337,10 +360,12 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 	}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           arg.PubkeyConv,
+		ShardCoordinator:          arg.ShardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             epochNotifier,
+		MultiRelayedTxEnableEpoch: generalConfig.MultiRelayedTransactionsEnableEpoch,
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	if err != nil {
@@ -352,9 +377,6 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	genesisFeeHandler := &disabled.FeeHandler{}
 	argsNewScProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:                    vmContainer,
@@ -411,8 +433,11 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		ScrForwarder:                   scForwarder,
 		EpochNotifier:                  epochNotifier,
 		RelayedTxEnableEpoch:           generalConfig.RelayedTransactionsEnableEpoch,
+		MultiRelayedTxEnableEpoch:      generalConfig.MultiRelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      generalConfig.MetaProtectionEnableEpoch,
+		GuardedAccounts:                guardedAccounts,
+		MultisigAccounts:               multisig.NewDisabledMultisigAccount(),
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -475,6 +500,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		arg.Economics,
 		vmFactoryImpl.BlockChainHookImpl(),
 		arg.Blkc,
+		&disabled.HistoricalAccounts{},
 	)
 	if err != nil {
 		return nil, err
@@ -423,6 +423,7 @@ func createConsensusOnlyNode(
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithPeerSignatureHandler(peerSigHandler),
 		node.WithIndexer(indexer.NewNilIndexer()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
 	)
 
 	if err != nil {
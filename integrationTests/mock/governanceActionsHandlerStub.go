@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// GovernanceActionsHandlerStub -
+type GovernanceActionsHandlerStub struct {
+	ProcessGovernanceActionCalled func(actionType string, arguments [][]byte, epoch uint32) error
+	AppliedConfigChangesCalled    func() []block.GovernanceConfigChange
+}
+
+// ProcessGovernanceAction -
+func (gahs *GovernanceActionsHandlerStub) ProcessGovernanceAction(actionType string, arguments [][]byte, epoch uint32) error {
+	if gahs.ProcessGovernanceActionCalled != nil {
+		return gahs.ProcessGovernanceActionCalled(actionType, arguments, epoch)
+	}
+	return nil
+}
+
+// AppliedConfigChanges -
+func (gahs *GovernanceActionsHandlerStub) AppliedConfigChanges() []block.GovernanceConfigChange {
+	if gahs.AppliedConfigChangesCalled != nil {
+		return gahs.AppliedConfigChangesCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (gahs *GovernanceActionsHandlerStub) IsInterfaceNil() bool {
+	return gahs == nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -257,7 +258,7 @@ func checkUserNamesAreSetCorrectly(
 			assert.Equal(t, userNames[i], string(userAcc.GetUserName()))
 
 			bech32c := integrationTests.TestAddressPubkeyConverter
-			usernameReportedByNode, err := node.Node.GetUsername(bech32c.Encode(player.Address))
+			usernameReportedByNode, err := node.Node.GetUsername(bech32c.Encode(player.Address), core.AccountQueryOptions{})
 			require.NoError(t, err)
 			require.Equal(t, userNames[i], usernameReportedByNode)
 		}
@@ -1,6 +1,7 @@
 package getAccount
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core"
 	"math/big"
 	"testing"
 
@@ -21,7 +22,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 	)
 
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(integrationTests.CreateRandomBytes(32))
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, err := n.GetAccount(encodedAddress, core.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -49,7 +50,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	)
 
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(addressBytes)
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, err := n.GetAccount(encodedAddress, core.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, recovAccnt.GetNonce())
@@ -0,0 +1,324 @@
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+)
+
+var _ process.BlockProcessor = (*blockProcessor)(nil)
+
+const maxShardHeadersInMetaBlock = 10
+
+// blockProcessor builds and validates empty blocks that only have to be correctly linked to the node's chain. On
+// the metachain, the blocks notarize, in order, the shard headers received from the network
+type blockProcessor struct {
+	shardID     uint32
+	chain       *chain
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+
+	shardGenesisHashes map[uint32][]byte
+	shardHeaders       map[uint32]map[string]*block.Header
+}
+
+func newBlockProcessor(
+	shardID uint32,
+	chain *chain,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	shardGenesisHashes map[uint32][]byte,
+) *blockProcessor {
+	return &blockProcessor{
+		shardID:            shardID,
+		chain:              chain,
+		marshalizer:        marshalizer,
+		hasher:             hasher,
+		shardGenesisHashes: shardGenesisHashes,
+		shardHeaders:       make(map[uint32]map[string]*block.Header),
+	}
+}
+
+func (bp *blockProcessor) isMetachain() bool {
+	return bp.shardID == core.MetachainShardId
+}
+
+// CreateNewHeader creates a new header for the node's shard
+func (bp *blockProcessor) CreateNewHeader(round uint64, nonce uint64) data.HeaderHandler {
+	if bp.isMetachain() {
+		return &block.MetaBlock{
+			Round:           round,
+			Nonce:           nonce,
+			SoftwareVersion: []byte("simulator"),
+		}
+	}
+
+	return &block.Header{
+		Round:           round,
+		Nonce:           nonce,
+		ShardID:         bp.shardID,
+		SoftwareVersion: []byte("simulator"),
+	}
+}
+
+// CreateBlock returns an empty body. On the metachain, the header notarizes the shard headers that follow the last
+// notarized ones
+func (bp *blockProcessor) CreateBlock(initialHdr data.HeaderHandler, _ func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+	if check.IfNil(initialHdr) {
+		return nil, nil, process.ErrNilBlockHeader
+	}
+
+	metaBlock, ok := initialHdr.(*block.MetaBlock)
+	if ok {
+		metaBlock.ShardInfo = bp.createShardInfo()
+	}
+
+	return initialHdr, &block.Body{}, nil
+}
+
+func (bp *blockProcessor) createShardInfo() []block.ShardData {
+	shardInfo := make([]block.ShardData, 0)
+	lastNotarized := bp.lastNotarizedShardHeaders()
+
+	for _, shardID := range bp.sortedShardIDs() {
+		last := lastNotarized[shardID]
+		for i := 0; i < maxShardHeadersInMetaBlock; i++ {
+			next, nextHash := bp.nextShardHeader(shardID, last)
+			if next == nil {
+				break
+			}
+
+			shardInfo = append(shardInfo, block.ShardData{
+				HeaderHash:   nextHash,
+				ShardID:      shardID,
+				Nonce:        next.Nonce,
+				Round:        next.Round,
+				PrevHash:     next.PrevHash,
+				PrevRandSeed: next.PrevRandSeed,
+			})
+			last = notarizedShardHeader{nonce: next.Nonce, hash: nextHash}
+		}
+	}
+
+	return shardInfo
+}
+
+func (bp *blockProcessor) nextShardHeader(shardID uint32, last notarizedShardHeader) (*block.Header, []byte) {
+	hashes := make([]string, 0)
+	for hash, header := range bp.shardHeaders[shardID] {
+		if header.Nonce == last.nonce+1 && bytes.Equal(header.PrevHash, last.hash) {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(hashes)
+
+	return bp.shardHeaders[shardID][hashes[0]], []byte(hashes[0])
+}
+
+type notarizedShardHeader struct {
+	nonce uint64
+	hash  []byte
+}
+
+// lastNotarizedShardHeaders is computed from the node's chain so that it is always consistent after rollbacks
+func (bp *blockProcessor) lastNotarizedShardHeaders() map[uint32]notarizedShardHeader {
+	lastNotarized := make(map[uint32]notarizedShardHeader)
+	for shardID, genesisHash := range bp.shardGenesisHashes {
+		lastNotarized[shardID] = notarizedShardHeader{nonce: 0, hash: genesisHash}
+	}
+
+	for nonce := uint64(1); nonce <= bp.chain.currentNonce(); nonce++ {
+		committed, _ := bp.chain.blockAt(nonce)
+		metaBlock, ok := committed.header.(*block.MetaBlock)
+		if !ok {
+			continue
+		}
+
+		for _, shardData := range metaBlock.ShardInfo {
+			lastNotarized[shardData.ShardID] = notarizedShardHeader{nonce: shardData.Nonce, hash: shardData.HeaderHash}
+		}
+	}
+
+	return lastNotarized
+}
+
+func (bp *blockProcessor) sortedShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, len(bp.shardGenesisHashes))
+	for shardID := range bp.shardGenesisHashes {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+// addShardHeader stores a shard header received by a metachain node so that it can be notarized
+func (bp *blockProcessor) addShardHeader(header *block.Header, hash []byte) {
+	headers, ok := bp.shardHeaders[header.ShardID]
+	if !ok {
+		headers = make(map[string]*block.Header)
+		bp.shardHeaders[header.ShardID] = headers
+	}
+
+	headers[string(hash)] = header
+}
+
+// ProcessBlock checks that the block follows the node's current block
+func (bp *blockProcessor) ProcessBlock(header data.HeaderHandler, body data.BodyHandler, _ func() time.Duration) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+	if check.IfNil(body) {
+		return process.ErrNilBlockBody
+	}
+
+	return bp.checkBlockValidity(header)
+}
+
+func (bp *blockProcessor) checkBlockValidity(header data.HeaderHandler) error {
+	if header.GetShardID() != bp.shardID {
+		return ErrWrongShard
+	}
+
+	current := bp.chain.current()
+	if header.GetNonce() != current.header.GetNonce()+1 {
+		return fmt.Errorf("%w: expected %d, got %d", ErrWrongNonce, current.header.GetNonce()+1, header.GetNonce())
+	}
+	if !bytes.Equal(header.GetPrevHash(), current.hash) {
+		return ErrWrongPrevHash
+	}
+	if !bytes.Equal(header.GetPrevRandSeed(), current.header.GetRandSeed()) {
+		return ErrWrongPrevRandSeed
+	}
+	if header.GetRound() <= current.header.GetRound() {
+		return ErrLowerRoundInBlock
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	lastNotarized := bp.lastNotarizedShardHeaders()
+	for _, shardData := range metaBlock.ShardInfo {
+		last := lastNotarized[shardData.ShardID]
+		if shardData.Nonce != last.nonce+1 || !bytes.Equal(shardData.PrevHash, last.hash) {
+			return fmt.Errorf("%w for shard %d notarized header", ErrWrongPrevHash, shardData.ShardID)
+		}
+		lastNotarized[shardData.ShardID] = notarizedShardHeader{nonce: shardData.Nonce, hash: shardData.HeaderHash}
+	}
+
+	return nil
+}
+
+// CommitBlock adds the block to the node's chain
+func (bp *blockProcessor) CommitBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+	if check.IfNil(body) {
+		return process.ErrNilBlockBody
+	}
+
+	err := bp.checkBlockValidity(header)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(bp.marshalizer, bp.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	return bp.chain.commit(header, hash)
+}
+
+// RevertAccountState does nothing as the simulated blocks do not change any state
+func (bp *blockProcessor) RevertAccountState(_ data.HeaderHandler) {
+}
+
+// PruneStateOnRollback does nothing
+func (bp *blockProcessor) PruneStateOnRollback(_ data.HeaderHandler, _ data.HeaderHandler) {
+}
+
+// RevertStateToBlock does nothing
+func (bp *blockProcessor) RevertStateToBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// RestoreBlockIntoPools does nothing
+func (bp *blockProcessor) RestoreBlockIntoPools(_ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// ApplyProcessedMiniBlocks does nothing
+func (bp *blockProcessor) ApplyProcessedMiniBlocks(_ *processedMb.ProcessedMiniBlockTracker) {
+}
+
+// MarshalizedDataToBroadcast returns empty maps as the simulated blocks do not contain any data
+func (bp *blockProcessor) MarshalizedDataToBroadcast(_ data.HeaderHandler, _ data.BodyHandler) (map[uint32][]byte, map[string][][]byte, error) {
+	return make(map[uint32][]byte), make(map[string][][]byte), nil
+}
+
+// DecodeBlockBody decodes the block body from the provided byte slice
+func (bp *blockProcessor) DecodeBlockBody(dta []byte) data.BodyHandler {
+	if dta == nil {
+		return &block.Body{}
+	}
+
+	body := &block.Body{}
+	err := bp.marshalizer.Unmarshal(body, dta)
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
+
+// DecodeBlockHeader decodes a header of the node's shard from the provided byte slice
+func (bp *blockProcessor) DecodeBlockHeader(dta []byte) data.HeaderHandler {
+	if dta == nil {
+		return nil
+	}
+
+	return decodeHeader(bp.marshalizer, bp.isMetachain(), dta)
+}
+
+// SetNumProcessedObj does nothing
+func (bp *blockProcessor) SetNumProcessedObj(_ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *blockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
+
+func decodeHeader(marshalizer marshal.Marshalizer, isMetachain bool, dta []byte) data.HeaderHandler {
+	var header data.HeaderHandler = &block.Header{}
+	if isMetachain {
+		header = &block.MetaBlock{}
+	}
+
+	err := marshalizer.Unmarshal(header, dta)
+	if err != nil {
+		return nil
+	}
+
+	return header
+}
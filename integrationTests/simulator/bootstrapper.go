@@ -0,0 +1,289 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.Bootstrapper = (*bootstrapper)(nil)
+
+const maxSyncedBlocksPerStep = 100
+const numNoncesKeptBehind = 10
+
+// orphanTimeoutRounds is the number of rounds after which a header that could not be linked to the node's chain
+// is forgotten, so that the node does not wait forever for a branch that nobody can provide
+const orphanTimeoutRounds = 5
+
+type knownHeader struct {
+	header        data.HeaderHandler
+	hash          []byte
+	receivedRound int64
+}
+
+// bootstrapper keeps the node's chain in sync with the final headers received from the network. It follows the
+// longest known branch: when a known header does not link to the current block and its parent is known, the
+// current block is rolled back. Missing headers are requested, once per round, from the peers of the same shard
+type bootstrapper struct {
+	chain          *chain
+	blockProcessor *blockProcessor
+	messenger      broadcaster
+	marshalizer    marshal.Marshalizer
+	currentRound   func() int64
+	requestTopic   string
+
+	knownHeaders       map[uint64]map[string]*knownHeader
+	lastRequestRound   int64
+	lastRequestedNonce uint64
+}
+
+func newBootstrapper(
+	chain *chain,
+	blockProcessor *blockProcessor,
+	messenger broadcaster,
+	marshalizer marshal.Marshalizer,
+	currentRound func() int64,
+	requestTopic string,
+) *bootstrapper {
+	return &bootstrapper{
+		chain:            chain,
+		blockProcessor:   blockProcessor,
+		messenger:        messenger,
+		marshalizer:      marshalizer,
+		currentRound:     currentRound,
+		requestTopic:     requestTopic,
+		knownHeaders:     make(map[uint64]map[string]*knownHeader),
+		lastRequestRound: -1,
+	}
+}
+
+// addKnownHeader stores a final header of the node's shard
+func (bs *bootstrapper) addKnownHeader(header data.HeaderHandler, hash []byte) {
+	if len(header.GetPubKeysBitmap()) == 0 {
+		return
+	}
+
+	headers, ok := bs.knownHeaders[header.GetNonce()]
+	if !ok {
+		headers = make(map[string]*knownHeader)
+		bs.knownHeaders[header.GetNonce()] = headers
+	}
+	if _, exists := headers[string(hash)]; exists {
+		return
+	}
+
+	headers[string(hash)] = &knownHeader{
+		header:        header,
+		hash:          hash,
+		receivedRound: bs.currentRound(),
+	}
+}
+
+// receivedProposedHeader requests the block that follows the current one when the proposed header shows that the
+// node fell behind and no final header above its current block is known
+func (bs *bootstrapper) receivedProposedHeader(header data.HeaderHandler) {
+	currentNonce := bs.chain.currentNonce()
+	if header.GetNonce() <= currentNonce+1 || bs.highestKnownNonce() > currentNonce {
+		return
+	}
+
+	bs.requestHeader(currentNonce + 1)
+}
+
+// sync commits the known headers that follow the current block, rolls back the current block when a longer
+// branch is known and requests the missing headers
+func (bs *bootstrapper) sync() {
+	bs.pruneKnownHeaders()
+
+	for i := 0; i < maxSyncedBlocksPerStep; i++ {
+		if !bs.syncOneBlock() {
+			return
+		}
+	}
+}
+
+func (bs *bootstrapper) syncOneBlock() bool {
+	current := bs.chain.current()
+	currentNonce := bs.chain.currentNonce()
+	if bs.highestKnownNonce() <= currentNonce {
+		return false
+	}
+
+	candidates := bs.knownHeaders[currentNonce+1]
+	if len(candidates) == 0 {
+		bs.requestHeader(currentNonce + 1)
+		return false
+	}
+
+	next := bs.bestCandidate(candidates, current.hash)
+	if next != nil {
+		delete(candidates, string(next.hash))
+		err := bs.blockProcessor.CommitBlock(next.header, &block.Body{})
+		if err != nil {
+			log.Debug("simulator bootstrapper: synced block could not be committed", "nonce", next.header.GetNonce(), "error", err.Error())
+		}
+
+		return true
+	}
+
+	fork := bs.bestCandidate(candidates, nil)
+	if currentNonce == 0 || !bs.isHeaderKnown(currentNonce, fork.header.GetPrevHash()) {
+		bs.requestHeader(currentNonce)
+		return false
+	}
+
+	removed, ok := bs.chain.rollback()
+	if !ok {
+		return false
+	}
+
+	log.Debug("simulator bootstrapper: rolled back block as a longer branch is known", "nonce", removed.header.GetNonce(), "hash", removed.hash)
+	bs.addKnownHeader(removed.header, removed.hash)
+
+	return true
+}
+
+// bestCandidate returns the candidate with the longest known branch, optionally filtered by its parent. The ties
+// are broken by hash so that the choice does not depend on the order in which the headers were received
+func (bs *bootstrapper) bestCandidate(candidates map[string]*knownHeader, prevHash []byte) *knownHeader {
+	var best *knownHeader
+	bestDepth := -1
+	for _, hash := range sortedKeys(candidates) {
+		candidate := candidates[hash]
+		if prevHash != nil && !bytes.Equal(candidate.header.GetPrevHash(), prevHash) {
+			continue
+		}
+
+		depth := bs.branchDepth(candidate)
+		if depth > bestDepth {
+			best = candidate
+			bestDepth = depth
+		}
+	}
+
+	return best
+}
+
+func (bs *bootstrapper) branchDepth(kh *knownHeader) int {
+	maxDepth := 0
+	for _, child := range bs.knownHeaders[kh.header.GetNonce()+1] {
+		if !bytes.Equal(child.header.GetPrevHash(), kh.hash) {
+			continue
+		}
+
+		depth := 1 + bs.branchDepth(child)
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+
+	return maxDepth
+}
+
+func (bs *bootstrapper) isHeaderKnown(nonce uint64, hash []byte) bool {
+	_, ok := bs.knownHeaders[nonce][string(hash)]
+
+	return ok
+}
+
+func (bs *bootstrapper) highestKnownNonce() uint64 {
+	highest := uint64(0)
+	for nonce, headers := range bs.knownHeaders {
+		if len(headers) > 0 && nonce > highest {
+			highest = nonce
+		}
+	}
+
+	return highest
+}
+
+// pruneKnownHeaders forgets the headers far behind the current block and the headers ahead of it that could not be
+// linked for too long
+func (bs *bootstrapper) pruneKnownHeaders() {
+	currentNonce := bs.chain.currentNonce()
+	currentRound := bs.currentRound()
+
+	for nonce, headers := range bs.knownHeaders {
+		if nonce+numNoncesKeptBehind < currentNonce {
+			delete(bs.knownHeaders, nonce)
+			continue
+		}
+		if nonce <= currentNonce {
+			continue
+		}
+
+		for hash, kh := range headers {
+			if currentRound-kh.receivedRound > orphanTimeoutRounds {
+				delete(headers, hash)
+			}
+		}
+	}
+}
+
+func (bs *bootstrapper) requestHeader(nonce uint64) {
+	currentRound := bs.currentRound()
+	if bs.lastRequestRound == currentRound && bs.lastRequestedNonce == nonce {
+		return
+	}
+
+	bs.lastRequestRound = currentRound
+	bs.lastRequestedNonce = nonce
+
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, nonce)
+	bs.messenger.Broadcast(bs.requestTopic, buff)
+}
+
+// reset forgets all the known headers, as a node that crashed loses them
+func (bs *bootstrapper) reset() {
+	bs.knownHeaders = make(map[uint64]map[string]*knownHeader)
+	bs.lastRequestRound = -1
+}
+
+// Close does nothing
+func (bs *bootstrapper) Close() error {
+	return nil
+}
+
+// AddSyncStateListener does nothing
+func (bs *bootstrapper) AddSyncStateListener(_ func(isSyncing bool)) {
+}
+
+// GetNodeState returns NsSynchronized if no final header above the current block is known
+func (bs *bootstrapper) GetNodeState() core.NodeState {
+	if bs.highestKnownNonce() > bs.chain.currentNonce() {
+		return core.NsNotSynchronized
+	}
+
+	return core.NsSynchronized
+}
+
+// StartSyncingBlocks does nothing as the simulator calls sync on each step
+func (bs *bootstrapper) StartSyncingBlocks() {
+}
+
+// SetStatusHandler does nothing
+func (bs *bootstrapper) SetStatusHandler(_ core.AppStatusHandler) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bs *bootstrapper) IsInterfaceNil() bool {
+	return bs == nil
+}
+
+func sortedKeys(headers map[string]*knownHeader) []string {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,85 @@
+package simulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ consensus.BroadcastMessenger = (*broadcastMessenger)(nil)
+
+// broadcastMessenger sends the consensus messages on the consensus topic and the committed headers on the headers
+// topic of the node's shard. The simulated blocks do not carry any transactions, so the block data is not sent
+type broadcastMessenger struct {
+	messenger      broadcaster
+	marshalizer    marshal.Marshalizer
+	consensusTopic string
+	headersTopic   string
+}
+
+// BroadcastBlock does nothing as the header is broadcast on its own and the simulated block bodies are empty
+func (bm *broadcastMessenger) BroadcastBlock(_ data.BodyHandler, _ data.HeaderHandler) error {
+	return nil
+}
+
+// BroadcastHeader sends the header on the headers topic of the node's shard
+func (bm *broadcastMessenger) BroadcastHeader(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilHeaderHandler
+	}
+
+	buff, err := bm.marshalizer.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	bm.messenger.Broadcast(bm.headersTopic, buff)
+
+	return nil
+}
+
+// BroadcastMiniBlocks does nothing
+func (bm *broadcastMessenger) BroadcastMiniBlocks(_ map[uint32][]byte) error {
+	return nil
+}
+
+// BroadcastTransactions does nothing
+func (bm *broadcastMessenger) BroadcastTransactions(_ map[string][][]byte) error {
+	return nil
+}
+
+// BroadcastConsensusMessage sends the consensus message on the consensus topic of the node's shard
+func (bm *broadcastMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
+	if message == nil {
+		return process.ErrNilMessage
+	}
+
+	buff, err := bm.marshalizer.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	bm.messenger.Broadcast(bm.consensusTopic, buff)
+
+	return nil
+}
+
+// BroadcastBlockDataLeader does nothing
+func (bm *broadcastMessenger) BroadcastBlockDataLeader(_ data.HeaderHandler, _ map[uint32][]byte, _ map[string][][]byte) error {
+	return nil
+}
+
+// PrepareBroadcastHeaderValidator does nothing as only the leader broadcasts the header in the simulation
+func (bm *broadcastMessenger) PrepareBroadcastHeaderValidator(_ data.HeaderHandler, _ map[uint32][]byte, _ map[string][][]byte, _ int) {
+}
+
+// PrepareBroadcastBlockDataValidator does nothing
+func (bm *broadcastMessenger) PrepareBroadcastBlockDataValidator(_ data.HeaderHandler, _ map[uint32][]byte, _ map[string][][]byte, _ int) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bm *broadcastMessenger) IsInterfaceNil() bool {
+	return bm == nil
+}
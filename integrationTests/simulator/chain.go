@@ -0,0 +1,94 @@
+package simulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type chainBlock struct {
+	header data.HeaderHandler
+	hash   []byte
+}
+
+// chain holds the blocks committed by a node, indexed by nonce, starting with the genesis block. It keeps the
+// node's data.ChainHandler in sync with the last committed block and records every commit and rollback, so that
+// forks can be inspected after the simulation
+type chain struct {
+	blockChain   data.ChainHandler
+	blocks       []chainBlock
+	commits      []chainBlock
+	numRollbacks int
+}
+
+func newChain(blockChain data.ChainHandler, genesis chainBlock) (*chain, error) {
+	err := blockChain.SetGenesisHeader(genesis.header)
+	if err != nil {
+		return nil, err
+	}
+	blockChain.SetGenesisHeaderHash(genesis.hash)
+
+	return &chain{
+		blockChain: blockChain,
+		blocks:     []chainBlock{genesis},
+		commits:    make([]chainBlock, 0),
+	}, nil
+}
+
+func (c *chain) current() chainBlock {
+	return c.blocks[len(c.blocks)-1]
+}
+
+func (c *chain) currentNonce() uint64 {
+	return uint64(len(c.blocks) - 1)
+}
+
+func (c *chain) blockAt(nonce uint64) (chainBlock, bool) {
+	if nonce >= uint64(len(c.blocks)) {
+		return chainBlock{}, false
+	}
+
+	return c.blocks[nonce], true
+}
+
+func (c *chain) commit(header data.HeaderHandler, hash []byte) error {
+	err := c.setCurrent(header, hash)
+	if err != nil {
+		return err
+	}
+
+	committed := chainBlock{header: header, hash: hash}
+	c.blocks = append(c.blocks, committed)
+	c.commits = append(c.commits, committed)
+
+	return nil
+}
+
+// rollback removes the last committed block and returns it. The genesis block is never removed
+func (c *chain) rollback() (chainBlock, bool) {
+	if len(c.blocks) == 1 {
+		return chainBlock{}, false
+	}
+
+	removed := c.current()
+	c.blocks = c.blocks[:len(c.blocks)-1]
+	c.numRollbacks++
+
+	var err error
+	if len(c.blocks) == 1 {
+		err = c.setCurrent(nil, nil)
+	} else {
+		err = c.setCurrent(c.current().header, c.current().hash)
+	}
+	log.LogIfError(err)
+
+	return removed, true
+}
+
+func (c *chain) setCurrent(header data.HeaderHandler, hash []byte) error {
+	err := c.blockChain.SetCurrentBlockHeader(header)
+	if err != nil {
+		return err
+	}
+	c.blockChain.SetCurrentBlockHeaderHash(hash)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package simulator
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var _ consensus.ChronologyHandler = (*chronology)(nil)
+
+const srBeforeStartRound = -1
+
+// waitingAllSignaturesMaxTimeThreshold is the part of the signature subround in which the leader waits for all the
+// signatures, the same as the one used by the bls signature subround
+const waitingAllSignaturesMaxTimeThreshold = 0.5
+
+// chronology is a steppable replacement of the consensus chronology. Instead of running the subrounds from its own
+// go routine and waiting for the consensus state to change, it is advanced by the simulator on each tick of the
+// virtual clock: the current subround does its job once, is checked on every step and is canceled when the round
+// time is out, exactly as spos.Subround.DoWork would do it in real time. The leader's timeout for waiting all the
+// signatures is also applied here, on the virtual clock, as the signature subround waits for it on the system clock
+type chronology struct {
+	genesisTime    time.Time
+	rounder        consensus.Rounder
+	syncTimer      ntp.SyncTimer
+	consensusState *spos.ConsensusState
+	worker         *worker
+
+	subroundId       int
+	subroundStarted  bool
+	subrounds        map[int]int
+	subroundHandlers []steppableSubround
+}
+
+func newChronology(
+	genesisTime time.Time,
+	rounder consensus.Rounder,
+	syncTimer ntp.SyncTimer,
+	consensusState *spos.ConsensusState,
+) *chronology {
+	return &chronology{
+		genesisTime:      genesisTime,
+		rounder:          rounder,
+		syncTimer:        syncTimer,
+		consensusState:   consensusState,
+		subroundId:       srBeforeStartRound,
+		subrounds:        make(map[int]int),
+		subroundHandlers: make([]steppableSubround, 0),
+	}
+}
+
+func (chr *chronology) setWorker(wrk *worker) {
+	chr.worker = wrk
+}
+
+// AddSubround adds a new subround. The subrounds that can not be driven step by step are ignored
+func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
+	subround, ok := subroundHandler.(steppableSubround)
+	if !ok {
+		log.Warn("simulator chronology: subround can not be driven step by step", "subround", subroundHandler.Name())
+		return
+	}
+
+	chr.subrounds[subround.Current()] = len(chr.subroundHandlers)
+	chr.subroundHandlers = append(chr.subroundHandlers, subround)
+}
+
+// RemoveAllSubrounds removes all the subrounds
+func (chr *chronology) RemoveAllSubrounds() {
+	chr.subrounds = make(map[int]int)
+	chr.subroundHandlers = make([]steppableSubround, 0)
+}
+
+// StartRounds does nothing as the rounds are advanced by the simulator
+func (chr *chronology) StartRounds() {
+}
+
+// step advances the consensus of the node as far as possible at the current virtual time
+func (chr *chronology) step() {
+	for i := 0; i <= len(chr.subroundHandlers); i++ {
+		if chr.subroundId == srBeforeStartRound {
+			chr.updateRound()
+		}
+		if chr.rounder.BeforeGenesis() {
+			return
+		}
+
+		subround := chr.loadSubroundHandler(chr.subroundId)
+		if subround == nil {
+			return
+		}
+
+		if !chr.doWork(subround) {
+			return
+		}
+
+		chr.subroundId = subround.Next()
+		chr.subroundStarted = false
+	}
+}
+
+// doWork returns true if the subround has finished. If the time for the round is out, the subround is canceled and
+// the node waits for the next round
+func (chr *chronology) doWork(subround steppableSubround) bool {
+	chr.worker.executeStoredMessages()
+
+	if !chr.subroundStarted {
+		chr.subroundStarted = true
+		if subround.StartWork() {
+			return true
+		}
+	}
+
+	chr.worker.executeStoredMessages()
+	chr.checkWaitingAllSignaturesTimeOut(subround)
+	if subround.CheckWork() {
+		return true
+	}
+
+	maxTime := chr.rounder.TimeDuration() * spos.MaxThresholdPercent / 100
+	if chr.rounder.RemainingTime(chr.rounder.TimeStamp(), maxTime) <= 0 {
+		subround.CancelWork()
+		chr.subroundId = srBeforeStartRound
+		chr.subroundStarted = false
+	}
+
+	return false
+}
+
+// checkWaitingAllSignaturesTimeOut lets the leader finish the signature subround with the threshold of signatures
+// once the time for waiting all of them is out on the virtual clock
+func (chr *chronology) checkWaitingAllSignaturesTimeOut(subround steppableSubround) {
+	if subround.Current() != bls.SrSignature || chr.consensusState.WaitingAllSignaturesTimeOut {
+		return
+	}
+	if !chr.consensusState.IsSelfLeaderInCurrentRound() {
+		return
+	}
+
+	maxTime := computeWaitingAllSignaturesMaxTime(subround)
+	if chr.rounder.RemainingTime(chr.rounder.TimeStamp(), maxTime) <= 0 {
+		chr.consensusState.WaitingAllSignaturesTimeOut = true
+	}
+}
+
+// waitingAllSignaturesMaxTime returns the time, from the start of the round, until which the leader waits for all
+// the signatures
+func (chr *chronology) waitingAllSignaturesMaxTime() (time.Duration, bool) {
+	subround := chr.loadSubroundHandler(bls.SrSignature)
+	if subround == nil {
+		return 0, false
+	}
+
+	return computeWaitingAllSignaturesMaxTime(subround), true
+}
+
+// computeWaitingAllSignaturesMaxTime computes the max time exactly as the bls signature subround does it
+func computeWaitingAllSignaturesMaxTime(subround consensus.SubroundHandler) time.Duration {
+	subroundDuration := float64(subround.EndTime() - subround.StartTime())
+	return time.Duration(float64(subround.StartTime()) + subroundDuration*waitingAllSignaturesMaxTimeThreshold)
+}
+
+func (chr *chronology) updateRound() {
+	oldRoundIndex := chr.rounder.Index()
+	chr.rounder.UpdateRound(chr.genesisTime, chr.syncTimer.CurrentTime())
+
+	if oldRoundIndex != chr.rounder.Index() {
+		chr.initRound()
+	}
+}
+
+func (chr *chronology) initRound() {
+	chr.subroundId = srBeforeStartRound
+	chr.subroundStarted = false
+
+	if !chr.rounder.BeforeGenesis() && len(chr.subroundHandlers) > 0 {
+		chr.subroundId = chr.subroundHandlers[0].Current()
+	}
+}
+
+// reset is called when the node restarts, so that it waits for the next round, as a freshly started node does
+func (chr *chronology) reset() {
+	chr.subroundId = srBeforeStartRound
+	chr.subroundStarted = false
+}
+
+func (chr *chronology) loadSubroundHandler(subroundId int) steppableSubround {
+	index, exists := chr.subrounds[subroundId]
+	if !exists {
+		return nil
+	}
+
+	return chr.subroundHandlers[index]
+}
+
+// Close does nothing
+func (chr *chronology) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chr *chronology) IsInterfaceNil() bool {
+	return chr == nil
+}
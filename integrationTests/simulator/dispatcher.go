@@ -0,0 +1,303 @@
+package simulator
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ memp2p.MessageDispatcher = (*messageDispatcher)(nil)
+
+// LinkConditions defines how the messages sent from one node to another are affected by the network. Each message
+// is delayed with Latency plus a random value in the [0, Jitter) interval and is lost with the LossRate probability
+type LinkConditions struct {
+	Latency  time.Duration
+	Jitter   time.Duration
+	LossRate float64
+}
+
+func (lc LinkConditions) check() error {
+	if lc.Latency < 0 || lc.Jitter < 0 {
+		return ErrInvalidLatency
+	}
+	if lc.LossRate < 0 || lc.LossRate > 1 {
+		return ErrInvalidLossRate
+	}
+
+	return nil
+}
+
+// NetworkStatistics holds the counters of the messages handled by the simulated network
+type NetworkStatistics struct {
+	NumSent      uint64
+	NumDelivered uint64
+	NumLost      uint64
+	NumBlocked   uint64
+}
+
+type link struct {
+	from int
+	to   int
+}
+
+type pendingDelivery struct {
+	deliverAt time.Time
+	from      int
+	to        int
+	seqNo     uint64
+	deliver   func()
+}
+
+// deliveryQueue orders the pending deliveries by delivery time, breaking the ties by sender, sender's
+// sequence number and receiver so that the order never depends on the order in which the messages were dispatched
+type deliveryQueue []*pendingDelivery
+
+func (dq deliveryQueue) Len() int {
+	return len(dq)
+}
+
+func (dq deliveryQueue) Less(i, j int) bool {
+	a, b := dq[i], dq[j]
+	if !a.deliverAt.Equal(b.deliverAt) {
+		return a.deliverAt.Before(b.deliverAt)
+	}
+	if a.from != b.from {
+		return a.from < b.from
+	}
+	if a.seqNo != b.seqNo {
+		return a.seqNo < b.seqNo
+	}
+
+	return a.to < b.to
+}
+
+func (dq deliveryQueue) Swap(i, j int) {
+	dq[i], dq[j] = dq[j], dq[i]
+}
+
+func (dq *deliveryQueue) Push(x interface{}) {
+	*dq = append(*dq, x.(*pendingDelivery))
+}
+
+func (dq *deliveryQueue) Pop() interface{} {
+	old := *dq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*dq = old[:n-1]
+
+	return item
+}
+
+// messageDispatcher is the memp2p.MessageDispatcher that applies the simulated network conditions. Every message
+// is delayed on the virtual clock and delivered, synchronously, by deliverUntil
+type messageDispatcher struct {
+	mut               sync.Mutex
+	seed              uint64
+	clock             *VirtualClock
+	peerIndexes       map[core.PeerID]int
+	defaultConditions LinkConditions
+	links             map[link]LinkConditions
+	partitionGroups   map[int]int
+	crashed           map[int]struct{}
+	queue             deliveryQueue
+	statistics        NetworkStatistics
+}
+
+func newMessageDispatcher(seed int64, clock *VirtualClock, defaultConditions LinkConditions) *messageDispatcher {
+	return &messageDispatcher{
+		seed:              uint64(seed),
+		clock:             clock,
+		peerIndexes:       make(map[core.PeerID]int),
+		defaultConditions: defaultConditions,
+		links:             make(map[link]LinkConditions),
+		crashed:           make(map[int]struct{}),
+		queue:             make(deliveryQueue, 0),
+	}
+}
+
+func (md *messageDispatcher) registerPeer(pid core.PeerID, nodeIndex int) {
+	md.mut.Lock()
+	md.peerIndexes[pid] = nodeIndex
+	md.mut.Unlock()
+}
+
+// Dispatch schedules the delivery of the message on the virtual clock, or drops it if the sender and the receiver
+// can not communicate or if the link loses it
+func (md *messageDispatcher) Dispatch(message p2p.MessageP2P, to core.PeerID, deliver func()) {
+	md.mut.Lock()
+	defer md.mut.Unlock()
+
+	fromIndex, found := md.peerIndexes[message.Peer()]
+	if !found {
+		return
+	}
+	toIndex, found := md.peerIndexes[to]
+	if !found || fromIndex == toIndex {
+		return
+	}
+
+	md.statistics.NumSent++
+	if !md.canCommunicate(fromIndex, toIndex) {
+		md.statistics.NumBlocked++
+		return
+	}
+
+	seqNo := seqNoFromMessage(message)
+	conditions := md.linkConditions(fromIndex, toIndex)
+	if randomUnit(md.seed, uint64(fromIndex), uint64(toIndex), seqNo, 0) < conditions.LossRate {
+		md.statistics.NumLost++
+		return
+	}
+
+	delay := conditions.Latency
+	if conditions.Jitter > 0 {
+		delay += time.Duration(randomUnit(md.seed, uint64(fromIndex), uint64(toIndex), seqNo, 1) * float64(conditions.Jitter))
+	}
+
+	heap.Push(&md.queue, &pendingDelivery{
+		deliverAt: md.clock.CurrentTime().Add(delay),
+		from:      fromIndex,
+		to:        toIndex,
+		seqNo:     seqNo,
+		deliver:   deliver,
+	})
+}
+
+// deliverUntil delivers, in order, all the messages due at the provided time. The messages sent while delivering
+// are delivered in the same call if they are also due
+func (md *messageDispatcher) deliverUntil(currentTime time.Time) {
+	for {
+		md.mut.Lock()
+		if len(md.queue) == 0 || md.queue[0].deliverAt.After(currentTime) {
+			md.mut.Unlock()
+			return
+		}
+
+		delivery := heap.Pop(&md.queue).(*pendingDelivery)
+		canDeliver := md.canCommunicate(delivery.from, delivery.to)
+		if canDeliver {
+			md.statistics.NumDelivered++
+		} else {
+			md.statistics.NumBlocked++
+		}
+		md.mut.Unlock()
+
+		if canDeliver {
+			delivery.deliver()
+		}
+	}
+}
+
+// canCommunicate is checked both when the message is sent and when it is delivered, so that a crash or a
+// partition also drops the messages that are in flight
+func (md *messageDispatcher) canCommunicate(from int, to int) bool {
+	_, isFromCrashed := md.crashed[from]
+	_, isToCrashed := md.crashed[to]
+	if isFromCrashed || isToCrashed {
+		return false
+	}
+	if md.partitionGroups == nil {
+		return true
+	}
+
+	return md.partitionGroups[from] == md.partitionGroups[to]
+}
+
+func (md *messageDispatcher) linkConditions(from int, to int) LinkConditions {
+	conditions, found := md.links[link{from: from, to: to}]
+	if found {
+		return conditions
+	}
+
+	return md.defaultConditions
+}
+
+func (md *messageDispatcher) setLinkConditions(from int, to int, conditions LinkConditions) {
+	md.mut.Lock()
+	md.links[link{from: from, to: to}] = conditions
+	md.mut.Unlock()
+}
+
+func (md *messageDispatcher) setDefaultConditions(conditions LinkConditions) {
+	md.mut.Lock()
+	md.defaultConditions = conditions
+	md.links = make(map[link]LinkConditions)
+	md.mut.Unlock()
+}
+
+// setPartition splits the nodes in groups that can only communicate inside the group. The nodes not present in
+// any of the provided groups form a group of their own
+func (md *messageDispatcher) setPartition(groups [][]int) {
+	md.mut.Lock()
+	defer md.mut.Unlock()
+
+	md.partitionGroups = make(map[int]int)
+	for groupIndex, group := range groups {
+		for _, nodeIndex := range group {
+			md.partitionGroups[nodeIndex] = groupIndex + 1
+		}
+	}
+}
+
+func (md *messageDispatcher) healPartition() {
+	md.mut.Lock()
+	md.partitionGroups = nil
+	md.mut.Unlock()
+}
+
+func (md *messageDispatcher) setCrashed(nodeIndex int, isCrashed bool) {
+	md.mut.Lock()
+	defer md.mut.Unlock()
+
+	if isCrashed {
+		md.crashed[nodeIndex] = struct{}{}
+		return
+	}
+
+	delete(md.crashed, nodeIndex)
+}
+
+func (md *messageDispatcher) getStatistics() NetworkStatistics {
+	md.mut.Lock()
+	defer md.mut.Unlock()
+
+	return md.statistics
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *messageDispatcher) IsInterfaceNil() bool {
+	return md == nil
+}
+
+func seqNoFromMessage(message p2p.MessageP2P) uint64 {
+	seqNo := message.SeqNo()
+	if len(seqNo) < 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(seqNo)
+}
+
+// randomUnit returns a value in the [0, 1) interval that only depends on the provided values
+func randomUnit(values ...uint64) float64 {
+	hash := uint64(0)
+	for _, value := range values {
+		hash = mix64(hash ^ value)
+	}
+
+	return float64(hash>>11) / float64(uint64(1)<<53)
+}
+
+func mix64(value uint64) uint64 {
+	value += 0x9e3779b97f4a7c15
+	value = (value ^ (value >> 30)) * 0xbf58476d1ce4e5b9
+	value = (value ^ (value >> 27)) * 0x94d049bb133111eb
+
+	return value ^ (value >> 31)
+}
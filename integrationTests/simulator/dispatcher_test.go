@@ -0,0 +1,92 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMessage(from core.PeerID, seqNo uint64) *mock.P2PMessageMock {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, seqNo)
+
+	return &mock.P2PMessageMock{
+		PeerField:  from,
+		SeqNoField: buff,
+	}
+}
+
+func createDispatcher(conditions LinkConditions) (*messageDispatcher, *VirtualClock) {
+	clock := NewVirtualClock(time.Unix(genesisUnixTime, 0))
+	md := newMessageDispatcher(1, clock, conditions)
+	md.registerPeer("pid0", 0)
+	md.registerPeer("pid1", 1)
+	md.registerPeer("pid2", 2)
+
+	return md, clock
+}
+
+func TestMessageDispatcher_ShouldDeliverAfterLatency(t *testing.T) {
+	t.Parallel()
+
+	md, clock := createDispatcher(LinkConditions{Latency: time.Second})
+
+	numDelivered := 0
+	md.Dispatch(createMessage("pid0", 1), "pid1", func() { numDelivered++ })
+
+	md.deliverUntil(clock.Advance(999 * time.Millisecond))
+	assert.Equal(t, 0, numDelivered)
+
+	md.deliverUntil(clock.Advance(time.Millisecond))
+	assert.Equal(t, 1, numDelivered)
+	assert.Equal(t, NetworkStatistics{NumSent: 1, NumDelivered: 1}, md.getStatistics())
+}
+
+func TestMessageDispatcher_ShouldDeliverInTimeOrder(t *testing.T) {
+	t.Parallel()
+
+	md, clock := createDispatcher(LinkConditions{Latency: time.Second})
+	md.setLinkConditions(2, 1, LinkConditions{Latency: 100 * time.Millisecond})
+
+	delivered := make([]int, 0)
+	md.Dispatch(createMessage("pid0", 1), "pid1", func() { delivered = append(delivered, 0) })
+	md.Dispatch(createMessage("pid2", 1), "pid1", func() { delivered = append(delivered, 2) })
+
+	md.deliverUntil(clock.Advance(time.Second))
+	assert.Equal(t, []int{2, 0}, delivered)
+}
+
+func TestMessageDispatcher_ShouldDropLostPartitionedAndCrashedMessages(t *testing.T) {
+	t.Parallel()
+
+	md, clock := createDispatcher(LinkConditions{Latency: time.Second})
+	md.setLinkConditions(0, 2, LinkConditions{LossRate: 1})
+
+	numDelivered := 0
+	deliver := func() { numDelivered++ }
+	md.Dispatch(createMessage("pid0", 1), "pid2", deliver)
+
+	md.setPartition([][]int{{0}})
+	md.Dispatch(createMessage("pid0", 2), "pid1", deliver)
+	md.healPartition()
+
+	md.Dispatch(createMessage("pid1", 1), "pid0", deliver)
+	md.setCrashed(0, true)
+
+	md.deliverUntil(clock.Advance(time.Second))
+	assert.Equal(t, 0, numDelivered)
+	assert.Equal(t, NetworkStatistics{NumSent: 3, NumLost: 1, NumBlocked: 2}, md.getStatistics())
+}
+
+func TestRandomUnit_ShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	value := randomUnit(1, 2, 3)
+	assert.Equal(t, value, randomUnit(1, 2, 3))
+	assert.NotEqual(t, value, randomUnit(1, 2, 4))
+	assert.True(t, value >= 0 && value < 1)
+}
@@ -0,0 +1,48 @@
+package simulator
+
+import "errors"
+
+// ErrInvalidNumberOfNodes signals that the number of nodes per shard or of metachain nodes is invalid
+var ErrInvalidNumberOfNodes = errors.New("invalid number of nodes")
+
+// ErrInvalidConsensusGroupSize signals that the consensus group size is invalid
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrInvalidRoundDuration signals that the round duration is invalid
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrInvalidTickDuration signals that the tick duration is invalid
+var ErrInvalidTickDuration = errors.New("invalid tick duration")
+
+// ErrInvalidLossRate signals that the provided message loss rate is not in the [0, 1] interval
+var ErrInvalidLossRate = errors.New("invalid loss rate")
+
+// ErrInvalidLatency signals that a negative latency or jitter has been provided
+var ErrInvalidLatency = errors.New("invalid latency")
+
+// ErrInvalidNodeIndex signals that the provided node index does not exist in the simulation
+var ErrInvalidNodeIndex = errors.New("invalid node index")
+
+// ErrNodeAlreadyCrashed signals that a crashed node was asked to crash again
+var ErrNodeAlreadyCrashed = errors.New("node already crashed")
+
+// ErrNodeNotCrashed signals that a running node was asked to restart
+var ErrNodeNotCrashed = errors.New("node is not crashed")
+
+// ErrWrongNonce signals that a block does not follow the current block of the node
+var ErrWrongNonce = errors.New("wrong nonce")
+
+// ErrWrongPrevHash signals that a block is not linked to the current block of the node
+var ErrWrongPrevHash = errors.New("wrong previous hash")
+
+// ErrWrongPrevRandSeed signals that the previous random seed of a block does not match the current block
+var ErrWrongPrevRandSeed = errors.New("wrong previous random seed")
+
+// ErrLowerRoundInBlock signals that a block has a round lower or equal to the one of the current block
+var ErrLowerRoundInBlock = errors.New("lower round in block")
+
+// ErrWrongShard signals that a block belongs to another shard
+var ErrWrongShard = errors.New("wrong shard")
+
+// ErrMissingSignatureSubround signals that the consensus subrounds do not contain the signature subround
+var ErrMissingSignatureSubround = errors.New("missing signature subround")
@@ -0,0 +1,19 @@
+package simulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// steppableSubround defines the subround methods used by the simulator's chronology to drive the consensus step
+// by step, on the virtual clock
+type steppableSubround interface {
+	consensus.SubroundHandler
+	StartWork() bool
+	CheckWork() bool
+	CancelWork()
+}
+
+// broadcaster defines the messenger method used by the simulated components to send messages to the network
+type broadcaster interface {
+	Broadcast(topic string, buff []byte)
+}
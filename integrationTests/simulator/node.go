@@ -0,0 +1,442 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+)
+
+const requestTopicSuffix = "_REQUEST"
+const consensusGroupCacheSize = 10000
+
+// Node is a shard or metachain node of the simulation. It runs the real BLS subrounds on top of a simulated block
+// processor, bootstrapper and worker, all of them driven by the simulator
+type Node struct {
+	index     int
+	shardID   uint32
+	pubKey    []byte
+	isCrashed bool
+
+	messenger      *memp2p.Messenger
+	chain          *chain
+	blockProcessor *blockProcessor
+	bootstrapper   *bootstrapper
+	worker         *worker
+	chronology     *chronology
+}
+
+type argNode struct {
+	index              int
+	shardID            uint32
+	privateKey         crypto.PrivateKey
+	simulator          *Simulator
+	eligible           map[uint32][]sharding.Validator
+	genesis            map[uint32]chainBlock
+	shardGenesisHashes map[uint32][]byte
+}
+
+func newNode(args argNode) (*Node, error) {
+	sim := args.simulator
+	marshalizer := integrationTests.TestMarshalizer
+	hasher := integrationTests.TestHasher
+	isMetachain := args.shardID == core.MetachainShardId
+
+	pubKey, err := args.privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	messenger, err := memp2p.NewMessenger(sim.network)
+	if err != nil {
+		return nil, err
+	}
+	sim.dispatcher.registerPeer(messenger.ID(), args.index)
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(sim.args.NumShards, args.shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockChain data.ChainHandler = blockchain.NewBlockChain()
+	if isMetachain {
+		blockChain = blockchain.NewMetaChain()
+	}
+	nodeChain, err := newChain(blockChain, args.genesis[args.shardID])
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	consensusGroupCache, err := lrucache.NewCache(consensusGroupCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	nodesCoordinator, err := sharding.NewIndexHashedNodesCoordinator(sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: sim.args.ShardConsensusGroupSize,
+		MetaConsensusGroupSize:  sim.args.MetaConsensusGroupSize,
+		Marshalizer:             marshalizer,
+		Hasher:                  hasher,
+		Shuffler:                &mock.NodeShufflerMock{},
+		EpochStartNotifier:      epochStartNotifier,
+		BootStorer:              integrationTests.CreateMemUnit(),
+		NbShards:                sim.args.NumShards,
+		EligibleNodes:           args.eligible,
+		WaitingNodes:            make(map[uint32][]sharding.Validator),
+		SelfPublicKey:           pubKey,
+		ConsensusGroupCache:     consensusGroupCache,
+		ShuffledOutHandler:      &mock.ShuffledOutHandlerStub{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	simRound, err := round.NewRound(sim.genesisTime, sim.clock.CurrentTime(), sim.args.RoundDuration, sim.clock, 0)
+	if err != nil {
+		return nil, err
+	}
+	rounder := &safeRounder{rounder: simRound}
+
+	consensusGroupSize := sim.args.ShardConsensusGroupSize
+	if isMetachain {
+		consensusGroupSize = sim.args.MetaConsensusGroupSize
+	}
+	consensusState, err := createConsensusState(nodesCoordinator, consensusGroupSize, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusService, err := bls.NewConsensusService()
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		index:     args.index,
+		shardID:   args.shardID,
+		pubKey:    pubKey,
+		messenger: messenger,
+		chain:     nodeChain,
+	}
+	n.blockProcessor = newBlockProcessor(args.shardID, nodeChain, marshalizer, hasher, args.shardGenesisHashes)
+	n.bootstrapper = newBootstrapper(
+		nodeChain,
+		n.blockProcessor,
+		messenger,
+		marshalizer,
+		sim.CurrentRound,
+		headersTopic(shardCoordinator)+requestTopicSuffix,
+	)
+	n.worker = newWorker(consensusState, consensusService, n.blockProcessor, rounder, marshalizer, args.shardID, sim.chainID)
+	n.worker.receivedProposedHeader = n.bootstrapper.receivedProposedHeader
+	n.chronology = newChronology(sim.genesisTime, rounder, sim.clock, consensusState)
+	cnsRounder := &consensusRounder{safeRounder: rounder}
+	n.chronology.setWorker(n.worker)
+
+	consensusCore, err := spos.NewConsensusCore(&spos.ConsensusCoreArgs{
+		BlockChain:     blockChain,
+		BlockProcessor: n.blockProcessor,
+		Bootstrapper:   n.bootstrapper,
+		BroadcastMessenger: &broadcastMessenger{
+			messenger:      messenger,
+			marshalizer:    marshalizer,
+			consensusTopic: spos.GetConsensusTopicID(shardCoordinator),
+			headersTopic:   headersTopic(shardCoordinator),
+		},
+		ChronologyHandler:             n.chronology,
+		Hasher:                        hasher,
+		Marshalizer:                   marshalizer,
+		BlsPrivateKey:                 args.privateKey,
+		BlsSingleSigner:               createSingleSigner(),
+		MultiSigner:                   mock.NewMultiSigner(uint32(consensusGroupSize)),
+		Rounder:                       cnsRounder,
+		ShardCoordinator:              shardCoordinator,
+		NodesCoordinator:              nodesCoordinator,
+		SyncTimer:                     sim.clock,
+		EpochStartRegistrationHandler: epochStartNotifier,
+		AntifloodHandler:              &mock.NilAntifloodHandler{},
+		PeerHonestyHandler:            &mock.PeerHonestyHandlerStub{},
+		HeaderSigVerifier:             &mock.HeaderSigVerifierStub{},
+		FallbackHeaderValidator:       &testscommon.FallBackHeaderValidatorStub{},
+		RoundTraceRecorder:            consensusTrace.NewDisabledRoundTraceRecorder(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subroundsFactory, err := bls.NewSubroundsFactory(consensusCore, consensusState, n.worker, sim.chainID, messenger.ID())
+	if err != nil {
+		return nil, err
+	}
+	err = subroundsFactory.GenerateSubrounds()
+	if err != nil {
+		return nil, err
+	}
+
+	waitingAllSignaturesMaxTime, ok := n.chronology.waitingAllSignaturesMaxTime()
+	if !ok {
+		return nil, ErrMissingSignatureSubround
+	}
+	cnsRounder.waitingAllSignaturesMaxTime = waitingAllSignaturesMaxTime
+
+	err = n.registerTopics(shardCoordinator, sim.args.NumShards)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func createConsensusState(
+	nodesCoordinator sharding.NodesCoordinator,
+	consensusGroupSize int,
+	pubKey []byte,
+) (*spos.ConsensusState, error) {
+	eligibleNodesPubKeys, err := nodesCoordinator.GetConsensusWhitelistedNodes(0)
+	if err != nil {
+		return nil, err
+	}
+
+	roundConsensus := spos.NewRoundConsensus(eligibleNodesPubKeys, consensusGroupSize, string(pubKey))
+	roundConsensus.ResetRoundState()
+
+	roundStatus := spos.NewRoundStatus()
+	roundStatus.ResetRoundStatus()
+
+	return spos.NewConsensusState(roundConsensus, spos.NewRoundThreshold(), roundStatus), nil
+}
+
+// createSingleSigner returns a signer whose signatures only depend on the private key and on the message, so that
+// the random seeds, and thus the consensus groups, are reproducible
+func createSingleSigner() crypto.SingleSigner {
+	return &mock.SignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			privateKeyBytes, err := private.ToByteArray()
+			if err != nil {
+				return nil, err
+			}
+
+			return integrationTests.TestHasher.Compute(string(privateKeyBytes) + string(msg)), nil
+		},
+	}
+}
+
+func headersTopic(shardCoordinator sharding.Coordinator) string {
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return factory.MetachainBlocksTopic
+	}
+
+	return factory.ShardBlocksTopic + shardCoordinator.CommunicationIdentifier(core.MetachainShardId)
+}
+
+func (n *Node) registerTopics(shardCoordinator sharding.Coordinator, numShards uint32) error {
+	err := n.registerTopic(spos.GetConsensusTopicID(shardCoordinator), n.worker)
+	if err != nil {
+		return err
+	}
+
+	ownHeadersTopic := headersTopic(shardCoordinator)
+	err = n.registerTopic(ownHeadersTopic, &messageProcessor{process: n.processOwnHeader})
+	if err != nil {
+		return err
+	}
+
+	err = n.registerTopic(ownHeadersTopic+requestTopicSuffix, &messageProcessor{process: n.processHeaderRequest})
+	if err != nil {
+		return err
+	}
+
+	if n.shardID != core.MetachainShardId {
+		return nil
+	}
+
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		topic := factory.ShardBlocksTopic + shardCoordinator.CommunicationIdentifier(shardID)
+		err = n.registerTopic(topic, &messageProcessor{process: n.processShardHeader})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *Node) registerTopic(topic string, processor p2p.MessageProcessor) error {
+	err := n.messenger.CreateTopic(topic, true)
+	if err != nil {
+		return err
+	}
+
+	return n.messenger.RegisterMessageProcessor(topic, processor)
+}
+
+func (n *Node) processOwnHeader(message p2p.MessageP2P) {
+	header := decodeHeader(integrationTests.TestMarshalizer, n.shardID == core.MetachainShardId, message.Data())
+	if check.IfNil(header) {
+		return
+	}
+
+	hash, err := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, header)
+	if err != nil {
+		return
+	}
+
+	n.bootstrapper.addKnownHeader(header, hash)
+	n.worker.ReceivedHeader(header, hash)
+}
+
+func (n *Node) processHeaderRequest(message p2p.MessageP2P) {
+	if len(message.Data()) != 8 {
+		return
+	}
+
+	nonce := binary.BigEndian.Uint64(message.Data())
+	committed, ok := n.chain.blockAt(nonce)
+	if !ok || nonce == 0 {
+		return
+	}
+
+	buff, err := integrationTests.TestMarshalizer.Marshal(committed.header)
+	if err != nil {
+		return
+	}
+
+	topic := message.Topics()[0]
+	err = n.messenger.SendToConnectedPeer(topic[:len(topic)-len(requestTopicSuffix)], buff, message.Peer())
+	log.LogIfError(err)
+}
+
+func (n *Node) processShardHeader(message p2p.MessageP2P) {
+	header, ok := decodeHeader(integrationTests.TestMarshalizer, false, message.Data()).(*block.Header)
+	if !ok || len(header.GetPubKeysBitmap()) == 0 {
+		return
+	}
+
+	hash, err := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, header)
+	if err != nil {
+		return
+	}
+
+	n.blockProcessor.addShardHeader(header, hash)
+}
+
+// step advances the node at the current virtual time
+func (n *Node) step() {
+	n.bootstrapper.sync()
+	n.chronology.step()
+}
+
+func (n *Node) crash() {
+	n.isCrashed = true
+}
+
+// restart brings back a crashed node. The committed blocks are kept, as they are persisted, while everything that
+// a real node holds in memory is lost
+func (n *Node) restart() {
+	n.isCrashed = false
+	n.worker.resetReceivedMessages()
+	n.bootstrapper.reset()
+	n.chronology.reset()
+}
+
+// Index returns the index of the node in the simulation
+func (n *Node) Index() int {
+	return n.index
+}
+
+// ShardID returns the shard of the node
+func (n *Node) ShardID() uint32 {
+	return n.shardID
+}
+
+// PubKey returns the public key of the node
+func (n *Node) PubKey() []byte {
+	return n.pubKey
+}
+
+// IsCrashed returns true if the node is crashed
+func (n *Node) IsCrashed() bool {
+	return n.isCrashed
+}
+
+// IsSynchronized returns true if the node does not know any final block above its current block
+func (n *Node) IsSynchronized() bool {
+	return n.bootstrapper.GetNodeState() == core.NsSynchronized
+}
+
+// CurrentNonce returns the nonce of the last committed block
+func (n *Node) CurrentNonce() uint64 {
+	return n.chain.currentNonce()
+}
+
+// CurrentHeader returns the last committed header, or the genesis header if no block was committed
+func (n *Node) CurrentHeader() data.HeaderHandler {
+	return n.chain.current().header
+}
+
+// CurrentHash returns the hash of the last committed header
+func (n *Node) CurrentHash() []byte {
+	return n.chain.current().hash
+}
+
+// HashAtNonce returns the hash of the committed header with the provided nonce
+func (n *Node) HashAtNonce(nonce uint64) ([]byte, bool) {
+	committed, ok := n.chain.blockAt(nonce)
+
+	return committed.hash, ok
+}
+
+// NumCommits returns how many blocks were committed by the node, including the ones rolled back afterwards
+func (n *Node) NumCommits() int {
+	return len(n.chain.commits)
+}
+
+// NumRollbacks returns how many blocks were rolled back by the node
+func (n *Node) NumRollbacks() int {
+	return n.chain.numRollbacks
+}
+
+// LastNotarizedShardNonce returns, for a metachain node, the nonce of the last shard header notarized in its chain
+func (n *Node) LastNotarizedShardNonce(shardID uint32) uint64 {
+	return n.blockProcessor.lastNotarizedShardHeaders()[shardID].nonce
+}
+
+// String returns a short description of the node
+func (n *Node) String() string {
+	return fmt.Sprintf("node %d, shard %d, nonce %d", n.index, n.shardID, n.CurrentNonce())
+}
+
+// messageProcessor is a p2p.MessageProcessor that hands the received messages to a node's function
+type messageProcessor struct {
+	process func(message p2p.MessageP2P)
+}
+
+// ProcessReceivedMessage calls the node's function
+func (mp *messageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	mp.process(message)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mp *messageProcessor) IsInterfaceNil() bool {
+	return mp == nil
+}
@@ -0,0 +1,97 @@
+package simulator
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.Rounder = (*safeRounder)(nil)
+var _ consensus.Rounder = (*consensusRounder)(nil)
+
+const unboundedRemainingTime = time.Duration(math.MaxInt64)
+
+// safeRounder guards a consensus.Rounder with a mutex. The chronology updates the round from the simulator's go
+// routine while the leader's signature subround still reads it from the go routine waiting for all the signatures
+type safeRounder struct {
+	mut     sync.RWMutex
+	rounder consensus.Rounder
+}
+
+// Index returns the index of the current round
+func (sr *safeRounder) Index() int64 {
+	sr.mut.RLock()
+	defer sr.mut.RUnlock()
+
+	return sr.rounder.Index()
+}
+
+// BeforeGenesis returns true if the current round is before the genesis round
+func (sr *safeRounder) BeforeGenesis() bool {
+	sr.mut.RLock()
+	defer sr.mut.RUnlock()
+
+	return sr.rounder.BeforeGenesis()
+}
+
+// UpdateRound updates the index and the time stamp of the round
+func (sr *safeRounder) UpdateRound(genesisTimeStamp time.Time, currentTimeStamp time.Time) {
+	sr.mut.Lock()
+	sr.rounder.UpdateRound(genesisTimeStamp, currentTimeStamp)
+	sr.mut.Unlock()
+}
+
+// TimeStamp returns the time stamp of the current round
+func (sr *safeRounder) TimeStamp() time.Time {
+	sr.mut.RLock()
+	defer sr.mut.RUnlock()
+
+	return sr.rounder.TimeStamp()
+}
+
+// TimeDuration returns the duration of a round
+func (sr *safeRounder) TimeDuration() time.Duration {
+	sr.mut.RLock()
+	defer sr.mut.RUnlock()
+
+	return sr.rounder.TimeDuration()
+}
+
+// RemainingTime returns the time left from the provided start time until the max time
+func (sr *safeRounder) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
+	sr.mut.RLock()
+	defer sr.mut.RUnlock()
+
+	return sr.rounder.RemainingTime(startTime, maxTime)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *safeRounder) IsInterfaceNil() bool {
+	return sr == nil
+}
+
+// consensusRounder is the rounder seen by the consensus subrounds. The leader of the signature subround sleeps, on the
+// system clock, for the time left until it stops waiting for all the signatures, which does not fit the virtual
+// clock. For that max time the remaining time is reported as unbounded and the chronology applies the timeout on
+// the virtual clock instead
+type consensusRounder struct {
+	*safeRounder
+	waitingAllSignaturesMaxTime time.Duration
+}
+
+// RemainingTime returns the time left from the provided start time until the max time, or an unbounded duration for
+// the time of waiting all the signatures
+func (cr *consensusRounder) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
+	if maxTime == cr.waitingAllSignaturesMaxTime {
+		return unboundedRemainingTime
+	}
+
+	return cr.safeRounder.RemainingTime(startTime, maxTime)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *consensusRounder) IsInterfaceNil() bool {
+	return cr == nil
+}
@@ -0,0 +1,518 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("integrationTests/simulator")
+
+// genesisUnixTime is the fixed start of every simulation, so that the headers do not depend on the wall clock
+const genesisUnixTime = 1600000000
+
+const chainID = "simulator"
+
+// ArgSimulator holds the arguments needed to create a new simulator
+type ArgSimulator struct {
+	Seed                    int64
+	NumShards               uint32
+	NodesPerShard           int
+	NumMetachainNodes       int
+	ShardConsensusGroupSize int
+	MetaConsensusGroupSize  int
+	RoundDuration           time.Duration
+	TickDuration            time.Duration
+	DefaultLinkConditions   LinkConditions
+}
+
+type scheduledAction struct {
+	at     time.Time
+	seqNo  int
+	action func()
+}
+
+// Simulator runs a whole network of nodes, with their real consensus subrounds, inside a single go routine. Time
+// is virtual and advances one tick on each step, the messages are delayed or lost according to the link
+// conditions derived from the seed, so that the same arguments and the same scenario always produce the same chains
+type Simulator struct {
+	args        ArgSimulator
+	chainID     []byte
+	genesisTime time.Time
+	clock       *VirtualClock
+	network     *memp2p.Network
+	dispatcher  *messageDispatcher
+	nodes       []*Node
+
+	scheduledActions []*scheduledAction
+	numScheduled     int
+}
+
+// NewSimulator creates the nodes of all the shards and of the metachain, connected through a simulated network
+func NewSimulator(args ArgSimulator) (*Simulator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisTime := time.Unix(genesisUnixTime, 0)
+	sim := &Simulator{
+		args:             args,
+		chainID:          []byte(chainID),
+		genesisTime:      genesisTime,
+		clock:            NewVirtualClock(genesisTime),
+		network:          memp2p.NewNetwork(),
+		scheduledActions: make([]*scheduledAction, 0),
+	}
+	sim.dispatcher = newMessageDispatcher(args.Seed, sim.clock, args.DefaultLinkConditions)
+	err = sim.network.SetMessageDispatcher(sim.dispatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sim.createNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	return sim, nil
+}
+
+func checkArgs(args ArgSimulator) error {
+	if args.NumShards == 0 || args.NodesPerShard <= 0 || args.NumMetachainNodes <= 0 {
+		return ErrInvalidNumberOfNodes
+	}
+	if args.ShardConsensusGroupSize <= 0 || args.ShardConsensusGroupSize > args.NodesPerShard {
+		return fmt.Errorf("%w for shards", ErrInvalidConsensusGroupSize)
+	}
+	if args.MetaConsensusGroupSize <= 0 || args.MetaConsensusGroupSize > args.NumMetachainNodes {
+		return fmt.Errorf("%w for metachain", ErrInvalidConsensusGroupSize)
+	}
+	if args.RoundDuration <= 0 {
+		return ErrInvalidRoundDuration
+	}
+	if args.TickDuration <= 0 || args.TickDuration > args.RoundDuration {
+		return ErrInvalidTickDuration
+	}
+
+	return args.DefaultLinkConditions.check()
+}
+
+func (sim *Simulator) shardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, sim.args.NumShards+1)
+	for shardID := uint32(0); shardID < sim.args.NumShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+func (sim *Simulator) numNodesInShard(shardID uint32) int {
+	if shardID == core.MetachainShardId {
+		return sim.args.NumMetachainNodes
+	}
+
+	return sim.args.NodesPerShard
+}
+
+func (sim *Simulator) createNodes() error {
+	keyGen := &mock.KeyGenMock{}
+	eligible := make(map[uint32][]sharding.Validator)
+	nodesShards := make([]uint32, 0)
+	privateKeys := make([][]byte, 0)
+
+	for _, shardID := range sim.shardIDs() {
+		for i := 0; i < sim.numNodesInShard(shardID); i++ {
+			nodeIndex := len(nodesShards)
+			privateKey := sim.hash("key", uint64(nodeIndex))
+			sk, err := keyGen.PrivateKeyFromByteArray(privateKey)
+			if err != nil {
+				return err
+			}
+			pubKey, err := sk.GeneratePublic().ToByteArray()
+			if err != nil {
+				return err
+			}
+
+			v, err := sharding.NewValidator(pubKey, 1, uint32(i))
+			if err != nil {
+				return err
+			}
+
+			eligible[shardID] = append(eligible[shardID], v)
+			nodesShards = append(nodesShards, shardID)
+			privateKeys = append(privateKeys, privateKey)
+		}
+	}
+
+	genesis, shardGenesisHashes, err := sim.createGenesisBlocks()
+	if err != nil {
+		return err
+	}
+
+	for nodeIndex, shardID := range nodesShards {
+		sk, err := keyGen.PrivateKeyFromByteArray(privateKeys[nodeIndex])
+		if err != nil {
+			return err
+		}
+
+		n, err := newNode(argNode{
+			index:              nodeIndex,
+			shardID:            shardID,
+			privateKey:         sk,
+			simulator:          sim,
+			eligible:           eligible,
+			genesis:            genesis,
+			shardGenesisHashes: shardGenesisHashes,
+		})
+		if err != nil {
+			return fmt.Errorf("%w while creating node %d", err, nodeIndex)
+		}
+
+		sim.nodes = append(sim.nodes, n)
+	}
+
+	return nil
+}
+
+func (sim *Simulator) createGenesisBlocks() (map[uint32]chainBlock, map[uint32][]byte, error) {
+	genesis := make(map[uint32]chainBlock)
+	shardGenesisHashes := make(map[uint32][]byte)
+
+	for _, shardID := range sim.shardIDs() {
+		randSeed := sim.hash("genesis", uint64(shardID))
+
+		var header data.HeaderHandler = &block.Header{
+			ShardID:      shardID,
+			RandSeed:     randSeed,
+			PrevRandSeed: randSeed,
+			TimeStamp:    uint64(sim.genesisTime.Unix()),
+			ChainID:      sim.chainID,
+		}
+		if shardID == core.MetachainShardId {
+			header = &block.MetaBlock{
+				RandSeed:     randSeed,
+				PrevRandSeed: randSeed,
+				TimeStamp:    uint64(sim.genesisTime.Unix()),
+				ChainID:      sim.chainID,
+			}
+		}
+
+		hash, err := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, header)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		genesis[shardID] = chainBlock{header: header, hash: hash}
+		if shardID != core.MetachainShardId {
+			shardGenesisHashes[shardID] = hash
+		}
+	}
+
+	return genesis, shardGenesisHashes, nil
+}
+
+// hash returns a value that only depends on the seed of the simulation and on the provided label and index
+func (sim *Simulator) hash(label string, index uint64) []byte {
+	buff := make([]byte, 16)
+	binary.BigEndian.PutUint64(buff, uint64(sim.args.Seed))
+	binary.BigEndian.PutUint64(buff[8:], index)
+
+	return integrationTests.TestHasher.Compute(label + string(buff))
+}
+
+// Step advances the virtual clock with one tick, runs the scheduled actions that are due, delivers the messages
+// that arrived in the meantime and lets every running node sync and do its consensus work
+func (sim *Simulator) Step() {
+	currentTime := sim.clock.Advance(sim.args.TickDuration)
+
+	sim.runScheduledActions(currentTime)
+	sim.dispatcher.deliverUntil(currentTime)
+
+	for _, n := range sim.nodes {
+		if n.IsCrashed() {
+			continue
+		}
+
+		n.step()
+	}
+}
+
+func (sim *Simulator) runScheduledActions(currentTime time.Time) {
+	for len(sim.scheduledActions) > 0 && !sim.scheduledActions[0].at.After(currentTime) {
+		sa := sim.scheduledActions[0]
+		sim.scheduledActions = sim.scheduledActions[1:]
+		sa.action()
+	}
+}
+
+// ScheduleAt runs the provided action when the virtual clock reaches the provided offset from the genesis time.
+// The actions scheduled for the same time run in the order they were scheduled
+func (sim *Simulator) ScheduleAt(offset time.Duration, action func()) {
+	sim.scheduledActions = append(sim.scheduledActions, &scheduledAction{
+		at:     sim.genesisTime.Add(offset),
+		seqNo:  sim.numScheduled,
+		action: action,
+	})
+	sim.numScheduled++
+
+	sort.Slice(sim.scheduledActions, func(i, j int) bool {
+		a, b := sim.scheduledActions[i], sim.scheduledActions[j]
+		if !a.at.Equal(b.at) {
+			return a.at.Before(b.at)
+		}
+
+		return a.seqNo < b.seqNo
+	})
+}
+
+// RunFor steps the simulation for the provided virtual duration
+func (sim *Simulator) RunFor(duration time.Duration) {
+	end := sim.clock.CurrentTime().Add(duration)
+	for sim.clock.CurrentTime().Before(end) {
+		sim.Step()
+	}
+}
+
+// RunRounds steps the simulation for the provided number of rounds
+func (sim *Simulator) RunRounds(numRounds int) {
+	sim.RunFor(time.Duration(numRounds) * sim.args.RoundDuration)
+}
+
+// RunUntil steps the simulation until the condition holds or until the provided number of rounds passed. It
+// returns true if the condition was met
+func (sim *Simulator) RunUntil(condition func() bool, maxRounds int) bool {
+	end := sim.clock.CurrentTime().Add(time.Duration(maxRounds) * sim.args.RoundDuration)
+	for sim.clock.CurrentTime().Before(end) {
+		if condition() {
+			return true
+		}
+
+		sim.Step()
+	}
+
+	return condition()
+}
+
+// CurrentRound returns the round index of the virtual clock
+func (sim *Simulator) CurrentRound() int64 {
+	return int64(sim.clock.CurrentTime().Sub(sim.genesisTime) / sim.args.RoundDuration)
+}
+
+// CurrentTime returns the time of the virtual clock
+func (sim *Simulator) CurrentTime() time.Time {
+	return sim.clock.CurrentTime()
+}
+
+// SetLinkConditions changes the conditions of the messages sent from one node to another
+func (sim *Simulator) SetLinkConditions(from int, to int, conditions LinkConditions) error {
+	err := conditions.check()
+	if err != nil {
+		return err
+	}
+	if !sim.isNodeIndexValid(from) || !sim.isNodeIndexValid(to) {
+		return ErrInvalidNodeIndex
+	}
+
+	sim.dispatcher.setLinkConditions(from, to, conditions)
+
+	return nil
+}
+
+// SetDefaultLinkConditions changes the conditions of all the links, discarding the ones set for individual links
+func (sim *Simulator) SetDefaultLinkConditions(conditions LinkConditions) error {
+	err := conditions.check()
+	if err != nil {
+		return err
+	}
+
+	sim.dispatcher.setDefaultConditions(conditions)
+
+	return nil
+}
+
+// Partition splits the network in the provided groups of node indexes. The nodes that are not present in any group
+// form a group of their own. The messages in flight between different groups are dropped
+func (sim *Simulator) Partition(groups ...[]int) error {
+	for _, group := range groups {
+		for _, nodeIndex := range group {
+			if !sim.isNodeIndexValid(nodeIndex) {
+				return ErrInvalidNodeIndex
+			}
+		}
+	}
+
+	sim.dispatcher.setPartition(groups)
+
+	return nil
+}
+
+// HealPartition reconnects all the nodes
+func (sim *Simulator) HealPartition() {
+	sim.dispatcher.healPartition()
+}
+
+// CrashNode stops the node: it does not run anymore and all the messages to and from it are dropped
+func (sim *Simulator) CrashNode(nodeIndex int) error {
+	if !sim.isNodeIndexValid(nodeIndex) {
+		return ErrInvalidNodeIndex
+	}
+	n := sim.nodes[nodeIndex]
+	if n.IsCrashed() {
+		return ErrNodeAlreadyCrashed
+	}
+
+	n.crash()
+	sim.dispatcher.setCrashed(nodeIndex, true)
+
+	return nil
+}
+
+// RestartNode brings back a crashed node with its committed blocks but without any in-memory state
+func (sim *Simulator) RestartNode(nodeIndex int) error {
+	if !sim.isNodeIndexValid(nodeIndex) {
+		return ErrInvalidNodeIndex
+	}
+	n := sim.nodes[nodeIndex]
+	if !n.IsCrashed() {
+		return ErrNodeNotCrashed
+	}
+
+	n.restart()
+	sim.dispatcher.setCrashed(nodeIndex, false)
+
+	return nil
+}
+
+func (sim *Simulator) isNodeIndexValid(nodeIndex int) bool {
+	return nodeIndex >= 0 && nodeIndex < len(sim.nodes)
+}
+
+// Nodes returns all the nodes, the shard nodes in shard order followed by the metachain nodes
+func (sim *Simulator) Nodes() []*Node {
+	return sim.nodes
+}
+
+// Node returns the node with the provided index
+func (sim *Simulator) Node(nodeIndex int) (*Node, error) {
+	if !sim.isNodeIndexValid(nodeIndex) {
+		return nil, ErrInvalidNodeIndex
+	}
+
+	return sim.nodes[nodeIndex], nil
+}
+
+// ShardNodes returns the nodes of the provided shard
+func (sim *Simulator) ShardNodes(shardID uint32) []*Node {
+	shardNodes := make([]*Node, 0)
+	for _, n := range sim.nodes {
+		if n.ShardID() == shardID {
+			shardNodes = append(shardNodes, n)
+		}
+	}
+
+	return shardNodes
+}
+
+// NetworkStatistics returns the counters of the messages handled by the simulated network
+func (sim *Simulator) NetworkStatistics() NetworkStatistics {
+	return sim.dispatcher.getStatistics()
+}
+
+// NumForks returns the number of nonces for which the nodes of the shard committed more than one block, including
+// the blocks rolled back afterwards
+func (sim *Simulator) NumForks(shardID uint32) int {
+	hashesByNonce := make(map[uint64]map[string]struct{})
+	for _, n := range sim.ShardNodes(shardID) {
+		for _, committed := range n.chain.commits {
+			nonce := committed.header.GetNonce()
+			if hashesByNonce[nonce] == nil {
+				hashesByNonce[nonce] = make(map[string]struct{})
+			}
+			hashesByNonce[nonce][string(committed.hash)] = struct{}{}
+		}
+	}
+
+	numForks := 0
+	for _, hashes := range hashesByNonce {
+		if len(hashes) > 1 {
+			numForks++
+		}
+	}
+
+	return numForks
+}
+
+// CommonNonce returns the highest nonce for which all the running nodes of the shard hold the same block
+func (sim *Simulator) CommonNonce(shardID uint32) uint64 {
+	runningNodes := make([]*Node, 0)
+	for _, n := range sim.ShardNodes(shardID) {
+		if !n.IsCrashed() {
+			runningNodes = append(runningNodes, n)
+		}
+	}
+	if len(runningNodes) == 0 {
+		return 0
+	}
+
+	nonce := runningNodes[0].CurrentNonce()
+	for _, n := range runningNodes[1:] {
+		if n.CurrentNonce() < nonce {
+			nonce = n.CurrentNonce()
+		}
+	}
+
+	for ; nonce > 0; nonce-- {
+		if sim.isNonceCommon(runningNodes, nonce) {
+			return nonce
+		}
+	}
+
+	return 0
+}
+
+func (sim *Simulator) isNonceCommon(nodes []*Node, nonce uint64) bool {
+	firstHash, _ := nodes[0].HashAtNonce(nonce)
+	for _, n := range nodes[1:] {
+		hash, _ := n.HashAtNonce(nonce)
+		if !bytes.Equal(hash, firstHash) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fingerprint returns a hash of the chains of all the nodes and of the network statistics. Two runs with the same
+// arguments and the same scenario have the same fingerprint
+func (sim *Simulator) Fingerprint() []byte {
+	buff := make([]byte, 0)
+	for _, n := range sim.nodes {
+		for nonce := uint64(0); nonce <= n.CurrentNonce(); nonce++ {
+			hash, _ := n.HashAtNonce(nonce)
+			buff = append(buff, hash...)
+		}
+		buff = append(buff, byte(n.NumRollbacks()))
+	}
+	buff = append(buff, []byte(fmt.Sprintf("%+v", sim.NetworkStatistics()))...)
+
+	return integrationTests.TestHasher.Compute(string(buff))
+}
+
+// Close closes all the messengers
+func (sim *Simulator) Close() {
+	for _, n := range sim.nodes {
+		log.LogIfError(n.messenger.Close())
+	}
+}
@@ -0,0 +1,180 @@
+package simulator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgSimulator(seed int64) ArgSimulator {
+	return ArgSimulator{
+		Seed:                    seed,
+		NumShards:               2,
+		NodesPerShard:           4,
+		NumMetachainNodes:       3,
+		ShardConsensusGroupSize: 4,
+		MetaConsensusGroupSize:  3,
+		RoundDuration:           4 * time.Second,
+		TickDuration:            50 * time.Millisecond,
+		DefaultLinkConditions: LinkConditions{
+			Latency:  20 * time.Millisecond,
+			Jitter:   300 * time.Millisecond,
+			LossRate: 0.05,
+		},
+	}
+}
+
+func createSimulator(t *testing.T, args ArgSimulator) *Simulator {
+	sim, err := NewSimulator(args)
+	require.Nil(t, err)
+
+	return sim
+}
+
+func TestNewSimulator_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgSimulator(1)
+	args.NodesPerShard = 0
+	sim, err := NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.Equal(t, ErrInvalidNumberOfNodes, err)
+
+	args = createArgSimulator(1)
+	args.ShardConsensusGroupSize = args.NodesPerShard + 1
+	sim, err = NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.True(t, errors.Is(err, ErrInvalidConsensusGroupSize))
+
+	args = createArgSimulator(1)
+	args.MetaConsensusGroupSize = 0
+	sim, err = NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.True(t, errors.Is(err, ErrInvalidConsensusGroupSize))
+
+	args = createArgSimulator(1)
+	args.RoundDuration = 0
+	sim, err = NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.Equal(t, ErrInvalidRoundDuration, err)
+
+	args = createArgSimulator(1)
+	args.TickDuration = args.RoundDuration + 1
+	sim, err = NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.Equal(t, ErrInvalidTickDuration, err)
+
+	args = createArgSimulator(1)
+	args.DefaultLinkConditions.LossRate = 1.5
+	sim, err = NewSimulator(args)
+	assert.Nil(t, sim)
+	assert.Equal(t, ErrInvalidLossRate, err)
+}
+
+func TestSimulator_AllShardsShouldProduceAndNotarizeBlocks(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t, createArgSimulator(1))
+	defer sim.Close()
+	sim.RunRounds(10)
+
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		assert.True(t, sim.CommonNonce(shardID) > 5)
+		assert.Equal(t, 0, sim.NumForks(shardID))
+	}
+	for _, n := range sim.ShardNodes(core.MetachainShardId) {
+		assert.True(t, n.LastNotarizedShardNonce(0) > 0)
+		assert.True(t, n.LastNotarizedShardNonce(1) > 0)
+	}
+}
+
+func TestSimulator_SameSeedShouldProduceTheSameChains(t *testing.T) {
+	t.Parallel()
+
+	runScenario := func(seed int64) []byte {
+		sim := createSimulator(t, createArgSimulator(seed))
+		defer sim.Close()
+		sim.ScheduleAt(10*time.Second, func() {
+			_ = sim.Partition([]int{0, 1})
+		})
+		sim.ScheduleAt(30*time.Second, sim.HealPartition)
+		sim.RunRounds(15)
+
+		return sim.Fingerprint()
+	}
+
+	fingerprint := runScenario(7)
+	assert.Equal(t, fingerprint, runScenario(7))
+	assert.NotEqual(t, fingerprint, runScenario(8))
+}
+
+func TestSimulator_PartitionShouldStopTheShardUntilHealed(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t, createArgSimulator(2))
+	defer sim.Close()
+	sim.RunRounds(5)
+
+	err := sim.Partition([]int{0, 1})
+	require.Nil(t, err)
+	sim.RunRounds(1)
+	nonceWhilePartitioned := sim.CommonNonce(0)
+	sim.RunRounds(5)
+	assert.Equal(t, nonceWhilePartitioned, sim.CommonNonce(0))
+	assert.True(t, sim.NetworkStatistics().NumBlocked > 0)
+
+	sim.HealPartition()
+	resumed := sim.RunUntil(func() bool {
+		return sim.CommonNonce(0) > nonceWhilePartitioned+2
+	}, 10)
+	assert.True(t, resumed)
+	assert.Equal(t, 0, sim.NumForks(0))
+}
+
+func TestSimulator_RestartedNodeShouldCatchUp(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t, createArgSimulator(3))
+	defer sim.Close()
+	sim.RunRounds(5)
+
+	err := sim.CrashNode(2)
+	require.Nil(t, err)
+	assert.Equal(t, ErrNodeAlreadyCrashed, sim.CrashNode(2))
+
+	crashedNode, _ := sim.Node(2)
+	nonceAtCrash := crashedNode.CurrentNonce()
+	sim.RunRounds(5)
+	assert.Equal(t, nonceAtCrash, crashedNode.CurrentNonce())
+
+	err = sim.RestartNode(2)
+	require.Nil(t, err)
+	assert.Equal(t, ErrNodeNotCrashed, sim.RestartNode(2))
+
+	caughtUp := sim.RunUntil(func() bool {
+		return crashedNode.CurrentNonce() > nonceAtCrash+5 && sim.CommonNonce(0) == crashedNode.CurrentNonce()
+	}, 10)
+	assert.True(t, caughtUp)
+	assert.True(t, crashedNode.IsSynchronized())
+}
+
+func TestSimulator_InvalidNodeIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t, createArgSimulator(1))
+	defer sim.Close()
+	numNodes := len(sim.Nodes())
+
+	assert.Equal(t, ErrInvalidNodeIndex, sim.CrashNode(numNodes))
+	assert.Equal(t, ErrInvalidNodeIndex, sim.RestartNode(-1))
+	assert.Equal(t, ErrInvalidNodeIndex, sim.Partition([]int{0, numNodes}))
+	assert.Equal(t, ErrInvalidNodeIndex, sim.SetLinkConditions(0, numNodes, LinkConditions{}))
+
+	n, err := sim.Node(numNodes)
+	assert.Nil(t, n)
+	assert.Equal(t, ErrInvalidNodeIndex, err)
+}
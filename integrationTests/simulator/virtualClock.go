@@ -0,0 +1,71 @@
+package simulator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var _ ntp.SyncTimer = (*VirtualClock)(nil)
+
+const formattedTimeLayout = "2006-01-02 15:04:05.000"
+
+// VirtualClock is a ntp.SyncTimer implementation whose time only moves forward when Advance is called. All the
+// nodes of a simulation share the same clock, so that rounds and timeouts are driven by the simulator and not by
+// the system time
+type VirtualClock struct {
+	mut         sync.RWMutex
+	currentTime time.Time
+}
+
+// NewVirtualClock creates a virtual clock that starts at the provided time
+func NewVirtualClock(startTime time.Time) *VirtualClock {
+	return &VirtualClock{
+		currentTime: startTime,
+	}
+}
+
+// Advance moves the clock forward with the provided duration and returns the new time
+func (vc *VirtualClock) Advance(duration time.Duration) time.Time {
+	vc.mut.Lock()
+	defer vc.mut.Unlock()
+
+	if duration > 0 {
+		vc.currentTime = vc.currentTime.Add(duration)
+	}
+
+	return vc.currentTime
+}
+
+// StartSyncingTime does nothing as the virtual clock does not need any synchronization
+func (vc *VirtualClock) StartSyncingTime() {
+}
+
+// ClockOffset returns 0 as the virtual clock is the reference for all the nodes
+func (vc *VirtualClock) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime returns the current virtual time as a formatted string
+func (vc *VirtualClock) FormattedCurrentTime() string {
+	return vc.CurrentTime().UTC().Format(formattedTimeLayout)
+}
+
+// CurrentTime returns the current virtual time
+func (vc *VirtualClock) CurrentTime() time.Time {
+	vc.mut.RLock()
+	defer vc.mut.RUnlock()
+
+	return vc.currentTime
+}
+
+// Close does nothing
+func (vc *VirtualClock) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vc *VirtualClock) IsInterfaceNil() bool {
+	return vc == nil
+}
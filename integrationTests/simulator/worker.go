@@ -0,0 +1,235 @@
+package simulator
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ spos.WorkerHandler = (*worker)(nil)
+var _ p2p.MessageProcessor = (*worker)(nil)
+
+// worker is a synchronous replacement of spos.Worker. The received consensus messages are only stored and they are
+// executed by the simulator's chronology, from the simulator's go routine, so that the order in which the
+// subrounds see the messages is fully determined by the virtual clock
+type worker struct {
+	consensusState   *spos.ConsensusState
+	consensusService spos.ConsensusService
+	blockProcessor   process.BlockProcessor
+	rounder          consensus.Rounder
+	marshalizer      marshal.Marshalizer
+	selfShardID      uint32
+	chainID          []byte
+
+	receivedMessages             map[consensus.MessageType][]*consensus.Message
+	receivedMessagesCalls        map[consensus.MessageType]func(*consensus.Message) bool
+	receivedHeadersHandlers      []func(data.HeaderHandler)
+	consensusStateChangedChannel chan bool
+
+	// receivedProposedHeader, when set, is called with the headers proposed in the consensus messages, letting a
+	// node that fell behind notice it even if it receives no final header
+	receivedProposedHeader func(data.HeaderHandler)
+}
+
+func newWorker(
+	consensusState *spos.ConsensusState,
+	consensusService spos.ConsensusService,
+	blockProcessor process.BlockProcessor,
+	rounder consensus.Rounder,
+	marshalizer marshal.Marshalizer,
+	selfShardID uint32,
+	chainID []byte,
+) *worker {
+	return &worker{
+		consensusState:               consensusState,
+		consensusService:             consensusService,
+		blockProcessor:               blockProcessor,
+		rounder:                      rounder,
+		marshalizer:                  marshalizer,
+		selfShardID:                  selfShardID,
+		chainID:                      chainID,
+		receivedMessages:             consensusService.InitReceivedMessages(),
+		receivedMessagesCalls:        make(map[consensus.MessageType]func(*consensus.Message) bool),
+		receivedHeadersHandlers:      make([]func(data.HeaderHandler), 0),
+		consensusStateChangedChannel: make(chan bool, 1),
+	}
+}
+
+// ProcessReceivedMessage validates the received consensus message and stores it for later execution
+func (wrk *worker) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	if check.IfNil(message) {
+		return spos.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return spos.ErrNilDataToProcess
+	}
+
+	cnsMsg := &consensus.Message{}
+	err := wrk.marshalizer.Unmarshal(cnsMsg, message.Data())
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(cnsMsg.ChainID, wrk.chainID) {
+		return spos.ErrInvalidChainID
+	}
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	if !wrk.consensusService.IsMessageTypeValid(msgType) {
+		return spos.ErrInvalidMessageType
+	}
+	if !wrk.consensusState.IsNodeInEligibleList(string(cnsMsg.PubKey)) {
+		return spos.ErrNodeIsNotInEligibleList
+	}
+	if wrk.consensusState.RoundIndex+1 < cnsMsg.RoundIndex {
+		return spos.ErrMessageForFutureRound
+	}
+	if wrk.consensusState.RoundIndex > cnsMsg.RoundIndex {
+		return spos.ErrMessageForPastRound
+	}
+	if wrk.consensusState.SelfPubKey() == string(cnsMsg.PubKey) {
+		return nil
+	}
+	if wrk.consensusState.RoundCanceled && wrk.consensusState.RoundIndex == cnsMsg.RoundIndex {
+		return nil
+	}
+
+	if len(cnsMsg.Header) > 0 && wrk.receivedProposedHeader != nil {
+		header := wrk.blockProcessor.DecodeBlockHeader(cnsMsg.Header)
+		if !check.IfNil(header) {
+			wrk.receivedProposedHeader(header)
+		}
+	}
+
+	wrk.receivedMessages[msgType] = append(wrk.receivedMessages[msgType], cnsMsg)
+
+	return nil
+}
+
+// executeStoredMessages executes, in the consensus service's message order, all the stored messages of the
+// current round that can proceed
+func (wrk *worker) executeStoredMessages() {
+	for _, msgType := range wrk.consensusService.GetMessageRange() {
+		cnsDataList := wrk.receivedMessages[msgType]
+		if len(cnsDataList) == 0 {
+			continue
+		}
+
+		for i, cnsDta := range cnsDataList {
+			if cnsDta == nil || cnsDta.RoundIndex != wrk.consensusState.RoundIndex {
+				continue
+			}
+			if !wrk.consensusService.CanProceed(wrk.consensusState, msgType) {
+				continue
+			}
+
+			cnsDataList[i] = nil
+			callReceivedMessage, exists := wrk.receivedMessagesCalls[msgType]
+			if exists {
+				_ = callReceivedMessage(cnsDta)
+			}
+		}
+
+		wrk.receivedMessages[msgType] = wrk.getCleanedList(cnsDataList)
+	}
+}
+
+func (wrk *worker) getCleanedList(cnsDataList []*consensus.Message) []*consensus.Message {
+	cleanedCnsDataList := make([]*consensus.Message, 0)
+	for _, cnsDta := range cnsDataList {
+		if cnsDta == nil || wrk.rounder.Index() > cnsDta.RoundIndex {
+			continue
+		}
+
+		cleanedCnsDataList = append(cleanedCnsDataList, cnsDta)
+	}
+
+	return cleanedCnsDataList
+}
+
+// resetReceivedMessages drops all the stored messages, as a node that crashed loses them
+func (wrk *worker) resetReceivedMessages() {
+	wrk.receivedMessages = wrk.consensusService.InitReceivedMessages()
+}
+
+// Close does nothing
+func (wrk *worker) Close() error {
+	return nil
+}
+
+// StartWorking does nothing as the stored messages are executed by the simulator's chronology
+func (wrk *worker) StartWorking() {
+}
+
+// AddReceivedMessageCall adds a new handler function for a received message type
+func (wrk *worker) AddReceivedMessageCall(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+	wrk.receivedMessagesCalls[messageType] = receivedMessageCall
+}
+
+// AddReceivedHeaderHandler adds a new handler function for a received header
+func (wrk *worker) AddReceivedHeaderHandler(handler func(data.HeaderHandler)) {
+	wrk.receivedHeadersHandlers = append(wrk.receivedHeadersHandlers, handler)
+}
+
+// RemoveAllReceivedMessagesCalls removes all the functions handlers
+func (wrk *worker) RemoveAllReceivedMessagesCalls() {
+	wrk.receivedMessagesCalls = make(map[consensus.MessageType]func(*consensus.Message) bool)
+}
+
+// Extend marks the round as extended and reverts the processed block, if any
+func (wrk *worker) Extend(subroundId int) {
+	wrk.consensusState.ExtendedCalled = true
+	if wrk.consensusService.IsSubroundStartRound(subroundId) {
+		return
+	}
+
+	wrk.blockProcessor.RevertAccountState(wrk.consensusState.Header)
+}
+
+// GetConsensusStateChangedChannel returns the consensus state changed channel. Nobody waits on it as the
+// simulator's chronology checks the subrounds on each step
+func (wrk *worker) GetConsensusStateChangedChannel() chan bool {
+	return wrk.consensusStateChangedChannel
+}
+
+// ExecuteStoredMessages does nothing as it is called by the subrounds from their own go routines. The simulator's
+// chronology executes the stored messages on each step instead
+func (wrk *worker) ExecuteStoredMessages() {
+}
+
+// DisplayStatistics does nothing
+func (wrk *worker) DisplayStatistics() {
+}
+
+// ReceivedHeader calls the received header handlers for the headers of the current round from the node's shard
+func (wrk *worker) ReceivedHeader(headerHandler data.HeaderHandler, _ []byte) {
+	isHeaderForOtherShard := headerHandler.GetShardID() != wrk.selfShardID
+	isHeaderForOtherRound := int64(headerHandler.GetRound()) != wrk.rounder.Index()
+	if isHeaderForOtherShard || isHeaderForOtherRound {
+		return
+	}
+
+	for _, handler := range wrk.receivedHeadersHandlers {
+		handler(headerHandler)
+	}
+}
+
+// SetAppStatusHandler does nothing
+func (wrk *worker) SetAppStatusHandler(_ core.AppStatusHandler) error {
+	return nil
+}
+
+// ResetConsensusMessages does nothing as the simulator does not limit the number of consensus messages
+func (wrk *worker) ResetConsensusMessages() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrk *worker) IsInterfaceNil() bool {
+	return wrk == nil
+}
@@ -49,7 +49,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	txProc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -896,6 +898,8 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 		ArgsParser:       smartContract.NewArgumentParser(),
 		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
 		EpochNotifier:    forking.NewGenericEpochNotifier(),
+		GuardedAccounts:  guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
 	}
 	txProcessor, _ := txProc.NewTxProcessor(argsNewTxProcessor)
 
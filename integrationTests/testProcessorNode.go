@@ -66,11 +66,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -281,9 +284,12 @@ type TestProcessorNode struct {
 	WaitTime                       time.Duration
 	HistoryRepository              dblookupext.HistoryRepository
 	EpochNotifier                  process.EpochNotifier
+	GuardedAccountHandler          process.GuardedAccountHandler
+	MultisigAccountHandler         process.MultisigAccountHandler
 	BuiltinEnableEpoch             uint32
 	DeployEnableEpoch              uint32
 	RelayedTxEnableEpoch           uint32
+	MultiRelayedTxEnableEpoch      uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	UseValidVmBlsSigVerifier       bool
 }
@@ -499,7 +505,7 @@ func NewTestProcessorNodeWithFullGenesis(
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain, &disabled.HistoricalAccounts{})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -592,24 +598,74 @@ func (tpn *TestProcessorNode) initValidatorStatistics() {
 	}
 
 	arguments := peer.ArgValidatorStatisticsProcessor{
-		PeerAdapter:         tpn.PeerState,
-		PubkeyConv:          TestValidatorPubkeyConverter,
-		NodesCoordinator:    tpn.NodesCoordinator,
-		ShardCoordinator:    tpn.ShardCoordinator,
-		DataPool:            tpn.DataPool,
-		StorageService:      tpn.Storage,
-		Marshalizer:         TestMarshalizer,
-		Rater:               rater,
-		MaxComputableRounds: 1000,
-		RewardsHandler:      tpn.EconomicsData,
-		NodesSetup:          tpn.NodesSetup,
-		GenesisNonce:        tpn.BlockChain.GetGenesisHeader().GetNonce(),
-		EpochNotifier:       &mock.EpochNotifierStub{},
+		PeerAdapter:           tpn.PeerState,
+		PubkeyConv:            TestValidatorPubkeyConverter,
+		NodesCoordinator:      tpn.NodesCoordinator,
+		ShardCoordinator:      tpn.ShardCoordinator,
+		DataPool:              tpn.DataPool,
+		StorageService:        tpn.Storage,
+		Marshalizer:           TestMarshalizer,
+		Rater:                 rater,
+		MaxComputableRounds:   1000,
+		RewardsHandler:        tpn.EconomicsData,
+		NodesSetup:            tpn.NodesSetup,
+		GenesisNonce:          tpn.BlockChain.GetGenesisHeader().GetNonce(),
+		EpochNotifier:         &mock.EpochNotifierStub{},
+		ParticipationRecorder: peer.NewDisabledValidatorParticipationRecorder(),
 	}
 
 	tpn.ValidatorStatisticsProcessor, _ = peer.NewValidatorStatisticsProcessor(arguments)
 }
 
+func (tpn *TestProcessorNode) getOrCreateGuardedAccountHandler() process.GuardedAccountHandler {
+	if !check.IfNil(tpn.GuardedAccountHandler) {
+		return tpn.GuardedAccountHandler
+	}
+
+	argsGuardedAccount := guardian.ArgsGuardedAccount{
+		Marshalizer:                TestMarshalizer,
+		EpochNotifier:              tpn.EpochNotifier,
+		TxVersionChecker:           versioning.NewTxVersionChecker(tpn.MinTransactionVersion),
+		ActivationEpochsDelay:      0,
+		GuardedAccountsEnableEpoch: 0,
+	}
+	guardedAccounts, err := guardian.NewGuardedAccount(argsGuardedAccount)
+	if err != nil {
+		tpn.GuardedAccountHandler = guardian.NewDisabledGuardedAccount()
+		return tpn.GuardedAccountHandler
+	}
+
+	tpn.GuardedAccountHandler = guardedAccounts
+	return tpn.GuardedAccountHandler
+}
+
+func (tpn *TestProcessorNode) getOrCreateMultisigAccountHandler() process.MultisigAccountHandler {
+	if !check.IfNil(tpn.MultisigAccountHandler) {
+		return tpn.MultisigAccountHandler
+	}
+
+	argsMultisigAccount := multisig.ArgsMultisigAccount{
+		Marshalizer:                 TestMarshalizer,
+		Accounts:                    tpn.AccntState,
+		KeyGen:                      tpn.OwnAccount.KeygenTxSign,
+		SingleSigner:                tpn.OwnAccount.SingleSigner,
+		PubkeyConv:                  TestAddressPubkeyConverter,
+		SignMarshalizer:             TestTxSignMarshalizer,
+		TxSignHasher:                TestTxSignHasher,
+		TxVersionChecker:            versioning.NewTxVersionChecker(tpn.MinTransactionVersion),
+		EpochNotifier:               tpn.EpochNotifier,
+		MultisigAccountsEnableEpoch: 0,
+	}
+	multisigAccounts, err := multisig.NewMultisigAccount(argsMultisigAccount)
+	if err != nil {
+		tpn.MultisigAccountHandler = multisig.NewDisabledMultisigAccount()
+		return tpn.MultisigAccountHandler
+	}
+
+	tpn.MultisigAccountHandler = multisigAccounts
+	return tpn.MultisigAccountHandler
+}
+
 func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initChainHandler()
 	tpn.initHeaderValidator()
@@ -641,7 +697,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain, &disabled.HistoricalAccounts{})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -714,10 +770,14 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		GuardedAccounts:  guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -744,6 +804,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 			ArgBlockChainHook:   argsHook,
 			Economics:           tpn.EconomicsData,
 			MessageSignVerifier: sigVerifier,
+			ProofVerifier:       tpn.createDoubleSigningProofVerifier(sigVerifier),
 			GasSchedule:         gasSchedule,
 			NodesConfigProvider: tpn.NodesSetup,
 			Hasher:              TestHasher,
@@ -809,7 +870,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 	vmContainer, _ := vmFactory.Create()
 
 	_ = builtInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(vmContainer, tpn.EconomicsData, vmFactory.BlockChainHookImpl(), tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(vmContainer, tpn.EconomicsData, vmFactory.BlockChainHookImpl(), tpn.BlockChain, &disabled.HistoricalAccounts{})
 }
 
 // InitializeProcessors will reinitialize processors
@@ -817,7 +878,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 	tpn.initValidatorStatistics()
 	tpn.initBlockTracker()
 	tpn.initInnerProcessors(gasMap)
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain, &disabled.HistoricalAccounts{})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -1047,6 +1108,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			MinTransactionVersion:   tpn.MinTransactionVersion,
 			TxSignHasher:            TestHasher,
 			EpochNotifier:           tpn.EpochNotifier,
+			GuardedAccounts:         tpn.getOrCreateGuardedAccountHandler(),
+			MultisigAccounts:        tpn.getOrCreateMultisigAccountHandler(),
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaIntercContFactArgs)
 
@@ -1111,6 +1174,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			MinTransactionVersion:   tpn.MinTransactionVersion,
 			TxSignHasher:            TestTxSignHasher,
 			EpochNotifier:           tpn.EpochNotifier,
+			GuardedAccounts:         tpn.getOrCreateGuardedAccountHandler(),
+			MultisigAccounts:        tpn.getOrCreateMultisigAccountHandler(),
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterContFactArgs)
 
@@ -1222,10 +1287,14 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  mapDNSAddresses,
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		GuardedAccounts:  tpn.getOrCreateGuardedAccountHandler(),
+		MultisigAccounts: tpn.getOrCreateMultisigAccountHandler(),
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1277,10 +1346,12 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	tpn.FeeAccumulator, _ = postprocess.NewFeeAccumulator()
 	tpn.ArgsParser = smartContract.NewArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  TestAddressPubkeyConverter,
-		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           TestAddressPubkeyConverter,
+		ShardCoordinator:          tpn.ShardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             tpn.EpochNotifier,
+		MultiRelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	tpn.GasHandler, _ = preprocess.NewGasComputation(tpn.EconomicsData, txTypeHandler)
@@ -1329,7 +1400,10 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		ScrForwarder:                   tpn.ScrForwarder,
 		EpochNotifier:                  tpn.EpochNotifier,
 		RelayedTxEnableEpoch:           tpn.RelayedTxEnableEpoch,
+		MultiRelayedTxEnableEpoch:      tpn.MultiRelayedTxEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: tpn.PenalizedTooMuchGasEnableEpoch,
+		GuardedAccounts:                tpn.getOrCreateGuardedAccountHandler(),
+		MultisigAccounts:               tpn.getOrCreateMultisigAccountHandler(),
 	}
 	tpn.TxProcessor, _ = transaction.NewTxProcessor(argsNewTxProcessor)
 
@@ -1370,6 +1444,19 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	)
 }
 
+func (tpn *TestProcessorNode) createDoubleSigningProofVerifier(sigVerifier vm.MessageSignVerifier) vm.DoubleSigningProofVerifier {
+	proofVerifier, err := slash.NewDoubleSigningProofVerifier(slash.ArgDoubleSigningProofVerifier{
+		Marshalizer:      TestMarshalizer,
+		Hasher:           TestHasher,
+		SigVerifier:      sigVerifier,
+		NodesCoordinator: tpn.NodesCoordinator,
+		ChainID:          tpn.ChainID,
+	})
+	log.LogIfError(err)
+
+	return proofVerifier
+}
+
 func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	interimProcFactory, _ := metaProcess.NewIntermediateProcessorsContainerFactory(
 		tpn.ShardCoordinator,
@@ -1398,10 +1485,14 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		GuardedAccounts:  tpn.getOrCreateGuardedAccountHandler(),
+		MultisigAccounts: tpn.getOrCreateMultisigAccountHandler(),
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1432,6 +1523,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		ArgBlockChainHook:   argsHook,
 		Economics:           tpn.EconomicsData,
 		MessageSignVerifier: signVerifier,
+		ProofVerifier:       tpn.createDoubleSigningProofVerifier(signVerifier),
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: tpn.NodesSetup,
 		Hasher:              TestHasher,
@@ -1489,10 +1581,12 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	tpn.FeeAccumulator, _ = postprocess.NewFeeAccumulator()
 	tpn.ArgsParser = smartContract.NewArgumentParser()
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  TestAddressPubkeyConverter,
-		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           TestAddressPubkeyConverter,
+		ShardCoordinator:          tpn.ShardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             tpn.EpochNotifier,
+		MultiRelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	tpn.GasHandler, _ = preprocess.NewGasComputation(tpn.EconomicsData, txTypeHandler)
@@ -1826,36 +1920,40 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		}
 
 		epochStartValidatorInfo, _ := metachain.NewValidatorInfoCreator(argsEpochValidatorInfo)
+		governanceActionsHandler := &mock.GovernanceActionsHandlerStub{}
 		argsEpochSystemSC := metachain.ArgsNewEpochStartSystemSCProcessing{
-			SystemVM:                systemVM,
-			UserAccountsDB:          tpn.AccntState,
-			PeerAccountsDB:          tpn.PeerState,
-			Marshalizer:             TestMarshalizer,
-			StartRating:             tpn.RatingsData.StartRating(),
-			ValidatorInfoCreator:    tpn.ValidatorStatisticsProcessor,
-			EndOfEpochCallerAddress: vm.EndOfEpochAddress,
-			StakingSCAddress:        vm.StakingSCAddress,
-			ChanceComputer:          tpn.NodesCoordinator,
-			EpochNotifier:           tpn.EpochNotifier,
-			GenesisNodesConfig:      tpn.NodesSetup,
-			StakingV2EnableEpoch:    StakingV2Epoch,
-			StakingDataProvider:     stakingDataProvider,
-			NodesConfigProvider:     tpn.NodesCoordinator,
-			ShardCoordinator:        tpn.ShardCoordinator,
+			SystemVM:                 systemVM,
+			UserAccountsDB:           tpn.AccntState,
+			PeerAccountsDB:           tpn.PeerState,
+			Marshalizer:              TestMarshalizer,
+			StartRating:              tpn.RatingsData.StartRating(),
+			ValidatorInfoCreator:     tpn.ValidatorStatisticsProcessor,
+			EndOfEpochCallerAddress:  vm.EndOfEpochAddress,
+			StakingSCAddress:         vm.StakingSCAddress,
+			ChanceComputer:           tpn.NodesCoordinator,
+			EpochNotifier:            tpn.EpochNotifier,
+			GenesisNodesConfig:       tpn.NodesSetup,
+			StakingV2EnableEpoch:     StakingV2Epoch,
+			StakingDataProvider:      stakingDataProvider,
+			NodesConfigProvider:      tpn.NodesCoordinator,
+			ShardCoordinator:         tpn.ShardCoordinator,
+			GovernanceActionsHandler: governanceActionsHandler,
 		}
 		epochStartSystemSCProcessor, _ := metachain.NewSystemSCProcessor(argsEpochSystemSC)
 		tpn.EpochStartSystemSCProcessor = epochStartSystemSCProcessor
 
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:             argumentsBase,
-			SCToProtocol:                 scToProtocolInstance,
-			PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
-			EpochEconomics:               epochEconomics,
-			EpochStartDataCreator:        epochStartDataCreator,
-			EpochRewardsCreator:          epochStartRewards,
-			EpochValidatorInfoCreator:    epochStartValidatorInfo,
-			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
-			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			ArgBaseProcessor:               argumentsBase,
+			SCToProtocol:                   scToProtocolInstance,
+			PendingMiniBlocksHandler:       &mock.PendingMiniBlocksHandlerStub{},
+			EpochEconomics:                 epochEconomics,
+			EpochStartDataCreator:          epochStartDataCreator,
+			EpochRewardsCreator:            epochStartRewards,
+			EpochValidatorInfoCreator:      epochStartValidatorInfo,
+			ValidatorStatisticsProcessor:   tpn.ValidatorStatisticsProcessor,
+			EpochSystemSCProcessor:         epochStartSystemSCProcessor,
+			ValidatorParticipationRecorder: peer.NewDisabledValidatorParticipationRecorder(),
+			GovernanceConfigChanges:        governanceActionsHandler,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -1958,6 +2056,8 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithEpochStartTrigger(tpn.EpochStartTrigger),
 		node.WithTxSignHasher(TestTxSignHasher),
 		node.WithTxVersionChecker(versioning.NewTxVersionChecker(tpn.MinTransactionVersion)),
+		node.WithGuardedAccountHandler(tpn.getOrCreateGuardedAccountHandler()),
+		node.WithMultisigAccountHandler(tpn.getOrCreateMultisigAccountHandler()),
 	)
 	log.LogIfError(err)
 
@@ -11,9 +11,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
+	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -133,7 +135,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain, &disabled.HistoricalAccounts{})
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -197,15 +199,17 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		argumentsBase.ForkDetector = tpn.ForkDetector
 		argumentsBase.TxCoordinator = &mock.TransactionCoordinatorMock{}
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:             argumentsBase,
-			SCToProtocol:                 &mock.SCToProtocolStub{},
-			PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
-			EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
-			EpochEconomics:               &mock.EpochEconomicsStub{},
-			EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
-			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
-			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
-			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			ArgBaseProcessor:               argumentsBase,
+			SCToProtocol:                   &mock.SCToProtocolStub{},
+			PendingMiniBlocksHandler:       &mock.PendingMiniBlocksHandlerStub{},
+			EpochStartDataCreator:          &mock.EpochStartDataCreatorStub{},
+			EpochEconomics:                 &mock.EpochEconomicsStub{},
+			EpochRewardsCreator:            &mock.EpochRewardsCreatorStub{},
+			EpochValidatorInfoCreator:      &mock.EpochValidatorInfoCreatorStub{},
+			ValidatorStatisticsProcessor:   &mock.ValidatorStatisticsProcessorStub{},
+			EpochSystemSCProcessor:         &mock.EpochStartSystemSCStub{},
+			ValidatorParticipationRecorder: peer.NewDisabledValidatorParticipationRecorder(),
+			GovernanceConfigChanges:        &mock.GovernanceActionsHandlerStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -248,6 +252,7 @@ func (tpn *TestProcessorNode) createShardBootstrapper() (TestBootstrapper, error
 		MiniblocksProvider:  tpn.MiniblocksProvider,
 		Uint64Converter:     TestUint64Converter,
 		Indexer:             indexer.NewNilIndexer(),
+		HistoryRepository:   tpn.HistoryRepository,
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -286,6 +291,7 @@ func (tpn *TestProcessorNode) createMetaChainBootstrapper() (TestBootstrapper, e
 		MiniblocksProvider:  tpn.MiniblocksProvider,
 		Uint64Converter:     TestUint64Converter,
 		Indexer:             indexer.NewNilIndexer(),
+		HistoryRepository:   tpn.HistoryRepository,
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
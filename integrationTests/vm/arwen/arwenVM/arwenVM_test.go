@@ -24,7 +24,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -472,8 +475,9 @@ func TestExecuteTransactionAndTimeToProcessChange(t *testing.T) {
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubkeyConv,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	feeHandler := &mock.FeeHandlerStub{
@@ -504,6 +508,8 @@ func TestExecuteTransactionAndTimeToProcessChange(t *testing.T) {
 		ArgsParser:       smartContract.NewArgumentParser(),
 		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
 		EpochNotifier:    forking.NewGenericEpochNotifier(),
+		GuardedAccounts:  guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
 	}
 	txProc, _ := processTransaction.NewTxProcessor(argsNewTxProcessor)
 
@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
@@ -34,6 +35,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
@@ -130,7 +133,7 @@ func SetupTestContext(t *testing.T) *TestContext {
 	context.initVMAndBlockchainHook()
 	context.initTxProcessorWithOneSCExecutorWithVMs()
 	context.ScAddress, _ = context.BlockchainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
-	context.QueryService, _ = smartContract.NewSCQueryService(context.VMContainer, context.EconomicsFee, context.BlockchainHook, &mock.BlockChainMock{})
+	context.QueryService, _ = smartContract.NewSCQueryService(context.VMContainer, context.EconomicsFee, context.BlockchainHook, &mock.BlockChainMock{}, &disabled.HistoricalAccounts{})
 
 	context.RewardsProcessor, err = rewardTransaction.NewRewardTxProcessor(context.Accounts, pkConverter, oneShardCoordinator)
 	require.Nil(t, err)
@@ -193,10 +196,14 @@ func (context *TestContext) initFeeHandlers() {
 
 func (context *TestContext) initVMAndBlockchainHook() {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     mock.NewGasScheduleNotifierMock(context.GasSchedule),
-		MapDNSAddresses: DNSAddresses,
-		Marshalizer:     marshalizer,
-		Accounts:        context.Accounts,
+		GasSchedule:      mock.NewGasScheduleNotifierMock(context.GasSchedule),
+		MapDNSAddresses:  DNSAddresses,
+		Marshalizer:      marshalizer,
+		Accounts:         context.Accounts,
+		ShardCoordinator: oneShardCoordinator,
+		GuardedAccounts:  guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	require.Nil(context.T, err)
@@ -243,10 +250,12 @@ func (context *TestContext) initVMAndBlockchainHook() {
 
 func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pkConverter,
-		ShardCoordinator: oneShardCoordinator,
-		BuiltInFuncNames: context.BlockchainHook.GetBuiltInFunctions().Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           pkConverter,
+		ShardCoordinator:          oneShardCoordinator,
+		BuiltInFunctions:          context.BlockchainHook.GetBuiltInFunctions(),
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             forking.NewGenericEpochNotifier(),
+		MultiRelayedTxEnableEpoch: 0,
 	}
 
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -300,6 +309,8 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		RelayedTxEnableEpoch:           0,
 		PenalizedTooMuchGasEnableEpoch: 0,
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
+		GuardedAccounts:                guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts:               multisig.NewDisabledMultisigAccount(),
 	}
 
 	context.TxProcessor, err = processTransaction.NewTxProcessor(argsNewTxProcessor)
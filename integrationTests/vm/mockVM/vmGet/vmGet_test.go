@@ -3,6 +3,7 @@ package vmGet
 import (
 	"encoding/hex"
 	"fmt"
+	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"math"
 	"math/big"
 	"testing"
@@ -32,6 +33,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 	},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&disabled.HistoricalAccounts{},
 	)
 
 	functionName := "Get"
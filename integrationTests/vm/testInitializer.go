@@ -1,3 +1,4 @@
+//go:build cgo
 // +build cgo
 
 package vm
@@ -23,6 +24,7 @@ import (
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
+	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
@@ -33,6 +35,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -65,6 +69,7 @@ type ArgEnableEpoch struct {
 	DeployEnableEpoch              uint32
 	MetaProtectionEnableEpoch      uint32
 	RelayedTxEnableEpoch           uint32
+	MultiRelayedTxEnableEpoch      uint32
 }
 
 // VMTestContext -
@@ -262,10 +267,12 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		}}
 
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: oneShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           pubkeyConv,
+		ShardCoordinator:          oneShardCoordinator,
+		BuiltInFunctions:          builtInFuncs,
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             &mock.EpochNotifierStub{},
+		MultiRelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	gasSchedule := make(map[string]map[string]uint64)
@@ -318,6 +325,9 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      argEnableEpoch.MetaProtectionEnableEpoch,
 		RelayedTxEnableEpoch:           argEnableEpoch.RelayedTxEnableEpoch,
+		MultiRelayedTxEnableEpoch:      argEnableEpoch.MultiRelayedTxEnableEpoch,
+		GuardedAccounts:                guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts:               multisig.NewDisabledMultisigAccount(),
 	}
 	txProcessor, _ := transaction.NewTxProcessor(argsNewTxProcessor)
 
@@ -360,10 +370,14 @@ func CreateVMAndBlockchainHook(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     mock.NewGasScheduleNotifierMock(actualGasSchedule),
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     testMarshalizer,
-		Accounts:        accnts,
+		GasSchedule:      mock.NewGasScheduleNotifierMock(actualGasSchedule),
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      testMarshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: shardCoordinator,
+		GuardedAccounts:  guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -421,10 +435,12 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	argEnableEpoch ArgEnableEpoch,
 ) (process.TransactionProcessor, process.SmartContractProcessor, process.IntermediateTransactionHandler) {
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
-		PubkeyConverter:  pubkeyConv,
-		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: blockChainHook.GetBuiltInFunctions().Keys(),
-		ArgumentParser:   parsers.NewCallArgsParser(),
+		PubkeyConverter:           pubkeyConv,
+		ShardCoordinator:          shardCoordinator,
+		BuiltInFunctions:          blockChainHook.GetBuiltInFunctions(),
+		ArgumentParser:            parsers.NewCallArgsParser(),
+		EpochNotifier:             forking.NewGenericEpochNotifier(),
+		MultiRelayedTxEnableEpoch: 0,
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
@@ -479,7 +495,10 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		RelayedTxEnableEpoch:           argEnableEpoch.RelayedTxEnableEpoch,
+		MultiRelayedTxEnableEpoch:      argEnableEpoch.MultiRelayedTxEnableEpoch,
 		MetaProtectionEnableEpoch:      argEnableEpoch.MetaProtectionEnableEpoch,
+		GuardedAccounts:                guardian.NewDisabledGuardedAccount(),
+		MultisigAccounts:               multisig.NewDisabledMultisigAccount(),
 	}
 	txProcessor, _ := transaction.NewTxProcessor(argsNewTxProcessor)
 
@@ -739,7 +758,7 @@ func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.Ac
 		},
 	}
 
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler, blockChainHook, &mock.BlockChainMock{})
+	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler, blockChainHook, &mock.BlockChainMock{}, &disabled.HistoricalAccounts{})
 
 	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
@@ -1,9 +1,14 @@
 package blockAPI
 
-import apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+import (
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
 
 // APIBlockHandler defines the behavior of a component able to return api blocks
 type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error)
+	GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error)
+	GetHeaderByHash(hash []byte) (data.HeaderHandler, error)
 }
@@ -5,6 +5,7 @@ import (
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
@@ -57,6 +58,35 @@ func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*ap
 	return mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, withTxs)
 }
 
+// GetHeaderByNonce will return a meta block header by nonce
+func (mbp *metaAPIBlockProcessor) GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
+
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(storerUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.GetHeaderByHash(headerHash)
+}
+
+// GetHeaderByHash will return a meta block header by hash
+func (mbp *metaAPIBlockProcessor) GetHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader := &block.MetaBlock{}
+	err = mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockHeader, nil
+}
+
 func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*apiBlock.APIBlock, error) {
 	blockHeader := &block.MetaBlock{}
 	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
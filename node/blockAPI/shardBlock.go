@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
@@ -56,6 +57,35 @@ func (sbp *shardAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*a
 	return sbp.convertShardBlockBytesToAPIBlock(hash, blockBytes, withTxs)
 }
 
+// GetHeaderByNonce will return a shard block header by nonce
+func (sbp *shardAPIBlockProcessor) GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(sbp.selfShardID)
+
+	nonceToByteSlice := sbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := sbp.store.Get(storerUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbp.GetHeaderByHash(headerHash)
+}
+
+// GetHeaderByHash will return a shard block header by hash
+func (sbp *shardAPIBlockProcessor) GetHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	blockBytes, err := sbp.getFromStorer(dataRetriever.BlockHeaderUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader := &block.Header{}
+	err = sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockHeader, nil
+}
+
 func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*apiBlock.APIBlock, error) {
 	blockHeader := &block.Header{}
 	err := sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -207,3 +207,54 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrNilBlockHeader is raised when a valid block header is expected but nil was used
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrNilTrie signals that a nil trie has been provided or obtained
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrBlockNotFound signals that the requested block could not be found
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrTransactionsPoolNotInspectable signals that the transactions pool of the current shard does not support inspection
+var ErrTransactionsPoolNotInspectable = errors.New("transactions pool does not support inspection")
+
+// ErrEmptyTransactionsBundle signals that an empty bundle of transactions has been provided for simulation
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrInvalidStateOverride signals that an invalid state override has been provided for simulation
+var ErrInvalidStateOverride = errors.New("invalid state override")
+
+// ErrTransactionSimulationDisabled signals that the transaction simulation routes are not enabled on this node
+var ErrTransactionSimulationDisabled = errors.New("transaction simulation is disabled")
+
+// ErrNilBlockChainHook signals that a nil blockchain hook has been provided
+var ErrNilBlockChainHook = errors.New("nil blockchain hook")
+
+// ErrStateChangesNotTracked signals that the state changes were requested while they were not being tracked
+var ErrStateChangesNotTracked = errors.New("state changes are not tracked")
+
+// ErrNilBlockSizeEstimator signals that a nil block size estimator has been provided
+var ErrNilBlockSizeEstimator = errors.New("nil block size estimator")
+
+// ErrInvalidNumberOfBlocks signals that an invalid number of blocks has been requested
+var ErrInvalidNumberOfBlocks = errors.New("invalid number of blocks")
+
+// ErrNilGuardedAccountHandler signals that a nil guarded account handler has been provided
+var ErrNilGuardedAccountHandler = errors.New("nil guarded account handler")
+
+// ErrNilMultisigAccountHandler signals that a nil multisig account handler has been provided
+var ErrNilMultisigAccountHandler = errors.New("nil multisig account handler")
+
+// ErrNilDoubleSigningDetector signals that a nil double signing detector has been provided
+var ErrNilDoubleSigningDetector = errors.New("nil double signing detector")
+
+// ErrNilValidatorParticipationRecorder signals that a nil validator participation recorder has been provided
+var ErrNilValidatorParticipationRecorder = errors.New("nil validator participation recorder")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilRoundTraceRecorder signals that a nil round trace recorder has been provided
+var ErrNilRoundTraceRecorder = errors.New("nil round trace recorder")
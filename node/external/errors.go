@@ -13,3 +13,6 @@ var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
 
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
+
+// ErrNilGovernanceProposalsHandler signals that a nil governance proposals handler has been provided
+var ErrNilGovernanceProposalsHandler = errors.New("nil governance proposals handler")
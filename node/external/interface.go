@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -37,3 +38,9 @@ type TotalStakedValueHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceProposalsHandler defines the behavior of a component able to return the governance proposals
+type GovernanceProposalsHandler interface {
+	GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error)
+	IsInterfaceNil() bool
+}
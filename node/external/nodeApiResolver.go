@@ -6,15 +6,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // NodeApiResolver can resolve API requests
 type NodeApiResolver struct {
-	scQueryService          SCQueryService
-	statusMetricsHandler    StatusMetricsHandler
-	txCostHandler           TransactionCostHandler
-	totalStakedValueHandler TotalStakedValueHandler
+	scQueryService             SCQueryService
+	statusMetricsHandler       StatusMetricsHandler
+	txCostHandler              TransactionCostHandler
+	totalStakedValueHandler    TotalStakedValueHandler
+	governanceProposalsHandler GovernanceProposalsHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -23,6 +25,7 @@ func NewNodeApiResolver(
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
 	totalStakedValueHandler TotalStakedValueHandler,
+	governanceProposalsHandler GovernanceProposalsHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -36,12 +39,16 @@ func NewNodeApiResolver(
 	if check.IfNil(totalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
+	if check.IfNil(governanceProposalsHandler) {
+		return nil, ErrNilGovernanceProposalsHandler
+	}
 
 	return &NodeApiResolver{
-		scQueryService:          scQueryService,
-		statusMetricsHandler:    statusMetricsHandler,
-		txCostHandler:           txCostHandler,
-		totalStakedValueHandler: totalStakedValueHandler,
+		scQueryService:             scQueryService,
+		statusMetricsHandler:       statusMetricsHandler,
+		txCostHandler:              txCostHandler,
+		totalStakedValueHandler:    totalStakedValueHandler,
+		governanceProposalsHandler: governanceProposalsHandler,
 	}, nil
 }
 
@@ -65,6 +72,11 @@ func (nar *NodeApiResolver) GetTotalStakedValue() (*big.Int, error) {
 	return nar.totalStakedValueHandler.GetTotalStakedValue()
 }
 
+// GetGovernanceProposals will return the governance proposals
+func (nar *NodeApiResolver) GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error) {
+	return nar.governanceProposalsHandler.GetGovernanceProposals()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/governanceAPI"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -16,7 +17,8 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, governanceAPIHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -26,7 +28,8 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, governanceAPIHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -36,7 +39,8 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler)
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler, governanceAPIHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
@@ -45,17 +49,29 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil, governanceAPIHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTotalStakedValueHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceProposalsHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceProposalsHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, governanceAPIHandler)
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -65,6 +81,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(&mock.SCQueryServiceStub{
 		ExecuteQueryCalled: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
@@ -74,6 +91,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	},
 		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
@@ -88,6 +106,7 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -99,6 +118,7 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -109,6 +129,7 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -120,6 +141,7 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -130,6 +152,7 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -141,6 +164,7 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -151,6 +175,7 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -162,6 +187,7 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -172,6 +198,7 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	governanceAPIHandler, _ := governanceAPI.NewDisabledGovernanceProposalsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -183,6 +210,7 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		governanceAPIHandler,
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
@@ -0,0 +1,20 @@
+package governanceAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/vm"
+
+type disabledGovernanceProposalsProcessor struct{}
+
+// NewDisabledGovernanceProposalsProcessor -
+func NewDisabledGovernanceProposalsProcessor() (*disabledGovernanceProposalsProcessor, error) {
+	return new(disabledGovernanceProposalsProcessor), nil
+}
+
+// GetGovernanceProposals -
+func (d *disabledGovernanceProposalsProcessor) GetGovernanceProposals() ([]*vm.GovernanceProposalApi, error) {
+	return nil, ErrCannotReturnProposalsFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledGovernanceProposalsProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
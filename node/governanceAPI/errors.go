@@ -0,0 +1,21 @@
+package governanceAPI
+
+import "errors"
+
+// ErrCannotCastAccountHandlerToUserAccount signal that returned account is wrong
+var ErrCannotCastAccountHandlerToUserAccount = errors.New("cannot cast AccountHandler to UserAccount")
+
+// ErrCannotReturnProposalsFromShardNode signals that the governance proposals cannot be returned by a shard node
+var ErrCannotReturnProposalsFromShardNode = errors.New("governance proposals cannot be returned by a shard node")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("trying to set nil marshalizer")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("trying to set nil accounts adapter")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("trying to set nil pubkey converter")
+
+// ErrMissingProposalsListPage signals that a page of the governance proposals list is missing
+var ErrMissingProposalsListPage = errors.New("missing governance proposals list page")
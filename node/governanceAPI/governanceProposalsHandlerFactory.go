@@ -0,0 +1,29 @@
+package governanceAPI
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ArgsGovernanceProposalsHandler is struct that contains components that are needed to create a GovernanceProposalsHandler
+type ArgsGovernanceProposalsHandler struct {
+	ShardID             uint32
+	InternalMarshalizer marshal.Marshalizer
+	Accounts            state.AccountsAdapter
+	PubkeyConverter     core.PubkeyConverter
+}
+
+// CreateGovernanceProposalsHandler wil create a new instance of GovernanceProposalsHandler
+func CreateGovernanceProposalsHandler(args *ArgsGovernanceProposalsHandler) (external.GovernanceProposalsHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledGovernanceProposalsProcessor()
+	}
+
+	return NewGovernanceProposalsProcessor(
+		args.InternalMarshalizer,
+		args.Accounts,
+		args.PubkeyConverter,
+	)
+}
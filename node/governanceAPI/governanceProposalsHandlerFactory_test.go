@@ -0,0 +1,42 @@
+package governanceAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGovernanceProposalsHandler_DisabledGovernanceProposalsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsGovernanceProposalsHandler{
+		ShardID: 0,
+	}
+
+	governanceProposalsHandler, err := CreateGovernanceProposalsHandler(args)
+	require.Nil(t, err)
+
+	governanceProposalsProc, ok := governanceProposalsHandler.(*disabledGovernanceProposalsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, governanceProposalsProc)
+}
+
+func TestCreateGovernanceProposalsHandler_GovernanceProposalsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsGovernanceProposalsHandler{
+		ShardID:             core.MetachainShardId,
+		InternalMarshalizer: &mock.MarshalizerMock{},
+		Accounts:            &mock.AccountsStub{},
+		PubkeyConverter:     mock.NewPubkeyConverterMock(32),
+	}
+
+	governanceProposalsHandler, err := CreateGovernanceProposalsHandler(args)
+	require.Nil(t, err)
+
+	governanceProposalsProc, ok := governanceProposalsHandler.(*governanceProposalsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, governanceProposalsProc)
+}
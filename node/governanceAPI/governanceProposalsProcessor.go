@@ -0,0 +1,178 @@
+package governanceAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	dataVm "github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const (
+	statusOpen     = "open"
+	statusPassed   = "passed"
+	statusRejected = "rejected"
+	statusExecuted = "executed"
+	statusFailed   = "failed"
+)
+
+type governanceProposalsProcessor struct {
+	marshalizer     marshal.Marshalizer
+	accounts        state.AccountsAdapter
+	pubkeyConverter core.PubkeyConverter
+}
+
+// NewGovernanceProposalsProcessor will create a new instance of governanceProposalsProcessor
+func NewGovernanceProposalsProcessor(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	pubkeyConverter core.PubkeyConverter,
+) (*governanceProposalsProcessor, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &governanceProposalsProcessor{
+		marshalizer:     marshalizer,
+		accounts:        accounts,
+		pubkeyConverter: pubkeyConverter,
+	}, nil
+}
+
+// GetGovernanceProposals will read all the proposals stored in the governance system smart contract
+func (gpp *governanceProposalsProcessor) GetGovernanceProposals() ([]*dataVm.GovernanceProposalApi, error) {
+	ah, err := gpp.accounts.GetExistingAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := ah.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccount
+	}
+
+	references, err := gpp.getProposalsReferences(account)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*dataVm.GovernanceProposalApi, 0, len(references))
+	for _, reference := range references {
+		marshaledProposal, errRetrieve := account.DataTrieTracker().RetrieveValue(systemSmartContracts.GovernanceProposalKey(reference))
+		if errRetrieve != nil {
+			return nil, fmt.Errorf("%w for proposal %s", errRetrieve, hex.EncodeToString(reference))
+		}
+		if len(marshaledProposal) == 0 {
+			continue
+		}
+
+		proposal := &systemSmartContracts.GeneralProposal{}
+		err = gpp.marshalizer.Unmarshal(proposal, marshaledProposal)
+		if err != nil {
+			return nil, fmt.Errorf("%w for proposal %s", err, hex.EncodeToString(reference))
+		}
+
+		proposals = append(proposals, gpp.convertProposal(reference, proposal))
+	}
+
+	return proposals, nil
+}
+
+func (gpp *governanceProposalsProcessor) getProposalsReferences(account state.UserAccountHandler) ([][]byte, error) {
+	marshaledNumProposals, err := account.DataTrieTracker().RetrieveValue([]byte(systemSmartContracts.GovernanceProposalsListKey))
+	if err != nil {
+		return nil, err
+	}
+
+	numProposals := big.NewInt(0).SetBytes(marshaledNumProposals).Uint64()
+	references := make([][]byte, 0, numProposals)
+	for page := uint64(0); uint64(len(references)) < numProposals; page++ {
+		marshaledPage, errRetrieve := account.DataTrieTracker().RetrieveValue(systemSmartContracts.GovernanceProposalsListPageKey(page))
+		if errRetrieve != nil {
+			return nil, errRetrieve
+		}
+		if len(marshaledPage) == 0 {
+			return nil, fmt.Errorf("%w, page %d", ErrMissingProposalsListPage, page)
+		}
+
+		proposalsPage := &systemSmartContracts.ProposalsList{}
+		err = gpp.marshalizer.Unmarshal(proposalsPage, marshaledPage)
+		if err != nil {
+			return nil, err
+		}
+
+		references = append(references, proposalsPage.References...)
+	}
+
+	return references, nil
+}
+
+func (gpp *governanceProposalsProcessor) convertProposal(
+	reference []byte,
+	proposal *systemSmartContracts.GeneralProposal,
+) *dataVm.GovernanceProposalApi {
+	actionType := string(proposal.ActionType)
+	actionArguments := make([]string, 0, len(proposal.ActionArguments))
+	for _, argument := range proposal.ActionArguments {
+		if actionType == systemSmartContracts.GovernanceActionWhiteList {
+			actionArguments = append(actionArguments, gpp.pubkeyConverter.Encode(argument))
+			continue
+		}
+		actionArguments = append(actionArguments, string(argument))
+	}
+
+	return &dataVm.GovernanceProposalApi{
+		Reference:       hex.EncodeToString(reference),
+		Issuer:          gpp.pubkeyConverter.Encode(proposal.IssuerAddress),
+		GitHubCommit:    string(proposal.GitHubCommit),
+		StartVoteNonce:  proposal.StartVoteNonce,
+		EndVoteNonce:    proposal.EndVoteNonce,
+		Yes:             proposal.Yes,
+		No:              proposal.No,
+		Veto:            proposal.Veto,
+		DontCare:        proposal.DontCare,
+		Status:          proposalStatus(proposal),
+		ActionType:      actionType,
+		ActionArguments: actionArguments,
+		ExecutionEpoch:  proposal.ExecutionEpoch,
+		ExecutionError:  string(proposal.ExecutionError),
+	}
+}
+
+func proposalStatus(proposal *systemSmartContracts.GeneralProposal) string {
+	if proposal.Executed {
+		if proposal.ExecutionFailed {
+			return statusFailed
+		}
+		return statusExecuted
+	}
+	if proposal.Closed {
+		if proposal.Voted {
+			return statusPassed
+		}
+		return statusRejected
+	}
+	// proposals accepted at genesis are passed without being closed
+	if proposal.Voted {
+		return statusPassed
+	}
+
+	return statusOpen
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpp *governanceProposalsProcessor) IsInterfaceNil() bool {
+	return gpp == nil
+}
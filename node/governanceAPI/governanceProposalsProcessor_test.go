@@ -0,0 +1,153 @@
+package governanceAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGovernanceProposalsProcessor(t *testing.T) {
+	t.Parallel()
+
+	proc, err := NewGovernanceProposalsProcessor(nil, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32))
+	assert.Nil(t, proc)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	proc, err = NewGovernanceProposalsProcessor(&mock.MarshalizerMock{}, nil, mock.NewPubkeyConverterMock(32))
+	assert.Nil(t, proc)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	proc, err = NewGovernanceProposalsProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{}, nil)
+	assert.Nil(t, proc)
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	proc, err = NewGovernanceProposalsProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32))
+	assert.Nil(t, err)
+	assert.False(t, proc.IsInterfaceNil())
+}
+
+func TestGovernanceProposalsProcessor_GetGovernanceProposalsCannotGetAccount(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proc, _ := NewGovernanceProposalsProcessor(
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		},
+		mock.NewPubkeyConverterMock(32),
+	)
+
+	proposals, err := proc.GetGovernanceProposals()
+	assert.Nil(t, proposals)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGovernanceProposalsProcessor_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	account, _ := state.NewUserAccount(vm.GovernanceSCAddress)
+
+	openRef := []byte("open proposal reference")
+	executedRef := []byte("executed proposal reference")
+	whiteListedAddress := []byte("whitelisted address")
+	firstPage := &systemSmartContracts.ProposalsList{References: [][]byte{openRef}}
+	secondPage := &systemSmartContracts.ProposalsList{References: [][]byte{executedRef}}
+	proposals := map[string]*systemSmartContracts.GeneralProposal{
+		string(openRef): {
+			GitHubCommit: openRef,
+			Yes:          5,
+			No:           2,
+		},
+		string(executedRef): {
+			GitHubCommit:    executedRef,
+			Yes:             10,
+			Voted:           true,
+			Closed:          true,
+			Executed:        true,
+			ActionType:      []byte(systemSmartContracts.GovernanceActionWhiteList),
+			ActionArguments: [][]byte{whiteListedAddress},
+			ExecutionEpoch:  3,
+		},
+	}
+
+	_ = account.DataTrieTracker().SaveKeyValue([]byte(systemSmartContracts.GovernanceProposalsListKey), big.NewInt(2).Bytes())
+	marshaledPage, _ := marshalizer.Marshal(firstPage)
+	_ = account.DataTrieTracker().SaveKeyValue(systemSmartContracts.GovernanceProposalsListPageKey(0), marshaledPage)
+	marshaledPage, _ = marshalizer.Marshal(secondPage)
+	_ = account.DataTrieTracker().SaveKeyValue(systemSmartContracts.GovernanceProposalsListPageKey(1), marshaledPage)
+	for reference, proposal := range proposals {
+		marshaledProposal, _ := marshalizer.Marshal(proposal)
+		_ = account.DataTrieTracker().SaveKeyValue(systemSmartContracts.GovernanceProposalKey([]byte(reference)), marshaledProposal)
+	}
+
+	proc, _ := NewGovernanceProposalsProcessor(
+		marshalizer,
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				return account, nil
+			},
+		},
+		mock.NewPubkeyConverterMock(32),
+	)
+
+	result, err := proc.GetGovernanceProposals()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(result))
+
+	assert.Equal(t, hex.EncodeToString(openRef), result[0].Reference)
+	assert.Equal(t, statusOpen, result[0].Status)
+	assert.Equal(t, int32(5), result[0].Yes)
+	assert.Equal(t, int32(2), result[0].No)
+
+	assert.Equal(t, hex.EncodeToString(executedRef), result[1].Reference)
+	assert.Equal(t, statusExecuted, result[1].Status)
+	assert.Equal(t, systemSmartContracts.GovernanceActionWhiteList, result[1].ActionType)
+	assert.Equal(t, []string{hex.EncodeToString(whiteListedAddress)}, result[1].ActionArguments)
+	assert.Equal(t, uint32(3), result[1].ExecutionEpoch)
+}
+
+func TestGovernanceProposalsProcessor_GetGovernanceProposalsMissingPageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	account, _ := state.NewUserAccount(vm.GovernanceSCAddress)
+	account.SetDataTrie(&mock.TrieStub{})
+	_ = account.DataTrieTracker().SaveKeyValue([]byte(systemSmartContracts.GovernanceProposalsListKey), big.NewInt(1).Bytes())
+
+	proc, _ := NewGovernanceProposalsProcessor(
+		&marshal.GogoProtoMarshalizer{},
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				return account, nil
+			},
+		},
+		mock.NewPubkeyConverterMock(32),
+	)
+
+	proposals, err := proc.GetGovernanceProposals()
+	assert.Nil(t, proposals)
+	assert.True(t, errors.Is(err, ErrMissingProposalsListPage))
+}
+
+func TestProposalStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, statusOpen, proposalStatus(&systemSmartContracts.GeneralProposal{}))
+	assert.Equal(t, statusPassed, proposalStatus(&systemSmartContracts.GeneralProposal{Voted: true}))
+	assert.Equal(t, statusPassed, proposalStatus(&systemSmartContracts.GeneralProposal{Voted: true, Closed: true}))
+	assert.Equal(t, statusRejected, proposalStatus(&systemSmartContracts.GeneralProposal{Closed: true}))
+	assert.Equal(t, statusExecuted, proposalStatus(&systemSmartContracts.GeneralProposal{Closed: true, Voted: true, Executed: true}))
+	assert.Equal(t, statusFailed, proposalStatus(&systemSmartContracts.GeneralProposal{Closed: true, Voted: true, Executed: true, ExecutionFailed: true}))
+}
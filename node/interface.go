@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -83,3 +84,19 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// BlockSizeEstimator defines the methods used to estimate how full a block body is
+type BlockSizeEstimator interface {
+	EstimateBlockBodySize(numMiniBlocks int, numTxs int) uint32
+	MaxBlockSize() uint32
+	IsInterfaceNil() bool
+}
+
+// txPoolInspector defines the read-only methods of the cache holding the transactions sent from the current shard
+type txPoolInspector interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+	GetSenderPoolInfo(sender []byte) (*txcache.SenderPoolInfo, bool)
+	CountSenders() uint64
+	NumBytes() int
+	NumBytesThreshold() uint32
+}
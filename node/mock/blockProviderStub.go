@@ -0,0 +1,24 @@
+package mock
+
+import (
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+)
+
+// BlockProviderStub -
+type BlockProviderStub struct {
+	GetBlockByHashCalled func(hash string, withTxs bool) (*apiBlock.APIBlock, error)
+}
+
+// GetBlockByHash -
+func (stub *BlockProviderStub) GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
+	if stub.GetBlockByHashCalled != nil {
+		return stub.GetBlockByHashCalled(hash, withTxs)
+	}
+
+	return &apiBlock.APIBlock{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+// BlockSizeEstimatorStub -
+type BlockSizeEstimatorStub struct {
+	EstimateBlockBodySizeCalled func(numMiniBlocks int, numTxs int) uint32
+	MaxBlockSizeCalled          func() uint32
+}
+
+// EstimateBlockBodySize -
+func (bses *BlockSizeEstimatorStub) EstimateBlockBodySize(numMiniBlocks int, numTxs int) uint32 {
+	if bses.EstimateBlockBodySizeCalled != nil {
+		return bses.EstimateBlockBodySizeCalled(numMiniBlocks, numTxs)
+	}
+
+	return 0
+}
+
+// MaxBlockSize -
+func (bses *BlockSizeEstimatorStub) MaxBlockSize() uint32 {
+	if bses.MaxBlockSizeCalled != nil {
+		return bses.MaxBlockSizeCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (bses *BlockSizeEstimatorStub) IsInterfaceNil() bool {
+	return bses == nil
+}
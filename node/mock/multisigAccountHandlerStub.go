@@ -0,0 +1,77 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// MultisigAccountHandlerStub -
+type MultisigAccountHandlerStub struct {
+	SetMultisigCalled                 func(account state.UserAccountHandler, threshold uint32, signers [][]byte) error
+	GetMultisigConfigCalled           func(account state.UserAccountHandler) (*multisig.MultisigConfig, error)
+	GetMultisigConfigForAddressCalled func(address []byte) (*multisig.MultisigConfig, error)
+	VerifyMultisigSignaturesCalled    func(config *multisig.MultisigConfig, messageForSigning []byte, signatures [][]byte) error
+	VerifyMultisigTransactionCalled   func(config *multisig.MultisigConfig, tx *transaction.Transaction) error
+	IsMultisigAccountsEnabledCalled   func() bool
+}
+
+// SetMultisig -
+func (mahs *MultisigAccountHandlerStub) SetMultisig(account state.UserAccountHandler, threshold uint32, signers [][]byte) error {
+	if mahs.SetMultisigCalled != nil {
+		return mahs.SetMultisigCalled(account, threshold, signers)
+	}
+
+	return nil
+}
+
+// GetMultisigConfig -
+func (mahs *MultisigAccountHandlerStub) GetMultisigConfig(account state.UserAccountHandler) (*multisig.MultisigConfig, error) {
+	if mahs.GetMultisigConfigCalled != nil {
+		return mahs.GetMultisigConfigCalled(account)
+	}
+
+	return nil, process.ErrAccountIsNotMultisig
+}
+
+// GetMultisigConfigForAddress -
+func (mahs *MultisigAccountHandlerStub) GetMultisigConfigForAddress(address []byte) (*multisig.MultisigConfig, error) {
+	if mahs.GetMultisigConfigForAddressCalled != nil {
+		return mahs.GetMultisigConfigForAddressCalled(address)
+	}
+
+	return nil, process.ErrAccountIsNotMultisig
+}
+
+// VerifyMultisigSignatures -
+func (mahs *MultisigAccountHandlerStub) VerifyMultisigSignatures(config *multisig.MultisigConfig, messageForSigning []byte, signatures [][]byte) error {
+	if mahs.VerifyMultisigSignaturesCalled != nil {
+		return mahs.VerifyMultisigSignaturesCalled(config, messageForSigning, signatures)
+	}
+
+	return nil
+}
+
+// VerifyMultisigTransaction -
+func (mahs *MultisigAccountHandlerStub) VerifyMultisigTransaction(config *multisig.MultisigConfig, tx *transaction.Transaction) error {
+	if mahs.VerifyMultisigTransactionCalled != nil {
+		return mahs.VerifyMultisigTransactionCalled(config, tx)
+	}
+
+	return nil
+}
+
+// IsMultisigAccountsEnabled -
+func (mahs *MultisigAccountHandlerStub) IsMultisigAccountsEnabled() bool {
+	if mahs.IsMultisigAccountsEnabledCalled != nil {
+		return mahs.IsMultisigAccountsEnabledCalled()
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (mahs *MultisigAccountHandlerStub) IsInterfaceNil() bool {
+	return mahs == nil
+}
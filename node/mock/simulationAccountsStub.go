@@ -0,0 +1,49 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// SimulationAccountsStub -
+type SimulationAccountsStub struct {
+	AccountsStub
+	SelectRootHashCalled func(rootHash []byte) error
+	ResetRootHashCalled  func()
+	StartTrackingCalled  func()
+	GetStateDiffCalled   func() ([]*transaction.AccountStateDiff, error)
+}
+
+// SelectRootHash -
+func (sas *SimulationAccountsStub) SelectRootHash(rootHash []byte) error {
+	if sas.SelectRootHashCalled != nil {
+		return sas.SelectRootHashCalled(rootHash)
+	}
+	return nil
+}
+
+// ResetRootHash -
+func (sas *SimulationAccountsStub) ResetRootHash() {
+	if sas.ResetRootHashCalled != nil {
+		sas.ResetRootHashCalled()
+	}
+}
+
+// StartTracking -
+func (sas *SimulationAccountsStub) StartTracking() {
+	if sas.StartTrackingCalled != nil {
+		sas.StartTrackingCalled()
+	}
+}
+
+// GetStateDiff -
+func (sas *SimulationAccountsStub) GetStateDiff() ([]*transaction.AccountStateDiff, error) {
+	if sas.GetStateDiffCalled != nil {
+		return sas.GetStateDiffCalled()
+	}
+	return make([]*transaction.AccountStateDiff, 0), nil
+}
+
+// IsInterfaceNil -
+func (sas *SimulationAccountsStub) IsInterfaceNil() bool {
+	return sas == nil
+}
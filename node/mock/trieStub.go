@@ -21,6 +21,7 @@ type TrieStub struct {
 	AppendToOldHashesCalled     func([][]byte)
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
 }
@@ -196,3 +197,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
@@ -30,11 +30,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -47,6 +49,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
+	"github.com/ElrondNetwork/elrond-go/process/peer"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
@@ -162,9 +168,17 @@ type Node struct {
 	historyRepository dblookupext.HistoryRepository
 
 	enableSignTxWithHashEpoch uint32
+	multiRelayedTxEnableEpoch uint32
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
+	guardedAccounts           process.GuardedAccountHandler
+	multisigAccounts          process.MultisigAccountHandler
+	doubleSigningDetector     process.DoubleSigningDetector
+	participationRecorder     process.ValidatorParticipationRecorder
+	roundTraceRecorder        consensus.RoundTraceRecorder
 	isInImportMode            bool
+
+	blockSizeEstimator BlockSizeEstimator
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -185,6 +199,11 @@ func NewNode(opts ...Option) (*Node, error) {
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
+		guardedAccounts:          guardian.NewDisabledGuardedAccount(),
+		multisigAccounts:         multisig.NewDisabledMultisigAccount(),
+		doubleSigningDetector:    slash.NewDisabledDoubleSigningDetector(),
+		participationRecorder:    peer.NewDisabledValidatorParticipationRecorder(),
+		roundTraceRecorder:       consensusTrace.NewDisabledRoundTraceRecorder(),
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -324,6 +343,8 @@ func (n *Node) StartConsensus() error {
 		PoolAdder:                n.dataPool.MiniBlocks(),
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
+		DoubleSigningDetector:    n.doubleSigningDetector,
+		RoundTraceRecorder:       n.roundTraceRecorder,
 	}
 
 	worker, err := spos.NewWorker(workerArgs)
@@ -334,6 +355,7 @@ func (n *Node) StartConsensus() error {
 	worker.StartWorking()
 
 	n.dataPool.Headers().RegisterHandler(worker.ReceivedHeader)
+	n.dataPool.Headers().RegisterHandler(n.doubleSigningDetector.ReceivedHeader)
 
 	// apply consensus group size on the input antiflooder just before consensus creation topic
 	n.inputAntifloodHandler.ApplyConsensusSize(n.nodesCoordinator.ConsensusGroupSize(n.shardCoordinator.SelfId()))
@@ -362,6 +384,7 @@ func (n *Node) StartConsensus() error {
 		PeerHonestyHandler:            n.peerHonestyHandler,
 		HeaderSigVerifier:             n.headerSigVerifier,
 		FallbackHeaderValidator:       n.fallbackHeaderValidator,
+		RoundTraceRecorder:            n.roundTraceRecorder,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -392,7 +415,7 @@ func (n *Node) StartConsensus() error {
 
 	chronologyHandler.StartRounds()
 
-	return n.addCloserInstances(chronologyHandler, bootstrapper, worker, n.syncTimer)
+	return n.addCloserInstances(chronologyHandler, bootstrapper, worker, n.syncTimer, n.roundTraceRecorder)
 }
 
 func (n *Node) addCloserInstances(closers ...update.Closer) error {
@@ -407,8 +430,8 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetBalance(address string, options core.AccountQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +445,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 }
 
 // GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetUsername(address string, options core.AccountQueryOptions) (string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -438,13 +461,13 @@ func (n *Node) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options core.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -463,8 +486,8 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetESDTBalance returns the esdt balance and properties from a given account
-func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetESDTBalance(address string, tokenName string, options core.AccountQueryOptions) (string, string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", "", err
 	}
@@ -489,9 +512,38 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 	return esdtToken.Value.String(), hex.EncodeToString(esdtToken.Properties), nil
 }
 
+// GetESDTNFTTokenData returns the esdt data of the non fungible or semi fungible token with the given nonce,
+// including its metadata, from a given account
+func (n *Node) GetESDTNFTTokenData(address string, tokenID string, nonce uint64, options core.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	account, err := n.getAccountHandler(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenID)
+	tokenKey = append(tokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue(tokenKey)
+	if err != nil || len(valueBytes) == 0 {
+		return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = n.internalMarshalizer.Unmarshal(esdtToken, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdtToken, nil
+}
+
 // GetAllESDTTokens returns the value of a key from a given account
-func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetAllESDTTokens(address string, options core.AccountQueryOptions) ([]string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +584,7 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
+func (n *Node) getAccountHandler(address string, options core.AccountQueryOptions) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -541,7 +593,34 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	return n.accounts.GetExistingAccount(addr)
+
+	accountsAdapter, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsAdapter.GetExistingAccount(addr)
+}
+
+// getAccountsAdapter returns the accounts adapter that should serve the query: the node's own accounts adapter
+// for the current state or a read-only one recreated from the root hash of the requested block
+func (n *Node) getAccountsAdapter(options core.AccountQueryOptions) (state.AccountsAdapter, error) {
+	if !options.IsHistorical() {
+		return n.accounts, nil
+	}
+
+	header, err := n.GetBlockHeaderForAccountQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDBFromRootHash(
+		n.accounts,
+		header.GetRootHash(),
+		n.hasher,
+		n.internalMarshalizer,
+		stateFactory.NewAccountCreator(),
+	)
 }
 
 func (n *Node) castAccountToUserAccount(ah state.AccountHandler) (state.UserAccountHandler, bool) {
@@ -644,6 +723,7 @@ func (n *Node) createShardBootstrapper(rounder consensus.Rounder) (process.Boots
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
 		Indexer:             n.indexer,
+		HistoryRepository:   n.historyRepository,
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -706,6 +786,7 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
 		Indexer:             n.indexer,
+		HistoryRepository:   n.historyRepository,
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
@@ -938,12 +1019,22 @@ func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction) err
 	return err
 }
 
+// ValidateTransactionFieldsForSimulation will validate the fields of a transaction for use in transaction simulation
+// process, without checking its nonce and the sender's balance against the node's state. It should be used when the
+// transaction is simulated on top of a modified state, such as the one having overrides or the previous transactions
+// of a bundle applied
+func (n *Node) ValidateTransactionFieldsForSimulation(tx *transaction.Transaction) error {
+	_, _, err := n.commonTransactionValidation(tx)
+	return err
+}
+
 func (n *Node) commonTransactionValidation(tx *transaction.Transaction) (process.TxValidator, process.TxValidatorHandler, error) {
 	txValidator, err := dataValidators.NewTxValidator(
 		n.accounts,
 		n.shardCoordinator,
 		n.whiteListRequest,
 		n.addressPubkeyConverter,
+		n.guardedAccounts,
 		core.MaxTxNonceDeltaAllowed,
 	)
 	if err != nil {
@@ -959,6 +1050,7 @@ func (n *Node) commonTransactionValidation(tx *transaction.Transaction) (process
 
 	currentEpoch := n.epochStartTrigger.Epoch()
 	enableSignWithTxHash := currentEpoch >= n.enableSignTxWithHashEpoch
+	enableMultiRelayedTx := currentEpoch >= n.multiRelayedTxEnableEpoch
 
 	argumentParser := smartContract.NewArgumentParser()
 	intTx, err := procTx.NewInterceptedTransaction(
@@ -975,8 +1067,10 @@ func (n *Node) commonTransactionValidation(tx *transaction.Transaction) (process
 		argumentParser,
 		n.chainID,
 		enableSignWithTxHash,
+		enableMultiRelayedTx,
 		n.txSignHasher,
 		n.txVersionChecker,
+		n.multisigAccounts,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1107,8 +1201,55 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
+// SetTransactionGuardian sets the guardian address and the guardian signature on a transaction created by the
+// CreateTransaction method, returning the recomputed transaction hash
+func (n *Node) SetTransactionGuardian(tx *transaction.Transaction, guardian string, guardianSignatureHex string) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	guardianAddress, err := n.addressPubkeyConverter.Decode(guardian)
+	if err != nil {
+		return nil, errors.New("could not create guardian address from provided param")
+	}
+
+	guardianSignatureBytes, err := hex.DecodeString(guardianSignatureHex)
+	if err != nil {
+		return nil, errors.New("could not fetch guardian signature bytes")
+	}
+
+	tx.GuardianAddr = guardianAddress
+	tx.GuardianSignature = guardianSignatureBytes
+
+	return core.CalculateHash(n.internalMarshalizer, n.hasher, tx)
+}
+
+// SetTransactionSignatures sets the signatures of the multisig account signers on a transaction created by the
+// CreateTransaction method and returns the new transaction hash
+func (n *Node) SetTransactionSignatures(tx *transaction.Transaction, signaturesHex []string) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	signatures := make([][]byte, 0, len(signaturesHex))
+	for _, signatureHex := range signaturesHex {
+		signatureBytes, err := hex.DecodeString(signatureHex)
+		if err != nil {
+			return nil, errors.New("could not fetch multisig signature bytes")
+		}
+		signatures = append(signatures, signatureBytes)
+	}
+
+	tx.Signatures = signatures
+
+	return core.CalculateHash(n.internalMarshalizer, n.hasher, tx)
+}
+
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+func (n *Node) GetAccount(address string, options core.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -1121,7 +1262,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accountsAdapter, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accountsAdapter.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -1190,6 +1336,23 @@ func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse,
 	return n.validatorsProvider.GetLatestValidators(), nil
 }
 
+// GetDoubleSigningProofs returns the most recent proofs of double signing built by the node, ready to be sent to the
+// staking system smart contract
+func (n *Node) GetDoubleSigningProofs() [][]byte {
+	return n.doubleSigningDetector.GetProofs()
+}
+
+// GetValidatorParticipation returns the per epoch history of the consensus participation of the provided validator,
+// as recorded by the metachain node
+func (n *Node) GetValidatorParticipation(blsKey string) (*state.ValidatorParticipationHistory, error) {
+	pubKey, err := n.validatorPubkeyConverter.Decode(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.participationRecorder.GetParticipationHistory(pubKey)
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.hardforkTrigger.Trigger(epoch, withEarlyEndOfEpoch)
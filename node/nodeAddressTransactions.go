@@ -0,0 +1,46 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// GetAddressTransactions returns a page of the transactions, smart contract results and rewards that had the given
+// address as sender or receiver, from the newest to the oldest one. Requires the address transactions index
+func (n *Node) GetAddressTransactions(address string, query dblookupext.AddressTransactionsQuery) (*apiAddress.AddressTransactions, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.historyRepository) {
+		return nil, errors.New("initialize PubkeyConverter and HistoryRepository first")
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	result, err := n.historyRepository.GetAddressTransactions(addressBytes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*apiAddress.AddressTransaction, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		transactions = append(transactions, &apiAddress.AddressTransaction{
+			Hash:       hex.EncodeToString(entry.TxHash),
+			Type:       block.Type(entry.Type).String(),
+			Epoch:      entry.Epoch,
+			BlockNonce: entry.BlockNonce,
+			BlockHash:  hex.EncodeToString(entry.BlockHash),
+			Round:      entry.Round,
+		})
+	}
+
+	return &apiAddress.AddressTransactions{
+		Transactions: transactions,
+		NextCursor:   result.NextCursor,
+	}, nil
+}
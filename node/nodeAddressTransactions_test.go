@@ -0,0 +1,90 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetAddressTransactionsNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	transactions, err := n.GetAddressTransactions(createDummyHexAddress(64), dblookupext.AddressTransactionsQuery{})
+	assert.NotNil(t, err)
+	assert.Nil(t, transactions)
+}
+
+func TestNode_GetAddressTransactionsInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	transactions, err := n.GetAddressTransactions("not hex", dblookupext.AddressTransactionsQuery{})
+	assert.NotNil(t, err)
+	assert.Nil(t, transactions)
+}
+
+func TestNode_GetAddressTransactionsIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	transactions, err := n.GetAddressTransactions(createDummyHexAddress(64), dblookupext.AddressTransactionsQuery{})
+	assert.Equal(t, dblookupext.ErrAddressTransactionsIndexNotEnabled, err)
+	assert.Nil(t, transactions)
+}
+
+func TestNode_GetAddressTransactionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hexAddress := createDummyHexAddress(64)
+	query := dblookupext.AddressTransactionsQuery{FromNonce: 3, ToNonce: 10, Size: 2}
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetAddressTransactionsCalled: func(addr []byte, q dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsResult, error) {
+			assert.Equal(t, hexAddress, hex.EncodeToString(addr))
+			assert.Equal(t, query, q)
+
+			return &dblookupext.AddressTransactionsResult{
+				Entries: []*dblookupext.AddressTransactionEntry{
+					{TxHash: []byte("tx"), Type: int32(block.RewardsBlock), Epoch: 1, BlockNonce: 7, Round: 8, BlockHash: []byte("block")},
+				},
+				NextCursor: "4",
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(historyRepo),
+	)
+
+	transactions, err := n.GetAddressTransactions(hexAddress, query)
+	require.Nil(t, err)
+	assert.Equal(t, &address.AddressTransactions{
+		Transactions: []*address.AddressTransaction{
+			{
+				Hash:       hex.EncodeToString([]byte("tx")),
+				Type:       "RewardsBlock",
+				Epoch:      1,
+				BlockNonce: 7,
+				BlockHash:  hex.EncodeToString([]byte("block")),
+				Round:      8,
+			},
+		},
+		NextCursor: "4",
+	}, transactions)
+}
@@ -2,9 +2,12 @@ package node
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 )
 
@@ -26,6 +29,47 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock,
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlockHeaderForAccountQuery returns the block header whose state root hash should be used when serving an
+// account query. The current block header is returned if the query does not target a past block
+func (n *Node) GetBlockHeaderForAccountQuery(options core.AccountQueryOptions) (data.HeaderHandler, error) {
+	if !options.IsHistorical() {
+		return n.getCurrentBlockHeader()
+	}
+
+	var header data.HeaderHandler
+	var err error
+	apiBlockProcessor := n.createAPIBlockProcessor()
+	if options.HasBlockNonce {
+		header, err = apiBlockProcessor.GetHeaderByNonce(options.BlockNonce)
+	} else {
+		header, err = apiBlockProcessor.GetHeaderByHash(options.BlockHash)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBlockNotFound, err.Error())
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+
+	return header, nil
+}
+
+func (n *Node) getCurrentBlockHeader() (data.HeaderHandler, error) {
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+
+	return header, nil
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
 		return blockAPI.NewShardApiBlockProcessor(
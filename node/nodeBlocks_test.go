@@ -3,10 +3,12 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node"
@@ -263,3 +265,75 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetBlockHeaderForAccountQuery_NotHistoricalShouldReturnCurrentHeader(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.Header{Nonce: 10}
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}),
+	)
+
+	header, err := n.GetBlockHeaderForAccountQuery(core.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, currentHeader, header)
+}
+
+func TestGetBlockHeaderForAccountQuery_ByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	expectedHeader := &block.Header{Nonce: 1, RootHash: []byte("root hash")}
+	n, _ := node.NewNode(
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				if unitType == dataRetriever.ShardHdrNonceHashDataUnit {
+					return headerHash, nil
+				}
+
+				assert.Equal(t, dataRetriever.BlockHeaderUnit, unitType)
+				assert.Equal(t, headerHash, key)
+				return json.Marshal(expectedHeader)
+			},
+		}),
+	)
+
+	header, err := n.GetBlockHeaderForAccountQuery(core.AccountQueryOptions{BlockNonce: 1, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHeader, header)
+}
+
+func TestGetBlockHeaderForAccountQuery_ByHashNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return nil, errors.New("key not found")
+			},
+		}),
+	)
+
+	header, err := n.GetBlockHeaderForAccountQuery(core.AccountQueryOptions{BlockHash: []byte("hash")})
+	assert.True(t, errors.Is(err, node.ErrBlockNotFound))
+	assert.Nil(t, header)
+}
@@ -0,0 +1,54 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+
+	apiEvents "github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+)
+
+// GetEvents returns a page of the smart contract events having the requested identifier (and, optionally, first
+// topic) that were emitted by the given address, from the newest to the oldest one. Requires the events index
+func (n *Node) GetEvents(address string, query dblookupext.EventsQuery) (*apiEvents.Events, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.historyRepository) {
+		return nil, errors.New("initialize PubkeyConverter and HistoryRepository first")
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	result, err := n.historyRepository.GetEvents(addressBytes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*apiEvents.Event, 0, len(result.Events))
+	for _, entry := range result.Events {
+		topics := make([]string, 0, len(entry.Topics))
+		for _, topic := range entry.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, &apiEvents.Event{
+			TxHash:     hex.EncodeToString(entry.TxHash),
+			EventIndex: entry.EventIndex,
+			Address:    n.addressPubkeyConverter.Encode(entry.Address),
+			Identifier: string(entry.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(entry.Data),
+			Epoch:      entry.Epoch,
+			BlockNonce: entry.BlockNonce,
+			BlockHash:  hex.EncodeToString(entry.BlockHash),
+			Round:      entry.Round,
+		})
+	}
+
+	return &apiEvents.Events{
+		Events:     events,
+		NextCursor: result.NextCursor,
+	}, nil
+}
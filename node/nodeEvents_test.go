@@ -0,0 +1,104 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetEventsNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	result, err := n.GetEvents(createDummyHexAddress(64), dblookupext.EventsQuery{})
+	assert.NotNil(t, err)
+	assert.Nil(t, result)
+}
+
+func TestNode_GetEventsInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	result, err := n.GetEvents("not hex", dblookupext.EventsQuery{})
+	assert.NotNil(t, err)
+	assert.Nil(t, result)
+}
+
+func TestNode_GetEventsIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	result, err := n.GetEvents(createDummyHexAddress(64), dblookupext.EventsQuery{Identifier: []byte("transfer")})
+	assert.Equal(t, dblookupext.ErrEventsIndexNotEnabled, err)
+	assert.Nil(t, result)
+}
+
+func TestNode_GetEventsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hexAddress := createDummyHexAddress(64)
+	query := dblookupext.EventsQuery{Identifier: []byte("transfer"), Topic: []byte("alice"), FromNonce: 3, Size: 2}
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetEventsCalled: func(addr []byte, q dblookupext.EventsQuery) (*dblookupext.EventsResult, error) {
+			assert.Equal(t, hexAddress, hex.EncodeToString(addr))
+			assert.Equal(t, query, q)
+
+			return &dblookupext.EventsResult{
+				Events: []*dblookupext.EventEntry{
+					{
+						TxHash:     []byte("tx"),
+						EventIndex: 2,
+						Address:    addr,
+						Identifier: []byte("transfer"),
+						Topics:     [][]byte{[]byte("alice"), []byte("bob")},
+						Data:       []byte("data"),
+						Epoch:      1,
+						BlockNonce: 7,
+						Round:      8,
+						BlockHash:  []byte("block"),
+					},
+				},
+				NextCursor: "4",
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(historyRepo),
+	)
+
+	result, err := n.GetEvents(hexAddress, query)
+	require.Nil(t, err)
+	assert.Equal(t, &events.Events{
+		Events: []*events.Event{
+			{
+				TxHash:     hex.EncodeToString([]byte("tx")),
+				EventIndex: 2,
+				Address:    hexAddress,
+				Identifier: "transfer",
+				Topics:     []string{hex.EncodeToString([]byte("alice")), hex.EncodeToString([]byte("bob"))},
+				Data:       hex.EncodeToString([]byte("data")),
+				Epoch:      1,
+				BlockNonce: 7,
+				BlockHash:  hex.EncodeToString([]byte("block")),
+				Round:      8,
+			},
+		},
+		NextCursor: "4",
+	}, result)
+}
@@ -0,0 +1,199 @@
+package node
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const (
+	// above this fill of the recent blocks or of the pool, the transactions paying the network minimum gas price
+	// are not expected to be included in the next blocks
+	congestionFillThreshold = 0.8
+
+	minimumGasPricePercentile = 10
+	medianGasPricePercentile  = 50
+	fastGasPricePercentile    = 90
+)
+
+// GetGasPriceSuggestion analyses the last numBlocks blocks of the current shard and returns the suggested gas prices.
+// The suggestion is computed from the gas prices of the transactions sent from the current shard that were included
+// in those blocks, the estimated fill of the blocks and the fill of the transactions pool
+func (n *Node) GetGasPriceSuggestion(numBlocks uint32) (*transaction.ApiGasPriceSuggestion, error) {
+	if numBlocks == 0 {
+		return nil, ErrInvalidNumberOfBlocks
+	}
+	if check.IfNil(n.blockSizeEstimator) {
+		return nil, ErrNilBlockSizeEstimator
+	}
+	if check.IfNil(n.feeHandler) {
+		return nil, ErrNilTxFeeHandler
+	}
+
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := n.getCurrentBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion := &transaction.ApiGasPriceSuggestion{
+		NetworkMinGasPrice:    n.feeHandler.MinGasPrice(),
+		LastBlockNonce:        header.GetNonce(),
+		PoolNumBytes:          uint64(pool.NumBytes()),
+		PoolNumBytesThreshold: pool.NumBytesThreshold(),
+	}
+	if suggestion.PoolNumBytesThreshold > 0 {
+		suggestion.PoolFill = float64(suggestion.PoolNumBytes) / float64(suggestion.PoolNumBytesThreshold)
+	}
+
+	gasPrices := make([]uint64, 0)
+	totalBlockFill := float64(0)
+	apiBlockProcessor := n.createAPIBlockProcessor()
+	for {
+		blockFill := n.computeBlockFill(header)
+		if suggestion.NumBlocks == 0 {
+			suggestion.LastBlockFill = blockFill
+		}
+		totalBlockFill += blockFill
+		suggestion.NumBlocks++
+		suggestion.FirstBlockNonce = header.GetNonce()
+		gasPrices = append(gasPrices, n.getIncludedGasPrices(header)...)
+
+		if suggestion.NumBlocks == numBlocks || header.GetNonce() == 0 {
+			break
+		}
+
+		prevHash := header.GetPrevHash()
+		header, err = apiBlockProcessor.GetHeaderByHash(prevHash)
+		if err != nil || check.IfNil(header) {
+			log.Debug("GetGasPriceSuggestion: cannot get previous header, analysing fewer blocks",
+				"hash", hex.EncodeToString(prevHash),
+				"error", err)
+			break
+		}
+	}
+
+	suggestion.NumTransactions = uint64(len(gasPrices))
+	suggestion.AverageBlockFill = totalBlockFill / float64(suggestion.NumBlocks)
+	fillSuggestedGasPrices(suggestion, gasPrices)
+
+	return suggestion, nil
+}
+
+// computeBlockFill returns the ratio between the estimated size of the block body and the maximum block size
+func (n *Node) computeBlockFill(header data.HeaderHandler) float64 {
+	maxBlockSize := n.blockSizeEstimator.MaxBlockSize()
+	if maxBlockSize == 0 {
+		return 0
+	}
+
+	numMiniBlocks := len(getMiniBlockHeaders(header))
+	bodySize := n.blockSizeEstimator.EstimateBlockBodySize(numMiniBlocks, int(header.GetTxCount()))
+	fill := float64(bodySize) / float64(maxBlockSize)
+	if fill > 1 {
+		return 1
+	}
+
+	return fill
+}
+
+// getIncludedGasPrices returns the gas prices of the transactions sent from the current shard which were included
+// in the provided block. The miniblocks or transactions that can not be read from storage are skipped
+func (n *Node) getIncludedGasPrices(header data.HeaderHandler) []uint64 {
+	gasPrices := make([]uint64, 0)
+	for _, mbHeader := range getMiniBlockHeaders(header) {
+		if mbHeader.Type != block.TxBlock || mbHeader.SenderShardID != n.shardCoordinator.SelfId() {
+			continue
+		}
+
+		miniBlock, err := n.getMiniBlockFromStorage(mbHeader.Hash, header.GetEpoch())
+		if err != nil {
+			log.Debug("GetGasPriceSuggestion: cannot get miniblock",
+				"hash", hex.EncodeToString(mbHeader.Hash),
+				"error", err)
+			continue
+		}
+
+		marshalizedTxs, err := n.store.GetStorer(dataRetriever.TransactionUnit).GetBulkFromEpoch(miniBlock.TxHashes, header.GetEpoch())
+		if err != nil {
+			log.Debug("GetGasPriceSuggestion: cannot get transactions",
+				"miniblock hash", hex.EncodeToString(mbHeader.Hash),
+				"error", err)
+			continue
+		}
+
+		for _, txBytes := range marshalizedTxs {
+			tx := &transaction.Transaction{}
+			err = n.internalMarshalizer.Unmarshal(tx, txBytes)
+			if err != nil {
+				continue
+			}
+
+			gasPrices = append(gasPrices, tx.GasPrice)
+		}
+	}
+
+	return gasPrices
+}
+
+func (n *Node) getMiniBlockFromStorage(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	mbBytes, err := n.store.GetStorer(dataRetriever.MiniBlockUnit).GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = n.internalMarshalizer.Unmarshal(miniBlock, mbBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+// fillSuggestedGasPrices sets the suggested gas prices from the sorted gas prices of the included transactions. While
+// neither the blocks nor the pool are congested, the network minimum gas price is suggested as the minimum one
+func fillSuggestedGasPrices(suggestion *transaction.ApiGasPriceSuggestion, gasPrices []uint64) {
+	sort.Slice(gasPrices, func(i, j int) bool {
+		return gasPrices[i] < gasPrices[j]
+	})
+
+	suggestion.MinimumGasPrice = suggestion.NetworkMinGasPrice
+	isCongested := suggestion.AverageBlockFill >= congestionFillThreshold || suggestion.PoolFill >= congestionFillThreshold
+	if isCongested {
+		suggestion.MinimumGasPrice = core.MaxUint64(suggestion.MinimumGasPrice, percentile(gasPrices, minimumGasPricePercentile))
+	}
+
+	suggestion.MedianGasPrice = core.MaxUint64(suggestion.MinimumGasPrice, percentile(gasPrices, medianGasPricePercentile))
+	suggestion.FastGasPrice = core.MaxUint64(suggestion.MedianGasPrice, percentile(gasPrices, fastGasPricePercentile))
+}
+
+func getMiniBlockHeaders(header data.HeaderHandler) []block.MiniBlockHeader {
+	switch castedHeader := header.(type) {
+	case *block.Header:
+		return castedHeader.MiniBlockHeaders
+	case *block.MetaBlock:
+		return castedHeader.MiniBlockHeaders
+	default:
+		return nil
+	}
+}
+
+// percentile returns the value found at the provided percentile of an ascending sorted slice
+func percentile(sortedValues []uint64, percent int) uint64 {
+	if len(sortedValues) == 0 {
+		return 0
+	}
+
+	return sortedValues[(len(sortedValues)-1)*percent/100]
+}
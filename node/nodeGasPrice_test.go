@@ -0,0 +1,216 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNetworkMinGasPrice = uint64(1000)
+
+type gasPriceTestChain struct {
+	storer        *mock.StorerMock
+	currentHeader *block.Header
+}
+
+// createGasPriceTestChain stores a chain of shard 0 blocks, one for each provided list of gas prices, the last list
+// belonging to the current block. Each block also holds a cross shard miniblock whose gas prices should be ignored
+func createGasPriceTestChain(t *testing.T, gasPricesPerBlock [][]uint64) *gasPriceTestChain {
+	marshalizer := &mock.MarshalizerFake{}
+	storer := mock.NewStorerMock()
+	chain := &gasPriceTestChain{storer: storer}
+
+	prevHash := []byte("genesis")
+	for i, gasPrices := range gasPricesPerBlock {
+		nonce := uint64(i + 1)
+		selfMbHash := []byte("self miniblock " + string(rune('a'+i)))
+		crossMbHash := []byte("cross miniblock " + string(rune('a'+i)))
+
+		selfMb := &block.MiniBlock{Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 0}
+		for j, gasPrice := range gasPrices {
+			txHash := []byte(string(selfMbHash) + " tx " + string(rune('a'+j)))
+			txBytes, _ := marshalizer.Marshal(&transaction.Transaction{Nonce: uint64(j), GasPrice: gasPrice})
+			require.Nil(t, storer.Put(txHash, txBytes))
+			selfMb.TxHashes = append(selfMb.TxHashes, txHash)
+		}
+		crossTxHash := []byte(string(crossMbHash) + " tx")
+		crossTxBytes, _ := marshalizer.Marshal(&transaction.Transaction{GasPrice: 1})
+		require.Nil(t, storer.Put(crossTxHash, crossTxBytes))
+		crossMb := &block.MiniBlock{Type: block.TxBlock, SenderShardID: 1, ReceiverShardID: 0, TxHashes: [][]byte{crossTxHash}}
+
+		selfMbBytes, _ := marshalizer.Marshal(selfMb)
+		require.Nil(t, storer.Put(selfMbHash, selfMbBytes))
+		crossMbBytes, _ := marshalizer.Marshal(crossMb)
+		require.Nil(t, storer.Put(crossMbHash, crossMbBytes))
+
+		header := &block.Header{
+			Nonce:    nonce,
+			PrevHash: prevHash,
+			TxCount:  uint32(len(gasPrices) + 1),
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: selfMbHash, Type: block.TxBlock, SenderShardID: 0, TxCount: uint32(len(gasPrices))},
+				{Hash: crossMbHash, Type: block.TxBlock, SenderShardID: 1, TxCount: 1},
+			},
+		}
+		headerHash := []byte("header " + string(rune('a'+i)))
+		headerBytes, _ := marshalizer.Marshal(header)
+		require.Nil(t, storer.Put(headerHash, headerBytes))
+
+		prevHash = headerHash
+		chain.currentHeader = header
+	}
+
+	return chain
+}
+
+// createNodeForGasPrice creates a node whose estimated block body size is 100 bytes for each transaction, out of a
+// maximum of 1000 bytes
+func createNodeForGasPrice(t *testing.T, chain *gasPriceTestChain) *node.Node {
+	n, err := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, testSizeCheckDelta),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithDataPool(createDataPoolForGasPrice(createTxCacheForPoolInspection(t))),
+		node.WithTxFeeHandler(&mock.FeeHandlerStub{
+			MinGasPriceCalled: func() uint64 {
+				return testNetworkMinGasPrice
+			},
+		}),
+		node.WithBlockSizeEstimator(&mock.BlockSizeEstimatorStub{
+			EstimateBlockBodySizeCalled: func(_ int, numTxs int) uint32 {
+				return uint32(numTxs) * 100
+			},
+			MaxBlockSizeCalled: func() uint32 {
+				return 1000
+			},
+		}),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return chain.currentHeader
+			},
+		}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(_ dataRetriever.UnitType) storage.Storer {
+				return chain.storer
+			},
+			GetCalled: func(_ dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return chain.storer.Get(key)
+			},
+		}),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func createDataPoolForGasPrice(cache storage.Cacher) dataRetriever.PoolsHolder {
+	dataPool := testscommon.NewPoolsHolderStub()
+	dataPool.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return &testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(_ string) storage.Cacher {
+				return cache
+			},
+		}
+	}
+
+	return dataPool
+}
+
+func TestNode_GetGasPriceSuggestionInvalidNumberOfBlocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForGasPrice(t, createGasPriceTestChain(t, [][]uint64{{1000}}))
+
+	suggestion, err := n.GetGasPriceSuggestion(0)
+	assert.Equal(t, node.ErrInvalidNumberOfBlocks, err)
+	assert.Nil(t, suggestion)
+}
+
+func TestNode_GetGasPriceSuggestionNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	suggestion, err := n.GetGasPriceSuggestion(10)
+	assert.Equal(t, node.ErrNilBlockSizeEstimator, err)
+	assert.Nil(t, suggestion)
+}
+
+func TestNode_GetGasPriceSuggestionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	chain := createGasPriceTestChain(t, [][]uint64{
+		{9000},
+		{3000, 1000},
+		{5000, 2000, 4000},
+	})
+	n := createNodeForGasPrice(t, chain)
+
+	suggestion, err := n.GetGasPriceSuggestion(2)
+	require.Nil(t, err)
+
+	// the first block is not analysed, while the cross shard transactions are ignored
+	assert.Equal(t, uint32(2), suggestion.NumBlocks)
+	assert.Equal(t, uint64(2), suggestion.FirstBlockNonce)
+	assert.Equal(t, uint64(3), suggestion.LastBlockNonce)
+	assert.Equal(t, uint64(5), suggestion.NumTransactions)
+	assert.InDelta(t, 0.4, suggestion.LastBlockFill, 1e-9)
+	assert.InDelta(t, 0.35, suggestion.AverageBlockFill, 1e-9)
+	assert.Equal(t, testNetworkMinGasPrice, suggestion.NetworkMinGasPrice)
+
+	// the blocks are not congested, so the network minimum gas price is enough
+	assert.Equal(t, testNetworkMinGasPrice, suggestion.MinimumGasPrice)
+	assert.Equal(t, uint64(3000), suggestion.MedianGasPrice)
+	assert.Equal(t, uint64(4000), suggestion.FastGasPrice)
+}
+
+func TestNode_GetGasPriceSuggestionCongestedBlocksShouldSuggestHigherMinimum(t *testing.T) {
+	t.Parallel()
+
+	chain := createGasPriceTestChain(t, [][]uint64{
+		{3000, 3000, 3000, 3000, 3000, 3000, 3000, 3000, 3000},
+		{2000, 2000, 2000, 2000, 2000, 2000, 2000, 2000, 8000},
+	})
+	n := createNodeForGasPrice(t, chain)
+
+	suggestion, err := n.GetGasPriceSuggestion(10)
+	require.Nil(t, err)
+
+	// the walk stops at the first block, whose previous header is not stored
+	assert.Equal(t, uint32(2), suggestion.NumBlocks)
+	assert.Equal(t, uint64(1), suggestion.FirstBlockNonce)
+	assert.Equal(t, uint64(18), suggestion.NumTransactions)
+	assert.InDelta(t, 1, suggestion.AverageBlockFill, 1e-9)
+
+	assert.Equal(t, uint64(2000), suggestion.MinimumGasPrice)
+	assert.Equal(t, uint64(3000), suggestion.MedianGasPrice)
+	assert.Equal(t, uint64(3000), suggestion.FastGasPrice)
+}
+
+func TestNode_GetGasPriceSuggestionWithoutTransactionsShouldSuggestNetworkMinimum(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForGasPrice(t, createGasPriceTestChain(t, [][]uint64{{}, {}}))
+
+	suggestion, err := n.GetGasPriceSuggestion(5)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), suggestion.NumTransactions)
+	assert.Equal(t, testNetworkMinGasPrice, suggestion.MinimumGasPrice)
+	assert.Equal(t, testNetworkMinGasPrice, suggestion.MedianGasPrice)
+	assert.Equal(t, testNetworkMinGasPrice, suggestion.FastGasPrice)
+	assert.Equal(t, uint64(0), suggestion.PoolNumBytes)
+}
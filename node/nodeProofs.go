@@ -0,0 +1,167 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetProof returns the merkle proof of the given account against the state root hash of the current block
+// or of the block selected through the provided options
+func (n *Node) GetProof(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	accountProof, _, _, err := n.getAccountProof(address, options)
+
+	return accountProof, err
+}
+
+// GetProofForKey returns the merkle proof of the given account against the state root hash of the current block
+// (or of the block selected through the provided options), together with the merkle proof of the key against the
+// account's data trie root hash
+func (n *Node) GetProofForKey(address string, key string, options core.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	accountProof, mainTrie, accountBytes, err := n.getAccountProof(address, options)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountBytes) == 0 {
+		return nil, ErrAccountNotFound
+	}
+
+	account := &state.UserAccountData{}
+	err = n.internalMarshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrie, err := mainTrie.Recreate(account.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieProof, dataTrieLeafValue, err := getProofAndValue(dataTrie, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+	dataTrieValue, err := trimDataTrieValue(dataTrieLeafValue, keyBytes, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof.Key = key
+	accountProof.DataTrieRootHash = hex.EncodeToString(account.RootHash)
+	accountProof.DataTrieProof = encodeProof(dataTrieProof)
+	accountProof.DataTrieLeafValue = hex.EncodeToString(dataTrieLeafValue)
+	accountProof.DataTrieValue = hex.EncodeToString(dataTrieValue)
+
+	return accountProof, nil
+}
+
+// trimDataTrieValue removes the key and the address appended by the trackable data trie to every saved value, the
+// same way the data trie's RetrieveValue does
+func trimDataTrieValue(leafValue []byte, key []byte, address []byte) ([]byte, error) {
+	if len(leafValue) == 0 {
+		return leafValue, nil
+	}
+
+	dataLength := len(leafValue) - len(key) - len(address)
+	if dataLength < 0 {
+		return nil, state.ErrNegativeValue
+	}
+
+	return leafValue[:dataLength], nil
+}
+
+func (n *Node) getAccountProof(address string, options core.AccountQueryOptions) (*apiAddress.AccountProof, data.Trie, []byte, error) {
+	addressBytes, err := n.decodeAddressForProof(address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	rootHash, mainTrie, err := n.getStateTrie(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proof, value, err := getProofAndValue(mainTrie, addressBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	accountProof := &apiAddress.AccountProof{
+		Address:  address,
+		RootHash: hex.EncodeToString(rootHash),
+		Proof:    encodeProof(proof),
+		Value:    hex.EncodeToString(value),
+	}
+
+	return accountProof, mainTrie, value, nil
+}
+
+func (n *Node) decodeAddressForProof(address string) ([]byte, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) || check.IfNil(n.blkc) {
+		return nil, errors.New("initialize AccountsAdapter, PubkeyConverter and Blockchain first")
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address, could not decode from: %w", err)
+	}
+
+	return addressBytes, nil
+}
+
+func (n *Node) getStateTrie(options core.AccountQueryOptions) ([]byte, data.Trie, error) {
+	header, err := n.GetBlockHeaderForAccountQuery(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHash := header.GetRootHash()
+	stateTrie, err := n.accounts.GetTrie(rootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if check.IfNil(stateTrie) {
+		return nil, nil, ErrNilTrie
+	}
+
+	return rootHash, stateTrie, nil
+}
+
+func getProofAndValue(tr data.Trie, key []byte) ([][]byte, []byte, error) {
+	proof, err := tr.GetProof(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := tr.Get(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proof, value, nil
+}
+
+func encodeProof(proof [][]byte) []string {
+	encodedProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encodedNode))
+	}
+
+	return encodedProof
+}
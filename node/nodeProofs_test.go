@@ -0,0 +1,236 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNodeForProofs(accounts state.AccountsAdapter, rootHash []byte) *node.Node {
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: rootHash}
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestNode_GetProofNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	proof, err := n.GetProof(createDummyHexAddress(64), core.AccountQueryOptions{})
+	assert.NotNil(t, err)
+	assert.Nil(t, proof)
+}
+
+func TestNode_GetProofGetTrieFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+
+	proof, err := n.GetProof(createDummyHexAddress(64), core.AccountQueryOptions{})
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, proof)
+}
+
+func TestNode_GetProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	encodedNodes := [][]byte{[]byte("node1"), []byte("node2")}
+	accountBytes := []byte("account")
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(hash []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, hash)
+			return &mock.TrieStub{
+				GetProofCalled: func(_ []byte) ([][]byte, error) {
+					return encodedNodes, nil
+				},
+				GetCalled: func(_ []byte) ([]byte, error) {
+					return accountBytes, nil
+				},
+			}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, rootHash)
+
+	address := createDummyHexAddress(64)
+	proof, err := n.GetProof(address, core.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, address, proof.Address)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString(encodedNodes[0]), hex.EncodeToString(encodedNodes[1])}, proof.Proof)
+	assert.Equal(t, hex.EncodeToString(accountBytes), proof.Value)
+}
+
+func TestNode_GetProofForKeyInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForProofs(&mock.AccountsStub{}, []byte("root hash"))
+
+	proof, err := n.GetProofForKey(createDummyHexAddress(64), "invalid hex key", core.AccountQueryOptions{})
+	assert.NotNil(t, err)
+	assert.Nil(t, proof)
+}
+
+func TestNode_GetProofForKeyMissingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return &mock.TrieStub{}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+
+	proof, err := n.GetProofForKey(createDummyHexAddress(64), "aabb", core.AccountQueryOptions{})
+	assert.Equal(t, node.ErrAccountNotFound, err)
+	assert.Nil(t, proof)
+}
+
+func TestNode_GetProofForKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dataTrieRootHash := []byte("data trie root hash")
+	account := &state.UserAccountData{RootHash: dataTrieRootHash}
+	accountBytes, _ := getMarshalizer().Marshal(account)
+	key := []byte("key")
+	dataTrieValue := []byte("value")
+	hexAddress := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(hexAddress)
+	dataTrieLeafValue := append(append(append([]byte{}, dataTrieValue...), key...), addressBytes...)
+
+	dataTrie := &mock.TrieStub{
+		GetProofCalled: func(k []byte) ([][]byte, error) {
+			assert.Equal(t, key, k)
+			return [][]byte{[]byte("data node")}, nil
+		},
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return dataTrieLeafValue, nil
+		},
+	}
+	mainTrie := &mock.TrieStub{
+		GetProofCalled: func(_ []byte) ([][]byte, error) {
+			return [][]byte{[]byte("main node")}, nil
+		},
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return accountBytes, nil
+		},
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, dataTrieRootHash, root)
+			return dataTrie, nil
+		},
+	}
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return mainTrie, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+
+	proof, err := n.GetProofForKey(hexAddress, hex.EncodeToString(key), core.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(key), proof.Key)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("main node"))}, proof.Proof)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), proof.DataTrieRootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("data node"))}, proof.DataTrieProof)
+	assert.Equal(t, hex.EncodeToString(dataTrieValue), proof.DataTrieValue)
+	assert.Equal(t, hex.EncodeToString(dataTrieLeafValue), proof.DataTrieLeafValue)
+}
+
+func TestNode_GetProofWithBlockNonceShouldUseItsRootHash(t *testing.T) {
+	t.Parallel()
+
+	historicalRootHash := []byte("historical root hash")
+	header := &block.Header{Nonce: 3, RootHash: historicalRootHash}
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(hash []byte) (data.Trie, error) {
+			assert.Equal(t, historicalRootHash, hash)
+			return &mock.TrieStub{}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, testSizeCheckDelta),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithBlockChain(&mock.BlockChainMock{}),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				if unitType == dataRetriever.ShardHdrNonceHashDataUnit {
+					return []byte("header hash"), nil
+				}
+
+				return json.Marshal(header)
+			},
+		}),
+	)
+
+	proof, err := n.GetProof(createDummyHexAddress(64), core.AccountQueryOptions{BlockNonce: 3, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(historicalRootHash), proof.RootHash)
+}
+
+func TestNode_GetBalanceWithPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return nil, errors.New("trie was not found")
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, testSizeCheckDelta),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(_ dataRetriever.UnitType, _ []byte) ([]byte, error) {
+				return json.Marshal(&block.Header{RootHash: []byte("root hash")})
+			},
+		}),
+	)
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), core.AccountQueryOptions{BlockHash: []byte("hash")})
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	assert.Nil(t, balance)
+}
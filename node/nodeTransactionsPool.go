@@ -0,0 +1,120 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// GetTransactionsPool returns the transactions sent from the accounts of the current shard that are waiting in the
+// pool, sorted by sender and nonce
+func (n *Node) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedTxs := make([]*txcache.WrappedTransaction, 0)
+	pool.ForEachTransaction(func(_ []byte, wrappedTx *txcache.WrappedTransaction) {
+		wrappedTxs = append(wrappedTxs, wrappedTx)
+	})
+
+	sort.Slice(wrappedTxs, func(i, j int) bool {
+		senderComparison := bytes.Compare(wrappedTxs[i].Tx.GetSndAddr(), wrappedTxs[j].Tx.GetSndAddr())
+		if senderComparison != 0 {
+			return senderComparison < 0
+		}
+
+		return wrappedTxs[i].Tx.GetNonce() < wrappedTxs[j].Tx.GetNonce()
+	})
+
+	return &transaction.ApiTransactionsPool{
+		NumSenders:   pool.CountSenders(),
+		Transactions: n.preparePoolTransactions(wrappedTxs),
+	}, nil
+}
+
+// GetTransactionsPoolForSender returns the transactions of the given sender waiting in the pool, the queued nonces,
+// the nonce gaps against the known account nonce and the sender's current score
+func (n *Node) GetTransactionsPoolForSender(sender string) (*transaction.ApiPoolSender, error) {
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	senderBytes, err := n.addressPubkeyConverter.Decode(sender)
+	if err != nil {
+		return nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	response := &transaction.ApiPoolSender{
+		Sender:       sender,
+		QueuedNonces: make([]uint64, 0),
+		NonceGaps:    make([]transaction.ApiNonceGap, 0),
+		Transactions: make([]*transaction.ApiPoolTransaction, 0),
+	}
+
+	info, ok := pool.GetSenderPoolInfo(senderBytes)
+	if !ok {
+		return response, nil
+	}
+
+	response.AccountNonce = info.AccountNonce
+	response.AccountNonceKnown = info.AccountNonceKnown
+	response.Score = info.Score
+	response.NumFailedSelections = info.NumFailedSelections
+	response.IsInGracePeriod = info.IsInGracePeriod
+	response.Transactions = n.preparePoolTransactions(info.Transactions)
+	for _, wrappedTx := range info.Transactions {
+		response.QueuedNonces = append(response.QueuedNonces, wrappedTx.Tx.GetNonce())
+	}
+	for _, gap := range info.NonceGaps {
+		response.NonceGaps = append(response.NonceGaps, transaction.ApiNonceGap{From: gap.From, To: gap.To})
+	}
+
+	return response, nil
+}
+
+func (n *Node) getTxPoolInspector() (txPoolInspector, error) {
+	if check.IfNil(n.dataPool) || check.IfNil(n.shardCoordinator) || check.IfNil(n.addressPubkeyConverter) {
+		return nil, errors.New("initialize DataPool, ShardCoordinator and PubkeyConverter first")
+	}
+
+	// all the transactions sent from the current shard are held in a single cache, regardless of their destination
+	cacheID := strconv.Itoa(int(n.shardCoordinator.SelfId()))
+	pool, ok := n.dataPool.Transactions().ShardDataStore(cacheID).(txPoolInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolNotInspectable
+	}
+
+	return pool, nil
+}
+
+func (n *Node) preparePoolTransactions(wrappedTxs []*txcache.WrappedTransaction) []*transaction.ApiPoolTransaction {
+	transactions := make([]*transaction.ApiPoolTransaction, 0, len(wrappedTxs))
+	for _, wrappedTx := range wrappedTxs {
+		tx := wrappedTx.Tx
+		apiTx := &transaction.ApiPoolTransaction{
+			Hash:     hex.EncodeToString(wrappedTx.TxHash),
+			Nonce:    tx.GetNonce(),
+			Sender:   n.addressPubkeyConverter.Encode(tx.GetSndAddr()),
+			Receiver: n.addressPubkeyConverter.Encode(tx.GetRcvAddr()),
+			GasPrice: tx.GetGasPrice(),
+			GasLimit: tx.GetGasLimit(),
+			Data:     tx.GetData(),
+		}
+		if tx.GetValue() != nil {
+			apiTx.Value = tx.GetValue().String()
+		}
+
+		transactions = append(transactions, apiTx)
+	}
+
+	return transactions
+}
@@ -0,0 +1,149 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTxCacheForPoolInspection(t *testing.T) *txcache.TxCache {
+	cache, err := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "0",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1 << 20,
+		CountPerSenderThreshold:    100,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       50000,
+		MinimumGasPrice:      1000000000,
+		GasProcessingDivisor: 100,
+	})
+	require.Nil(t, err)
+
+	return cache
+}
+
+func addTxToCache(cache *txcache.TxCache, hash string, sender []byte, nonce uint64) {
+	cache.AddTx(&txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			Nonce:    nonce,
+			SndAddr:  sender,
+			RcvAddr:  []byte("receiver"),
+			Value:    big.NewInt(10),
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+		},
+		TxHash: []byte(hash),
+		Size:   128,
+	})
+}
+
+func createNodeForPoolInspection(cache storage.Cacher) *node.Node {
+	dataPool := testscommon.NewPoolsHolderStub()
+	dataPool.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return &testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+				if cacheID != "0" {
+					return nil
+				}
+				return cache
+			},
+		}
+	}
+
+	n, _ := node.NewNode(
+		node.WithDataPool(dataPool),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	return n
+}
+
+func TestNode_GetTransactionsPoolNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	pool, err := n.GetTransactionsPool()
+	assert.NotNil(t, err)
+	assert.Nil(t, pool)
+
+	senderPool, err := n.GetTransactionsPoolForSender(createDummyHexAddress(64))
+	assert.NotNil(t, err)
+	assert.Nil(t, senderPool)
+}
+
+func TestNode_GetTransactionsPoolNotInspectableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForPoolInspection(testscommon.NewCacherStub())
+
+	pool, err := n.GetTransactionsPool()
+	assert.Equal(t, node.ErrTransactionsPoolNotInspectable, err)
+	assert.Nil(t, pool)
+}
+
+func TestNode_GetTransactionsPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	alice := []byte("alice-address-padded-to-32-bytes")
+	bob := []byte("bob-address-padded-to-32-bytes!!")
+	cache := createTxCacheForPoolInspection(t)
+	addTxToCache(cache, "bob-1", bob, 1)
+	addTxToCache(cache, "alice-7", alice, 7)
+	addTxToCache(cache, "alice-5", alice, 5)
+	n := createNodeForPoolInspection(cache)
+
+	pool, err := n.GetTransactionsPool()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), pool.NumSenders)
+	require.Len(t, pool.Transactions, 3)
+	assert.Equal(t, hex.EncodeToString([]byte("alice-5")), pool.Transactions[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("alice-7")), pool.Transactions[1].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("bob-1")), pool.Transactions[2].Hash)
+	assert.Equal(t, hex.EncodeToString(alice), pool.Transactions[0].Sender)
+	assert.Equal(t, "10", pool.Transactions[0].Value)
+	assert.Equal(t, uint64(50000), pool.Transactions[0].GasLimit)
+}
+
+func TestNode_GetTransactionsPoolForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	alice := []byte("alice-address-padded-to-32-bytes")
+	cache := createTxCacheForPoolInspection(t)
+	addTxToCache(cache, "alice-7", alice, 7)
+	addTxToCache(cache, "alice-5", alice, 5)
+	cache.NotifyAccountNonce(alice, 3)
+	n := createNodeForPoolInspection(cache)
+
+	senderPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString(alice))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(alice), senderPool.Sender)
+	assert.Equal(t, uint64(3), senderPool.AccountNonce)
+	assert.True(t, senderPool.AccountNonceKnown)
+	assert.Equal(t, []uint64{5, 7}, senderPool.QueuedNonces)
+	assert.Equal(t, []transaction.ApiNonceGap{{From: 3, To: 4}, {From: 6, To: 6}}, senderPool.NonceGaps)
+	require.Len(t, senderPool.Transactions, 2)
+
+	unknownSender := createDummyHexAddress(64)
+	senderPool, err = n.GetTransactionsPoolForSender(unknownSender)
+	require.Nil(t, err)
+	assert.Equal(t, unknownSender, senderPool.Sender)
+	assert.Empty(t, senderPool.QueuedNonces)
+	assert.Empty(t, senderPool.Transactions)
+
+	senderPool, err = n.GetTransactionsPoolForSender("not hex")
+	assert.NotNil(t, err)
+	assert.Nil(t, senderPool)
+}
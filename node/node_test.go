@@ -113,7 +113,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", core.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -126,7 +126,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", core.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -146,7 +146,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), core.AccountQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -175,7 +175,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -190,7 +190,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -213,7 +213,7 @@ func TestGetUsername(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accDB),
 	)
-	username, err := n.GetUsername(createDummyHexAddress(64))
+	username, err := n.GetUsername(createDummyHexAddress(64), core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, string(expectedUsername), username)
 }
@@ -239,7 +239,7 @@ func TestNode_GetESDTBalance(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	value, _, err := n.GetESDTBalance(createDummyHexAddress(64), esdtToken)
+	value, _, err := n.GetESDTBalance(createDummyHexAddress(64), esdtToken, core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), value)
 }
@@ -280,7 +280,7 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	value, err := n.GetAllESDTTokens(createDummyHexAddress(64))
+	value, err := n.GetAllESDTTokens(createDummyHexAddress(64), core.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(value))
 	assert.Equal(t, esdtToken, value[0])
@@ -817,6 +817,106 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSetTransactionGuardian_NilTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterStub{}),
+	)
+
+	txHash, err := n.SetTransactionGuardian(nil, "guardian", "617eff4f")
+	assert.Nil(t, txHash)
+	assert.Equal(t, node.ErrNilTransaction, err)
+}
+
+func TestSetTransactionGuardian_InvalidGuardianSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(
+			&mock.PubkeyConverterStub{
+				DecodeCalled: func(hexAddress string) ([]byte, error) {
+					return []byte(hexAddress), nil
+				},
+			}),
+	)
+
+	txHash, err := n.SetTransactionGuardian(&transaction.Transaction{}, "guardian", "not a hex string")
+	assert.Nil(t, txHash)
+	assert.NotNil(t, err)
+}
+
+func TestSetTransactionGuardian_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHash := []byte("expected hash")
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithHasher(
+			mock.HasherMock{
+				ComputeCalled: func(s string) []byte {
+					return expectedHash
+				},
+			},
+		),
+		node.WithAddressPubkeyConverter(
+			&mock.PubkeyConverterStub{
+				DecodeCalled: func(hexAddress string) ([]byte, error) {
+					return []byte(hexAddress), nil
+				},
+			}),
+	)
+
+	tx := &transaction.Transaction{Nonce: 1}
+	txHash, err := n.SetTransactionGuardian(tx, "guardian", "617eff4f")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHash, txHash)
+	assert.Equal(t, []byte("guardian"), tx.GuardianAddr)
+	assert.Equal(t, []byte{0x61, 0x7e, 0xff, 0x4f}, tx.GuardianSignature)
+}
+
+func TestSetTransactionSignatures_NilTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	txHash, err := n.SetTransactionSignatures(nil, []string{"617eff4f"})
+	assert.Nil(t, txHash)
+	assert.Equal(t, node.ErrNilTransaction, err)
+}
+
+func TestSetTransactionSignatures_InvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	txHash, err := n.SetTransactionSignatures(&transaction.Transaction{}, []string{"617eff4f", "not a hex string"})
+	assert.Nil(t, txHash)
+	assert.NotNil(t, err)
+}
+
+func TestSetTransactionSignatures_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHash := []byte("expected hash")
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithHasher(
+			mock.HasherMock{
+				ComputeCalled: func(s string) []byte {
+					return expectedHash
+				},
+			},
+		),
+	)
+
+	tx := &transaction.Transaction{Nonce: 1}
+	txHash, err := n.SetTransactionSignatures(tx, []string{"617eff4f", "aabb"})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHash, txHash)
+	assert.Equal(t, [][]byte{{0x61, 0x7e, 0xff, 0x4f}, {0xaa, 0xbb}}, tx.Signatures)
+}
+
 func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T) {
 	t.Parallel()
 
@@ -1240,6 +1340,41 @@ func TestNode_ValidatorStatisticsApi(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNode_GetValidatorParticipationInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithValidatorParticipationRecorder(&mock.ValidatorParticipationRecorderStub{}),
+	)
+
+	history, err := n.GetValidatorParticipation("not a hex key")
+	assert.Nil(t, history)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetValidatorParticipationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("bls key")
+	expectedHistory := &state.ValidatorParticipationHistory{
+		Epochs: []*state.ValidatorParticipation{{Epoch: 3, NumSelected: 10, NumProposed: 1}},
+	}
+	n, _ := node.NewNode(
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithValidatorParticipationRecorder(&mock.ValidatorParticipationRecorderStub{
+			GetParticipationHistoryCalled: func(pubKey []byte) (*state.ValidatorParticipationHistory, error) {
+				assert.Equal(t, blsKey, pubKey)
+				return expectedHistory, nil
+			},
+		}),
+	)
+
+	history, err := n.GetValidatorParticipation(hex.EncodeToString(blsKey))
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHistory, history)
+}
+
 func TestNode_StartConsensusGenesisBlockNotInitializedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1574,6 +1709,7 @@ func TestStartConsensus_ShardBootstrapperPubKeyToByteArrayError(t *testing.T) {
 		node.WithInternalMarshalizer(&mock.MarshalizerMock{}, 0),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithIndexer(indexer.NewNilIndexer()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
 	)
 
 	err := n.StartConsensus()
@@ -1658,6 +1794,7 @@ func TestStartConsensus_ShardBootstrapperInvalidConsensusType(t *testing.T) {
 		node.WithBlockTracker(&mock.BlockTrackerStub{}),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithIndexer(indexer.NewNilIndexer()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
 	)
 
 	err := n.StartConsensus()
@@ -1775,6 +1912,7 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithPeerSignatureHandler(&mock.PeerSignatureHandler{}),
 		node.WithIndexer(indexer.NewNilIndexer()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
 	)
 
 	err := n.StartConsensus()
@@ -1790,7 +1928,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -1809,7 +1947,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -1835,7 +1973,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 			}),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -1855,7 +1993,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -1879,7 +2017,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -1906,7 +2044,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), core.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, accnt, recovAccnt)
@@ -743,6 +743,14 @@ func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	}
 }
 
+// WithMultiRelayedTxEnableEpoch sets up multiRelayedTxEnableEpoch for the node
+func WithMultiRelayedTxEnableEpoch(multiRelayedTxEnableEpoch uint32) Option {
+	return func(n *Node) error {
+		n.multiRelayedTxEnableEpoch = multiRelayedTxEnableEpoch
+		return nil
+	}
+}
+
 // WithTxSignHasher sets up a transaction sign hasher for the node
 func WithTxSignHasher(txSignHasher hashing.Hasher) Option {
 	return func(n *Node) error {
@@ -765,6 +773,50 @@ func WithTxVersionChecker(txVersionChecker process.TxVersionCheckerHandler) Opti
 	}
 }
 
+// WithGuardedAccountHandler sets up the guarded accounts handler used when validating the transactions
+func WithGuardedAccountHandler(guardedAccounts process.GuardedAccountHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(guardedAccounts) {
+			return ErrNilGuardedAccountHandler
+		}
+		n.guardedAccounts = guardedAccounts
+		return nil
+	}
+}
+
+// WithMultisigAccountHandler sets up the multisig accounts handler used when validating the transactions
+func WithMultisigAccountHandler(multisigAccounts process.MultisigAccountHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(multisigAccounts) {
+			return ErrNilMultisigAccountHandler
+		}
+		n.multisigAccounts = multisigAccounts
+		return nil
+	}
+}
+
+// WithDoubleSigningDetector sets up the detector of double proposals and double signatures seen by the node
+func WithDoubleSigningDetector(doubleSigningDetector process.DoubleSigningDetector) Option {
+	return func(n *Node) error {
+		if check.IfNil(doubleSigningDetector) {
+			return ErrNilDoubleSigningDetector
+		}
+		n.doubleSigningDetector = doubleSigningDetector
+		return nil
+	}
+}
+
+// WithValidatorParticipationRecorder sets up the recorder of the validators participation history
+func WithValidatorParticipationRecorder(participationRecorder process.ValidatorParticipationRecorder) Option {
+	return func(n *Node) error {
+		if check.IfNil(participationRecorder) {
+			return ErrNilValidatorParticipationRecorder
+		}
+		n.participationRecorder = participationRecorder
+		return nil
+	}
+}
+
 // WithImportMode sets up the flag if the node is running in import mode
 func WithImportMode(importMode bool) Option {
 	return func(n *Node) error {
@@ -772,3 +824,25 @@ func WithImportMode(importMode bool) Option {
 		return nil
 	}
 }
+
+// WithBlockSizeEstimator sets up the block size estimator used when computing the fill of the past blocks
+func WithBlockSizeEstimator(blockSizeEstimator BlockSizeEstimator) Option {
+	return func(n *Node) error {
+		if check.IfNil(blockSizeEstimator) {
+			return ErrNilBlockSizeEstimator
+		}
+		n.blockSizeEstimator = blockSizeEstimator
+		return nil
+	}
+}
+
+// WithRoundTraceRecorder sets up the recorder of the consensus rounds timelines
+func WithRoundTraceRecorder(roundTraceRecorder consensus.RoundTraceRecorder) Option {
+	return func(n *Node) error {
+		if check.IfNil(roundTraceRecorder) {
+			return ErrNilRoundTraceRecorder
+		}
+		n.roundTraceRecorder = roundTraceRecorder
+		return nil
+	}
+}
@@ -1487,3 +1487,123 @@ func TestWithTxVersionChecker_OkTxVersionCheckerShoulWork(t *testing.T) {
 	assert.Equal(t, txVersionChecker, node.txVersionChecker)
 	assert.Nil(t, err)
 }
+
+func TestWithGuardedAccountHandler_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithGuardedAccountHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilGuardedAccountHandler, err)
+}
+
+func TestWithGuardedAccountHandler_OkGuardedAccountHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	guardedAccounts := &mock.GuardedAccountHandlerStub{}
+	opt := WithGuardedAccountHandler(guardedAccounts)
+	err := opt(node)
+
+	assert.Equal(t, guardedAccounts, node.guardedAccounts)
+	assert.Nil(t, err)
+}
+
+func TestWithMultisigAccountHandler_NilMultisigAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithMultisigAccountHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilMultisigAccountHandler, err)
+}
+
+func TestWithMultisigAccountHandler_OkMultisigAccountHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	multisigAccounts := &mock.MultisigAccountHandlerStub{}
+	opt := WithMultisigAccountHandler(multisigAccounts)
+	err := opt(node)
+
+	assert.Equal(t, multisigAccounts, node.multisigAccounts)
+	assert.Nil(t, err)
+}
+
+func TestWithDoubleSigningDetector_NilDoubleSigningDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithDoubleSigningDetector(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilDoubleSigningDetector, err)
+}
+
+func TestWithDoubleSigningDetector_OkDoubleSigningDetectorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	doubleSigningDetector := &mock.DoubleSigningDetectorStub{}
+	opt := WithDoubleSigningDetector(doubleSigningDetector)
+	err := opt(node)
+
+	assert.Equal(t, doubleSigningDetector, node.doubleSigningDetector)
+	assert.Nil(t, err)
+}
+
+func TestWithValidatorParticipationRecorder_NilRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithValidatorParticipationRecorder(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilValidatorParticipationRecorder, err)
+}
+
+func TestWithValidatorParticipationRecorder_OkRecorderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	participationRecorder := &mock.ValidatorParticipationRecorderStub{}
+	opt := WithValidatorParticipationRecorder(participationRecorder)
+	err := opt(node)
+
+	assert.Equal(t, participationRecorder, node.participationRecorder)
+	assert.Nil(t, err)
+}
+
+func TestWithRoundTraceRecorder_NilRoundTraceRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithRoundTraceRecorder(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilRoundTraceRecorder, err)
+}
+
+func TestWithRoundTraceRecorder_OkRoundTraceRecorderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	roundTraceRecorder := &mock.RoundTraceRecorderStub{}
+	opt := WithRoundTraceRecorder(roundTraceRecorder)
+	err := opt(node)
+
+	assert.Equal(t, roundTraceRecorder, node.roundTraceRecorder)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,254 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("node/subscriptions")
+
+const finalHeadersQueueSize = 100
+
+// ArgsBlockEventsNotifier holds the arguments needed to create a block events notifier
+type ArgsBlockEventsNotifier struct {
+	BlockTracker    BlockTracker
+	BlockProvider   BlockProvider
+	TxLogsStorer    storage.Storer
+	Marshalizer     marshal.Marshalizer
+	PubkeyConverter core.PubkeyConverter
+	SelfShardID     uint32
+}
+
+type finalHeader struct {
+	nonce uint64
+	hash  []byte
+}
+
+// blockEventsNotifier listens for the self shard headers that become final, loads the corresponding blocks
+// together with their transactions and log events and forwards them to all the registered subscribers
+type blockEventsNotifier struct {
+	blockProvider   BlockProvider
+	txLogsStorer    storage.Storer
+	marshalizer     marshal.Marshalizer
+	pubkeyConverter core.PubkeyConverter
+	selfShardID     uint32
+
+	finalHeaders      chan *finalHeader
+	lastNotifiedNonce uint64
+	cancelFunc        func()
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[subscription.Subscriber]struct{}
+	isClosed       bool
+}
+
+// NewBlockEventsNotifier creates a new block events notifier and registers it on the block tracker's hooks
+func NewBlockEventsNotifier(args ArgsBlockEventsNotifier) (*blockEventsNotifier, error) {
+	if check.IfNil(args.BlockTracker) {
+		return nil, ErrNilBlockTracker
+	}
+	if check.IfNil(args.BlockProvider) {
+		return nil, ErrNilBlockProvider
+	}
+	if check.IfNil(args.TxLogsStorer) {
+		return nil, ErrNilTxLogsStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	ben := &blockEventsNotifier{
+		blockProvider:   args.BlockProvider,
+		txLogsStorer:    args.TxLogsStorer,
+		marshalizer:     args.Marshalizer,
+		pubkeyConverter: args.PubkeyConverter,
+		selfShardID:     args.SelfShardID,
+		finalHeaders:    make(chan *finalHeader, finalHeadersQueueSize),
+		subscribers:     make(map[subscription.Subscriber]struct{}),
+	}
+
+	args.BlockTracker.RegisterSelfNotarizedHeadersHandler(ben.receivedFinalHeaders)
+	args.BlockTracker.RegisterFinalMetachainHeadersHandler(ben.receivedFinalHeaders)
+
+	var ctx context.Context
+	ctx, ben.cancelFunc = context.WithCancel(context.Background())
+	go ben.processFinalHeaders(ctx)
+
+	return ben, nil
+}
+
+func (ben *blockEventsNotifier) receivedFinalHeaders(_ uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+	if len(headers) != len(headersHashes) || !ben.hasSubscribers() {
+		return
+	}
+
+	for i, header := range headers {
+		if check.IfNil(header) || header.GetShardID() != ben.selfShardID {
+			continue
+		}
+
+		select {
+		case ben.finalHeaders <- &finalHeader{nonce: header.GetNonce(), hash: headersHashes[i]}:
+		default:
+			log.Debug("blockEventsNotifier: final headers queue is full, header dropped",
+				"nonce", header.GetNonce())
+		}
+	}
+}
+
+func (ben *blockEventsNotifier) processFinalHeaders(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("blockEventsNotifier's go routine is stopping...")
+			return
+		case header := <-ben.finalHeaders:
+			ben.processFinalHeader(header)
+		}
+	}
+}
+
+func (ben *blockEventsNotifier) processFinalHeader(header *finalHeader) {
+	if header.nonce <= ben.lastNotifiedNonce || !ben.hasSubscribers() {
+		return
+	}
+
+	block, err := ben.blockProvider.GetBlockByHash(hex.EncodeToString(header.hash), true)
+	if err != nil {
+		log.Debug("blockEventsNotifier: could not load final block",
+			"nonce", header.nonce, "hash", header.hash, "error", err.Error())
+		return
+	}
+
+	blockEvents := &subscription.BlockEvents{
+		Block:  block,
+		Events: ben.getEvents(block),
+	}
+	ben.lastNotifiedNonce = header.nonce
+
+	ben.mutSubscribers.RLock()
+	for subscriber := range ben.subscribers {
+		subscriber.Notify(blockEvents)
+	}
+	ben.mutSubscribers.RUnlock()
+}
+
+func (ben *blockEventsNotifier) getEvents(block *apiBlock.APIBlock) []*subscription.Event {
+	events := make([]*subscription.Event, 0)
+	for _, miniBlock := range block.MiniBlocks {
+		for _, tx := range miniBlock.Transactions {
+			if tx == nil {
+				continue
+			}
+
+			events = append(events, ben.getTxEvents(tx.Hash)...)
+		}
+	}
+
+	return events
+}
+
+func (ben *blockEventsNotifier) getTxEvents(txHash string) []*subscription.Event {
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil
+	}
+
+	logBytes, err := ben.txLogsStorer.Get(txHashBytes)
+	if err != nil {
+		// the transaction did not generate any log
+		return nil
+	}
+
+	txLog := &transaction.Log{}
+	err = ben.marshalizer.Unmarshal(txLog, logBytes)
+	if err != nil {
+		log.Debug("blockEventsNotifier: could not unmarshal transaction log", "txHash", txHash, "error", err.Error())
+		return nil
+	}
+
+	events := make([]*subscription.Event, 0, len(txLog.Events))
+	for _, event := range txLog.Events {
+		if event == nil {
+			continue
+		}
+
+		topics := make([]string, 0, len(event.Topics))
+		for _, topic := range event.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, &subscription.Event{
+			TxHash:     txHash,
+			Address:    ben.pubkeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(event.Data),
+		})
+	}
+
+	return events
+}
+
+func (ben *blockEventsNotifier) hasSubscribers() bool {
+	ben.mutSubscribers.RLock()
+	defer ben.mutSubscribers.RUnlock()
+
+	return len(ben.subscribers) > 0
+}
+
+// Subscribe registers a new subscriber which will receive all the finalized blocks of the self shard
+func (ben *blockEventsNotifier) Subscribe(subscriber subscription.Subscriber) error {
+	if check.IfNil(subscriber) {
+		return ErrNilSubscriber
+	}
+
+	ben.mutSubscribers.Lock()
+	defer ben.mutSubscribers.Unlock()
+
+	if ben.isClosed {
+		return ErrNotifierClosed
+	}
+
+	ben.subscribers[subscriber] = struct{}{}
+
+	return nil
+}
+
+// Unsubscribe removes the provided subscriber
+func (ben *blockEventsNotifier) Unsubscribe(subscriber subscription.Subscriber) {
+	ben.mutSubscribers.Lock()
+	delete(ben.subscribers, subscriber)
+	ben.mutSubscribers.Unlock()
+}
+
+// Close stops the notifier's go routine and removes all the subscribers
+func (ben *blockEventsNotifier) Close() error {
+	ben.mutSubscribers.Lock()
+	ben.isClosed = true
+	ben.subscribers = make(map[subscription.Subscriber]struct{})
+	ben.mutSubscribers.Unlock()
+
+	ben.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ben *blockEventsNotifier) IsInterfaceNil() bool {
+	return ben == nil
+}
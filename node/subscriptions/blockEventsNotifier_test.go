@@ -0,0 +1,303 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type finalHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+
+type subscriberStub struct {
+	mut         sync.Mutex
+	blockEvents []*subscription.BlockEvents
+}
+
+func (ss *subscriberStub) Notify(blockEvents *subscription.BlockEvents) {
+	ss.mut.Lock()
+	ss.blockEvents = append(ss.blockEvents, blockEvents)
+	ss.mut.Unlock()
+}
+
+func (ss *subscriberStub) received() []*subscription.BlockEvents {
+	ss.mut.Lock()
+	defer ss.mut.Unlock()
+
+	return append([]*subscription.BlockEvents(nil), ss.blockEvents...)
+}
+
+func (ss *subscriberStub) IsInterfaceNil() bool {
+	return ss == nil
+}
+
+func createMockArgs() ArgsBlockEventsNotifier {
+	return ArgsBlockEventsNotifier{
+		BlockTracker:    &mock.BlockTrackerStub{},
+		BlockProvider:   &mock.BlockProviderStub{},
+		TxLogsStorer:    mock.NewStorerMock(),
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		PubkeyConverter: mock.NewPubkeyConverterMock(32),
+		SelfShardID:     1,
+	}
+}
+
+func createArgsWithHandlers() (ArgsBlockEventsNotifier, *[]finalHeadersHandler) {
+	handlers := make([]finalHeadersHandler, 0)
+	args := createMockArgs()
+	args.BlockTracker = &mock.BlockTrackerStub{
+		RegisterSelfNotarizedHeadersHandlerCalled: func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			handlers = append(handlers, handler)
+		},
+		RegisterFinalMetachainHeadersHandlerCalled: func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			handlers = append(handlers, handler)
+		},
+	}
+
+	return args, &handlers
+}
+
+func waitForNotifications(subscriber *subscriberStub, numNotifications int) []*subscription.BlockEvents {
+	for i := 0; i < 100; i++ {
+		received := subscriber.received()
+		if len(received) >= numNotifications {
+			return received
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	return subscriber.received()
+}
+
+//------- NewBlockEventsNotifier
+
+func TestNewBlockEventsNotifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BlockTracker = nil
+	ben, err := NewBlockEventsNotifier(args)
+	assert.True(t, check.IfNil(ben))
+	assert.Equal(t, ErrNilBlockTracker, err)
+
+	args = createMockArgs()
+	args.BlockProvider = nil
+	ben, err = NewBlockEventsNotifier(args)
+	assert.True(t, check.IfNil(ben))
+	assert.Equal(t, ErrNilBlockProvider, err)
+
+	args = createMockArgs()
+	args.TxLogsStorer = nil
+	ben, err = NewBlockEventsNotifier(args)
+	assert.True(t, check.IfNil(ben))
+	assert.Equal(t, ErrNilTxLogsStorer, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	ben, err = NewBlockEventsNotifier(args)
+	assert.True(t, check.IfNil(ben))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs()
+	args.PubkeyConverter = nil
+	ben, err = NewBlockEventsNotifier(args)
+	assert.True(t, check.IfNil(ben))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+}
+
+func TestNewBlockEventsNotifier_ShouldRegisterHandlers(t *testing.T) {
+	t.Parallel()
+
+	args, handlers := createArgsWithHandlers()
+	ben, err := NewBlockEventsNotifier(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ben.Close()
+	}()
+
+	assert.False(t, check.IfNil(ben))
+	assert.Equal(t, 2, len(*handlers))
+}
+
+//------- Subscribe
+
+func TestBlockEventsNotifier_SubscribeNilSubscriberShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ben, _ := NewBlockEventsNotifier(createMockArgs())
+	defer func() {
+		_ = ben.Close()
+	}()
+
+	err := ben.Subscribe(nil)
+
+	assert.Equal(t, ErrNilSubscriber, err)
+}
+
+func TestBlockEventsNotifier_SubscribeAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ben, _ := NewBlockEventsNotifier(createMockArgs())
+	_ = ben.Close()
+
+	err := ben.Subscribe(&subscriberStub{})
+
+	assert.Equal(t, ErrNotifierClosed, err)
+	assert.False(t, ben.hasSubscribers())
+}
+
+//------- notifications
+
+func TestBlockEventsNotifier_ShouldNotifySelfShardFinalBlocksWithEvents(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	selfHeaderHash := []byte("self header")
+	args, handlers := createArgsWithHandlers()
+	args.BlockProvider = &mock.BlockProviderStub{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
+			assert.Equal(t, hex.EncodeToString(selfHeaderHash), hash)
+			assert.True(t, withTxs)
+
+			return &apiBlock.APIBlock{
+				Nonce: 5,
+				MiniBlocks: []*apiBlock.APIMiniBlock{
+					{
+						Transactions: []*transaction.ApiTransactionResult{
+							{Hash: hex.EncodeToString(txHash)},
+							{Hash: hex.EncodeToString([]byte("tx without logs"))},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	txLog := &transaction.Log{
+		Events: []*transaction.Event{
+			{
+				Address:    []byte("sc address"),
+				Identifier: []byte("transfer"),
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		},
+	}
+	logBuff, _ := args.Marshalizer.Marshal(txLog)
+	_ = args.TxLogsStorer.Put(txHash, logBuff)
+
+	ben, _ := NewBlockEventsNotifier(args)
+	defer func() {
+		_ = ben.Close()
+	}()
+	subscriber := &subscriberStub{}
+	_ = ben.Subscribe(subscriber)
+
+	(*handlers)[0](1,
+		[]data.HeaderHandler{&block.Header{ShardID: 0, Nonce: 4}, &block.Header{ShardID: 1, Nonce: 5}},
+		[][]byte{[]byte("other shard header"), selfHeaderHash},
+	)
+
+	received := waitForNotifications(subscriber, 1)
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, uint64(5), received[0].Block.Nonce)
+	require.Equal(t, 1, len(received[0].Events))
+	assert.Equal(t, &subscription.Event{
+		TxHash:     hex.EncodeToString(txHash),
+		Address:    args.PubkeyConverter.Encode([]byte("sc address")),
+		Identifier: "transfer",
+		Topics:     []string{hex.EncodeToString([]byte("topic"))},
+		Data:       hex.EncodeToString([]byte("data")),
+	}, received[0].Events[0])
+}
+
+func TestBlockEventsNotifier_ShouldNotNotifySameNonceTwice(t *testing.T) {
+	t.Parallel()
+
+	args, handlers := createArgsWithHandlers()
+	ben, _ := NewBlockEventsNotifier(args)
+	defer func() {
+		_ = ben.Close()
+	}()
+	subscriber := &subscriberStub{}
+	_ = ben.Subscribe(subscriber)
+
+	header := &block.Header{ShardID: 1, Nonce: 7}
+	(*handlers)[0](1, []data.HeaderHandler{header}, [][]byte{[]byte("hash")})
+	(*handlers)[1](1, []data.HeaderHandler{header}, [][]byte{[]byte("hash")})
+	(*handlers)[0](1, []data.HeaderHandler{&block.Header{ShardID: 1, Nonce: 8}}, [][]byte{[]byte("next")})
+
+	received := waitForNotifications(subscriber, 3)
+	assert.Equal(t, 2, len(received))
+}
+
+func TestBlockEventsNotifier_BlockNotFoundShouldRetryOnNextCall(t *testing.T) {
+	t.Parallel()
+
+	mutFail := sync.Mutex{}
+	shouldFail := true
+	args, handlers := createArgsWithHandlers()
+	args.BlockProvider = &mock.BlockProviderStub{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
+			mutFail.Lock()
+			defer mutFail.Unlock()
+
+			if shouldFail {
+				return nil, errors.New("not found")
+			}
+			return &apiBlock.APIBlock{Nonce: 3}, nil
+		},
+	}
+	ben, _ := NewBlockEventsNotifier(args)
+	defer func() {
+		_ = ben.Close()
+	}()
+	subscriber := &subscriberStub{}
+	_ = ben.Subscribe(subscriber)
+
+	header := &block.Header{ShardID: 1, Nonce: 3}
+	(*handlers)[0](1, []data.HeaderHandler{header}, [][]byte{[]byte("hash")})
+	assert.Equal(t, 0, len(waitForNotifications(subscriber, 1)))
+
+	mutFail.Lock()
+	shouldFail = false
+	mutFail.Unlock()
+	(*handlers)[0](1, []data.HeaderHandler{header}, [][]byte{[]byte("hash")})
+
+	assert.Equal(t, 1, len(waitForNotifications(subscriber, 1)))
+}
+
+func TestBlockEventsNotifier_UnsubscribedShouldNotLoadBlocks(t *testing.T) {
+	t.Parallel()
+
+	args, handlers := createArgsWithHandlers()
+	args.BlockProvider = &mock.BlockProviderStub{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
+			assert.Fail(t, "should have not loaded the block")
+			return nil, nil
+		},
+	}
+	ben, _ := NewBlockEventsNotifier(args)
+	defer func() {
+		_ = ben.Close()
+	}()
+	subscriber := &subscriberStub{}
+	_ = ben.Subscribe(subscriber)
+	ben.Unsubscribe(subscriber)
+
+	(*handlers)[0](1, []data.HeaderHandler{&block.Header{ShardID: 1, Nonce: 1}}, [][]byte{[]byte("hash")})
+	time.Sleep(time.Millisecond * 50)
+
+	assert.Equal(t, 0, len(subscriber.received()))
+}
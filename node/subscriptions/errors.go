@@ -0,0 +1,24 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilBlockTracker signals that a nil block tracker has been provided
+var ErrNilBlockTracker = errors.New("nil block tracker")
+
+// ErrNilBlockProvider signals that a nil block provider has been provided
+var ErrNilBlockProvider = errors.New("nil block provider")
+
+// ErrNilTxLogsStorer signals that a nil transaction logs storer has been provided
+var ErrNilTxLogsStorer = errors.New("nil transaction logs storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilSubscriber signals that a nil subscriber has been provided
+var ErrNilSubscriber = errors.New("nil subscriber")
+
+// ErrNotifierClosed signals that the notifier was closed and does not accept new subscribers
+var ErrNotifierClosed = errors.New("block events notifier is closed")
@@ -0,0 +1,19 @@
+package subscriptions
+
+import (
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// BlockTracker defines the block tracker hooks used to get notified about final headers
+type BlockTracker interface {
+	RegisterSelfNotarizedHeadersHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	RegisterFinalMetachainHeadersHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	IsInterfaceNil() bool
+}
+
+// BlockProvider defines the component able to return a block, together with its transactions, by its hash
+type BlockProvider interface {
+	GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock, error)
+	IsInterfaceNil() bool
+}
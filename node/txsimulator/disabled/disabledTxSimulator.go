@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+type txSimulator struct {
+}
+
+// NewDisabledTxSimulator returns a transaction simulator used when the simulation routes are not open, so that the
+// node does not create the simulation virtual machines
+func NewDisabledTxSimulator() *txSimulator {
+	return &txSimulator{}
+}
+
+// ProcessTx returns ErrTransactionSimulationDisabled
+func (ts *txSimulator) ProcessTx(_ *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nil, node.ErrTransactionSimulationDisabled
+}
+
+// ProcessBundle returns ErrTransactionSimulationDisabled
+func (ts *txSimulator) ProcessBundle(
+	_ []*transaction.Transaction,
+	_ map[string]*transaction.AccountOverride,
+) ([]*transaction.SimulationResults, error) {
+	return nil, node.ErrTransactionSimulationDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txSimulator) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxSimulator_ShouldReturnSimulationDisabled(t *testing.T) {
+	t.Parallel()
+
+	ts := NewDisabledTxSimulator()
+	assert.False(t, ts.IsInterfaceNil())
+
+	result, err := ts.ProcessTx(&transaction.Transaction{})
+	assert.Nil(t, result)
+	assert.Equal(t, node.ErrTransactionSimulationDisabled, err)
+
+	results, err := ts.ProcessBundle([]*transaction.Transaction{{}}, nil)
+	assert.Nil(t, results)
+	assert.Equal(t, node.ErrTransactionSimulationDisabled, err)
+}
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
@@ -10,3 +11,13 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// SimulationAccountsHandler defines the accounts adapter used by the simulation processors, able to serve an
+// uncommitted copy of the state and to report the changes made on it
+type SimulationAccountsHandler interface {
+	state.AccountsAdapter
+	SelectRootHash(rootHash []byte) error
+	ResetRootHash()
+	StartTracking()
+	GetStateDiff() ([]*transaction.AccountStateDiff, error)
+}
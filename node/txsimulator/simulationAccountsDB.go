@@ -0,0 +1,284 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+// simulationAccountsDB is the accounts adapter used by the transaction simulation processors. Outside a simulation
+// it works read-only over the node's state. During a simulation (after SelectRootHash is called) it serves a copy of
+// the state recreated from the given root hash, on which the simulated transactions are applied and which is never
+// committed. While tracking is enabled, it records the accounts and the storage keys changed on this copy, together
+// with their previous values, so that the changes made by each transaction can be reported
+type simulationAccountsDB struct {
+	*state.HistoricalAccountsDB
+	pubkeyConverter core.PubkeyConverter
+
+	mutTracking  sync.Mutex
+	isTracking   bool
+	touched      map[string]*touchedAccount
+	touchedOrder []string
+}
+
+type accountSnapshot struct {
+	balance  *big.Int
+	nonce    uint64
+	codeHash []byte
+}
+
+type touchedAccount struct {
+	address       []byte
+	before        *accountSnapshot
+	storageBefore map[string][]byte
+}
+
+// NewSimulationAccountsDB returns a new instance of simulationAccountsDB
+func NewSimulationAccountsDB(
+	accountsDB state.AccountsAdapter,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	pubkeyConverter core.PubkeyConverter,
+) (*simulationAccountsDB, error) {
+	if check.IfNil(accountsDB) {
+		return nil, node.ErrNilAccountsAdapter
+	}
+	if check.IfNil(hasher) {
+		return nil, node.ErrNilHasher
+	}
+	if check.IfNil(marshalizer) {
+		return nil, node.ErrNilMarshalizer
+	}
+	if check.IfNil(pubkeyConverter) {
+		return nil, node.ErrNilPubkeyConverter
+	}
+
+	readOnlyAccounts, err := NewReadOnlyAccountsDB(accountsDB)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalAccounts, err := state.NewHistoricalAccountsDB(readOnlyAccounts, hasher, marshalizer, factory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulationAccountsDB{
+		HistoricalAccountsDB: historicalAccounts,
+		pubkeyConverter:      pubkeyConverter,
+	}, nil
+}
+
+// SaveAccount records the changes made on the account, if tracking is enabled, and saves it in the active state
+func (sadb *simulationAccountsDB) SaveAccount(account state.AccountHandler) error {
+	if !check.IfNil(account) {
+		sadb.recordChange(account.AddressBytes(), account)
+	}
+
+	return sadb.HistoricalAccountsDB.SaveAccount(account)
+}
+
+// RemoveAccount records the removal of the account, if tracking is enabled, and removes it from the active state
+func (sadb *simulationAccountsDB) RemoveAccount(address []byte) error {
+	sadb.recordChange(address, nil)
+
+	return sadb.HistoricalAccountsDB.RemoveAccount(address)
+}
+
+// ResetRootHash makes all the subsequent calls use the read-only node's state and disables the tracking
+func (sadb *simulationAccountsDB) ResetRootHash() {
+	sadb.mutTracking.Lock()
+	sadb.isTracking = false
+	sadb.touched = nil
+	sadb.touchedOrder = nil
+	sadb.mutTracking.Unlock()
+
+	sadb.HistoricalAccountsDB.ResetRootHash()
+}
+
+// StartTracking forgets the previously recorded changes and starts recording the new ones
+func (sadb *simulationAccountsDB) StartTracking() {
+	sadb.mutTracking.Lock()
+	sadb.isTracking = true
+	sadb.touched = make(map[string]*touchedAccount)
+	sadb.touchedOrder = make([]string, 0)
+	sadb.mutTracking.Unlock()
+}
+
+func (sadb *simulationAccountsDB) recordChange(address []byte, account state.AccountHandler) {
+	sadb.mutTracking.Lock()
+	defer sadb.mutTracking.Unlock()
+
+	if !sadb.isTracking {
+		return
+	}
+
+	// the previous values are read from the active state before the account is saved, and only once for each account
+	// and storage key, so that they hold the values from before the tracking started
+	var previous state.UserAccountHandler
+	isPreviousLoaded := false
+	loadPrevious := func() state.UserAccountHandler {
+		if !isPreviousLoaded {
+			previous = sadb.getExistingUserAccount(address)
+			isPreviousLoaded = true
+		}
+		return previous
+	}
+
+	touched, found := sadb.touched[string(address)]
+	if !found {
+		touched = &touchedAccount{
+			address:       address,
+			before:        createAccountSnapshot(loadPrevious()),
+			storageBefore: make(map[string][]byte),
+		}
+		sadb.touched[string(address)] = touched
+		sadb.touchedOrder = append(sadb.touchedOrder, string(address))
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok || check.IfNil(userAccount.DataTrieTracker()) {
+		return
+	}
+
+	for key := range userAccount.DataTrieTracker().DirtyData() {
+		_, alreadyRecorded := touched.storageBefore[key]
+		if alreadyRecorded {
+			continue
+		}
+
+		touched.storageBefore[key] = retrieveStorageValue(loadPrevious(), []byte(key))
+	}
+}
+
+func (sadb *simulationAccountsDB) getExistingUserAccount(address []byte) state.UserAccountHandler {
+	account, err := sadb.HistoricalAccountsDB.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	return userAccount
+}
+
+// GetStateDiff returns the changes recorded since the tracking was started, in the order in which the accounts were
+// first changed. The accounts and the storage keys that ended up having their previous values are not reported
+func (sadb *simulationAccountsDB) GetStateDiff() ([]*transaction.AccountStateDiff, error) {
+	sadb.mutTracking.Lock()
+	defer sadb.mutTracking.Unlock()
+
+	if !sadb.isTracking {
+		return nil, node.ErrStateChangesNotTracked
+	}
+
+	stateDiff := make([]*transaction.AccountStateDiff, 0, len(sadb.touchedOrder))
+	for _, address := range sadb.touchedOrder {
+		touched := sadb.touched[address]
+		current := sadb.getExistingUserAccount(touched.address)
+		after := createAccountSnapshot(current)
+
+		accountDiff := &transaction.AccountStateDiff{
+			Address:        sadb.pubkeyConverter.Encode(touched.address),
+			BalanceBefore:  touched.before.balance.String(),
+			BalanceAfter:   after.balance.String(),
+			NonceBefore:    touched.before.nonce,
+			NonceAfter:     after.nonce,
+			CodeHashBefore: hex.EncodeToString(touched.before.codeHash),
+			CodeHashAfter:  hex.EncodeToString(after.codeHash),
+			Storage:        sadb.computeStorageDiff(touched, current),
+		}
+
+		isUnchanged := len(accountDiff.Storage) == 0 && touched.before.isEqual(after)
+		if isUnchanged {
+			continue
+		}
+
+		stateDiff = append(stateDiff, accountDiff)
+	}
+
+	return stateDiff, nil
+}
+
+func (sadb *simulationAccountsDB) computeStorageDiff(touched *touchedAccount, current state.UserAccountHandler) []*transaction.StorageDiff {
+	keys := make([]string, 0, len(touched.storageBefore))
+	for key := range touched.storageBefore {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	storageDiff := make([]*transaction.StorageDiff, 0)
+	for _, key := range keys {
+		before := touched.storageBefore[key]
+		after := retrieveStorageValue(current, []byte(key))
+		if bytes.Equal(before, after) {
+			continue
+		}
+
+		storageDiff = append(storageDiff, &transaction.StorageDiff{
+			Key:    hex.EncodeToString([]byte(key)),
+			Before: hex.EncodeToString(before),
+			After:  hex.EncodeToString(after),
+		})
+	}
+
+	return storageDiff
+}
+
+func createAccountSnapshot(account state.UserAccountHandler) *accountSnapshot {
+	if check.IfNil(account) {
+		return &accountSnapshot{
+			balance: big.NewInt(0),
+		}
+	}
+
+	balance := big.NewInt(0)
+	if account.GetBalance() != nil {
+		balance.Set(account.GetBalance())
+	}
+
+	return &accountSnapshot{
+		balance:  balance,
+		nonce:    account.GetNonce(),
+		codeHash: account.GetCodeHash(),
+	}
+}
+
+func (snapshot *accountSnapshot) isEqual(other *accountSnapshot) bool {
+	return snapshot.balance.Cmp(other.balance) == 0 &&
+		snapshot.nonce == other.nonce &&
+		bytes.Equal(snapshot.codeHash, other.codeHash)
+}
+
+func retrieveStorageValue(account state.UserAccountHandler, key []byte) []byte {
+	if check.IfNil(account) || check.IfNil(account.DataTrieTracker()) {
+		return nil
+	}
+
+	value, err := account.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		// missing data trie
+		return nil
+	}
+
+	return value
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sadb *simulationAccountsDB) IsInterfaceNil() bool {
+	return sadb == nil
+}
@@ -0,0 +1,213 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testHasher      = sha256.Sha256{}
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+	addressAlice    = []byte("alice...........................")
+	addressBob      = []byte("bob.............................")
+)
+
+// createTestAccountsDB returns a committed state holding alice, having a balance of 1000 and nonce 5,
+// and the root hash of that state
+func createTestAccountsDB(t *testing.T) (state.AccountsAdapter, []byte) {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, testMarshalizer, testHasher, 5)
+	accounts, err := state.NewAccountsDB(tr, testHasher, testMarshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	account, _ := accounts.LoadAccount(addressAlice)
+	alice := account.(state.UserAccountHandler)
+	_ = alice.AddToBalance(big.NewInt(1000))
+	alice.IncreaseNonce(5)
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	require.Nil(t, accounts.SaveAccount(alice))
+
+	rootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	return accounts, rootHash
+}
+
+func createTestSimulationAccountsDB(t *testing.T) (*simulationAccountsDB, state.AccountsAdapter, []byte) {
+	accounts, rootHash := createTestAccountsDB(t)
+	simulationAccounts, err := NewSimulationAccountsDB(accounts, testHasher, testMarshalizer, mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+
+	return simulationAccounts, accounts, rootHash
+}
+
+func loadTestUserAccount(t *testing.T, accounts state.AccountsAdapter, address []byte) state.UserAccountHandler {
+	account, err := accounts.LoadAccount(address)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler)
+}
+
+func TestNewSimulationAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{}
+	pubkeyConverter := mock.NewPubkeyConverterMock(32)
+
+	_, err := NewSimulationAccountsDB(nil, testHasher, testMarshalizer, pubkeyConverter)
+	require.Equal(t, node.ErrNilAccountsAdapter, err)
+
+	_, err = NewSimulationAccountsDB(accounts, nil, testMarshalizer, pubkeyConverter)
+	require.Equal(t, node.ErrNilHasher, err)
+
+	_, err = NewSimulationAccountsDB(accounts, testHasher, nil, pubkeyConverter)
+	require.Equal(t, node.ErrNilMarshalizer, err)
+
+	_, err = NewSimulationAccountsDB(accounts, testHasher, testMarshalizer, nil)
+	require.Equal(t, node.ErrNilPubkeyConverter, err)
+
+	simulationAccounts, err := NewSimulationAccountsDB(accounts, testHasher, testMarshalizer, pubkeyConverter)
+	require.Nil(t, err)
+	require.False(t, simulationAccounts.IsInterfaceNil())
+}
+
+func TestSimulationAccountsDB_ChangesShouldNotReachTheNodeState(t *testing.T) {
+	t.Parallel()
+
+	simulationAccounts, accounts, rootHash := createTestSimulationAccountsDB(t)
+
+	// outside a simulation, the writes are ignored
+	alice := loadTestUserAccount(t, simulationAccounts, addressAlice)
+	_ = alice.AddToBalance(big.NewInt(1))
+	require.Nil(t, simulationAccounts.SaveAccount(alice))
+	require.Equal(t, big.NewInt(1000), loadTestUserAccount(t, simulationAccounts, addressAlice).GetBalance())
+
+	require.Nil(t, simulationAccounts.SelectRootHash(rootHash))
+	alice = loadTestUserAccount(t, simulationAccounts, addressAlice)
+	_ = alice.AddToBalance(big.NewInt(1))
+	require.Nil(t, simulationAccounts.SaveAccount(alice))
+	require.Equal(t, big.NewInt(1001), loadTestUserAccount(t, simulationAccounts, addressAlice).GetBalance())
+
+	simulationAccounts.ResetRootHash()
+	require.Equal(t, big.NewInt(1000), loadTestUserAccount(t, simulationAccounts, addressAlice).GetBalance())
+	require.Equal(t, big.NewInt(1000), loadTestUserAccount(t, accounts, addressAlice).GetBalance())
+
+	currentRootHash, _ := accounts.RootHash()
+	require.Equal(t, rootHash, currentRootHash)
+}
+
+func TestSimulationAccountsDB_GetStateDiffNotTrackingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simulationAccounts, _, rootHash := createTestSimulationAccountsDB(t)
+	require.Nil(t, simulationAccounts.SelectRootHash(rootHash))
+
+	_, err := simulationAccounts.GetStateDiff()
+	require.Equal(t, node.ErrStateChangesNotTracked, err)
+
+	simulationAccounts.StartTracking()
+	simulationAccounts.ResetRootHash()
+
+	_, err = simulationAccounts.GetStateDiff()
+	require.Equal(t, node.ErrStateChangesNotTracked, err)
+}
+
+func TestSimulationAccountsDB_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	simulationAccounts, _, rootHash := createTestSimulationAccountsDB(t)
+	require.Nil(t, simulationAccounts.SelectRootHash(rootHash))
+	simulationAccounts.StartTracking()
+
+	alice := loadTestUserAccount(t, simulationAccounts, addressAlice)
+	_ = alice.SubFromBalance(big.NewInt(100))
+	alice.IncreaseNonce(1)
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new value"))
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("another key"), []byte("another value"))
+	require.Nil(t, simulationAccounts.SaveAccount(alice))
+
+	// saved twice: the initial values should be reported as the previous ones
+	alice = loadTestUserAccount(t, simulationAccounts, addressAlice)
+	_ = alice.SubFromBalance(big.NewInt(50))
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("newest value"))
+	require.Nil(t, simulationAccounts.SaveAccount(alice))
+
+	bob := loadTestUserAccount(t, simulationAccounts, addressBob)
+	_ = bob.AddToBalance(big.NewInt(150))
+	require.Nil(t, simulationAccounts.SaveAccount(bob))
+
+	// saved without changes: should not be reported
+	unchanged := loadTestUserAccount(t, simulationAccounts, []byte("carol..........................."))
+	require.Nil(t, simulationAccounts.SaveAccount(unchanged))
+
+	stateDiff, err := simulationAccounts.GetStateDiff()
+	require.Nil(t, err)
+
+	expectedStateDiff := []*transaction.AccountStateDiff{
+		{
+			Address:       hex.EncodeToString(addressAlice),
+			BalanceBefore: "1000",
+			BalanceAfter:  "850",
+			NonceBefore:   5,
+			NonceAfter:    6,
+			Storage: []*transaction.StorageDiff{
+				{
+					Key:    hex.EncodeToString([]byte("another key")),
+					Before: "",
+					After:  hex.EncodeToString([]byte("another value")),
+				},
+				{
+					Key:    hex.EncodeToString([]byte("key")),
+					Before: hex.EncodeToString([]byte("value")),
+					After:  hex.EncodeToString([]byte("newest value")),
+				},
+			},
+		},
+		{
+			Address:       hex.EncodeToString(addressBob),
+			BalanceBefore: "0",
+			BalanceAfter:  "150",
+			NonceBefore:   0,
+			NonceAfter:    0,
+			Storage:       []*transaction.StorageDiff{},
+		},
+	}
+	require.Equal(t, expectedStateDiff, stateDiff)
+
+	// a new tracking session only reports the changes made since it started
+	simulationAccounts.StartTracking()
+	stateDiff, err = simulationAccounts.GetStateDiff()
+	require.Nil(t, err)
+	require.Empty(t, stateDiff)
+}
+
+func TestSimulationAccountsDB_GetStateDiffRevertedChangesShouldNotBeReported(t *testing.T) {
+	t.Parallel()
+
+	simulationAccounts, _, rootHash := createTestSimulationAccountsDB(t)
+	require.Nil(t, simulationAccounts.SelectRootHash(rootHash))
+	simulationAccounts.StartTracking()
+
+	snapshot := simulationAccounts.JournalLen()
+	alice := loadTestUserAccount(t, simulationAccounts, addressAlice)
+	_ = alice.AddToBalance(big.NewInt(1))
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new value"))
+	require.Nil(t, simulationAccounts.SaveAccount(alice))
+	require.Nil(t, simulationAccounts.RevertToSnapshot(snapshot))
+
+	stateDiff, err := simulationAccounts.GetStateDiff()
+	require.Nil(t, err)
+	require.Empty(t, stateDiff)
+}
@@ -2,13 +2,18 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -21,13 +26,20 @@ type ArgsTxSimulator struct {
 	IntermmediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter     core.PubkeyConverter
 	ShardCoordinator           sharding.Coordinator
+	Accounts                   SimulationAccountsHandler
+	BlockChain                 data.ChainHandler
+	BlockChainHook             process.BlockChainHookHandler
 }
 
 type transactionSimulator struct {
+	mutSimulation          sync.Mutex
 	txProcessor            TransactionProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
+	accounts               SimulationAccountsHandler
+	blockChain             data.ChainHandler
+	blockChainHook         process.BlockChainHookHandler
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -44,17 +56,94 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, node.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.Accounts) {
+		return nil, node.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, node.ErrNilBlockchain
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, node.ErrNilBlockChainHook
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
 		intermProcContainer:    args.IntermmediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
+		accounts:               args.Accounts,
+		blockChain:             args.BlockChain,
+		blockChainHook:         args.BlockChainHook,
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	results, err := ts.ProcessBundle([]*transaction.Transaction{tx}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0], nil
+}
+
+// ProcessBundle will process the transactions, one after the other, on a copy of the state of the current block,
+// after applying the provided state overrides (keyed by the bech32 addresses). Each transaction sees the changes made
+// by the previous ones, while none of them is ever committed. A result, holding the state changes made by the
+// transaction, is returned for each of the provided transactions
+func (ts *transactionSimulator) ProcessBundle(
+	txs []*transaction.Transaction,
+	overrides map[string]*transaction.AccountOverride,
+) ([]*transaction.SimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, node.ErrEmptyTransactionsBundle
+	}
+
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	header := ts.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = ts.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, node.ErrNilBlockHeader
+	}
+
+	err := ts.accounts.SelectRootHash(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+	defer ts.accounts.ResetRootHash()
+
+	ts.blockChainHook.SetCurrentHeader(header)
+
+	err = ts.applyOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*transaction.SimulationResults, 0, len(txs))
+	for _, tx := range txs {
+		ts.accounts.StartTracking()
+
+		result, errProcess := ts.processTx(tx)
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		result.StateDiff, errProcess = ts.accounts.GetStateDiff()
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -131,6 +220,129 @@ func (ts *transactionSimulator) addIntermediateTxsToResult(result *transaction.S
 	return nil
 }
 
+func (ts *transactionSimulator) applyOverrides(overrides map[string]*transaction.AccountOverride) error {
+	for address, override := range overrides {
+		if override == nil {
+			continue
+		}
+
+		err := ts.applyOverride(address, override)
+		if err != nil {
+			return fmt.Errorf("%w for address %s: %s", node.ErrInvalidStateOverride, address, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (ts *transactionSimulator) applyOverride(address string, override *transaction.AccountOverride) error {
+	addressBytes, err := ts.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return err
+	}
+
+	account, err := ts.loadUserAccount(addressBytes)
+	if err != nil {
+		return err
+	}
+
+	if override.Nonce != nil {
+		account, err = recreateAccountWithNonce(account, *override.Nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(override.Balance) > 0 {
+		balance, ok := big.NewInt(0).SetString(override.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return fmt.Errorf("invalid balance %s", override.Balance)
+		}
+
+		err = account.SubFromBalance(account.GetBalance())
+		if err != nil {
+			return err
+		}
+		err = account.AddToBalance(balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(override.Code) > 0 {
+		code, errDecode := hex.DecodeString(override.Code)
+		if errDecode != nil {
+			return fmt.Errorf("invalid code: %s", errDecode.Error())
+		}
+		account.SetCode(code)
+	}
+
+	if len(override.CodeMetadata) > 0 {
+		codeMetadata, errDecode := hex.DecodeString(override.CodeMetadata)
+		if errDecode != nil {
+			return fmt.Errorf("invalid code metadata: %s", errDecode.Error())
+		}
+		account.SetCodeMetadata(codeMetadata)
+	}
+
+	for key, value := range override.Storage {
+		keyBytes, errDecode := hex.DecodeString(key)
+		if errDecode != nil || len(keyBytes) == 0 {
+			return fmt.Errorf("invalid storage key %s", key)
+		}
+		valueBytes, errDecode := hex.DecodeString(value)
+		if errDecode != nil {
+			return fmt.Errorf("invalid storage value for key %s", key)
+		}
+
+		err = account.DataTrieTracker().SaveKeyValue(keyBytes, valueBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ts.accounts.SaveAccount(account)
+}
+
+func (ts *transactionSimulator) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := ts.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+// recreateAccountWithNonce returns a copy of the account having the given nonce, as the nonce of an account can only
+// be increased
+func recreateAccountWithNonce(account state.UserAccountHandler, nonce uint64) (state.UserAccountHandler, error) {
+	recreated, err := state.NewUserAccount(account.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	err = recreated.AddToBalance(account.GetBalance())
+	if err != nil {
+		return nil, err
+	}
+	recreated.AddToDeveloperReward(account.GetDeveloperReward())
+	recreated.SetCode(account.GetCode())
+	recreated.SetCodeHash(account.GetCodeHash())
+	recreated.SetCodeMetadata(account.GetCodeMetadata())
+	recreated.SetRootHash(account.GetRootHash())
+	recreated.SetDataTrie(account.DataTrie())
+	recreated.SetOwnerAddress(account.GetOwnerAddress())
+	recreated.SetUserName(account.GetUserName())
+	recreated.IncreaseNonce(nonce)
+
+	return recreated, nil
+}
+
 func (ts *transactionSimulator) adaptSmartContractResult(scr *smartContractResult.SmartContractResult) *transaction.ApiSmartContractResult {
 	return &transaction.ApiSmartContractResult{
 		Nonce:          scr.Nonce,
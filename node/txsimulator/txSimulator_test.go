@@ -3,6 +3,7 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -10,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
@@ -50,6 +52,33 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: node.ErrNilIntermediateProcessorContainer,
 		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Accounts = nil
+				return args
+			},
+			exError: node.ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilBlockChain",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BlockChain = nil
+				return args
+			},
+			exError: node.ErrNilBlockchain,
+		},
+		{
+			name: "NilBlockChainHook",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BlockChainHook = nil
+				return args
+			},
+			exError: node.ErrNilBlockChainHook,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -132,5 +161,243 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		IntermmediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:     &mock.PubkeyConverterMock{},
 		ShardCoordinator:           mock.NewMultiShardsCoordinatorMock(2),
+		Accounts:                   &mock.SimulationAccountsStub{},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{}
+			},
+		},
+		BlockChainHook: &mock.BlockChainHookHandlerStub{},
+	}
+}
+
+// getTxSimulatorArgsWithState returns the arguments of a simulator working over a real state, in which the
+// transaction processor moves the transactions' value from the sender to the receiver
+func getTxSimulatorArgsWithState(t *testing.T) (ArgsTxSimulator, state.AccountsAdapter) {
+	accounts, rootHash := createTestAccountsDB(t)
+	simulationAccounts, _ := NewSimulationAccountsDB(accounts, testHasher, testMarshalizer, mock.NewPubkeyConverterMock(32))
+
+	args := getTxSimulatorArgs()
+	args.Accounts = simulationAccounts
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+	args.IntermmediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			sender := loadTestUserAccount(t, simulationAccounts, tx.SndAddr)
+			if sender.GetNonce() != tx.Nonce {
+				return vmcommon.UserError, process.ErrHigherNonceInTransaction
+			}
+			err := sender.SubFromBalance(tx.Value)
+			if err != nil {
+				return vmcommon.UserError, err
+			}
+			sender.IncreaseNonce(1)
+			_ = simulationAccounts.SaveAccount(sender)
+
+			receiver := loadTestUserAccount(t, simulationAccounts, tx.RcvAddr)
+			_ = receiver.AddToBalance(tx.Value)
+			_ = simulationAccounts.SaveAccount(receiver)
+
+			return vmcommon.Ok, nil
+		},
+	}
+
+	return args, accounts
+}
+
+func TestTransactionSimulator_ProcessBundleEmptyBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessBundle(nil, nil)
+	require.Nil(t, results)
+	require.Equal(t, node.ErrEmptyTransactionsBundle, err)
+}
+
+func TestTransactionSimulator_ProcessBundleShouldUseTheCurrentHeader(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	selectedRootHash := make([]byte, 0)
+	numResets := 0
+	var hookHeader data.HeaderHandler
+
+	args := getTxSimulatorArgs()
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return header
+		},
+	}
+	args.Accounts = &mock.SimulationAccountsStub{
+		SelectRootHashCalled: func(rootHash []byte) error {
+			selectedRootHash = rootHash
+			return nil
+		},
+		ResetRootHashCalled: func() {
+			numResets++
+		},
+	}
+	args.BlockChainHook = &mock.BlockChainHookHandlerStub{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			hookHeader = hdr
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	_, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.Nil(t, err)
+	require.Equal(t, header.RootHash, selectedRootHash)
+	require.Equal(t, header, hookHeader)
+	require.Equal(t, 1, numResets)
+}
+
+func TestTransactionSimulator_ProcessBundleStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("state not available")
+	args := getTxSimulatorArgs()
+	args.Accounts = &mock.SimulationAccountsStub{
+		SelectRootHashCalled: func(_ []byte) error {
+			return expectedErr
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Fail(t, "should have not been called")
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	_, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.Equal(t, expectedErr, err)
+}
+
+func TestTransactionSimulator_ProcessBundleShouldApplyTheTransactionsInOrder(t *testing.T) {
+	t.Parallel()
+
+	args, accounts := getTxSimulatorArgsWithState(t)
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessBundle([]*transaction.Transaction{
+		{Nonce: 5, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(300)},
+		{Nonce: 6, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(200)},
+		{Nonce: 6, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(100)},
+	}, nil)
+	require.Nil(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, transaction.TxStatusSuccess, results[0].Status)
+	require.Equal(t, []*transaction.AccountStateDiff{
+		{
+			Address:       hex.EncodeToString(addressAlice),
+			BalanceBefore: "1000",
+			BalanceAfter:  "700",
+			NonceBefore:   5,
+			NonceAfter:    6,
+			Storage:       []*transaction.StorageDiff{},
+		},
+		{
+			Address:       hex.EncodeToString(addressBob),
+			BalanceBefore: "0",
+			BalanceAfter:  "300",
+			Storage:       []*transaction.StorageDiff{},
+		},
+	}, results[0].StateDiff)
+
+	require.Equal(t, transaction.TxStatusSuccess, results[1].Status)
+	require.Equal(t, "700", results[1].StateDiff[0].BalanceBefore)
+	require.Equal(t, "500", results[1].StateDiff[0].BalanceAfter)
+	require.Equal(t, "500", results[1].StateDiff[1].BalanceAfter)
+
+	require.Equal(t, transaction.TxStatusFail, results[2].Status)
+	require.Equal(t, process.ErrHigherNonceInTransaction.Error(), results[2].FailReason)
+	require.Empty(t, results[2].StateDiff)
+
+	// nothing should have reached the node's state
+	account, _ := accounts.GetExistingAccount(addressAlice)
+	require.Equal(t, big.NewInt(1000), account.(state.UserAccountHandler).GetBalance())
+	_, err = accounts.GetExistingAccount(addressBob)
+	require.NotNil(t, err)
+}
+
+func TestTransactionSimulator_ProcessBundleShouldApplyTheOverrides(t *testing.T) {
+	t.Parallel()
+
+	args, _ := getTxSimulatorArgsWithState(t)
+	ts, _ := NewTransactionSimulator(args)
+
+	nonce := uint64(2)
+	overrides := map[string]*transaction.AccountOverride{
+		hex.EncodeToString(addressAlice): {
+			Balance: "5000",
+			Nonce:   &nonce,
+			Storage: map[string]string{
+				hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("overridden")),
+			},
+		},
+		hex.EncodeToString(addressBob): {
+			Code:         "aabb",
+			CodeMetadata: "0100",
+		},
+	}
+
+	results, err := ts.ProcessBundle([]*transaction.Transaction{
+		{Nonce: 2, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(3000)},
+	}, overrides)
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results[0].Status)
+
+	aliceDiff := results[0].StateDiff[0]
+	require.Equal(t, "5000", aliceDiff.BalanceBefore)
+	require.Equal(t, "2000", aliceDiff.BalanceAfter)
+	require.Equal(t, uint64(2), aliceDiff.NonceBefore)
+	require.Equal(t, uint64(3), aliceDiff.NonceAfter)
+
+	bobDiff := results[0].StateDiff[1]
+	require.Equal(t, "3000", bobDiff.BalanceAfter)
+	require.Equal(t, hex.EncodeToString(testHasher.Compute(string([]byte{0xaa, 0xbb}))), bobDiff.CodeHashBefore)
+
+	// the overrides are not kept between simulations
+	results, err = ts.ProcessBundle([]*transaction.Transaction{
+		{Nonce: 5, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(0)},
+	}, nil)
+	require.Nil(t, err)
+	require.Equal(t, "1000", results[0].StateDiff[0].BalanceBefore)
+	require.Equal(t, uint64(5), results[0].StateDiff[0].NonceBefore)
+}
+
+func TestTransactionSimulator_ProcessBundleInvalidOverridesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := getTxSimulatorArgsWithState(t)
+	ts, _ := NewTransactionSimulator(args)
+	txs := []*transaction.Transaction{
+		{Nonce: 5, SndAddr: addressAlice, RcvAddr: addressBob, Value: big.NewInt(0)},
+	}
+	alice := hex.EncodeToString(addressAlice)
+
+	invalidOverrides := []map[string]*transaction.AccountOverride{
+		{"not hex": {Balance: "1"}},
+		{alice: {Balance: "-1"}},
+		{alice: {Balance: "1a"}},
+		{alice: {Code: "zz"}},
+		{alice: {CodeMetadata: "zz"}},
+		{alice: {Storage: map[string]string{"": "aa"}}},
+		{alice: {Storage: map[string]string{"aa": "zz"}}},
+	}
+	for _, overrides := range invalidOverrides {
+		results, err := ts.ProcessBundle(txs, overrides)
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, node.ErrInvalidStateOverride))
 	}
 }
@@ -105,6 +105,11 @@ func (w *readOnlyAccountsDB) RecreateAllTries(_ []byte, _ context.Context) (map[
 	return nil, nil
 }
 
+// GetTrie calls the original accounts' function with the same name
+func (w *readOnlyAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return w.originalAccounts.GetTrie(rootHash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (w *readOnlyAccountsDB) IsInterfaceNil() bool {
 	return w == nil
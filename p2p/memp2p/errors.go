@@ -10,3 +10,6 @@ var ErrNotConnectedToNetwork = errors.New("not connected to network")
 
 // ErrReceivingPeerNotConnected signals that the receiving peer of a sending operation is not connected to the network
 var ErrReceivingPeerNotConnected = errors.New("receiving peer not connected to network")
+
+// ErrNilMessageDispatcher signals that a nil message dispatcher has been provided
+var ErrNilMessageDispatcher = errors.New("nil message dispatcher")
@@ -0,0 +1,13 @@
+package memp2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// MessageDispatcher decides if, when and in what order a message sent on the in-memory network reaches its
+// destination. The deliver function processes the message on the receiving messenger synchronously
+type MessageDispatcher interface {
+	Dispatch(message p2p.MessageP2P, to core.PeerID, deliver func())
+	IsInterfaceNil() bool
+}
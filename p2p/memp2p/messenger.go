@@ -302,7 +302,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.sendMessage(messageObject, peer)
 	}
 
 	return nil
@@ -311,33 +311,37 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 func (messenger *Messenger) processFromQueue() {
 	for {
 		messageObject := <-messenger.processQueue
-		if check.IfNil(messageObject) {
-			continue
-		}
+		messenger.processMessage(messageObject)
+	}
+}
 
-		topic := messageObject.Topics()[0]
-		if topic == "" {
-			continue
-		}
+func (messenger *Messenger) processMessage(messageObject p2p.MessageP2P) {
+	if check.IfNil(messageObject) {
+		return
+	}
 
-		messenger.topicsMutex.Lock()
-		_, found := messenger.topics[topic]
-		if !found {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
+	topic := messageObject.Topics()[0]
+	if topic == "" {
+		return
+	}
 
-		// numReceived gets incremented because the message arrived on a registered topic
-		atomic.AddUint64(&messenger.numReceived, 1)
-		validator := messenger.topicValidators[topic]
-		if check.IfNil(validator) {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
+	messenger.topicsMutex.Lock()
+	_, found := messenger.topics[topic]
+	if !found {
 		messenger.topicsMutex.Unlock()
+		return
+	}
 
-		_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
+	// numReceived gets incremented because the message arrived on a registered topic
+	atomic.AddUint64(&messenger.numReceived, 1)
+	validator := messenger.topicValidators[topic]
+	if check.IfNil(validator) {
+		messenger.topicsMutex.Unlock()
+		return
 	}
+	messenger.topicsMutex.Unlock()
+
+	_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
 }
 
 // SendToConnectedPeer sends a message directly to the peer specified by the ID.
@@ -351,7 +355,7 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.sendMessage(messageObject, receivingPeer)
 
 		return nil
 	}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
@@ -184,3 +185,44 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func TestNetwork_SetMessageDispatcherNilDispatcherShouldErr(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	err := network.SetMessageDispatcher(nil)
+	assert.Equal(t, memp2p.ErrNilMessageDispatcher, err)
+}
+
+func TestNetwork_MessagesShouldBeRoutedThroughTheDispatcher(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	peer1, _ := memp2p.NewMessenger(network)
+	peer2, _ := memp2p.NewMessenger(network)
+	_ = peer1.CreateTopic("rocket", false)
+	_ = peer2.CreateTopic("rocket", false)
+
+	delayed := make([]func(), 0)
+	destinations := make([]core.PeerID, 0)
+	err := network.SetMessageDispatcher(&mock.MessageDispatcherStub{
+		DispatchCalled: func(message p2p.MessageP2P, to core.PeerID, deliver func()) {
+			destinations = append(destinations, to)
+			delayed = append(delayed, deliver)
+		},
+	})
+	assert.Nil(t, err)
+
+	_ = peer1.SendToConnectedPeer("rocket", []byte("launch the rocket"), peer2.ID())
+	peer1.Broadcast("rocket", []byte("launch all the rockets"))
+
+	assert.Equal(t, 3, len(destinations))
+	assert.Equal(t, peer2.ID(), destinations[0])
+	assert.Equal(t, uint64(0), peer1.NumMessagesReceived())
+	assert.Equal(t, uint64(0), peer2.NumMessagesReceived())
+
+	for _, deliver := range delayed {
+		deliver()
+	}
+
+	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
+	assert.Equal(t, uint64(2), peer2.NumMessagesReceived())
+}
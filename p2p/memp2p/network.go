@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
 // Network provides in-memory connectivity for the Messenger
@@ -12,8 +14,9 @@ import (
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
 type Network struct {
-	mutex sync.RWMutex
-	peers map[core.PeerID]*Messenger
+	mutex      sync.RWMutex
+	peers      map[core.PeerID]*Messenger
+	dispatcher MessageDispatcher
 }
 
 // NewNetwork constructs a new Network instance with an empty
@@ -129,3 +132,32 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// SetMessageDispatcher sets the dispatcher that will handle all the messages sent on this network. By default, the
+// messages are queued directly on the receiving peers
+func (network *Network) SetMessageDispatcher(dispatcher MessageDispatcher) error {
+	if check.IfNil(dispatcher) {
+		return ErrNilMessageDispatcher
+	}
+
+	network.mutex.Lock()
+	network.dispatcher = dispatcher
+	network.mutex.Unlock()
+
+	return nil
+}
+
+func (network *Network) sendMessage(message p2p.MessageP2P, peer *Messenger) {
+	network.mutex.RLock()
+	dispatcher := network.dispatcher
+	network.mutex.RUnlock()
+
+	if check.IfNil(dispatcher) {
+		peer.receiveMessage(message)
+		return
+	}
+
+	dispatcher.Dispatch(message, peer.ID(), func() {
+		peer.processMessage(message)
+	})
+}
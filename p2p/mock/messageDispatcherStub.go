@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// MessageDispatcherStub -
+type MessageDispatcherStub struct {
+	DispatchCalled func(message p2p.MessageP2P, to core.PeerID, deliver func())
+}
+
+// Dispatch -
+func (mds *MessageDispatcherStub) Dispatch(message p2p.MessageP2P, to core.PeerID, deliver func()) {
+	if mds.DispatchCalled != nil {
+		mds.DispatchCalled(message, to, deliver)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mds *MessageDispatcherStub) IsInterfaceNil() bool {
+	return mds == nil
+}
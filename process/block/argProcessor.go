@@ -56,13 +56,15 @@ type ArgShardProcessor struct {
 // new instances of meta processor
 type ArgMetaProcessor struct {
 	ArgBaseProcessor
-	PendingMiniBlocksHandler     process.PendingMiniBlocksHandler
-	SCToProtocol                 process.SmartContractToProtocolHandler
-	EpochStartDataCreator        process.EpochStartDataCreator
-	EpochEconomics               process.EndOfEpochEconomics
-	EpochRewardsCreator          process.RewardsCreator
-	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
-	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
-	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
-	RewardsV2EnableEpoch         uint32
+	PendingMiniBlocksHandler       process.PendingMiniBlocksHandler
+	SCToProtocol                   process.SmartContractToProtocolHandler
+	EpochStartDataCreator          process.EpochStartDataCreator
+	EpochEconomics                 process.EndOfEpochEconomics
+	EpochRewardsCreator            process.RewardsCreator
+	EpochValidatorInfoCreator      process.EpochStartValidatorInfoCreator
+	EpochSystemSCProcessor         process.EpochStartSystemSCProcessor
+	ValidatorStatisticsProcessor   process.ValidatorStatisticsProcessor
+	ValidatorParticipationRecorder process.ValidatorParticipationRecorder
+	GovernanceConfigChanges        process.GovernanceConfigChangesHandler
+	RewardsV2EnableEpoch           uint32
 }
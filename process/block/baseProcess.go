@@ -1234,8 +1234,18 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
+	// the transactions and the rewards are only needed by the per-address transactions index
+	var transactionsFromPool map[string]data.TransactionHandler
+	if bp.historyRepo.IsAddressTransactionsIndexEnabled() {
+		transactionsFromPool = make(map[string]data.TransactionHandler)
+		for _, blockType := range []block.Type{block.TxBlock, block.RewardsBlock} {
+			for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+				transactionsFromPool[hash] = tx
+			}
+		}
+	}
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, transactionsFromPool)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
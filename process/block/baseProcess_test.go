@@ -1205,3 +1205,40 @@ func TestAddHeaderIntoTrackerPool_ShouldWork(t *testing.T) {
 	sp.AddHeaderIntoTrackerPool(nonce, shardID)
 	assert.True(t, wasCalled)
 }
+
+func TestRecordBlockInHistory_ShouldCopyTransactionsOnlyIfAddressIndexEnabled(t *testing.T) {
+	t.Parallel()
+
+	requestedBlockTypes := make(map[block.Type]int)
+	var recordedTxs map[string]data.TransactionHandler
+	isAddressIndexEnabled := false
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			requestedBlockTypes[blockType]++
+			return map[string]data.TransactionHandler{"tx": &transaction.Transaction{}}
+		},
+	}
+	arguments.HistoryRepository = &testscommon.HistoryRepositoryStub{
+		IsAddressTransactionsIndexEnabledCalled: func() bool {
+			return isAddressIndexEnabled
+		},
+		RecordBlockCalled: func(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, txs map[string]data.TransactionHandler) error {
+			recordedTxs = txs
+			return nil
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.RecordBlockInHistory([]byte("hash"), &block.Header{}, &block.Body{})
+	assert.Nil(t, recordedTxs)
+	assert.Equal(t, 0, requestedBlockTypes[block.TxBlock])
+	assert.Equal(t, 0, requestedBlockTypes[block.RewardsBlock])
+
+	isAddressIndexEnabled = true
+	sp.RecordBlockInHistory([]byte("hash"), &block.Header{}, &block.Body{})
+	assert.Equal(t, 1, len(recordedTxs))
+	assert.Equal(t, 1, requestedBlockTypes[block.TxBlock])
+	assert.Equal(t, 1, requestedBlockTypes[block.RewardsBlock])
+}
@@ -343,6 +343,14 @@ func (mp *metaProcessor) CreateBlockBody(metaBlock *block.MetaBlock, haveTime fu
 	return mp.createBlockBody(metaBlock, haveTime)
 }
 
+func (mp *metaProcessor) CreateEpochStartBody(metaBlock *block.MetaBlock) (data.BodyHandler, error) {
+	return mp.createEpochStartBody(metaBlock)
+}
+
+func (mp *metaProcessor) VerifyGovernanceConfigChanges(header *block.MetaBlock) error {
+	return mp.verifyGovernanceConfigChanges(header)
+}
+
 func (sp *shardProcessor) CreateBlockBody(shardHdr *block.Header, haveTime func() bool) (data.BodyHandler, error) {
 	return sp.createBlockBody(shardHdr, haveTime)
 }
@@ -369,3 +377,7 @@ func (mp *metaProcessor) RequestShardHeadersIfNeeded(hdrsAddedForShard map[uint3
 func (bp *baseProcessor) AddHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	bp.addHeaderIntoTrackerPool(nonce, shardID)
 }
+
+func (bp *baseProcessor) RecordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	bp.recordBlockInHistory(blockHeaderHash, blockHeader, blockBody)
+}
@@ -35,6 +35,8 @@ type metaProcessor struct {
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	participationRecorder        process.ValidatorParticipationRecorder
+	governanceConfigChanges      process.GovernanceConfigChangesHandler
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -78,6 +80,12 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.ValidatorParticipationRecorder) {
+		return nil, process.ErrNilValidatorParticipationRecorder
+	}
+	if check.IfNil(arguments.GovernanceConfigChanges) {
+		return nil, process.ErrNilGovernanceConfigChangesHandler
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -120,6 +128,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		epochEconomics:               arguments.EpochEconomics,
 		epochRewardsCreator:          arguments.EpochRewardsCreator,
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
+		participationRecorder:        arguments.ValidatorParticipationRecorder,
+		governanceConfigChanges:      arguments.GovernanceConfigChanges,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
 		rewardsV2EnableEpoch:         arguments.RewardsV2EnableEpoch,
@@ -415,6 +425,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.verifyGovernanceConfigChanges(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifyFees(header)
 	if err != nil {
 		return err
@@ -435,6 +450,25 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 	return nil
 }
 
+// verifyGovernanceConfigChanges checks that the epoch start metablock holds the gas schedule and economics changes
+// applied by the governance proposals executed in this node
+func (mp *metaProcessor) verifyGovernanceConfigChanges(header *block.MetaBlock) error {
+	appliedConfigChanges := mp.governanceConfigChanges.AppliedConfigChanges()
+	receivedConfigChanges := header.EpochStart.GovernanceConfigChanges
+	if len(appliedConfigChanges) != len(receivedConfigChanges) {
+		return fmt.Errorf("%w, applied %d changes, received %d changes",
+			process.ErrGovernanceConfigChangesMismatch, len(appliedConfigChanges), len(receivedConfigChanges))
+	}
+
+	for i := range appliedConfigChanges {
+		if !appliedConfigChanges[i].Equal(receivedConfigChanges[i]) {
+			return fmt.Errorf("%w at index %d", process.ErrGovernanceConfigChangesMismatch, i)
+		}
+	}
+
+	return nil
+}
+
 // SetNumProcessedObj will set the num of processed headers
 func (mp *metaProcessor) SetNumProcessedObj(numObj uint64) {
 	mp.headersCounter.shardMBHeadersTotalProcessed = numObj
@@ -842,6 +876,7 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 	}
 
 	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(mp.epochRewardsCreator.GetProtocolSustainabilityRewards())
+	metaBlock.EpochStart.GovernanceConfigChanges = mp.governanceConfigChanges.AppliedConfigChanges()
 
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(rewardMiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
@@ -1140,6 +1175,11 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	errNotCritical := mp.participationRecorder.Commit()
+	if errNotCritical != nil {
+		log.Debug("participationRecorder.Commit", "error", errNotCritical.Error())
+	}
+
 	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
 
 	err = mp.saveLastNotarizedHeader(header)
@@ -81,14 +81,16 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
-		SCToProtocol:                 &mock.SCToProtocolStub{},
-		PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
-		EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
-		EpochEconomics:               &mock.EpochEconomicsStub{},
-		EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
-		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
-		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
-		EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+		SCToProtocol:                   &mock.SCToProtocolStub{},
+		PendingMiniBlocksHandler:       &mock.PendingMiniBlocksHandlerStub{},
+		EpochStartDataCreator:          &mock.EpochStartDataCreatorStub{},
+		EpochEconomics:                 &mock.EpochEconomicsStub{},
+		EpochRewardsCreator:            &mock.EpochRewardsCreatorStub{},
+		EpochValidatorInfoCreator:      &mock.EpochValidatorInfoCreatorStub{},
+		ValidatorStatisticsProcessor:   &mock.ValidatorStatisticsProcessorStub{},
+		EpochSystemSCProcessor:         &mock.EpochStartSystemSCStub{},
+		ValidatorParticipationRecorder: &mock.ValidatorParticipationRecorderStub{},
+		GovernanceConfigChanges:        &mock.GovernanceConfigChangesHandlerStub{},
 	}
 	return arguments
 }
@@ -328,6 +330,28 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilValidatorParticipationRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.ValidatorParticipationRecorder = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilValidatorParticipationRecorder, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilGovernanceConfigChangesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.GovernanceConfigChanges = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilGovernanceConfigChangesHandler, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -786,6 +810,13 @@ func TestMetaProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 		return &block.Header{}, []byte("hash"), nil
 	}
 	arguments.BlockTracker = blockTrackerMock
+	participationCommitCalled := false
+	arguments.ValidatorParticipationRecorder = &mock.ValidatorParticipationRecorderStub{
+		CommitCalled: func() error {
+			participationCommitCalled = true
+			return nil
+		},
+	}
 	mp, _ := blproc.NewMetaProcessor(arguments)
 
 	mdp.HeadersCalled = func() dataRetriever.HeadersPool {
@@ -811,6 +842,7 @@ func TestMetaProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	err := mp.CommitBlock(hdr, body)
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
+	assert.True(t, participationCommitCalled)
 	//this should sleep as there is an async call to display current header and block in CommitBlock
 	time.Sleep(time.Second)
 }
@@ -886,6 +918,64 @@ func TestMetaProcessor_ApplyBodyToHeaderShouldSetEpochStart(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func createGovernanceConfigChangesForTest() []block.GovernanceConfigChange {
+	return []block.GovernanceConfigChange{
+		{
+			ActionType:      []byte("changeGasSchedule"),
+			ActionArguments: [][]byte{[]byte("BuiltInCost"), []byte("ESDTTransfer"), []byte("100")},
+		},
+		{
+			ActionType:      []byte("changeEconomics"),
+			ActionArguments: [][]byte{[]byte("MinGasPrice"), []byte("1500")},
+		},
+	}
+}
+
+func TestMetaProcessor_CreateEpochStartBodyShouldSaveTheGovernanceConfigChanges(t *testing.T) {
+	t.Parallel()
+
+	configChanges := createGovernanceConfigChangesForTest()
+	arguments := createMockMetaArguments()
+	arguments.GovernanceConfigChanges = &mock.GovernanceConfigChangesHandlerStub{
+		AppliedConfigChangesCalled: func() []block.GovernanceConfigChange {
+			return configChanges
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	metaBlk := &block.MetaBlock{Epoch: 3}
+	metaBlk.EpochStart.Economics.RewardsForProtocolSustainability = big.NewInt(0)
+	_, err := mp.CreateEpochStartBody(metaBlk)
+	assert.Nil(t, err)
+	assert.Equal(t, configChanges, metaBlk.EpochStart.GovernanceConfigChanges)
+}
+
+func TestMetaProcessor_VerifyGovernanceConfigChanges(t *testing.T) {
+	t.Parallel()
+
+	configChanges := createGovernanceConfigChangesForTest()
+	arguments := createMockMetaArguments()
+	arguments.GovernanceConfigChanges = &mock.GovernanceConfigChangesHandlerStub{
+		AppliedConfigChangesCalled: func() []block.GovernanceConfigChange {
+			return configChanges
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	metaBlk := &block.MetaBlock{Epoch: 3}
+	err := mp.VerifyGovernanceConfigChanges(metaBlk)
+	assert.True(t, errors.Is(err, process.ErrGovernanceConfigChangesMismatch))
+
+	metaBlk.EpochStart.GovernanceConfigChanges = createGovernanceConfigChangesForTest()
+	metaBlk.EpochStart.GovernanceConfigChanges[1].ActionArguments[1] = []byte("1000")
+	err = mp.VerifyGovernanceConfigChanges(metaBlk)
+	assert.True(t, errors.Is(err, process.ErrGovernanceConfigChangesMismatch))
+
+	metaBlk.EpochStart.GovernanceConfigChanges = createGovernanceConfigChangesForTest()
+	err = mp.VerifyGovernanceConfigChanges(metaBlk)
+	assert.Nil(t, err)
+}
+
 func TestMetaProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +167,17 @@ func (bsc *blockSizeComputation) isMaxBlockSizeWithoutThrottleReached(totalMiniB
 	return miniblocksSize+txsSize > bsc.maxSize
 }
 
+// EstimateBlockBodySize returns the estimated size in bytes of a block body containing the provided number of
+// miniblocks and transactions hashes
+func (bsc *blockSizeComputation) EstimateBlockBodySize(numMiniBlocks int, numTxs int) uint32 {
+	return bsc.miniblockSize*uint32(numMiniBlocks) + bsc.txSize*uint32(numTxs)
+}
+
+// MaxBlockSize returns the maximum allowed not throttled block size
+func (bsc *blockSizeComputation) MaxBlockSize() uint32 {
+	return bsc.maxSize
+}
+
 // MaxTransactionsInOneMiniblock returns the maximum transactions in a single miniblock
 func (bsc *blockSizeComputation) MaxTransactionsInOneMiniblock() int {
 	return int((bsc.maxSize - bsc.miniblockSize) / bsc.txSize)
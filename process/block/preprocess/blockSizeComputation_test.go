@@ -190,3 +190,13 @@ func TestBlockSizeComputation_MaxTransactionsInOneMiniblock(t *testing.T) {
 
 	assert.Equal(t, 27756, maxTxs)
 }
+
+func TestBlockSizeComputation_EstimateBlockBodySize(t *testing.T) {
+	t.Parallel()
+
+	bsc, _ := preprocess.NewBlockSizeComputation(&mock.ProtobufMarshalizerMock{}, &mock.BlockSizeThrottlerStub{}, maxSizeInBytes)
+
+	assert.Equal(t, uint32(0), bsc.EstimateBlockBodySize(0, 0))
+	assert.Equal(t, 2*bsc.MiniblockSize()+10*bsc.TxSize(), bsc.EstimateBlockBodySize(2, 10))
+	assert.Equal(t, maxSizeInBytes, bsc.MaxBlockSize())
+}
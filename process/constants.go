@@ -30,6 +30,8 @@ const (
 	BuiltInFunctionCall
 	// RelayedTx defines ID of a transaction of type relayed
 	RelayedTx
+	// MultiRelayedTx defines ID of a transaction of type relayed, carrying multiple user transactions
+	MultiRelayedTx
 	// RewardTx defines ID of a reward transaction
 	RewardTx
 	// InvalidTransaction defines unknown transaction type
@@ -2,8 +2,10 @@ package coordinator
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -15,18 +17,22 @@ import (
 var _ process.TxTypeHandler = (*txTypeHandler)(nil)
 
 type txTypeHandler struct {
-	pubkeyConv       core.PubkeyConverter
-	shardCoordinator sharding.Coordinator
-	builtInFuncNames map[string]struct{}
-	argumentParser   process.CallArgumentsParser
+	pubkeyConv                core.PubkeyConverter
+	shardCoordinator          sharding.Coordinator
+	builtInFunctions          process.BuiltInFunctionContainer
+	argumentParser            process.CallArgumentsParser
+	multiRelayedTxEnableEpoch uint32
+	flagMultiRelayedTx        atomic.Flag
 }
 
 // ArgNewTxTypeHandler defines the arguments needed to create a new tx type handler
 type ArgNewTxTypeHandler struct {
-	PubkeyConverter  core.PubkeyConverter
-	ShardCoordinator sharding.Coordinator
-	BuiltInFuncNames map[string]struct{}
-	ArgumentParser   process.CallArgumentsParser
+	PubkeyConverter           core.PubkeyConverter
+	ShardCoordinator          sharding.Coordinator
+	BuiltInFunctions          process.BuiltInFunctionContainer
+	ArgumentParser            process.CallArgumentsParser
+	EpochNotifier             process.EpochNotifier
+	MultiRelayedTxEnableEpoch uint32
 }
 
 // NewTxTypeHandler creates a transaction type handler
@@ -42,16 +48,21 @@ func NewTxTypeHandler(
 	if check.IfNil(args.ArgumentParser) {
 		return nil, process.ErrNilArgumentParser
 	}
-	if args.BuiltInFuncNames == nil {
+	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	tc := &txTypeHandler{
-		pubkeyConv:       args.PubkeyConverter,
-		shardCoordinator: args.ShardCoordinator,
-		argumentParser:   args.ArgumentParser,
-		builtInFuncNames: args.BuiltInFuncNames,
+		pubkeyConv:                args.PubkeyConverter,
+		shardCoordinator:          args.ShardCoordinator,
+		argumentParser:            args.ArgumentParser,
+		builtInFunctions:          args.BuiltInFunctions,
+		multiRelayedTxEnableEpoch: args.MultiRelayedTxEnableEpoch,
 	}
+	args.EpochNotifier.RegisterNotifyHandler(tc)
 
 	return tc, nil
 }
@@ -97,6 +108,10 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pr
 		return process.RelayedTx, process.RelayedTx
 	}
 
+	if tth.isMultiRelayedTransaction(funcName) {
+		return process.MultiRelayedTx, process.MultiRelayedTx
+	}
+
 	isDestInSelfShard := tth.isDestAddressInSelfShard(tx.GetRcvAddr())
 	if isDestInSelfShard && core.IsSmartContractAddress(tx.GetRcvAddr()) {
 		return process.SCInvoking, process.SCInvoking
@@ -122,14 +137,23 @@ func (tth *txTypeHandler) isSCCallAfterBuiltIn(function string, args [][]byte, t
 	if !core.IsSmartContractAddress(tx.GetRcvAddr()) {
 		return false
 	}
-	if len(args) <= 2 {
-		return false
-	}
-	if function != core.BuiltInFunctionESDTTransfer {
+
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		return len(args) > 2
+	case core.BuiltInFunctionESDTNFTTransfer:
+		// on the destination shard the arguments are: token, nonce, quantity, the token data and the SC call
+		return len(args) > 4
+	case core.BuiltInFunctionMultiESDTTransfer:
+		// the arguments are: the number of tokens, the token/value pairs and the SC call
+		if len(args) == 0 {
+			return false
+		}
+		numOfTransfers := big.NewInt(0).SetBytes(args[0]).Uint64()
+		return numOfTransfers < uint64(len(args)) && uint64(len(args)) > 1+2*numOfTransfers
+	default:
 		return false
 	}
-
-	return true
 }
 
 func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]byte) {
@@ -145,19 +169,31 @@ func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]b
 	return function, args
 }
 
+// isBuiltInFunctionCall returns true only for the built-in functions active in the current epoch, so that a
+// transaction calling a not yet activated built-in function keeps being processed as before the activation
 func (tth *txTypeHandler) isBuiltInFunctionCall(functionName string) bool {
-	if len(tth.builtInFuncNames) == 0 {
+	if len(functionName) == 0 {
 		return false
 	}
 
-	_, ok := tth.builtInFuncNames[functionName]
-	return ok
+	function, err := tth.builtInFunctions.Get(functionName)
+	if err != nil {
+		return false
+	}
+
+	return function.IsActive()
 }
 
 func (tth *txTypeHandler) isRelayedTransaction(functionName string) bool {
 	return functionName == core.RelayedTransaction
 }
 
+// isMultiRelayedTransaction returns true only after the multi relayed transactions activation, so that such a
+// transaction keeps being processed as before the activation
+func (tth *txTypeHandler) isMultiRelayedTransaction(functionName string) bool {
+	return functionName == core.MultiRelayedTransaction && tth.flagMultiRelayedTx.IsSet()
+}
+
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	isEmptyAddress := bytes.Equal(tx.GetRcvAddr(), make([]byte, tth.pubkeyConv.Len()))
 	return isEmptyAddress
@@ -182,6 +218,12 @@ func (tth *txTypeHandler) checkTxValidity(tx data.TransactionHandler) error {
 	return nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (tth *txTypeHandler) EpochConfirmed(epoch uint32) {
+	tth.flagMultiRelayedTx.Toggle(epoch >= tth.multiRelayedTxEnableEpoch)
+	log.Debug("txTypeHandler: multi relayed transactions", "enabled", tth.flagMultiRelayedTx.IsSet())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tth *txTypeHandler) IsInterfaceNil() bool {
 	return tth == nil
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,8 +19,9 @@ func createMockArguments() ArgNewTxTypeHandler {
 	return ArgNewTxTypeHandler{
 		PubkeyConverter:  createMockPubkeyConverter(),
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 }
 
@@ -64,13 +66,24 @@ func TestNewTxTypeHandler_NilBuiltInFuncs(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArguments()
-	arg.BuiltInFuncNames = nil
+	arg.BuiltInFunctions = nil
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.Nil(t, tth)
 	assert.Equal(t, process.ErrNilBuiltInFunction, err)
 }
 
+func TestNewTxTypeHandler_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.EpochNotifier = nil
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.Nil(t, tth)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
 func TestNewTxTypeHandler_ValsOk(t *testing.T) {
 	t.Parallel()
 
@@ -243,7 +256,7 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 		},
 	}
 	builtIn := "builtIn"
-	arg.BuiltInFuncNames[builtIn] = struct{}{}
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{})
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.NotNil(t, tth)
@@ -254,6 +267,38 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeNotActiveBuiltInFunc(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte("builtIn")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	builtIn := "builtIn"
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{
+		IsActiveCalled: func() bool {
+			return false
+		},
+	})
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txTypeIn)
+	assert.Equal(t, process.MoveBalance, txTypeCross)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeRelayedFunc(t *testing.T) {
 	t.Parallel()
 
@@ -280,6 +325,64 @@ func TestTxTypeHandler_ComputeTransactionTypeRelayedFunc(t *testing.T) {
 	assert.Equal(t, process.RelayedTx, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeMultiRelayedFunc(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.MultiRelayedTransaction)
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MultiRelayedTx, txTypeIn)
+	assert.Equal(t, process.MultiRelayedTx, txTypeCross)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeMultiRelayedFuncBeforeEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.MultiRelayedTransaction)
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	arg.MultiRelayedTxEnableEpoch = 1
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txTypeIn)
+	assert.Equal(t, process.MoveBalance, txTypeCross)
+
+	tth.EpochConfirmed(1)
+	txTypeIn, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MultiRelayedTx, txTypeIn)
+	assert.Equal(t, process.MultiRelayedTx, txTypeCross)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeForSCRCallBack(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -19,6 +20,7 @@ type txValidator struct {
 	shardCoordinator     sharding.Coordinator
 	whiteListHandler     process.WhiteListHandler
 	pubkeyConverter      core.PubkeyConverter
+	guardedAccounts      process.GuardedAccountHandler
 	maxNonceDeltaAllowed int
 }
 
@@ -28,6 +30,7 @@ func NewTxValidator(
 	shardCoordinator sharding.Coordinator,
 	whiteListHandler process.WhiteListHandler,
 	pubkeyConverter core.PubkeyConverter,
+	guardedAccounts process.GuardedAccountHandler,
 	maxNonceDeltaAllowed int,
 ) (*txValidator, error) {
 	if check.IfNil(accounts) {
@@ -42,6 +45,9 @@ func NewTxValidator(
 	if check.IfNil(pubkeyConverter) {
 		return nil, fmt.Errorf("%w in NewTxValidator", process.ErrNilPubkeyConverter)
 	}
+	if check.IfNil(guardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
 
 	return &txValidator{
 		accounts:             accounts,
@@ -49,6 +55,7 @@ func NewTxValidator(
 		whiteListHandler:     whiteListHandler,
 		maxNonceDeltaAllowed: maxNonceDeltaAllowed,
 		pubkeyConverter:      pubkeyConverter,
+		guardedAccounts:      guardedAccounts,
 	}, nil
 }
 
@@ -113,6 +120,28 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
+	return txv.checkGuardedAccount(interceptedTx, account)
+}
+
+// checkGuardedAccount rejects the transactions sent from a guarded account which are not co-signed by its guardian
+func (txv *txValidator) checkGuardedAccount(interceptedTx process.TxValidatorHandler, account state.UserAccountHandler) error {
+	txHandler, ok := interceptedTx.(processor.InterceptedTransactionHandler)
+	if !ok {
+		return nil
+	}
+	tx, ok := txHandler.Transaction().(*transaction.Transaction)
+	if !ok {
+		return nil
+	}
+
+	err := txv.guardedAccounts.CheckGuardedTransaction(account, tx)
+	if err != nil {
+		return fmt.Errorf("%w, for address: %s",
+			err,
+			txv.pubkeyConverter.Encode(account.AddressBytes()),
+		)
+	}
+
 	return nil
 }
 
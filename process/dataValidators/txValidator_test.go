@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -74,6 +76,7 @@ func TestNewTxValidator_NilAccountsShouldErr(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -91,6 +94,7 @@ func TestNewTxValidator_NilShardCoordinatorShouldErr(t *testing.T) {
 		nil,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -109,6 +113,7 @@ func TestTxValidator_NewValidatorNilWhiteListHandlerShouldErr(t *testing.T) {
 		shardCoordinator,
 		nil,
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -127,6 +132,7 @@ func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		nil,
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -134,6 +140,25 @@ func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, process.ErrNilPubkeyConverter))
 }
 
+func TestNewTxValidator_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := getAccAdapter(0, big.NewInt(0))
+	maxNonceDeltaAllowed := 100
+	shardCoordinator := createMockCoordinator("_", 0)
+	txValidator, err := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&mock.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		nil,
+		maxNonceDeltaAllowed,
+	)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
 func TestNewTxValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -145,6 +170,7 @@ func TestNewTxValidator_ShouldWork(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -167,6 +193,7 @@ func TestTxValidator_CheckTxValidityTxCrossShardShouldWork(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -192,6 +219,7 @@ func TestTxValidator_CheckTxValidityAccountNonceIsGreaterThanTxNonceShouldReturn
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -218,6 +246,7 @@ func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -246,6 +275,7 @@ func TestTxValidator_CheckTxValidityAccountBalanceIsLessThanTxTotalValueShouldRe
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 	assert.Nil(t, err)
@@ -273,6 +303,7 @@ func TestTxValidator_CheckTxValidityAccountNotExitsShouldReturnFalse(t *testing.
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -302,6 +333,7 @@ func TestTxValidator_CheckTxValidityAccountNotExitsButWhiteListedShouldReturnTru
 			},
 		},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -336,6 +368,7 @@ func TestTxValidator_CheckTxValidityWrongAccountTypeShouldReturnFalse(t *testing
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -360,6 +393,7 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		maxNonceDeltaAllowed,
 	)
 
@@ -371,6 +405,53 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityNotGuardedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := getAccAdapter(0, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	addressMock := []byte("address")
+	tx := &transaction.Transaction{Nonce: 1, SndAddr: addressMock}
+	txValidator, _ := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&mock.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{
+			CheckGuardedTransactionCalled: func(account state.UserAccountHandler, checkedTx *transaction.Transaction) error {
+				assert.Equal(t, addressMock, account.AddressBytes())
+				assert.True(t, tx == checkedTx)
+				return process.ErrTransactionNotGuarded
+			},
+		},
+		100,
+	)
+
+	interceptedTx := &mock.InterceptedTxHandlerStub{
+		SenderShardIdCalled: func() uint32 {
+			return 0
+		},
+		ReceiverShardIdCalled: func() uint32 {
+			return 0
+		},
+		NonceCalled: func() uint64 {
+			return tx.Nonce
+		},
+		SenderAddressCalled: func() []byte {
+			return addressMock
+		},
+		FeeCalled: func() *big.Int {
+			return big.NewInt(0)
+		},
+		TransactionCalled: func() data.TransactionHandler {
+			return tx
+		},
+	}
+
+	result := txValidator.CheckTxValidity(interceptedTx)
+	assert.True(t, errors.Is(result, process.ErrTransactionNotGuarded))
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
@@ -383,6 +464,7 @@ func TestTxValidator_IsInterfaceNil(t *testing.T) {
 		shardCoordinator,
 		&mock.WhiteListHandlerStub{},
 		mock.NewPubkeyConverterMock(32),
+		&mock.GuardedAccountHandlerStub{},
 		100,
 	)
 	_ = txValidator
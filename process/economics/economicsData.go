@@ -1,6 +1,7 @@
 package economics
 
 import (
+	"fmt"
 	"math/big"
 	"strconv"
 	"sync"
@@ -26,12 +27,12 @@ type economicsData struct {
 	leaderPercentage                 float64
 	protocolSustainabilityPercentage float64
 	protocolSustainabilityAddress    string
-	maxGasLimitPerBlock              uint64
-	maxGasLimitPerMetaBlock          uint64
-	gasPerDataByte                   uint64
-	minGasPrice                      uint64
+	maxGasLimitPerBlock              atomic.Uint64
+	maxGasLimitPerMetaBlock          atomic.Uint64
+	gasPerDataByte                   atomic.Uint64
+	minGasPrice                      atomic.Uint64
 	gasPriceModifier                 float64
-	minGasLimit                      uint64
+	minGasLimit                      atomic.Uint64
 	developerPercentage              float64
 	genesisTotalSupply               *big.Int
 	minInflation                     float64
@@ -65,7 +66,7 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		return nil, err
 	}
 
-	if convertedData.maxGasLimitPerBlock < convertedData.minGasLimit {
+	if convertedData.maxGasLimitPerBlock.Get() < convertedData.minGasLimit.Get() {
 		return nil, process.ErrInvalidMaxGasLimitPerBlock
 	}
 	if check.IfNil(args.EpochNotifier) {
@@ -81,11 +82,6 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		leaderPercentage:                 args.Economics.RewardsSettings.LeaderPercentage,
 		protocolSustainabilityPercentage: args.Economics.RewardsSettings.ProtocolSustainabilityPercentage,
 		protocolSustainabilityAddress:    args.Economics.RewardsSettings.ProtocolSustainabilityAddress,
-		maxGasLimitPerBlock:              convertedData.maxGasLimitPerBlock,
-		maxGasLimitPerMetaBlock:          convertedData.maxGasLimitPerMetaBlock,
-		minGasPrice:                      convertedData.minGasPrice,
-		minGasLimit:                      convertedData.minGasLimit,
-		gasPerDataByte:                   convertedData.gasPerDataByte,
 		developerPercentage:              args.Economics.RewardsSettings.DeveloperPercentage,
 		minInflation:                     args.Economics.GlobalSettings.MinimumInflation,
 		genesisTotalSupply:               convertedData.genesisTotalSupply,
@@ -96,6 +92,12 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		topUpFactor:                      args.Economics.RewardsSettings.TopUpFactor,
 	}
 
+	ed.maxGasLimitPerBlock.Set(convertedData.maxGasLimitPerBlock.Get())
+	ed.maxGasLimitPerMetaBlock.Set(convertedData.maxGasLimitPerMetaBlock.Get())
+	ed.minGasPrice.Set(convertedData.minGasPrice.Get())
+	ed.minGasLimit.Set(convertedData.minGasLimit.Get())
+	ed.gasPerDataByte.Set(convertedData.gasPerDataByte.Get())
+
 	ed.yearSettings = make(map[uint32]*config.YearSetting)
 	for _, yearSetting := range args.Economics.GlobalSettings.YearSettings {
 		ed.yearSettings[yearSetting.Year] = &config.YearSetting{
@@ -143,14 +145,16 @@ func convertValues(economics *config.EconomicsConfig) (*economicsData, error) {
 		return nil, process.ErrInvalidGenesisTotalSupply
 	}
 
-	return &economicsData{
-		minGasPrice:             minGasPrice,
-		minGasLimit:             minGasLimit,
-		maxGasLimitPerBlock:     maxGasLimitPerBlock,
-		maxGasLimitPerMetaBlock: maxGasLimitPerMetaBlock,
-		gasPerDataByte:          gasPerDataByte,
-		genesisTotalSupply:      genesisTotalSupply,
-	}, nil
+	convertedData := &economicsData{
+		genesisTotalSupply: genesisTotalSupply,
+	}
+	convertedData.minGasPrice.Set(minGasPrice)
+	convertedData.minGasLimit.Set(minGasLimit)
+	convertedData.maxGasLimitPerBlock.Set(maxGasLimitPerBlock)
+	convertedData.maxGasLimitPerMetaBlock.Set(maxGasLimitPerMetaBlock)
+	convertedData.gasPerDataByte.Set(gasPerDataByte)
+
+	return convertedData, nil
 }
 
 func checkValues(economics *config.EconomicsConfig) error {
@@ -218,14 +222,14 @@ func (ed *economicsData) GenesisTotalSupply() *big.Int {
 
 // MinGasPrice will return min gas price
 func (ed *economicsData) MinGasPrice() uint64 {
-	return ed.minGasPrice
+	return ed.minGasPrice.Get()
 }
 
 // MinGasPriceForProcessing returns the minimum allowed gas price for processing
 func (ed *economicsData) MinGasPriceForProcessing() uint64 {
 	priceModifier := ed.GasPriceModifier()
 
-	return uint64(float64(ed.minGasPrice) * priceModifier)
+	return uint64(float64(ed.minGasPrice.Get()) * priceModifier)
 }
 
 // GasPriceModifier will return the gas price modifier
@@ -238,12 +242,12 @@ func (ed *economicsData) GasPriceModifier() float64 {
 
 // MinGasLimit will return min gas limit
 func (ed *economicsData) MinGasLimit() uint64 {
-	return ed.minGasLimit
+	return ed.minGasLimit.Get()
 }
 
 // GasPerDataByte will return the gas required for a economicsData byte
 func (ed *economicsData) GasPerDataByte() uint64 {
-	return ed.gasPerDataByte
+	return ed.gasPerDataByte.Get()
 }
 
 // ComputeMoveBalanceFee computes the provided transaction's fee
@@ -319,7 +323,7 @@ func (ed *economicsData) SplitTxGasInCategories(tx process.TransactionWithFeeHan
 
 // CheckValidityTxValues checks if the provided transaction is economically correct
 func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
-	if ed.minGasPrice > tx.GetGasPrice() {
+	if ed.minGasPrice.Get() > tx.GetGasPrice() {
 		return process.ErrInsufficientGasPriceInTx
 	}
 
@@ -330,7 +334,7 @@ func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 		}
 	}
 
-	if tx.GetGasLimit() >= ed.maxGasLimitPerBlock {
+	if tx.GetGasLimit() >= ed.maxGasLimitPerBlock.Get() {
 		return process.ErrMoreGasThanGasLimitPerBlock
 	}
 
@@ -349,9 +353,9 @@ func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 // MaxGasLimitPerBlock will return maximum gas limit allowed per block
 func (ed *economicsData) MaxGasLimitPerBlock(shardID uint32) uint64 {
 	if shardID == core.MetachainShardId {
-		return ed.maxGasLimitPerMetaBlock
+		return ed.maxGasLimitPerMetaBlock.Get()
 	}
-	return ed.maxGasLimitPerBlock
+	return ed.maxGasLimitPerBlock.Get()
 }
 
 // DeveloperPercentage will return the developer percentage value
@@ -381,10 +385,10 @@ func (ed *economicsData) RewardsTopUpFactor() float64 {
 
 // ComputeGasLimit returns the gas limit need by the provided transaction in order to be executed
 func (ed *economicsData) ComputeGasLimit(tx process.TransactionWithFeeHandler) uint64 {
-	gasLimit := ed.minGasLimit
+	gasLimit := ed.minGasLimit.Get()
 
 	dataLen := uint64(len(tx.GetData()))
-	gasLimit += dataLen * ed.gasPerDataByte
+	gasLimit += dataLen * ed.gasPerDataByte.Get()
 
 	return gasLimit
 }
@@ -424,6 +428,38 @@ func (ed *economicsData) ComputeTxFeeBasedOnGasUsed(tx process.TransactionWithFe
 	return txFee
 }
 
+// SetFeeParameter changes one of the fee parameters. It is called when a governance proposal changing the economics
+// is executed
+func (ed *economicsData) SetFeeParameter(parameter string, value uint64) error {
+	switch parameter {
+	case "MaxGasLimitPerBlock":
+		if value < ed.minGasLimit.Get() {
+			return process.ErrInvalidMaxGasLimitPerBlock
+		}
+		ed.maxGasLimitPerBlock.Set(value)
+	case "MaxGasLimitPerMetaBlock":
+		if value < ed.minGasLimit.Get() {
+			return process.ErrInvalidMaxGasLimitPerBlock
+		}
+		ed.maxGasLimitPerMetaBlock.Set(value)
+	case "GasPerDataByte":
+		ed.gasPerDataByte.Set(value)
+	case "MinGasPrice":
+		ed.minGasPrice.Set(value)
+	case "MinGasLimit":
+		if value > ed.maxGasLimitPerBlock.Get() || value > ed.maxGasLimitPerMetaBlock.Get() {
+			return process.ErrInvalidMinimumGasLimitForTx
+		}
+		ed.minGasLimit.Set(value)
+	default:
+		return fmt.Errorf("%w %s", process.ErrUnknownEconomicsParameter, parameter)
+	}
+
+	log.Debug("economics: fee parameter changed", "parameter", parameter, "value", value)
+
+	return nil
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (ed *economicsData) EpochConfirmed(epoch uint32) {
 	ed.flagPenalizedTooMuchGas.Toggle(epoch >= ed.penalizedTooMuchGasEnableEpoch)
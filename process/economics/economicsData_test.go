@@ -1,6 +1,7 @@
 package economics_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -632,3 +633,31 @@ func TestEconomicsData_ComputeGasUsedAndFeeBasedOnRefundValueCheck(t *testing.T)
 	require.Equal(t, expectedGasUsed, gasUsed)
 	require.Equal(t, expectedFee, fee)
 }
+
+func TestEconomicsData_SetFeeParameter(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createArgsForEconomicsDataRealFees())
+
+	err := economicsData.SetFeeParameter("LeaderPercentage", 1)
+	assert.True(t, errors.Is(err, process.ErrUnknownEconomicsParameter))
+
+	err = economicsData.SetFeeParameter("MaxGasLimitPerBlock", 10)
+	assert.Equal(t, process.ErrInvalidMaxGasLimitPerBlock, err)
+	assert.Equal(t, uint64(1500000000), economicsData.MaxGasLimitPerBlock(0))
+
+	err = economicsData.SetFeeParameter("MinGasLimit", 2000000000)
+	assert.Equal(t, process.ErrInvalidMinimumGasLimitForTx, err)
+	assert.Equal(t, uint64(50000), economicsData.MinGasLimit())
+
+	assert.Nil(t, economicsData.SetFeeParameter("MaxGasLimitPerBlock", 2000000000))
+	assert.Equal(t, uint64(2000000000), economicsData.MaxGasLimitPerBlock(0))
+	assert.Nil(t, economicsData.SetFeeParameter("MaxGasLimitPerMetaBlock", 20000000000))
+	assert.Equal(t, uint64(20000000000), economicsData.MaxGasLimitPerBlock(core.MetachainShardId))
+	assert.Nil(t, economicsData.SetFeeParameter("MinGasLimit", 60000))
+	assert.Equal(t, uint64(60000), economicsData.MinGasLimit())
+	assert.Nil(t, economicsData.SetFeeParameter("GasPerDataByte", 2000))
+	assert.Equal(t, uint64(2000), economicsData.GasPerDataByte())
+	assert.Nil(t, economicsData.SetFeeParameter("MinGasPrice", 2000000000))
+	assert.Equal(t, uint64(2000000000), economicsData.MinGasPrice())
+}
@@ -16,33 +16,33 @@ func NewTestEconomicsData(internalData *economicsData) *TestEconomicsData {
 
 // SetMaxGasLimitPerBlock sets the maximum gas limit allowed per one block
 func (ted *TestEconomicsData) SetMaxGasLimitPerBlock(maxGasLimitPerBlock uint64) {
-	ted.maxGasLimitPerBlock = maxGasLimitPerBlock
-	ted.maxGasLimitPerMetaBlock = maxGasLimitPerBlock
+	ted.maxGasLimitPerBlock.Set(maxGasLimitPerBlock)
+	ted.maxGasLimitPerMetaBlock.Set(maxGasLimitPerBlock)
 }
 
 // SetMinGasPrice sets the minimum gas price for a transaction to be accepted
 func (ted *TestEconomicsData) SetMinGasPrice(minGasPrice uint64) {
-	ted.minGasPrice = minGasPrice
+	ted.minGasPrice.Set(minGasPrice)
 }
 
 // SetMinGasLimit sets the minimum gas limit for a transaction to be accepted
 func (ted *TestEconomicsData) SetMinGasLimit(minGasLimit uint64) {
-	ted.minGasLimit = minGasLimit
+	ted.minGasLimit.Set(minGasLimit)
 }
 
 // GetMinGasLimit returns the minimum gas limit for a transaction to be accepted
 func (ted *TestEconomicsData) GetMinGasLimit() uint64 {
-	return ted.minGasLimit
+	return ted.minGasLimit.Get()
 }
 
 // GetMinGasPrice returns the current min gas price
 func (ted *TestEconomicsData) GetMinGasPrice() uint64 {
-	return ted.minGasPrice
+	return ted.minGasPrice.Get()
 }
 
 // SetGasPerDataByte sets gas per data byte for a transaction to be accepted
 func (ted *TestEconomicsData) SetGasPerDataByte(gasPerDataByte uint64) {
-	ted.gasPerDataByte = gasPerDataByte
+	ted.gasPerDataByte.Set(gasPerDataByte)
 }
 
 // SetTotalSupply sets the total supply when booting the network
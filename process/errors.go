@@ -887,6 +887,9 @@ var ErrOnlySystemAccountAccepted = errors.New("only system account is accepted")
 // ErrNilPauseHandler signals that nil pause handler has been provided
 var ErrNilPauseHandler = errors.New("nil pause handler")
 
+// ErrNilRolesHandler signals that nil roles handler has been provided
+var ErrNilRolesHandler = errors.New("nil roles handler")
+
 // ErrESDTTokenIsPaused signals that esdt token is paused
 var ErrESDTTokenIsPaused = errors.New("esdt token is paused")
 
@@ -934,3 +937,150 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
+
+// ErrNilHistoricalAccountsHandler signals that a nil historical accounts handler was provided
+var ErrNilHistoricalAccountsHandler = errors.New("nil historical accounts handler")
+
+// ErrActionNotAllowed signals that action is not allowed
+var ErrActionNotAllowed = errors.New("action is not allowed")
+
+// ErrNewNFTDataOnSenderAddress signals that a new non fungible token was about to be created on the sender address
+var ErrNewNFTDataOnSenderAddress = errors.New("new NFT data on sender")
+
+// ErrNFTTokenDoesNotExist signals that the non fungible token does not exist
+var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+
+// ErrNFTDoesNotHaveMetadata signals that the non fungible token does not have metadata
+var ErrNFTDoesNotHaveMetadata = errors.New("NFT does not have metadata")
+
+// ErrInvalidNFTQuantity signals that an invalid non fungible token quantity was provided
+var ErrInvalidNFTQuantity = errors.New("invalid NFT quantity")
+
+// ErrInvalidESDTTokenType signals that an invalid ESDT token type was provided or found
+var ErrInvalidESDTTokenType = errors.New("invalid ESDT token type")
+
+// ErrNilTokenTypeHandler signals that a nil token type handler has been provided
+var ErrNilTokenTypeHandler = errors.New("nil token type handler")
+
+// ErrInvalidRoyalties signals that the provided royalties are invalid
+var ErrInvalidRoyalties = errors.New("invalid royalties")
+
+// ErrInvalidRcvAddr signals that an invalid receiver address was provided
+var ErrInvalidRcvAddr = errors.New("invalid receiver address")
+
+// ErrNilGuardedAccountHandler signals that a nil guarded account handler was provided
+var ErrNilGuardedAccountHandler = errors.New("nil guarded account handler")
+
+// ErrGuardedAccountsNotEnabled signals that the guarded accounts feature is not enabled yet
+var ErrGuardedAccountsNotEnabled = errors.New("guarded accounts are not enabled")
+
+// ErrAccountHasNoActiveGuardian signals that the account has no active guardian
+var ErrAccountHasNoActiveGuardian = errors.New("account has no active guardian")
+
+// ErrCannotSetOwnAddressAsGuardian signals that an account tried to set its own address as guardian
+var ErrCannotSetOwnAddressAsGuardian = errors.New("cannot set own address as guardian")
+
+// ErrInvalidGuardianAddress signals that an invalid guardian address was provided
+var ErrInvalidGuardianAddress = errors.New("invalid guardian address")
+
+// ErrNoGuardianToRemove signals that the account has neither an active nor a pending guardian
+var ErrNoGuardianToRemove = errors.New("no guardian to remove")
+
+// ErrTransactionNotGuarded signals that a transaction sent from a guarded account is not co-signed by its guardian
+var ErrTransactionNotGuarded = errors.New("transaction from a guarded account is not guarded")
+
+// ErrGuardedTransactionNotExpected signals that a guarded transaction was sent from an account without an active guardian
+var ErrGuardedTransactionNotExpected = errors.New("guarded transaction not expected")
+
+// ErrGuardianMismatch signals that the guardian of a transaction is not the active guardian of the sender account
+var ErrGuardianMismatch = errors.New("guardian mismatch")
+
+// ErrMissingGuardianData signals that a guarded transaction does not hold the guardian address or signature
+var ErrMissingGuardianData = errors.New("missing guardian address or signature")
+
+// ErrGuardianDataOnUnguardedTransaction signals that a transaction holds guardian data without having the guarded option set
+var ErrGuardianDataOnUnguardedTransaction = errors.New("guardian data on a transaction without the guarded option")
+
+// ErrNilMultisigAccountHandler signals that a nil multisig account handler was provided
+var ErrNilMultisigAccountHandler = errors.New("nil multisig account handler")
+
+// ErrMultisigAccountsNotEnabled signals that the multisig accounts feature is not enabled yet
+var ErrMultisigAccountsNotEnabled = errors.New("multisig accounts are not enabled")
+
+// ErrAccountIsNotMultisig signals that the account is not a multisig account
+var ErrAccountIsNotMultisig = errors.New("account is not multisig")
+
+// ErrInvalidMultisigThreshold signals that the number of signatures needed by a multisig account is invalid
+var ErrInvalidMultisigThreshold = errors.New("invalid multisig threshold")
+
+// ErrInvalidNumberOfMultisigSigners signals that an invalid number of signers was provided for a multisig account
+var ErrInvalidNumberOfMultisigSigners = errors.New("invalid number of multisig signers")
+
+// ErrDuplicatedMultisigSigner signals that a signer was provided more than once for a multisig account
+var ErrDuplicatedMultisigSigner = errors.New("duplicated multisig signer")
+
+// ErrMultisigSignaturesRequired signals that a transaction sent from a multisig account is signed by a single signer
+var ErrMultisigSignaturesRequired = errors.New("transaction from a multisig account requires multiple signatures")
+
+// ErrSignatureAndMultisigSignatures signals that a transaction holds both the sender signature and multisig signatures
+var ErrSignatureAndMultisigSignatures = errors.New("transaction holds both the sender signature and multisig signatures")
+
+// ErrTooManyMultisigSignatures signals that a transaction holds more signatures than the signers of the multisig account
+var ErrTooManyMultisigSignatures = errors.New("too many multisig signatures")
+
+// ErrInvalidMultisigSignature signals that a multisig signature does not belong to any of the remaining signers
+var ErrInvalidMultisigSignature = errors.New("invalid multisig signature")
+
+// ErrNotEnoughMultisigSignatures signals that a transaction holds fewer signatures than the multisig threshold
+var ErrNotEnoughMultisigSignatures = errors.New("not enough multisig signatures")
+
+// ErrMultiRelayedTxDisabled signals that the relayed transactions carrying multiple user transactions are disabled
+var ErrMultiRelayedTxDisabled = errors.New("multi relayed tx is disabled")
+
+// ErrInvalidNumberOfInnerTransactions signals that a multi relayed tx carries an invalid number of user transactions
+var ErrInvalidNumberOfInnerTransactions = errors.New("invalid number of inner transactions in multi relayed tx")
+
+// ErrMultiRelayedTxValueNotZero signals that a multi relayed tx has a non zero value
+var ErrMultiRelayedTxValueNotZero = errors.New("multi relayed tx value should be zero")
+
+// ErrInnerTxSenderNotInReceiverShard signals that the sender of an inner transaction is not in the same shard as
+// the receiver of the multi relayed tx
+var ErrInnerTxSenderNotInReceiverShard = errors.New("inner tx sender is not in the receiver shard of the multi relayed tx")
+
+// ErrNilMessageSignVerifier signals that a nil message signature verifier has been provided
+var ErrNilMessageSignVerifier = errors.New("nil message signature verifier")
+
+// ErrNilDoubleSigningProofVerifier signals that a nil double signing proof verifier has been provided
+var ErrNilDoubleSigningProofVerifier = errors.New("nil double signing proof verifier")
+
+// ErrNilDoubleSigningDetector signals that a nil double signing detector has been provided
+var ErrNilDoubleSigningDetector = errors.New("nil double signing detector")
+
+// ErrInvalidDoubleSigningProof signals that a double signing proof does not hold two different headers signed by the
+// same key in the same round and shard
+var ErrInvalidDoubleSigningProof = errors.New("invalid double signing proof")
+
+// ErrInvalidMaxNumDoubleSigningProofs signals that an invalid maximum number of kept double signing proofs was provided
+var ErrInvalidMaxNumDoubleSigningProofs = errors.New("invalid maximum number of double signing proofs")
+
+// ErrNilValidatorParticipationRecorder signals that a nil validator participation recorder has been provided
+var ErrNilValidatorParticipationRecorder = errors.New("nil validator participation recorder")
+
+// ErrValidatorParticipationNotEnabled signals that the validator participation history is not recorded by the node
+var ErrValidatorParticipationNotEnabled = errors.New("validator participation history is not enabled")
+
+// ErrInvalidMaxNumEpochs signals that an invalid maximum number of epochs was provided
+var ErrInvalidMaxNumEpochs = errors.New("invalid maximum number of epochs")
+
+// ErrBuiltInFunctionIsNotActive signals that the called built-in function is not active in the current epoch
+var ErrBuiltInFunctionIsNotActive = errors.New("built in function is not active")
+
+// ErrUnknownEconomicsParameter signals that the economics parameter to be changed is unknown
+var ErrUnknownEconomicsParameter = errors.New("unknown economics parameter")
+
+// ErrNilGovernanceConfigChangesHandler signals that a nil governance config changes handler has been provided
+var ErrNilGovernanceConfigChangesHandler = errors.New("nil governance config changes handler")
+
+// ErrGovernanceConfigChangesMismatch signals that the governance config changes from the epoch start block do not
+// match the ones applied by the node
+var ErrGovernanceConfigChangesMismatch = errors.New("governance config changes mismatch")
@@ -43,8 +43,11 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	SizeCheckDelta            uint32
 	MinTransactionVersion     uint32
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	GuardedAccounts           process.GuardedAccountHandler
+	MultisigAccounts          process.MultisigAccountHandler
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -79,6 +82,9 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	MinTransactionVersion     uint32
 	SizeCheckDelta            uint32
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	GuardedAccounts           process.GuardedAccountHandler
+	MultisigAccounts          process.MultisigAccountHandler
 }
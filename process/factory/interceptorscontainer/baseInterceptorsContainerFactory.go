@@ -39,6 +39,7 @@ type baseInterceptorsContainerFactory struct {
 	whiteListHandler       process.WhiteListHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
 	addressPubkeyConverter core.PubkeyConverter
+	guardedAccounts        process.GuardedAccountHandler
 }
 
 func checkBaseParams(
@@ -57,6 +58,8 @@ func checkBaseParams(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	addressPubkeyConverter core.PubkeyConverter,
+	guardedAccounts process.GuardedAccountHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) error {
 	if check.IfNil(shardCoordinator) {
 		return process.ErrNilShardCoordinator
@@ -100,6 +103,12 @@ func checkBaseParams(
 	if check.IfNil(addressPubkeyConverter) {
 		return process.ErrNilPubkeyConverter
 	}
+	if check.IfNil(guardedAccounts) {
+		return process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(multisigAccounts) {
+		return process.ErrNilMultisigAccountHandler
+	}
 
 	return nil
 }
@@ -160,6 +169,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 		bicf.shardCoordinator,
 		bicf.whiteListHandler,
 		bicf.addressPubkeyConverter,
+		bicf.guardedAccounts,
 		bicf.maxTxNonceDeltaAllowed,
 	)
 	if err != nil {
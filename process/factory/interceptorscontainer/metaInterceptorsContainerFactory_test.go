@@ -249,6 +249,28 @@ func TestNewMetaInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilGuardedAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.GuardedAccounts = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewMetaInterceptorsContainerFactory_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.MultisigAccounts = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -530,5 +552,7 @@ func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryAr
 		MinTransactionVersion:   1,
 		TxSignHasher:            mock.HasherMock{},
 		EpochNotifier:           &mock.EpochNotifierStub{},
+		GuardedAccounts:         &mock.GuardedAccountHandlerStub{},
+		MultisigAccounts:        &mock.MultisigAccountHandlerStub{},
 	}
 }
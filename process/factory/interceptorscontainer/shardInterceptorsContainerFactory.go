@@ -44,6 +44,8 @@ func NewShardInterceptorsContainerFactory(
 		args.WhiteListHandler,
 		args.WhiteListerVerifiedTxs,
 		args.AddressPubkeyConverter,
+		args.GuardedAccounts,
+		args.MultisigAccounts,
 	)
 	if err != nil {
 		return nil, err
@@ -111,8 +113,10 @@ func NewShardInterceptorsContainerFactory(
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		MultiRelayedTxEnableEpoch: args.MultiRelayedTxEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
+		MultisigAccounts:          args.MultisigAccounts,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -134,6 +138,7 @@ func NewShardInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		guardedAccounts:        args.GuardedAccounts,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
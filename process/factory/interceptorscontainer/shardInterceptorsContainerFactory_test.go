@@ -225,6 +225,28 @@ func TestNewShardInterceptorsContainerFactory_NilTxSignHasherShouldErr(t *testin
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilGuardedAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.GuardedAccounts = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewShardInterceptorsContainerFactory_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.MultisigAccounts = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -580,5 +602,7 @@ func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactory
 		MinTransactionVersion:   1,
 		TxSignHasher:            mock.HasherMock{},
 		EpochNotifier:           &mock.EpochNotifierStub{},
+		GuardedAccounts:         &mock.GuardedAccountHandlerStub{},
+		MultisigAccounts:        &mock.MultisigAccountHandlerStub{},
 	}
 }
@@ -23,35 +23,41 @@ import (
 var _ process.VirtualMachinesContainerFactory = (*vmContainerFactory)(nil)
 
 type vmContainerFactory struct {
-	chanceComputer         sharding.ChanceComputer
-	validatorAccountsDB    state.AccountsAdapter
-	blockChainHookImpl     *hooks.BlockChainHookImpl
-	cryptoHook             vmcommon.CryptoHook
-	systemContracts        vm.SystemSCContainer
-	economics              process.EconomicsDataHandler
-	messageSigVerifier     vm.MessageSignVerifier
-	nodesConfigProvider    vm.NodesConfigProvider
-	gasSchedule            core.GasScheduleNotifier
-	hasher                 hashing.Hasher
-	marshalizer            marshal.Marshalizer
-	systemSCConfig         *config.SystemSmartContractsConfig
-	epochNotifier          process.EpochNotifier
-	addressPubKeyConverter core.PubkeyConverter
+	chanceComputer            sharding.ChanceComputer
+	validatorAccountsDB       state.AccountsAdapter
+	blockChainHookImpl        *hooks.BlockChainHookImpl
+	cryptoHook                vmcommon.CryptoHook
+	systemContracts           vm.SystemSCContainer
+	economics                 process.EconomicsDataHandler
+	messageSigVerifier        vm.MessageSignVerifier
+	proofVerifier             vm.DoubleSigningProofVerifier
+	nodesConfigProvider       vm.NodesConfigProvider
+	gasSchedule               core.GasScheduleNotifier
+	hasher                    hashing.Hasher
+	marshalizer               marshal.Marshalizer
+	systemSCConfig            *config.SystemSmartContractsConfig
+	epochNotifier             process.EpochNotifier
+	addressPubKeyConverter    core.PubkeyConverter
+	esdtNFTEnableEpoch        uint32
+	esdtLocalRolesEnableEpoch uint32
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
 type ArgsNewVMContainerFactory struct {
-	ArgBlockChainHook   hooks.ArgBlockChainHook
-	Economics           process.EconomicsDataHandler
-	MessageSignVerifier vm.MessageSignVerifier
-	GasSchedule         core.GasScheduleNotifier
-	NodesConfigProvider vm.NodesConfigProvider
-	Hasher              hashing.Hasher
-	Marshalizer         marshal.Marshalizer
-	SystemSCConfig      *config.SystemSmartContractsConfig
-	ValidatorAccountsDB state.AccountsAdapter
-	ChanceComputer      sharding.ChanceComputer
-	EpochNotifier       process.EpochNotifier
+	ArgBlockChainHook         hooks.ArgBlockChainHook
+	Economics                 process.EconomicsDataHandler
+	MessageSignVerifier       vm.MessageSignVerifier
+	ProofVerifier             vm.DoubleSigningProofVerifier
+	GasSchedule               core.GasScheduleNotifier
+	NodesConfigProvider       vm.NodesConfigProvider
+	Hasher                    hashing.Hasher
+	Marshalizer               marshal.Marshalizer
+	SystemSCConfig            *config.SystemSmartContractsConfig
+	ValidatorAccountsDB       state.AccountsAdapter
+	ChanceComputer            sharding.ChanceComputer
+	EpochNotifier             process.EpochNotifier
+	ESDTNFTEnableEpoch        uint32
+	ESDTLocalRolesEnableEpoch uint32
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -62,6 +68,9 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 	if check.IfNil(args.MessageSignVerifier) {
 		return nil, process.ErrNilKeyGen
 	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, process.ErrNilDoubleSigningProofVerifier
+	}
 	if check.IfNil(args.NodesConfigProvider) {
 		return nil, process.ErrNilNodesConfigProvider
 	}
@@ -94,19 +103,22 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 	cryptoHook := hooks.NewVMCryptoHook()
 
 	return &vmContainerFactory{
-		blockChainHookImpl:     blockChainHookImpl,
-		cryptoHook:             cryptoHook,
-		economics:              args.Economics,
-		messageSigVerifier:     args.MessageSignVerifier,
-		gasSchedule:            args.GasSchedule,
-		nodesConfigProvider:    args.NodesConfigProvider,
-		hasher:                 args.Hasher,
-		marshalizer:            args.Marshalizer,
-		systemSCConfig:         args.SystemSCConfig,
-		validatorAccountsDB:    args.ValidatorAccountsDB,
-		chanceComputer:         args.ChanceComputer,
-		epochNotifier:          args.EpochNotifier,
-		addressPubKeyConverter: args.ArgBlockChainHook.PubkeyConv,
+		blockChainHookImpl:        blockChainHookImpl,
+		cryptoHook:                cryptoHook,
+		economics:                 args.Economics,
+		messageSigVerifier:        args.MessageSignVerifier,
+		proofVerifier:             args.ProofVerifier,
+		gasSchedule:               args.GasSchedule,
+		nodesConfigProvider:       args.NodesConfigProvider,
+		hasher:                    args.Hasher,
+		marshalizer:               args.Marshalizer,
+		systemSCConfig:            args.SystemSCConfig,
+		validatorAccountsDB:       args.ValidatorAccountsDB,
+		chanceComputer:            args.ChanceComputer,
+		epochNotifier:             args.EpochNotifier,
+		addressPubKeyConverter:    args.ArgBlockChainHook.PubkeyConv,
+		esdtNFTEnableEpoch:        args.ESDTNFTEnableEpoch,
+		esdtLocalRolesEnableEpoch: args.ESDTLocalRolesEnableEpoch,
 	}, nil
 }
 
@@ -161,16 +173,19 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 	}
 
 	argsNewSystemScFactory := systemVMFactory.ArgsNewSystemSCFactory{
-		SystemEI:               systemEI,
-		SigVerifier:            vmf.messageSigVerifier,
-		GasSchedule:            vmf.gasSchedule,
-		NodesConfigProvider:    vmf.nodesConfigProvider,
-		Hasher:                 vmf.hasher,
-		Marshalizer:            vmf.marshalizer,
-		SystemSCConfig:         vmf.systemSCConfig,
-		Economics:              vmf.economics,
-		EpochNotifier:          vmf.epochNotifier,
-		AddressPubKeyConverter: vmf.addressPubKeyConverter,
+		SystemEI:                  systemEI,
+		SigVerifier:               vmf.messageSigVerifier,
+		ProofVerifier:             vmf.proofVerifier,
+		GasSchedule:               vmf.gasSchedule,
+		NodesConfigProvider:       vmf.nodesConfigProvider,
+		Hasher:                    vmf.hasher,
+		Marshalizer:               vmf.marshalizer,
+		SystemSCConfig:            vmf.systemSCConfig,
+		Economics:                 vmf.economics,
+		EpochNotifier:             vmf.epochNotifier,
+		AddressPubKeyConverter:    vmf.addressPubKeyConverter,
+		ESDTNFTEnableEpoch:        vmf.esdtNFTEnableEpoch,
+		ESDTLocalRolesEnableEpoch: vmf.esdtLocalRolesEnableEpoch,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
@@ -48,6 +48,7 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 		ArgBlockChainHook:   createMockVMAccountsArguments(),
 		Economics:           &economicsmocks.EconomicsHandlerStub{},
 		MessageSignVerifier: &mock.MessageSignVerifierMock{},
+		ProofVerifier:       &mock.DoubleSigningProofVerifierStub{},
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Hasher:              &mock.HasherMock{},
@@ -130,6 +131,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 		ArgBlockChainHook:   createMockVMAccountsArguments(),
 		Economics:           economicsData,
 		MessageSignVerifier: &mock.MessageSignVerifierMock{},
+		ProofVerifier:       &mock.DoubleSigningProofVerifierStub{},
 		GasSchedule:         makeGasSchedule(),
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Hasher:              &mock.HasherMock{},
@@ -246,6 +248,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["Slash"] = value
 
 	return gasMap
 }
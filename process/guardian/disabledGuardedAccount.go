@@ -0,0 +1,42 @@
+package guardian
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.GuardedAccountHandler = (*disabledGuardedAccount)(nil)
+
+type disabledGuardedAccount struct {
+}
+
+// NewDisabledGuardedAccount returns a guarded account handler for which no account can be guarded
+func NewDisabledGuardedAccount() *disabledGuardedAccount {
+	return &disabledGuardedAccount{}
+}
+
+// GetActiveGuardian returns ErrAccountHasNoActiveGuardian
+func (dga *disabledGuardedAccount) GetActiveGuardian(_ state.UserAccountHandler) ([]byte, error) {
+	return nil, process.ErrAccountHasNoActiveGuardian
+}
+
+// SetGuardian returns ErrGuardedAccountsNotEnabled
+func (dga *disabledGuardedAccount) SetGuardian(_ state.UserAccountHandler, _ []byte) error {
+	return process.ErrGuardedAccountsNotEnabled
+}
+
+// RemoveGuardian returns ErrGuardedAccountsNotEnabled
+func (dga *disabledGuardedAccount) RemoveGuardian(_ state.UserAccountHandler) error {
+	return process.ErrGuardedAccountsNotEnabled
+}
+
+// CheckGuardedTransaction returns nil
+func (dga *disabledGuardedAccount) CheckGuardedTransaction(_ state.UserAccountHandler, _ *transaction.Transaction) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dga *disabledGuardedAccount) IsInterfaceNil() bool {
+	return dga == nil
+}
@@ -0,0 +1,260 @@
+package guardian
+
+import (
+	"bytes"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/guardians"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/guardian")
+
+var _ process.GuardedAccountHandler = (*guardedAccount)(nil)
+
+var guardiansKey = []byte(core.ElrondProtectedKeyPrefix + core.GuardiansKeyIdentifier)
+
+// ArgsGuardedAccount defines the arguments needed to create a guarded account handler
+type ArgsGuardedAccount struct {
+	Marshalizer                marshal.Marshalizer
+	EpochNotifier              process.EpochNotifier
+	TxVersionChecker           process.TxVersionCheckerHandler
+	ActivationEpochsDelay      uint32
+	GuardedAccountsEnableEpoch uint32
+}
+
+type guardedAccount struct {
+	marshalizer                marshal.Marshalizer
+	txVersionChecker           process.TxVersionCheckerHandler
+	activationEpochsDelay      uint32
+	guardedAccountsEnableEpoch uint32
+	flagGuardedAccounts        atomic.Flag
+	mutEpoch                   sync.RWMutex
+	currentEpoch               uint32
+}
+
+// NewGuardedAccount creates a new guarded account handler. A newly set guardian becomes active only after the
+// configured number of epochs, so that the owner of a stolen key can not instantly replace the guardian
+func NewGuardedAccount(args ArgsGuardedAccount) (*guardedAccount, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.TxVersionChecker) {
+		return nil, process.ErrNilTransactionVersionChecker
+	}
+
+	ga := &guardedAccount{
+		marshalizer:                args.Marshalizer,
+		txVersionChecker:           args.TxVersionChecker,
+		activationEpochsDelay:      args.ActivationEpochsDelay,
+		guardedAccountsEnableEpoch: args.GuardedAccountsEnableEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(ga)
+
+	return ga, nil
+}
+
+// GetActiveGuardian returns the address of the active guardian of the provided account
+func (ga *guardedAccount) GetActiveGuardian(account state.UserAccountHandler) ([]byte, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	configuredGuardians, err := ga.getGuardians(account)
+	if err != nil {
+		return nil, err
+	}
+
+	activeGuardian, _ := ga.splitGuardians(configuredGuardians)
+	if activeGuardian == nil {
+		return nil, process.ErrAccountHasNoActiveGuardian
+	}
+
+	return activeGuardian.Address, nil
+}
+
+// SetGuardian sets the provided address as the pending guardian of the account, replacing any other pending guardian.
+// The pending guardian becomes active after the activation delay, while the current active guardian remains active
+// until then. As any other transaction of a guarded account, the call has to be co-signed by the active guardian
+func (ga *guardedAccount) SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error {
+	if !ga.flagGuardedAccounts.IsSet() {
+		return process.ErrGuardedAccountsNotEnabled
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if len(guardianAddress) != len(account.AddressBytes()) {
+		return process.ErrInvalidGuardianAddress
+	}
+	if bytes.Equal(guardianAddress, account.AddressBytes()) {
+		return process.ErrCannotSetOwnAddressAsGuardian
+	}
+
+	configuredGuardians, err := ga.getGuardians(account)
+	if err != nil {
+		return err
+	}
+
+	activeGuardian, _ := ga.splitGuardians(configuredGuardians)
+	newGuardians := &guardians.Guardians{Slice: make([]*guardians.Guardian, 0, 2)}
+	if activeGuardian != nil {
+		newGuardians.Slice = append(newGuardians.Slice, activeGuardian)
+	}
+
+	isActiveGuardian := activeGuardian != nil && bytes.Equal(activeGuardian.Address, guardianAddress)
+	if !isActiveGuardian {
+		newGuardians.Slice = append(newGuardians.Slice, &guardians.Guardian{
+			Address:         guardianAddress,
+			ActivationEpoch: ga.getCurrentEpoch() + ga.activationEpochsDelay,
+		})
+	}
+
+	return ga.saveGuardians(account, newGuardians)
+}
+
+// RemoveGuardian removes the pending guardian of the account if there is one, otherwise the active guardian. This is
+// how a pending guardian is canceled before it becomes active
+func (ga *guardedAccount) RemoveGuardian(account state.UserAccountHandler) error {
+	if !ga.flagGuardedAccounts.IsSet() {
+		return process.ErrGuardedAccountsNotEnabled
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+
+	configuredGuardians, err := ga.getGuardians(account)
+	if err != nil {
+		return err
+	}
+
+	activeGuardian, pendingGuardian := ga.splitGuardians(configuredGuardians)
+	newGuardians := &guardians.Guardians{Slice: make([]*guardians.Guardian, 0, 1)}
+	switch {
+	case pendingGuardian != nil:
+		if activeGuardian != nil {
+			newGuardians.Slice = append(newGuardians.Slice, activeGuardian)
+		}
+	case activeGuardian != nil:
+	default:
+		return process.ErrNoGuardianToRemove
+	}
+
+	return ga.saveGuardians(account, newGuardians)
+}
+
+// CheckGuardedTransaction checks that a transaction sent from the provided account is co-signed by the active
+// guardian of the account, if it has one. There is no exception for the guardian changes, otherwise a stolen owner
+// key would be enough to replace the guardian once the activation delay passes. The guardian signature itself is
+// verified when the transaction is intercepted
+func (ga *guardedAccount) CheckGuardedTransaction(account state.UserAccountHandler, tx *transaction.Transaction) error {
+	if !ga.flagGuardedAccounts.IsSet() {
+		return nil
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if tx == nil {
+		return process.ErrNilTransaction
+	}
+
+	isGuardedTx := ga.txVersionChecker.IsGuardedTransaction(tx)
+	activeGuardian, err := ga.GetActiveGuardian(account)
+	if err == process.ErrAccountHasNoActiveGuardian {
+		if isGuardedTx {
+			return process.ErrGuardedTransactionNotExpected
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isGuardedTx {
+		return process.ErrTransactionNotGuarded
+	}
+	if !bytes.Equal(activeGuardian, tx.GuardianAddr) {
+		return process.ErrGuardianMismatch
+	}
+
+	return nil
+}
+
+// splitGuardians returns the guardian which is active in the current epoch, having the latest activation epoch, and
+// the guardian which will become active in a future epoch, if any
+func (ga *guardedAccount) splitGuardians(configuredGuardians *guardians.Guardians) (*guardians.Guardian, *guardians.Guardian) {
+	currentEpoch := ga.getCurrentEpoch()
+
+	var activeGuardian, pendingGuardian *guardians.Guardian
+	for _, g := range configuredGuardians.Slice {
+		if g.ActivationEpoch > currentEpoch {
+			pendingGuardian = g
+			continue
+		}
+		if activeGuardian == nil || g.ActivationEpoch >= activeGuardian.ActivationEpoch {
+			activeGuardian = g
+		}
+	}
+
+	return activeGuardian, pendingGuardian
+}
+
+func (ga *guardedAccount) getGuardians(account state.UserAccountHandler) (*guardians.Guardians, error) {
+	configuredGuardians := &guardians.Guardians{Slice: make([]*guardians.Guardian, 0)}
+
+	marshalledData, err := account.DataTrieTracker().RetrieveValue(guardiansKey)
+	if err != nil || len(marshalledData) == 0 {
+		return configuredGuardians, nil
+	}
+
+	err = ga.marshalizer.Unmarshal(configuredGuardians, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return configuredGuardians, nil
+}
+
+func (ga *guardedAccount) saveGuardians(account state.UserAccountHandler, configuredGuardians *guardians.Guardians) error {
+	if len(configuredGuardians.Slice) == 0 {
+		return account.DataTrieTracker().SaveKeyValue(guardiansKey, nil)
+	}
+
+	marshalledData, err := ga.marshalizer.Marshal(configuredGuardians)
+	if err != nil {
+		return err
+	}
+
+	return account.DataTrieTracker().SaveKeyValue(guardiansKey, marshalledData)
+}
+
+func (ga *guardedAccount) getCurrentEpoch() uint32 {
+	ga.mutEpoch.RLock()
+	defer ga.mutEpoch.RUnlock()
+
+	return ga.currentEpoch
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (ga *guardedAccount) EpochConfirmed(epoch uint32) {
+	ga.mutEpoch.Lock()
+	ga.currentEpoch = epoch
+	ga.mutEpoch.Unlock()
+
+	ga.flagGuardedAccounts.Toggle(epoch >= ga.guardedAccountsEnableEpoch)
+	log.Debug("guarded accounts", "enabled", ga.flagGuardedAccounts.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ga *guardedAccount) IsInterfaceNil() bool {
+	return ga == nil
+}
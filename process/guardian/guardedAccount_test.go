@@ -0,0 +1,261 @@
+package guardian
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testActivationEpochsDelay = uint32(10)
+
+var (
+	ownerAddress       = []byte("owner address 0000000000000000000")
+	guardianAddress    = []byte("guardian address 0000000000000000")
+	newGuardianAddress = []byte("new guardian address 000000000000")
+)
+
+func createMockArgsGuardedAccount() ArgsGuardedAccount {
+	return ArgsGuardedAccount{
+		Marshalizer:                &mock.MarshalizerMock{},
+		EpochNotifier:              &mock.EpochNotifierStub{},
+		TxVersionChecker:           versioning.NewTxVersionChecker(1),
+		ActivationEpochsDelay:      testActivationEpochsDelay,
+		GuardedAccountsEnableEpoch: 0,
+	}
+}
+
+func createGuardedTx(guardian []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:           ownerAddress,
+		RcvAddr:           []byte("receiver"),
+		Version:           2,
+		Options:           versioning.MaskGuardedTransaction,
+		GuardianAddr:      guardian,
+		GuardianSignature: []byte("guardian signature"),
+	}
+}
+
+func TestNewGuardedAccount_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.Marshalizer = nil
+
+	ga, err := NewGuardedAccount(args)
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewGuardedAccount_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.EpochNotifier = nil
+
+	ga, err := NewGuardedAccount(args)
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewGuardedAccount_NilTxVersionCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.TxVersionChecker = nil
+
+	ga, err := NewGuardedAccount(args)
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilTransactionVersionChecker, err)
+}
+
+func TestNewGuardedAccount_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registerWasCalled := false
+	args := createMockArgsGuardedAccount()
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			registerWasCalled = true
+		},
+	}
+
+	ga, err := NewGuardedAccount(args)
+	assert.False(t, check.IfNil(ga))
+	assert.Nil(t, err)
+	assert.True(t, registerWasCalled)
+}
+
+func TestGuardedAccount_NotEnabledShouldNotAllowSettingGuardians(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.GuardedAccountsEnableEpoch = 5
+	ga, _ := NewGuardedAccount(args)
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, ga.SetGuardian(account, guardianAddress))
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, ga.RemoveGuardian(account))
+	assert.Nil(t, ga.CheckGuardedTransaction(account, createGuardedTx(guardianAddress)))
+
+	ga.EpochConfirmed(5)
+	assert.Nil(t, ga.SetGuardian(account, guardianAddress))
+}
+
+func TestGuardedAccount_SetGuardianInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	assert.Equal(t, process.ErrNilUserAccount, ga.SetGuardian(nil, guardianAddress))
+	assert.Equal(t, process.ErrInvalidGuardianAddress, ga.SetGuardian(account, []byte("short")))
+	assert.Equal(t, process.ErrCannotSetOwnAddressAsGuardian, ga.SetGuardian(account, ownerAddress))
+}
+
+func TestGuardedAccount_SetGuardianShouldActivateAfterDelay(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	ga.EpochConfirmed(3)
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+
+	_, err := ga.GetActiveGuardian(account)
+	assert.Equal(t, process.ErrAccountHasNoActiveGuardian, err)
+
+	ga.EpochConfirmed(3 + testActivationEpochsDelay - 1)
+	_, err = ga.GetActiveGuardian(account)
+	assert.Equal(t, process.ErrAccountHasNoActiveGuardian, err)
+
+	ga.EpochConfirmed(3 + testActivationEpochsDelay)
+	activeGuardian, err := ga.GetActiveGuardian(account)
+	assert.Nil(t, err)
+	assert.Equal(t, guardianAddress, activeGuardian)
+}
+
+func TestGuardedAccount_SetGuardianShouldKeepActiveGuardianUntilTheNewOneIsActive(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+	ga.EpochConfirmed(testActivationEpochsDelay)
+	require.Nil(t, ga.SetGuardian(account, newGuardianAddress))
+
+	ga.EpochConfirmed(2*testActivationEpochsDelay - 1)
+	activeGuardian, _ := ga.GetActiveGuardian(account)
+	assert.Equal(t, guardianAddress, activeGuardian)
+
+	ga.EpochConfirmed(2 * testActivationEpochsDelay)
+	activeGuardian, _ = ga.GetActiveGuardian(account)
+	assert.Equal(t, newGuardianAddress, activeGuardian)
+}
+
+func TestGuardedAccount_RemoveGuardianShouldRemovePendingGuardianFirst(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	assert.Equal(t, process.ErrNoGuardianToRemove, ga.RemoveGuardian(account))
+
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+	ga.EpochConfirmed(testActivationEpochsDelay)
+	require.Nil(t, ga.SetGuardian(account, newGuardianAddress))
+
+	require.Nil(t, ga.RemoveGuardian(account))
+	ga.EpochConfirmed(3 * testActivationEpochsDelay)
+	activeGuardian, _ := ga.GetActiveGuardian(account)
+	assert.Equal(t, guardianAddress, activeGuardian)
+
+	require.Nil(t, ga.RemoveGuardian(account))
+	_, err := ga.GetActiveGuardian(account)
+	assert.Equal(t, process.ErrAccountHasNoActiveGuardian, err)
+}
+
+func TestGuardedAccount_CheckGuardedTransaction(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	unguardedTx := &transaction.Transaction{SndAddr: ownerAddress, RcvAddr: []byte("receiver"), Version: 1}
+	assert.Nil(t, ga.CheckGuardedTransaction(account, unguardedTx))
+	assert.Equal(t, process.ErrGuardedTransactionNotExpected, ga.CheckGuardedTransaction(account, createGuardedTx(guardianAddress)))
+
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+	ga.EpochConfirmed(testActivationEpochsDelay)
+
+	assert.Equal(t, process.ErrTransactionNotGuarded, ga.CheckGuardedTransaction(account, unguardedTx))
+	assert.Equal(t, process.ErrGuardianMismatch, ga.CheckGuardedTransaction(account, createGuardedTx(newGuardianAddress)))
+	assert.Nil(t, ga.CheckGuardedTransaction(account, createGuardedTx(guardianAddress)))
+
+}
+
+func TestGuardedAccount_CheckGuardedTransactionChangingTheGuardianShouldNeedTheGuardianSignature(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+	ga.EpochConfirmed(testActivationEpochsDelay)
+
+	for _, data := range []string{core.BuiltInFunctionSetGuardian + "@6775617264696172", core.BuiltInFunctionRemoveGuardian} {
+		unguardedTx := &transaction.Transaction{
+			SndAddr: ownerAddress,
+			RcvAddr: ownerAddress,
+			Data:    []byte(data),
+			Version: 1,
+		}
+		assert.Equal(t, process.ErrTransactionNotGuarded, ga.CheckGuardedTransaction(account, unguardedTx))
+
+		guardedTx := createGuardedTx(guardianAddress)
+		guardedTx.RcvAddr = ownerAddress
+		guardedTx.Data = []byte(data)
+		assert.Nil(t, ga.CheckGuardedTransaction(account, guardedTx))
+	}
+}
+
+func TestGuardedAccount_RemoveGuardianShouldCancelThePendingGuardian(t *testing.T) {
+	t.Parallel()
+
+	ga, _ := NewGuardedAccount(createMockArgsGuardedAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+	require.Nil(t, ga.SetGuardian(account, guardianAddress))
+	ga.EpochConfirmed(testActivationEpochsDelay)
+
+	require.Nil(t, ga.SetGuardian(account, newGuardianAddress))
+	require.Nil(t, ga.RemoveGuardian(account))
+
+	ga.EpochConfirmed(2 * testActivationEpochsDelay)
+	activeGuardian, err := ga.GetActiveGuardian(account)
+	require.Nil(t, err)
+	assert.Equal(t, guardianAddress, activeGuardian)
+
+	configuredGuardians, _ := ga.getGuardians(account)
+	assert.Equal(t, 1, len(configuredGuardians.Slice))
+}
+
+func TestDisabledGuardedAccount(t *testing.T) {
+	t.Parallel()
+
+	dga := NewDisabledGuardedAccount()
+	require.False(t, check.IfNil(dga))
+
+	account, _ := state.NewUserAccount(ownerAddress)
+	_, err := dga.GetActiveGuardian(account)
+	assert.Equal(t, process.ErrAccountHasNoActiveGuardian, err)
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, dga.SetGuardian(account, guardianAddress))
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, dga.RemoveGuardian(account))
+	assert.Nil(t, dga.CheckGuardedTransaction(account, createGuardedTx(guardianAddress)))
+}
@@ -33,6 +33,8 @@ type ArgInterceptedDataFactory struct {
 	ChainID                   []byte
 	MinTransactionVersion     uint32
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	MultisigAccounts          process.MultisigAccountHandler
 }
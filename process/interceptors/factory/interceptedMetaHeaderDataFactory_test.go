@@ -74,6 +74,7 @@ func createMockArgument() *ArgInterceptedDataFactory {
 		MinTransactionVersion:   1,
 		TxSignHasher:            mock.HasherMock{},
 		EpochNotifier:           &mock.EpochNotifierStub{},
+		MultisigAccounts:        &mock.MultisigAccountHandlerStub{},
 	}
 }
 
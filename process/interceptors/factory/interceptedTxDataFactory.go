@@ -35,7 +35,10 @@ type interceptedTxDataFactory struct {
 	epochStartTrigger           process.EpochStartTriggerHandler
 	txSignHasher                hashing.Hasher
 	txVersionChecker            process.TxVersionCheckerHandler
+	multisigAccounts            process.MultisigAccountHandler
+	multiRelayedTxEnableEpoch   uint32
 	flagEnableSignedTxWithHash  atomic.Flag
+	flagMultiRelayedTx          atomic.Flag
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -88,6 +91,9 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 	if check.IfNil(argument.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(argument.MultisigAccounts) {
+		return nil, process.ErrNilMultisigAccountHandler
+	}
 
 	itdf := &interceptedTxDataFactory{
 		protoMarshalizer:            argument.ProtoMarshalizer,
@@ -106,6 +112,8 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		enableSignedTxWithHashEpoch: argument.EnableSignTxWithHashEpoch,
 		txSignHasher:                argument.TxSignHasher,
 		txVersionChecker:            versioning.NewTxVersionChecker(argument.MinTransactionVersion),
+		multisigAccounts:            argument.MultisigAccounts,
+		multiRelayedTxEnableEpoch:   argument.MultiRelayedTxEnableEpoch,
 	}
 
 	argument.EpochNotifier.RegisterNotifyHandler(itdf)
@@ -129,8 +137,10 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.argsParser,
 		itdf.chainID,
 		itdf.flagEnableSignedTxWithHash.IsSet(),
+		itdf.flagMultiRelayedTx.IsSet(),
 		itdf.txSignHasher,
 		itdf.txVersionChecker,
+		itdf.multisigAccounts,
 	)
 }
 
@@ -143,4 +153,7 @@ func (itdf *interceptedTxDataFactory) IsInterfaceNil() bool {
 func (itdf *interceptedTxDataFactory) EpochConfirmed(epoch uint32) {
 	itdf.flagEnableSignedTxWithHash.Toggle(epoch >= itdf.enableSignedTxWithHashEpoch)
 	log.Debug("interceptors: transaction signed with hash", "enabled", itdf.flagEnableSignedTxWithHash.IsSet())
+
+	itdf.flagMultiRelayedTx.Toggle(epoch >= itdf.multiRelayedTxEnableEpoch)
+	log.Debug("interceptors: multi relayed transactions", "enabled", itdf.flagMultiRelayedTx.IsSet())
 }
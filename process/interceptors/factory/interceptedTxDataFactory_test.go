@@ -163,6 +163,17 @@ func TestNewInterceptedTxDataFactory_NilEpochNotifierShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilEpochNotifier, err)
 }
 
+func TestNewInterceptedTxDataFactory_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.MultisigAccounts = nil
+
+	imh, err := NewInterceptedTxDataFactory(arg)
+	assert.Nil(t, imh)
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+}
+
 func TestInterceptedTxDataFactory_ShouldWorkAndCreate(t *testing.T) {
 	t.Parallel()
 
@@ -9,7 +9,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/multisig"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -70,6 +72,7 @@ type TxValidatorHandler interface {
 // TxVersionCheckerHandler defines the functionality that is needed for a TxVersionChecker to validate transaction version
 type TxVersionCheckerHandler interface {
 	IsSignedWithHash(tx *transaction.Transaction) bool
+	IsGuardedTransaction(tx *transaction.Transaction) bool
 	CheckTxVersion(tx *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
@@ -669,11 +672,12 @@ type NetworkConnectionWatcher interface {
 
 // SCQuery represents a prepared query for executing a function of the smart contract
 type SCQuery struct {
-	ScAddress  []byte
-	FuncName   string
-	CallerAddr []byte
-	CallValue  *big.Int
-	Arguments  [][]byte
+	ScAddress   []byte
+	FuncName    string
+	CallerAddr  []byte
+	CallValue   *big.Int
+	Arguments   [][]byte
+	BlockHeader data.HeaderHandler
 }
 
 // GasHandler is able to perform some gas calculation
@@ -798,6 +802,14 @@ type PeerValidatorMapper interface {
 	IsInterfaceNil() bool
 }
 
+// HistoricalAccountsHandler defines the behavior of a component able to temporarily switch the accounts state
+// to the one identified by a given root hash
+type HistoricalAccountsHandler interface {
+	SelectRootHash(rootHash []byte) error
+	ResetRootHash()
+	IsInterfaceNil() bool
+}
+
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
@@ -870,6 +882,7 @@ type MiniBlockProvider interface {
 type BuiltinFunction interface {
 	ProcessBuiltinFunction(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
 	SetNewGasConfig(gasCost *GasCost)
+	IsActive() bool
 	IsInterfaceNil() bool
 }
 
@@ -1009,6 +1022,40 @@ type ESDTPauseHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTTokenTypeHandler provides the type an esdt token was issued with
+type ESDTTokenTypeHandler interface {
+	GetTokenType(esdtTokenKey []byte) core.ESDTType
+	IsInterfaceNil() bool
+}
+
+// ESDTRoleHandler provides CheckAllowedToExecute function which checks whether an account holds a role for an esdt token
+type ESDTRoleHandler interface {
+	CheckAllowedToExecute(account state.UserAccountHandler, tokenID []byte, action []byte) error
+	IsInterfaceNil() bool
+}
+
+// GuardedAccountHandler handles the guardians of an account and checks if the transactions sent from a guarded
+// account are co-signed by its active guardian
+type GuardedAccountHandler interface {
+	GetActiveGuardian(account state.UserAccountHandler) ([]byte, error)
+	SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error
+	RemoveGuardian(account state.UserAccountHandler) error
+	CheckGuardedTransaction(account state.UserAccountHandler, tx *transaction.Transaction) error
+	IsInterfaceNil() bool
+}
+
+// MultisigAccountHandler handles the configuration of the native multisig accounts, which hold the public keys of
+// their signers and the number of signatures needed by their transactions
+type MultisigAccountHandler interface {
+	SetMultisig(account state.UserAccountHandler, threshold uint32, signers [][]byte) error
+	GetMultisigConfig(account state.UserAccountHandler) (*multisig.MultisigConfig, error)
+	GetMultisigConfigForAddress(address []byte) (*multisig.MultisigConfig, error)
+	VerifyMultisigSignatures(config *multisig.MultisigConfig, messageForSigning []byte, signatures [][]byte) error
+	VerifyMultisigTransaction(config *multisig.MultisigConfig, tx *transaction.Transaction) error
+	IsMultisigAccountsEnabled() bool
+	IsInterfaceNil() bool
+}
+
 // PayableHandler provides IsPayable function which returns if an account is payable or not
 type PayableHandler interface {
 	IsPayable(address []byte) (bool, error)
@@ -1020,3 +1067,35 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// DoubleSigningProofVerifier verifies the proofs that a BLS key signed two different headers in the same round and shard
+type DoubleSigningProofVerifier interface {
+	VerifyProof(proof []byte) (*slash.DoubleSigningProof, error)
+	IsInterfaceNil() bool
+}
+
+// DoubleSigningDetector watches the received headers and consensus signature shares in order to build proofs of
+// double signing
+type DoubleSigningDetector interface {
+	ReceivedHeader(header data.HeaderHandler, headerHash []byte)
+	ReceivedSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte)
+	GetProofs() [][]byte
+	IsInterfaceNil() bool
+}
+
+// ValidatorParticipationRecorder keeps, for each validator, the per epoch history of its consensus participation and
+// of the resulting rating changes, as computed by the validator statistics processor
+type ValidatorParticipationRecorder interface {
+	RecordParticipation(pubKey []byte, participation *state.ValidatorParticipation)
+	Reset()
+	Commit() error
+	GetParticipationHistory(pubKey []byte) (*state.ValidatorParticipationHistory, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceConfigChangesHandler provides the gas schedule and economics changes applied by the executed governance
+// proposals, which are saved in the epoch start metablocks
+type GovernanceConfigChangesHandler interface {
+	AppliedConfigChanges() []block.GovernanceConfigChange
+	IsInterfaceNil() bool
+}
@@ -10,6 +10,7 @@ import (
 type BuiltInFunctionStub struct {
 	ProcessBuiltinFunctionCalled func(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
 	SetNewGasConfigCalled        func(gasCost *process.GasCost)
+	IsActiveCalled               func() bool
 }
 
 // ProcessBuiltinFunction -
@@ -27,6 +28,14 @@ func (b *BuiltInFunctionStub) SetNewGasConfig(gasCost *process.GasCost) {
 	}
 }
 
+// IsActive -
+func (b *BuiltInFunctionStub) IsActive() bool {
+	if b.IsActiveCalled != nil {
+		return b.IsActiveCalled()
+	}
+	return true
+}
+
 // IsInterfaceNil -
 func (b *BuiltInFunctionStub) IsInterfaceNil() bool {
 	return b == nil
@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/slash"
+
+// DoubleSigningProofVerifierStub -
+type DoubleSigningProofVerifierStub struct {
+	VerifyProofCalled func(proof []byte) (*slash.DoubleSigningProof, error)
+}
+
+// VerifyProof -
+func (stub *DoubleSigningProofVerifierStub) VerifyProof(proof []byte) (*slash.DoubleSigningProof, error) {
+	if stub.VerifyProofCalled != nil {
+		return stub.VerifyProofCalled(proof)
+	}
+	return &slash.DoubleSigningProof{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *DoubleSigningProofVerifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
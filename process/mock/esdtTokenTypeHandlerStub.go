@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// ESDTTokenTypeHandlerStub -
+type ESDTTokenTypeHandlerStub struct {
+	GetTokenTypeCalled func(esdtTokenKey []byte) core.ESDTType
+}
+
+// GetTokenType -
+func (e *ESDTTokenTypeHandlerStub) GetTokenType(esdtTokenKey []byte) core.ESDTType {
+	if e.GetTokenTypeCalled != nil {
+		return e.GetTokenTypeCalled(esdtTokenKey)
+	}
+
+	return core.Fungible
+}
+
+// IsInterfaceNil -
+func (e *ESDTTokenTypeHandlerStub) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// GovernanceConfigChangesHandlerStub -
+type GovernanceConfigChangesHandlerStub struct {
+	AppliedConfigChangesCalled func() []block.GovernanceConfigChange
+}
+
+// AppliedConfigChanges -
+func (stub *GovernanceConfigChangesHandlerStub) AppliedConfigChanges() []block.GovernanceConfigChange {
+	if stub.AppliedConfigChangesCalled != nil {
+		return stub.AppliedConfigChangesCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *GovernanceConfigChangesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// GuardedAccountHandlerStub -
+type GuardedAccountHandlerStub struct {
+	GetActiveGuardianCalled       func(account state.UserAccountHandler) ([]byte, error)
+	SetGuardianCalled             func(account state.UserAccountHandler, guardianAddress []byte) error
+	RemoveGuardianCalled          func(account state.UserAccountHandler) error
+	CheckGuardedTransactionCalled func(account state.UserAccountHandler, tx *transaction.Transaction) error
+}
+
+// GetActiveGuardian -
+func (gahs *GuardedAccountHandlerStub) GetActiveGuardian(account state.UserAccountHandler) ([]byte, error) {
+	if gahs.GetActiveGuardianCalled != nil {
+		return gahs.GetActiveGuardianCalled(account)
+	}
+
+	return nil, process.ErrAccountHasNoActiveGuardian
+}
+
+// SetGuardian -
+func (gahs *GuardedAccountHandlerStub) SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error {
+	if gahs.SetGuardianCalled != nil {
+		return gahs.SetGuardianCalled(account, guardianAddress)
+	}
+
+	return nil
+}
+
+// RemoveGuardian -
+func (gahs *GuardedAccountHandlerStub) RemoveGuardian(account state.UserAccountHandler) error {
+	if gahs.RemoveGuardianCalled != nil {
+		return gahs.RemoveGuardianCalled(account)
+	}
+
+	return nil
+}
+
+// CheckGuardedTransaction -
+func (gahs *GuardedAccountHandlerStub) CheckGuardedTransaction(account state.UserAccountHandler, tx *transaction.Transaction) error {
+	if gahs.CheckGuardedTransactionCalled != nil {
+		return gahs.CheckGuardedTransactionCalled(account, tx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (gahs *GuardedAccountHandlerStub) IsInterfaceNil() bool {
+	return gahs == nil
+}
@@ -0,0 +1,28 @@
+package mock
+
+// HistoricalAccountsHandlerStub -
+type HistoricalAccountsHandlerStub struct {
+	SelectRootHashCalled func(rootHash []byte) error
+	ResetRootHashCalled  func()
+}
+
+// SelectRootHash -
+func (hahs *HistoricalAccountsHandlerStub) SelectRootHash(rootHash []byte) error {
+	if hahs.SelectRootHashCalled != nil {
+		return hahs.SelectRootHashCalled(rootHash)
+	}
+
+	return nil
+}
+
+// ResetRootHash -
+func (hahs *HistoricalAccountsHandlerStub) ResetRootHash() {
+	if hahs.ResetRootHashCalled != nil {
+		hahs.ResetRootHashCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (hahs *HistoricalAccountsHandlerStub) IsInterfaceNil() bool {
+	return hahs == nil
+}
@@ -0,0 +1,19 @@
+package mock
+
+// MessageSignVerifierStub -
+type MessageSignVerifierStub struct {
+	VerifyCalled func(message []byte, signedMessage []byte, pubKey []byte) error
+}
+
+// Verify -
+func (m *MessageSignVerifierStub) Verify(message []byte, signedMessage []byte, pubKey []byte) error {
+	if m.VerifyCalled != nil {
+		return m.VerifyCalled(message, signedMessage, pubKey)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (m *MessageSignVerifierStub) IsInterfaceNil() bool {
+	return m == nil
+}
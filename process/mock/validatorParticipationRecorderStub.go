@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// ValidatorParticipationRecorderStub -
+type ValidatorParticipationRecorderStub struct {
+	RecordParticipationCalled     func(pubKey []byte, participation *state.ValidatorParticipation)
+	ResetCalled                   func()
+	CommitCalled                  func() error
+	GetParticipationHistoryCalled func(pubKey []byte) (*state.ValidatorParticipationHistory, error)
+}
+
+// RecordParticipation -
+func (stub *ValidatorParticipationRecorderStub) RecordParticipation(pubKey []byte, participation *state.ValidatorParticipation) {
+	if stub.RecordParticipationCalled != nil {
+		stub.RecordParticipationCalled(pubKey, participation)
+	}
+}
+
+// Reset -
+func (stub *ValidatorParticipationRecorderStub) Reset() {
+	if stub.ResetCalled != nil {
+		stub.ResetCalled()
+	}
+}
+
+// Commit -
+func (stub *ValidatorParticipationRecorderStub) Commit() error {
+	if stub.CommitCalled != nil {
+		return stub.CommitCalled()
+	}
+
+	return nil
+}
+
+// GetParticipationHistory -
+func (stub *ValidatorParticipationRecorderStub) GetParticipationHistory(pubKey []byte) (*state.ValidatorParticipationHistory, error) {
+	if stub.GetParticipationHistoryCalled != nil {
+		return stub.GetParticipationHistoryCalled(pubKey)
+	}
+
+	return &state.ValidatorParticipationHistory{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ValidatorParticipationRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,53 @@
+package multisig
+
+import (
+	dataMultisig "github.com/ElrondNetwork/elrond-go/data/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.MultisigAccountHandler = (*disabledMultisigAccount)(nil)
+
+type disabledMultisigAccount struct {
+}
+
+// NewDisabledMultisigAccount returns a multisig account handler for which no account can be multisig
+func NewDisabledMultisigAccount() *disabledMultisigAccount {
+	return &disabledMultisigAccount{}
+}
+
+// SetMultisig returns ErrMultisigAccountsNotEnabled
+func (dma *disabledMultisigAccount) SetMultisig(_ state.UserAccountHandler, _ uint32, _ [][]byte) error {
+	return process.ErrMultisigAccountsNotEnabled
+}
+
+// GetMultisigConfig returns ErrAccountIsNotMultisig
+func (dma *disabledMultisigAccount) GetMultisigConfig(_ state.UserAccountHandler) (*dataMultisig.MultisigConfig, error) {
+	return nil, process.ErrAccountIsNotMultisig
+}
+
+// GetMultisigConfigForAddress returns ErrAccountIsNotMultisig
+func (dma *disabledMultisigAccount) GetMultisigConfigForAddress(_ []byte) (*dataMultisig.MultisigConfig, error) {
+	return nil, process.ErrAccountIsNotMultisig
+}
+
+// VerifyMultisigSignatures returns ErrAccountIsNotMultisig
+func (dma *disabledMultisigAccount) VerifyMultisigSignatures(_ *dataMultisig.MultisigConfig, _ []byte, _ [][]byte) error {
+	return process.ErrAccountIsNotMultisig
+}
+
+// VerifyMultisigTransaction returns ErrAccountIsNotMultisig
+func (dma *disabledMultisigAccount) VerifyMultisigTransaction(_ *dataMultisig.MultisigConfig, _ *transaction.Transaction) error {
+	return process.ErrAccountIsNotMultisig
+}
+
+// IsMultisigAccountsEnabled returns false
+func (dma *disabledMultisigAccount) IsMultisigAccountsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dma *disabledMultisigAccount) IsInterfaceNil() bool {
+	return dma == nil
+}
@@ -0,0 +1,279 @@
+package multisig
+
+import (
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	dataMultisig "github.com/ElrondNetwork/elrond-go/data/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/multisig")
+
+var _ process.MultisigAccountHandler = (*multisigAccount)(nil)
+
+var multisigKey = []byte(core.ElrondProtectedKeyPrefix + core.MultisigKeyIdentifier)
+
+// MaxNumSigners is the maximum number of signers a multisig account can have
+const MaxNumSigners = 20
+
+// ArgsMultisigAccount defines the arguments needed to create a multisig account handler
+type ArgsMultisigAccount struct {
+	Marshalizer                 marshal.Marshalizer
+	Accounts                    state.AccountsAdapter
+	KeyGen                      crypto.KeyGenerator
+	SingleSigner                crypto.SingleSigner
+	PubkeyConv                  core.PubkeyConverter
+	SignMarshalizer             marshal.Marshalizer
+	TxSignHasher                hashing.Hasher
+	TxVersionChecker            process.TxVersionCheckerHandler
+	EpochNotifier               process.EpochNotifier
+	MultisigAccountsEnableEpoch uint32
+}
+
+type multisigAccount struct {
+	marshalizer                 marshal.Marshalizer
+	accounts                    state.AccountsAdapter
+	keyGen                      crypto.KeyGenerator
+	singleSigner                crypto.SingleSigner
+	pubkeyConv                  core.PubkeyConverter
+	signMarshalizer             marshal.Marshalizer
+	txSignHasher                hashing.Hasher
+	txVersionChecker            process.TxVersionCheckerHandler
+	multisigAccountsEnableEpoch uint32
+	flagMultisigAccounts        atomic.Flag
+}
+
+// NewMultisigAccount creates a new multisig account handler. The configuration of a multisig account is kept in
+// the protected part of its data trie
+func NewMultisigAccount(args ArgsMultisigAccount) (*multisigAccount, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if check.IfNil(args.PubkeyConv) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.SignMarshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.TxVersionChecker) {
+		return nil, process.ErrNilTransactionVersionChecker
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	ma := &multisigAccount{
+		marshalizer:                 args.Marshalizer,
+		accounts:                    args.Accounts,
+		keyGen:                      args.KeyGen,
+		singleSigner:                args.SingleSigner,
+		pubkeyConv:                  args.PubkeyConv,
+		signMarshalizer:             args.SignMarshalizer,
+		txSignHasher:                args.TxSignHasher,
+		txVersionChecker:            args.TxVersionChecker,
+		multisigAccountsEnableEpoch: args.MultisigAccountsEnableEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(ma)
+
+	return ma, nil
+}
+
+// SetMultisig turns the provided account into a multisig account, or replaces the configuration of an account which
+// is already multisig. Each signer must be a valid public key and at least threshold signers will have to sign the
+// transactions of the account
+func (ma *multisigAccount) SetMultisig(account state.UserAccountHandler, threshold uint32, signers [][]byte) error {
+	if !ma.flagMultisigAccounts.IsSet() {
+		return process.ErrMultisigAccountsNotEnabled
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if len(signers) == 0 || len(signers) > MaxNumSigners {
+		return process.ErrInvalidNumberOfMultisigSigners
+	}
+	if threshold == 0 || int(threshold) > len(signers) {
+		return process.ErrInvalidMultisigThreshold
+	}
+
+	err := ma.checkSigners(signers)
+	if err != nil {
+		return err
+	}
+
+	config := &dataMultisig.MultisigConfig{
+		Threshold: threshold,
+		Signers:   signers,
+	}
+	marshalledData, err := ma.marshalizer.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return account.DataTrieTracker().SaveKeyValue(multisigKey, marshalledData)
+}
+
+func (ma *multisigAccount) checkSigners(signers [][]byte) error {
+	uniqueSigners := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		_, found := uniqueSigners[string(signer)]
+		if found {
+			return process.ErrDuplicatedMultisigSigner
+		}
+		uniqueSigners[string(signer)] = struct{}{}
+
+		_, err := ma.keyGen.PublicKeyFromByteArray(signer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMultisigConfig returns the multisig configuration of the provided account
+func (ma *multisigAccount) GetMultisigConfig(account state.UserAccountHandler) (*dataMultisig.MultisigConfig, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	marshalledData, err := account.DataTrieTracker().RetrieveValue(multisigKey)
+	if err != nil || len(marshalledData) == 0 {
+		return nil, process.ErrAccountIsNotMultisig
+	}
+
+	config := &dataMultisig.MultisigConfig{}
+	err = ma.marshalizer.Unmarshal(config, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// GetMultisigConfigForAddress returns the multisig configuration of the account with the provided address, as found
+// in the current state
+func (ma *multisigAccount) GetMultisigConfigForAddress(address []byte) (*dataMultisig.MultisigConfig, error) {
+	accountHandler, err := ma.accounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return nil, process.ErrAccountIsNotMultisig
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return ma.GetMultisigConfig(account)
+}
+
+// VerifyMultisigSignatures checks that each signature belongs to a different signer of the provided multisig
+// configuration and that there are at least as many signatures as the configured threshold
+func (ma *multisigAccount) VerifyMultisigSignatures(
+	config *dataMultisig.MultisigConfig,
+	messageForSigning []byte,
+	signatures [][]byte,
+) error {
+	if config == nil {
+		return process.ErrAccountIsNotMultisig
+	}
+	if len(signatures) > len(config.Signers) {
+		return process.ErrTooManyMultisigSignatures
+	}
+	if uint32(len(signatures)) < config.Threshold {
+		return process.ErrNotEnoughMultisigSignatures
+	}
+
+	usedSigners := make([]bool, len(config.Signers))
+	for _, signature := range signatures {
+		signerIndex := ma.findSigner(config.Signers, usedSigners, messageForSigning, signature)
+		if signerIndex < 0 {
+			return process.ErrInvalidMultisigSignature
+		}
+
+		usedSigners[signerIndex] = true
+	}
+
+	return nil
+}
+
+func (ma *multisigAccount) findSigner(
+	signers [][]byte,
+	usedSigners []bool,
+	messageForSigning []byte,
+	signature []byte,
+) int {
+	for idx, signer := range signers {
+		if usedSigners[idx] {
+			continue
+		}
+
+		signerPubKey, err := ma.keyGen.PublicKeyFromByteArray(signer)
+		if err != nil {
+			continue
+		}
+
+		err = ma.singleSigner.Verify(signerPubKey, messageForSigning, signature)
+		if err == nil {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// VerifyMultisigTransaction checks the signatures of the provided transaction against the provided multisig
+// configuration. It is used when the transaction is processed, as the configuration might have changed since the
+// transaction was intercepted
+func (ma *multisigAccount) VerifyMultisigTransaction(config *dataMultisig.MultisigConfig, tx *transaction.Transaction) error {
+	if tx == nil {
+		return process.ErrNilTransaction
+	}
+
+	messageForSigning, err := tx.GetDataForSigning(ma.pubkeyConv, ma.signMarshalizer)
+	if err != nil {
+		return err
+	}
+	if ma.txVersionChecker.IsSignedWithHash(tx) {
+		messageForSigning = ma.txSignHasher.Compute(string(messageForSigning))
+	}
+
+	return ma.VerifyMultisigSignatures(config, messageForSigning, tx.Signatures)
+}
+
+// IsMultisigAccountsEnabled returns true if the multisig accounts are enabled in the current epoch
+func (ma *multisigAccount) IsMultisigAccountsEnabled() bool {
+	return ma.flagMultisigAccounts.IsSet()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (ma *multisigAccount) EpochConfirmed(epoch uint32) {
+	ma.flagMultisigAccounts.Toggle(epoch >= ma.multisigAccountsEnableEpoch)
+	log.Debug("multisig accounts", "enabled", ma.flagMultisigAccounts.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ma *multisigAccount) IsInterfaceNil() bool {
+	return ma == nil
+}
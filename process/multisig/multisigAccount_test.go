@@ -0,0 +1,329 @@
+package multisig
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	dataMultisig "github.com/ElrondNetwork/elrond-go/data/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ownerAddress = []byte("owner address 0000000000000000000")
+	signerA      = []byte("signer A 000000000000000000000000")
+	signerB      = []byte("signer B 000000000000000000000000")
+	signerC      = []byte("signer C 000000000000000000000000")
+	errInvalidPk = errors.New("invalid public key")
+
+	errInvalidSignature = errors.New("invalid signature")
+)
+
+func createMockArgsMultisigAccount() ArgsMultisigAccount {
+	return ArgsMultisigAccount{
+		Marshalizer: &mock.MarshalizerMock{},
+		Accounts:    &mock.AccountsStub{},
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				if len(b) != len(ownerAddress) {
+					return nil, errInvalidPk
+				}
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+		SingleSigner:                &mock.SignerMock{},
+		PubkeyConv:                  &mock.PubkeyConverterStub{},
+		SignMarshalizer:             &mock.MarshalizerMock{},
+		TxSignHasher:                mock.HasherMock{},
+		TxVersionChecker:            versioning.NewTxVersionChecker(1),
+		EpochNotifier:               &mock.EpochNotifierStub{},
+		MultisigAccountsEnableEpoch: 0,
+	}
+}
+
+func TestNewMultisigAccount_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.Marshalizer = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewMultisigAccount_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.Accounts = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewMultisigAccount_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.KeyGen = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewMultisigAccount_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.SingleSigner = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewMultisigAccount_NilPubkeyConvShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.PubkeyConv = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilPubkeyConverter, err)
+}
+
+func TestNewMultisigAccount_NilSignMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.SignMarshalizer = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewMultisigAccount_NilTxSignHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.TxSignHasher = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewMultisigAccount_NilTxVersionCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.TxVersionChecker = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilTransactionVersionChecker, err)
+}
+
+func TestNewMultisigAccount_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.EpochNotifier = nil
+
+	ma, err := NewMultisigAccount(args)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewMultisigAccount_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ma, err := NewMultisigAccount(createMockArgsMultisigAccount())
+	assert.False(t, check.IfNil(ma))
+	assert.Nil(t, err)
+}
+
+func TestMultisigAccount_SetMultisigNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMultisigAccount()
+	args.MultisigAccountsEnableEpoch = 2
+	ma, _ := NewMultisigAccount(args)
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	assert.Equal(t, process.ErrMultisigAccountsNotEnabled, ma.SetMultisig(account, 1, [][]byte{signerA}))
+	assert.False(t, ma.IsMultisigAccountsEnabled())
+
+	ma.EpochConfirmed(2)
+	assert.Nil(t, ma.SetMultisig(account, 1, [][]byte{signerA}))
+	assert.True(t, ma.IsMultisigAccountsEnabled())
+}
+
+func TestMultisigAccount_SetMultisigInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ma, _ := NewMultisigAccount(createMockArgsMultisigAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	tooManySigners := make([][]byte, MaxNumSigners+1)
+	assert.Equal(t, process.ErrNilUserAccount, ma.SetMultisig(nil, 1, [][]byte{signerA}))
+	assert.Equal(t, process.ErrInvalidNumberOfMultisigSigners, ma.SetMultisig(account, 1, nil))
+	assert.Equal(t, process.ErrInvalidNumberOfMultisigSigners, ma.SetMultisig(account, 1, tooManySigners))
+	assert.Equal(t, process.ErrInvalidMultisigThreshold, ma.SetMultisig(account, 0, [][]byte{signerA, signerB}))
+	assert.Equal(t, process.ErrInvalidMultisigThreshold, ma.SetMultisig(account, 3, [][]byte{signerA, signerB}))
+	assert.Equal(t, process.ErrDuplicatedMultisigSigner, ma.SetMultisig(account, 2, [][]byte{signerA, signerA}))
+	assert.Equal(t, errInvalidPk, ma.SetMultisig(account, 2, [][]byte{signerA, []byte("short")}))
+
+	_, err := ma.GetMultisigConfig(account)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+}
+
+func TestMultisigAccount_SetMultisigShouldSaveConfig(t *testing.T) {
+	t.Parallel()
+
+	ma, _ := NewMultisigAccount(createMockArgsMultisigAccount())
+	account, _ := state.NewUserAccount(ownerAddress)
+
+	require.Nil(t, ma.SetMultisig(account, 2, [][]byte{signerA, signerB, signerC}))
+
+	config, err := ma.GetMultisigConfig(account)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), config.Threshold)
+	assert.Equal(t, [][]byte{signerA, signerB, signerC}, config.Signers)
+}
+
+func TestMultisigAccount_GetMultisigConfigForAddress(t *testing.T) {
+	t.Parallel()
+
+	multisigAcc, _ := state.NewUserAccount(ownerAddress)
+	regularAcc, _ := state.NewUserAccount(signerA)
+	args := createMockArgsMultisigAccount()
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			switch string(address) {
+			case string(ownerAddress):
+				return multisigAcc, nil
+			case string(signerA):
+				return regularAcc, nil
+			default:
+				return nil, state.ErrAccNotFound
+			}
+		},
+	}
+	ma, _ := NewMultisigAccount(args)
+	require.Nil(t, ma.SetMultisig(multisigAcc, 1, [][]byte{signerA, signerB}))
+
+	config, err := ma.GetMultisigConfigForAddress(ownerAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), config.Threshold)
+
+	_, err = ma.GetMultisigConfigForAddress(signerA)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+
+	_, err = ma.GetMultisigConfigForAddress(signerB)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+}
+
+// createArgsWithSignerMocks returns arguments for which each signature is valid only if it is "sig-" followed by the
+// public key of the signer
+func createArgsWithSignerMocks() ArgsMultisigAccount {
+	args := createMockArgsMultisigAccount()
+	pubKeys := make(map[crypto.PublicKey][]byte)
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			pubKey := &mock.SingleSignPublicKey{}
+			pubKeys[pubKey] = b
+			return pubKey, nil
+		},
+	}
+	args.SingleSigner = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if !bytes.Equal(sig, append([]byte("sig-"), pubKeys[public]...)) {
+				return errInvalidSignature
+			}
+			return nil
+		},
+	}
+
+	return args
+}
+
+func TestMultisigAccount_VerifyMultisigSignatures(t *testing.T) {
+	t.Parallel()
+
+	ma, _ := NewMultisigAccount(createArgsWithSignerMocks())
+	config := &dataMultisig.MultisigConfig{Threshold: 2, Signers: [][]byte{signerA, signerB, signerC}}
+	sigA := append([]byte("sig-"), signerA...)
+	sigB := append([]byte("sig-"), signerB...)
+	sigC := append([]byte("sig-"), signerC...)
+	msg := []byte("message")
+
+	assert.Equal(t, process.ErrAccountIsNotMultisig, ma.VerifyMultisigSignatures(nil, msg, [][]byte{sigA, sigB}))
+	assert.Equal(t, process.ErrNotEnoughMultisigSignatures, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigA}))
+	assert.Equal(t, process.ErrTooManyMultisigSignatures, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigA, sigB, sigC, sigA}))
+	assert.Equal(t, process.ErrInvalidMultisigSignature, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigA, []byte("sig-other")}))
+	assert.Equal(t, process.ErrInvalidMultisigSignature, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigA, sigA}))
+	assert.Nil(t, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigC, sigA}))
+	assert.Nil(t, ma.VerifyMultisigSignatures(config, msg, [][]byte{sigA, sigB, sigC}))
+}
+
+func TestMultisigAccount_VerifyMultisigTransaction(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsWithSignerMocks()
+	ma, _ := NewMultisigAccount(args)
+	sigA := append([]byte("sig-"), signerA...)
+	sigB := append([]byte("sig-"), signerB...)
+	tx := &transaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(0),
+		SndAddr:    ownerAddress,
+		RcvAddr:    ownerAddress,
+		Version:    1,
+		Signatures: [][]byte{sigA, sigB},
+	}
+
+	assert.Equal(t, process.ErrNilTransaction, ma.VerifyMultisigTransaction(&dataMultisig.MultisigConfig{}, nil))
+
+	oldConfig := &dataMultisig.MultisigConfig{Threshold: 2, Signers: [][]byte{signerA, signerB}}
+	assert.Nil(t, ma.VerifyMultisigTransaction(oldConfig, tx))
+
+	// the signers have changed since the transaction was signed
+	newConfig := &dataMultisig.MultisigConfig{Threshold: 2, Signers: [][]byte{signerA, signerC}}
+	assert.Equal(t, process.ErrInvalidMultisigSignature, ma.VerifyMultisigTransaction(newConfig, tx))
+
+	// the threshold has been raised since the transaction was signed
+	raisedThresholdConfig := &dataMultisig.MultisigConfig{Threshold: 3, Signers: [][]byte{signerA, signerB, signerC}}
+	assert.Equal(t, process.ErrNotEnoughMultisigSignatures, ma.VerifyMultisigTransaction(raisedThresholdConfig, tx))
+}
+
+func TestDisabledMultisigAccount(t *testing.T) {
+	t.Parallel()
+
+	dma := NewDisabledMultisigAccount()
+	require.False(t, check.IfNil(dma))
+
+	account, _ := state.NewUserAccount(ownerAddress)
+	assert.Equal(t, process.ErrMultisigAccountsNotEnabled, dma.SetMultisig(account, 1, [][]byte{signerA}))
+	_, err := dma.GetMultisigConfig(account)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+	_, err = dma.GetMultisigConfigForAddress(ownerAddress)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+	assert.Equal(t, process.ErrAccountIsNotMultisig, dma.VerifyMultisigSignatures(&dataMultisig.MultisigConfig{}, nil, nil))
+	assert.Equal(t, process.ErrAccountIsNotMultisig, dma.VerifyMultisigTransaction(&dataMultisig.MultisigConfig{}, &transaction.Transaction{}))
+	assert.False(t, dma.IsMultisigAccountsEnabled())
+}
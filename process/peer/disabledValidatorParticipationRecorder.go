@@ -0,0 +1,39 @@
+package peer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.ValidatorParticipationRecorder = (*disabledValidatorParticipationRecorder)(nil)
+
+type disabledValidatorParticipationRecorder struct {
+}
+
+// NewDisabledValidatorParticipationRecorder returns a recorder which does not keep the validators participation
+func NewDisabledValidatorParticipationRecorder() *disabledValidatorParticipationRecorder {
+	return &disabledValidatorParticipationRecorder{}
+}
+
+// RecordParticipation does nothing
+func (dvpr *disabledValidatorParticipationRecorder) RecordParticipation(_ []byte, _ *state.ValidatorParticipation) {
+}
+
+// Reset does nothing
+func (dvpr *disabledValidatorParticipationRecorder) Reset() {
+}
+
+// Commit does nothing
+func (dvpr *disabledValidatorParticipationRecorder) Commit() error {
+	return nil
+}
+
+// GetParticipationHistory returns the not enabled error
+func (dvpr *disabledValidatorParticipationRecorder) GetParticipationHistory(_ []byte) (*state.ValidatorParticipationHistory, error) {
+	return nil, process.ErrValidatorParticipationNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dvpr *disabledValidatorParticipationRecorder) IsInterfaceNil() bool {
+	return dvpr == nil
+}
@@ -53,6 +53,7 @@ type ArgValidatorStatisticsProcessor struct {
 	SwitchJailWaitingEnableEpoch    uint32
 	BelowSignedThresholdEnableEpoch uint32
 	EpochNotifier                   process.EpochNotifier
+	ParticipationRecorder           process.ValidatorParticipationRecorder
 }
 
 type validatorStatistics struct {
@@ -74,6 +75,7 @@ type validatorStatistics struct {
 	jailedEnableEpoch               uint32
 	belowSignedThresholdEnableEpoch uint32
 	flagJailedEnabled               atomic.Flag
+	participationRecorder           process.ValidatorParticipationRecorder
 }
 
 // NewValidatorStatisticsProcessor instantiates a new validatorStatistics structure responsible of keeping account of
@@ -115,6 +117,9 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 	if check.IfNil(arguments.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(arguments.ParticipationRecorder) {
+		return nil, process.ErrNilValidatorParticipationRecorder
+	}
 
 	vs := &validatorStatistics{
 		peerAdapter:                     arguments.PeerAdapter,
@@ -132,6 +137,7 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 		ratingEnableEpoch:               arguments.RatingEnableEpoch,
 		jailedEnableEpoch:               arguments.SwitchJailWaitingEnableEpoch,
 		belowSignedThresholdEnableEpoch: arguments.BelowSignedThresholdEnableEpoch,
+		participationRecorder:           arguments.ParticipationRecorder,
 	}
 
 	arguments.EpochNotifier.RegisterNotifyHandler(vs)
@@ -318,6 +324,7 @@ func (vs *validatorStatistics) UpdatePeerState(header data.HeaderHandler, cache
 	vs.mutValidatorStatistics.Lock()
 	vs.missedBlocksCounters.reset()
 	vs.mutValidatorStatistics.Unlock()
+	vs.participationRecorder.Reset()
 
 	previousHeader, ok := cache[string(header.GetPrevHash())]
 	if !ok {
@@ -370,7 +377,8 @@ func (vs *validatorStatistics) UpdatePeerState(header data.HeaderHandler, cache
 		consensusGroup,
 		previousHeader.GetPubKeysBitmap(),
 		big.NewInt(0).Sub(previousHeader.GetAccumulatedFees(), previousHeader.GetDeveloperFees()),
-		previousHeader.GetShardID())
+		previousHeader.GetShardID(),
+		consensusGroupEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -774,7 +782,14 @@ func (vs *validatorStatistics) computeDecrease(
 		swInner.Stop("SetConsecutiveProposerMisses")
 
 		swInner.Start("SetTempRating")
+		previousTempRating := leaderPeerAcc.GetTempRating()
 		leaderPeerAcc.SetTempRating(newRating)
+		vs.recordParticipation(consensusGroup[0].PubKey(), leaderPeerAcc, previousTempRating, &state.ValidatorParticipation{
+			Epoch:              epoch,
+			ShardId:            shardID,
+			NumSelected:        1,
+			NumMissedProposals: 1,
+		})
 		vs.jailValidatorIfBadRatingAndInactive(leaderPeerAcc)
 
 		err = vs.peerAdapter.SaveAccount(leaderPeerAcc)
@@ -813,8 +828,15 @@ func (vs *validatorStatistics) decreaseForConsensusValidators(
 		}
 		vs.missedBlocksCounters.decreaseValidator(consensusGroup[j].PubKey())
 
-		newRating := vs.rater.ComputeDecreaseValidator(shardId, validatorPeerAccount.GetTempRating())
+		previousTempRating := validatorPeerAccount.GetTempRating()
+		newRating := vs.rater.ComputeDecreaseValidator(shardId, previousTempRating)
 		validatorPeerAccount.SetTempRating(newRating)
+		vs.recordParticipation(consensusGroup[j].PubKey(), validatorPeerAccount, previousTempRating, &state.ValidatorParticipation{
+			Epoch:               epoch,
+			ShardId:             shardId,
+			NumSelected:         1,
+			NumMissedSignatures: 1,
+		})
 		vs.jailValidatorIfBadRatingAndInactive(validatorPeerAccount)
 		err := vs.peerAdapter.SaveAccount(validatorPeerAccount)
 		if err != nil {
@@ -828,6 +850,8 @@ func (vs *validatorStatistics) decreaseForConsensusValidators(
 // RevertPeerState takes the current and previous headers and undos the peer state
 //  for all of the consensus members
 func (vs *validatorStatistics) RevertPeerState(header data.HeaderHandler) error {
+	vs.participationRecorder.Reset()
+
 	return vs.peerAdapter.RecreateTrie(header.GetValidatorStatsRootHash())
 }
 
@@ -867,6 +891,7 @@ func (vs *validatorStatistics) updateShardDataPeerState(
 			h.PubKeysBitmap,
 			big.NewInt(0).Sub(h.AccumulatedFees, h.DeveloperFees),
 			h.ShardID,
+			epoch,
 		)
 		if shardInfoErr != nil {
 			return shardInfoErr
@@ -959,6 +984,7 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 	signingBitmap []byte,
 	accumulatedFees *big.Int,
 	shardId uint32,
+	epoch uint32,
 ) error {
 
 	if len(signingBitmap) == 0 {
@@ -973,6 +999,8 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 
 		peerAcc.IncreaseNumSelectedInSuccessBlocks()
 
+		previousTempRating := peerAcc.GetTempRating()
+		participation := &state.ValidatorParticipation{Epoch: epoch, ShardId: shardId, NumSelected: 1}
 		newRating := peerAcc.GetRating()
 		isLeader := i == 0
 		validatorSigned := (signingBitmap[i/8] & (1 << (uint16(i) % 8))) != 0
@@ -985,15 +1013,21 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 			newRating = vs.rater.ComputeIncreaseProposer(shardId, peerAcc.GetTempRating())
 			leaderAccumulatedFees := core.GetPercentageOfValue(accumulatedFees, vs.rewardsHandler.LeaderPercentage())
 			peerAcc.AddToAccumulatedFees(leaderAccumulatedFees)
+			participation.NumProposed = 1
+		case leaderFail:
+			participation.NumProposed = 1
 		case validatorSuccess:
 			peerAcc.IncreaseValidatorSuccessRate(1)
 			newRating = vs.rater.ComputeIncreaseValidator(shardId, peerAcc.GetTempRating())
+			participation.NumSignatures = 1
 		case validatorIgnoredSignature:
 			peerAcc.IncreaseValidatorIgnoredSignaturesRate(1)
 			newRating = vs.rater.ComputeIncreaseValidator(shardId, peerAcc.GetTempRating())
+			participation.NumMissedSignatures = 1
 		}
 
 		peerAcc.SetTempRating(newRating)
+		vs.recordParticipation(validatorList[i].PubKey(), peerAcc, previousTempRating, participation)
 
 		err = vs.peerAdapter.SaveAccount(peerAcc)
 		if err != nil {
@@ -1004,6 +1038,19 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 	return nil
 }
 
+// recordParticipation completes the provided participation with the rating change of the validator and passes it to
+// the participation recorder, which keeps it until the block is committed
+func (vs *validatorStatistics) recordParticipation(
+	pubKey []byte,
+	peerAcc state.PeerAccountHandler,
+	previousTempRating uint32,
+	participation *state.ValidatorParticipation,
+) {
+	participation.RatingDelta = int64(peerAcc.GetTempRating()) - int64(previousTempRating)
+	participation.TempRating = peerAcc.GetTempRating()
+	vs.participationRecorder.RecordParticipation(pubKey, participation)
+}
+
 func (vs *validatorStatistics) loadPeerAccount(address []byte) (state.PeerAccountHandler, error) {
 	account, err := vs.peerAdapter.LoadAccount(address)
 	if err != nil {
@@ -1144,6 +1191,10 @@ func (vs *validatorStatistics) decreaseAll(
 	consensusGroupAppearances := uint32(float64(consensusGroupSize)*percentageRoundMissedFromTotalValidators +
 		1 - math.SmallestNonzeroFloat64)
 	ratingDifference := uint32(0)
+	missedSignaturesAppearances := uint32(0)
+	if consensusGroupAppearances > leaderAppearances {
+		missedSignaturesAppearances = consensusGroupAppearances - leaderAppearances
+	}
 
 	for i, validator := range shardValidators {
 		validatorPeerAccount, errLoad := vs.loadPeerAccount(validator)
@@ -1166,7 +1217,16 @@ func (vs *validatorStatistics) decreaseAll(
 			ratingDifference = validatorPeerAccount.GetTempRating() - currentTempRating
 		}
 
+		previousTempRating := validatorPeerAccount.GetTempRating()
 		validatorPeerAccount.SetTempRating(currentTempRating)
+		// the consensus groups of the missed rounds are not computed, so the expected appearances are recorded instead
+		vs.recordParticipation(validator, validatorPeerAccount, previousTempRating, &state.ValidatorParticipation{
+			Epoch:               epoch,
+			ShardId:             shardID,
+			NumSelected:         consensusGroupAppearances,
+			NumMissedProposals:  leaderAppearances,
+			NumMissedSignatures: missedSignaturesAppearances,
+		})
 		vs.jailValidatorIfBadRatingAndInactive(validatorPeerAccount)
 		err = vs.peerAdapter.SaveAccount(validatorPeerAccount)
 		if err != nil {
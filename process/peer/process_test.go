@@ -86,16 +86,17 @@ func createMockArguments() peer.ArgValidatorStatisticsProcessor {
 				return nil
 			},
 		},
-		StorageService:      &mock.ChainStorerMock{},
-		NodesCoordinator:    &mock.NodesCoordinatorMock{},
-		ShardCoordinator:    mock.NewOneShardCoordinatorMock(),
-		PubkeyConv:          createMockPubkeyConverter(),
-		PeerAdapter:         getAccountsMock(),
-		Rater:               createMockRater(),
-		RewardsHandler:      economicsData,
-		MaxComputableRounds: 1000,
-		NodesSetup:          &mock.NodesSetupStub{},
-		EpochNotifier:       &mock.EpochNotifierStub{},
+		StorageService:        &mock.ChainStorerMock{},
+		NodesCoordinator:      &mock.NodesCoordinatorMock{},
+		ShardCoordinator:      mock.NewOneShardCoordinatorMock(),
+		PubkeyConv:            createMockPubkeyConverter(),
+		PeerAdapter:           getAccountsMock(),
+		Rater:                 createMockRater(),
+		RewardsHandler:        economicsData,
+		MaxComputableRounds:   1000,
+		NodesSetup:            &mock.NodesSetupStub{},
+		EpochNotifier:         &mock.EpochNotifierStub{},
+		ParticipationRecorder: &mock.ValidatorParticipationRecorderStub{},
 	}
 	return arguments
 }
@@ -229,6 +230,17 @@ func TestNewValidatorStatisticsProcessor_NilDataPoolShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
 }
 
+func TestNewValidatorStatisticsProcessor_NilParticipationRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.ParticipationRecorder = nil
+	validatorStatistics, err := peer.NewValidatorStatisticsProcessor(arguments)
+
+	assert.Nil(t, validatorStatistics)
+	assert.Equal(t, process.ErrNilValidatorParticipationRecorder, err)
+}
+
 func TestNewValidatorStatisticsProcessor(t *testing.T) {
 	t.Parallel()
 
@@ -682,6 +694,53 @@ func TestValidatorStatisticsProcessor_UpdatePeerState_IncreasesIgnoredSignatures
 	assert.Equal(t, uint32(1), validator.IncreaseValidatorSuccessRateValue)
 }
 
+func TestValidatorStatisticsProcessor_UpdatePeerStateShouldRecordTheParticipation(t *testing.T) {
+	t.Parallel()
+
+	consensusGroup := make(map[string][]sharding.Validator)
+	arguments := createUpdateTestArgs(consensusGroup)
+	numResets := 0
+	participations := make(map[string]*state.ValidatorParticipation)
+	arguments.ParticipationRecorder = &mock.ValidatorParticipationRecorderStub{
+		RecordParticipationCalled: func(pubKey []byte, participation *state.ValidatorParticipation) {
+			participations[string(pubKey)] = participation
+		},
+		ResetCalled: func() {
+			numResets++
+		},
+	}
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	cache := createMockCache()
+	prevHeader, header := generateTestMetaBlockHeaders(cache)
+	prevHeader.PubKeysBitmap = []byte{5}
+	header.Round = prevHeader.Round + 2
+	header.Epoch = 1
+
+	v1 := mock.NewValidatorMock([]byte("pk1"))
+	v2 := mock.NewValidatorMock([]byte("pk2"))
+	v3 := mock.NewValidatorMock([]byte("pk3"))
+	v4 := mock.NewValidatorMock([]byte("pk4"))
+
+	prevHeaderConsensusKey := fmt.Sprintf(consensusGroupFormat, prevHeader.PrevRandSeed, prevHeader.Round, prevHeader.GetShardID(), prevHeader.Epoch)
+	consensusGroup[prevHeaderConsensusKey] = []sharding.Validator{v1, v2, v3}
+	missedHeaderConsensusKey := fmt.Sprintf(consensusGroupFormat, prevHeader.RandSeed, prevHeader.Round+1, prevHeader.GetShardID(), prevHeader.Epoch)
+	consensusGroup[missedHeaderConsensusKey] = []sharding.Validator{v4}
+
+	_, err := validatorStatistics.UpdatePeerState(header, cache)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numResets)
+
+	metaShard := prevHeader.GetShardID()
+	assert.Equal(t, &state.ValidatorParticipation{Epoch: 1, ShardId: metaShard, NumSelected: 1, NumProposed: 1}, participations["pk1"])
+	assert.Equal(t, &state.ValidatorParticipation{Epoch: 1, ShardId: metaShard, NumSelected: 1, NumMissedSignatures: 1}, participations["pk2"])
+	assert.Equal(t, &state.ValidatorParticipation{Epoch: 1, ShardId: metaShard, NumSelected: 1, NumSignatures: 1}, participations["pk3"])
+	assert.Equal(t, &state.ValidatorParticipation{Epoch: 1, ShardId: metaShard, NumSelected: 1, NumMissedProposals: 1}, participations["pk4"])
+
+	_ = validatorStatistics.RevertPeerState(header)
+	assert.Equal(t, 2, numResets)
+}
+
 func generateTestMetaBlockHeaders(cache map[string]data.HeaderHandler) (*block.MetaBlock, *block.MetaBlock) {
 	prevHeader := &block.MetaBlock{
 		Round:           1,
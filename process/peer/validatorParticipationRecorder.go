@@ -0,0 +1,184 @@
+package peer
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ process.ValidatorParticipationRecorder = (*validatorParticipationRecorder)(nil)
+
+// ArgValidatorParticipationRecorder holds all dependencies for the validatorParticipationRecorder
+type ArgValidatorParticipationRecorder struct {
+	Storer       storage.Storer
+	Marshalizer  marshal.Marshalizer
+	MaxNumEpochs uint32
+}
+
+type participationKey struct {
+	pubKey string
+	epoch  uint32
+}
+
+// validatorParticipationRecorder accumulates the participation computed for the block being processed and merges it
+// in the stored histories only when the block is committed
+type validatorParticipationRecorder struct {
+	storer       storage.Storer
+	marshalizer  marshal.Marshalizer
+	maxNumEpochs uint32
+
+	mutPending sync.Mutex
+	pending    map[participationKey]*state.ValidatorParticipation
+}
+
+// NewValidatorParticipationRecorder creates a recorder which keeps the participation history of each validator for the
+// most recent epochs in the provided storer
+func NewValidatorParticipationRecorder(args ArgValidatorParticipationRecorder) (*validatorParticipationRecorder, error) {
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.MaxNumEpochs == 0 {
+		return nil, process.ErrInvalidMaxNumEpochs
+	}
+
+	return &validatorParticipationRecorder{
+		storer:       args.Storer,
+		marshalizer:  args.Marshalizer,
+		maxNumEpochs: args.MaxNumEpochs,
+		pending:      make(map[participationKey]*state.ValidatorParticipation),
+	}, nil
+}
+
+// RecordParticipation adds the provided counters and rating delta to the pending participation of the validator in the
+// epoch of the provided participation. The temp rating is overwritten as it holds the last computed rating
+func (vpr *validatorParticipationRecorder) RecordParticipation(pubKey []byte, participation *state.ValidatorParticipation) {
+	if participation == nil {
+		return
+	}
+
+	vpr.mutPending.Lock()
+	defer vpr.mutPending.Unlock()
+
+	key := participationKey{pubKey: string(pubKey), epoch: participation.Epoch}
+	existing, ok := vpr.pending[key]
+	if !ok {
+		existing = &state.ValidatorParticipation{Epoch: participation.Epoch}
+		vpr.pending[key] = existing
+	}
+
+	mergeParticipation(existing, participation)
+}
+
+func mergeParticipation(destination *state.ValidatorParticipation, source *state.ValidatorParticipation) {
+	destination.ShardId = source.ShardId
+	destination.NumSelected += source.NumSelected
+	destination.NumProposed += source.NumProposed
+	destination.NumMissedProposals += source.NumMissedProposals
+	destination.NumSignatures += source.NumSignatures
+	destination.NumMissedSignatures += source.NumMissedSignatures
+	destination.RatingDelta += source.RatingDelta
+	destination.TempRating = source.TempRating
+}
+
+// Reset drops the pending participation, as the block it was computed for is either reprocessed or reverted
+func (vpr *validatorParticipationRecorder) Reset() {
+	vpr.mutPending.Lock()
+	vpr.pending = make(map[participationKey]*state.ValidatorParticipation)
+	vpr.mutPending.Unlock()
+}
+
+// Commit merges the pending participation in the stored histories
+func (vpr *validatorParticipationRecorder) Commit() error {
+	vpr.mutPending.Lock()
+	pending := vpr.pending
+	vpr.pending = make(map[participationKey]*state.ValidatorParticipation)
+	vpr.mutPending.Unlock()
+
+	pendingByPubKey := make(map[string][]*state.ValidatorParticipation)
+	for key, participation := range pending {
+		pendingByPubKey[key.pubKey] = append(pendingByPubKey[key.pubKey], participation)
+	}
+
+	for pubKey, participations := range pendingByPubKey {
+		err := vpr.commitForPubKey([]byte(pubKey), participations)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vpr *validatorParticipationRecorder) commitForPubKey(pubKey []byte, participations []*state.ValidatorParticipation) error {
+	history, err := vpr.GetParticipationHistory(pubKey)
+	if err != nil {
+		return err
+	}
+
+	for _, participation := range participations {
+		existing := findEpoch(history, participation.Epoch)
+		if existing == nil {
+			existing = &state.ValidatorParticipation{Epoch: participation.Epoch}
+			history.Epochs = append(history.Epochs, existing)
+		}
+
+		mergeParticipation(existing, participation)
+	}
+
+	sort.Slice(history.Epochs, func(i, j int) bool {
+		return history.Epochs[i].Epoch < history.Epochs[j].Epoch
+	})
+	if uint32(len(history.Epochs)) > vpr.maxNumEpochs {
+		history.Epochs = history.Epochs[uint32(len(history.Epochs))-vpr.maxNumEpochs:]
+	}
+
+	buff, err := vpr.marshalizer.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	return vpr.storer.Put(pubKey, buff)
+}
+
+func findEpoch(history *state.ValidatorParticipationHistory, epoch uint32) *state.ValidatorParticipation {
+	for _, participation := range history.Epochs {
+		if participation.Epoch == epoch {
+			return participation
+		}
+	}
+
+	return nil
+}
+
+// GetParticipationHistory returns the committed participation of the validator, sorted by epoch
+func (vpr *validatorParticipationRecorder) GetParticipationHistory(pubKey []byte) (*state.ValidatorParticipationHistory, error) {
+	history := &state.ValidatorParticipationHistory{
+		Epochs: make([]*state.ValidatorParticipation, 0),
+	}
+
+	buff, err := vpr.storer.Get(pubKey)
+	if err != nil {
+		// nothing recorded yet for this validator
+		return history, nil
+	}
+
+	err = vpr.marshalizer.Unmarshal(history, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vpr *validatorParticipationRecorder) IsInterfaceNil() bool {
+	return vpr == nil
+}
@@ -0,0 +1,141 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgValidatorParticipationRecorder() ArgValidatorParticipationRecorder {
+	return ArgValidatorParticipationRecorder{
+		Storer:       mock.NewStorerMock(),
+		Marshalizer:  &mock.MarshalizerMock{},
+		MaxNumEpochs: 2,
+	}
+}
+
+func TestNewValidatorParticipationRecorder(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgValidatorParticipationRecorder()
+	args.Storer = nil
+	vpr, err := NewValidatorParticipationRecorder(args)
+	assert.True(t, check.IfNil(vpr))
+	assert.Equal(t, process.ErrNilStorage, err)
+
+	args = createMockArgValidatorParticipationRecorder()
+	args.Marshalizer = nil
+	vpr, err = NewValidatorParticipationRecorder(args)
+	assert.True(t, check.IfNil(vpr))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgValidatorParticipationRecorder()
+	args.MaxNumEpochs = 0
+	vpr, err = NewValidatorParticipationRecorder(args)
+	assert.True(t, check.IfNil(vpr))
+	assert.Equal(t, process.ErrInvalidMaxNumEpochs, err)
+
+	args = createMockArgValidatorParticipationRecorder()
+	vpr, err = NewValidatorParticipationRecorder(args)
+	assert.False(t, check.IfNil(vpr))
+	assert.Nil(t, err)
+}
+
+func TestValidatorParticipationRecorder_CommitShouldMergeTheParticipationInTheHistory(t *testing.T) {
+	t.Parallel()
+
+	vpr, _ := NewValidatorParticipationRecorder(createMockArgValidatorParticipationRecorder())
+	pubKey := []byte("pk1")
+
+	history, err := vpr.GetParticipationHistory(pubKey)
+	require.Nil(t, err)
+	assert.Empty(t, history.Epochs)
+
+	vpr.RecordParticipation(pubKey, &state.ValidatorParticipation{Epoch: 3, ShardId: 1, NumSelected: 1, NumProposed: 1, RatingDelta: 10, TempRating: 510})
+	vpr.RecordParticipation(pubKey, &state.ValidatorParticipation{Epoch: 3, ShardId: 1, NumSelected: 1, NumMissedSignatures: 1, RatingDelta: -2, TempRating: 508})
+	vpr.RecordParticipation([]byte("pk2"), &state.ValidatorParticipation{Epoch: 3, ShardId: 1, NumSelected: 1, NumSignatures: 1})
+
+	// the pending participation is not visible before the commit
+	history, _ = vpr.GetParticipationHistory(pubKey)
+	assert.Empty(t, history.Epochs)
+
+	err = vpr.Commit()
+	require.Nil(t, err)
+
+	vpr.RecordParticipation(pubKey, &state.ValidatorParticipation{Epoch: 3, ShardId: 1, NumSelected: 1, NumMissedProposals: 1, RatingDelta: -5, TempRating: 503})
+	err = vpr.Commit()
+	require.Nil(t, err)
+
+	history, err = vpr.GetParticipationHistory(pubKey)
+	require.Nil(t, err)
+	expected := []*state.ValidatorParticipation{
+		{
+			Epoch:               3,
+			ShardId:             1,
+			NumSelected:         3,
+			NumProposed:         1,
+			NumMissedProposals:  1,
+			NumMissedSignatures: 1,
+			RatingDelta:         3,
+			TempRating:          503,
+		},
+	}
+	assert.Equal(t, expected, history.Epochs)
+
+	history, _ = vpr.GetParticipationHistory([]byte("pk2"))
+	require.Equal(t, 1, len(history.Epochs))
+	assert.Equal(t, uint32(1), history.Epochs[0].NumSignatures)
+}
+
+func TestValidatorParticipationRecorder_ResetShouldDropThePendingParticipation(t *testing.T) {
+	t.Parallel()
+
+	vpr, _ := NewValidatorParticipationRecorder(createMockArgValidatorParticipationRecorder())
+	pubKey := []byte("pk1")
+
+	vpr.RecordParticipation(pubKey, &state.ValidatorParticipation{Epoch: 3, NumSelected: 1})
+	vpr.Reset()
+	err := vpr.Commit()
+	require.Nil(t, err)
+
+	history, _ := vpr.GetParticipationHistory(pubKey)
+	assert.Empty(t, history.Epochs)
+}
+
+func TestValidatorParticipationRecorder_CommitShouldKeepTheMostRecentEpochs(t *testing.T) {
+	t.Parallel()
+
+	vpr, _ := NewValidatorParticipationRecorder(createMockArgValidatorParticipationRecorder())
+	pubKey := []byte("pk1")
+
+	for _, epoch := range []uint32{5, 3, 4} {
+		vpr.RecordParticipation(pubKey, &state.ValidatorParticipation{Epoch: epoch, NumSelected: 1})
+		err := vpr.Commit()
+		require.Nil(t, err)
+	}
+
+	history, _ := vpr.GetParticipationHistory(pubKey)
+	require.Equal(t, 2, len(history.Epochs))
+	assert.Equal(t, uint32(4), history.Epochs[0].Epoch)
+	assert.Equal(t, uint32(5), history.Epochs[1].Epoch)
+}
+
+func TestDisabledValidatorParticipationRecorder(t *testing.T) {
+	t.Parallel()
+
+	dvpr := NewDisabledValidatorParticipationRecorder()
+	assert.False(t, check.IfNil(dvpr))
+
+	dvpr.RecordParticipation([]byte("pk1"), &state.ValidatorParticipation{})
+	dvpr.Reset()
+	assert.Nil(t, dvpr.Commit())
+
+	history, err := dvpr.GetParticipationHistory([]byte("pk1"))
+	assert.Nil(t, history)
+	assert.Equal(t, process.ErrValidatorParticipationNotEnabled, err)
+}
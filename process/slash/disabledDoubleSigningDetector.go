@@ -0,0 +1,34 @@
+package slash
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.DoubleSigningDetector = (*disabledDoubleSigningDetector)(nil)
+
+type disabledDoubleSigningDetector struct {
+}
+
+// NewDisabledDoubleSigningDetector returns a double signing detector which does not look for double signing
+func NewDisabledDoubleSigningDetector() *disabledDoubleSigningDetector {
+	return &disabledDoubleSigningDetector{}
+}
+
+// ReceivedHeader does nothing
+func (dsd *disabledDoubleSigningDetector) ReceivedHeader(_ data.HeaderHandler, _ []byte) {
+}
+
+// ReceivedSignatureShare does nothing
+func (dsd *disabledDoubleSigningDetector) ReceivedSignatureShare(_ []byte, _ []byte, _ []byte) {
+}
+
+// GetProofs returns an empty slice
+func (dsd *disabledDoubleSigningDetector) GetProofs() [][]byte {
+	return make([][]byte, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsd *disabledDoubleSigningDetector) IsInterfaceNil() bool {
+	return dsd == nil
+}
@@ -0,0 +1,310 @@
+package slash
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+var log = logger.GetOrCreate("process/slash")
+
+var _ process.DoubleSigningDetector = (*doubleSigningDetector)(nil)
+
+// ArgDoubleSigningDetector is the argument for the double signing detector constructor
+type ArgDoubleSigningDetector struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	NodesCoordinator sharding.NodesCoordinator
+	ProofVerifier    process.DoubleSigningProofVerifier
+	CacheCapacity    int
+	MaxNumProofs     int
+}
+
+type receivedHeader struct {
+	buff    []byte
+	shardID uint32
+	round   uint64
+}
+
+type pendingShare struct {
+	pubKey    []byte
+	signature []byte
+}
+
+type signedHeaderRecord struct {
+	headerHash []byte
+	header     []byte
+	signature  []byte
+}
+
+// doubleSigningDetector remembers, for each key, round and shard, the first header proposed or signed by that key. When
+// the same key is found signing a different header in the same round and shard, a proof is built out of the two headers
+type doubleSigningDetector struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	nodesCoordinator sharding.NodesCoordinator
+	proofVerifier    process.DoubleSigningProofVerifier
+	maxNumProofs     int
+
+	mutDetector   sync.Mutex
+	headers       storage.Cacher
+	pendingShares storage.Cacher
+	records       storage.Cacher
+	proofs        [][]byte
+	proofKeys     map[string]struct{}
+}
+
+// NewDoubleSigningDetector creates a detector of double proposals and double signatures
+func NewDoubleSigningDetector(args ArgDoubleSigningDetector) (*doubleSigningDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, process.ErrNilDoubleSigningProofVerifier
+	}
+	if args.MaxNumProofs < 1 {
+		return nil, process.ErrInvalidMaxNumDoubleSigningProofs
+	}
+
+	headers, err := lrucache.NewCache(args.CacheCapacity)
+	if err != nil {
+		return nil, err
+	}
+	pendingShares, err := lrucache.NewCache(args.CacheCapacity)
+	if err != nil {
+		return nil, err
+	}
+	records, err := lrucache.NewCache(args.CacheCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doubleSigningDetector{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		nodesCoordinator: args.NodesCoordinator,
+		proofVerifier:    args.ProofVerifier,
+		maxNumProofs:     args.MaxNumProofs,
+		headers:          headers,
+		pendingShares:    pendingShares,
+		records:          records,
+		proofs:           make([][]byte, 0),
+		proofKeys:        make(map[string]struct{}),
+	}, nil
+}
+
+// ReceivedHeader records the header so that the signature shares given for it can be checked. If the header already
+// holds the leader signature, it is also checked against the other headers proposed by the same leader
+func (dsd *doubleSigningDetector) ReceivedHeader(header data.HeaderHandler, _ []byte) {
+	if check.IfNil(header) {
+		return
+	}
+
+	buff, err := dsd.marshalizer.Marshal(header)
+	if err != nil {
+		log.Trace("doubleSigningDetector.ReceivedHeader: marshal", "error", err)
+		return
+	}
+	headerHash, err := computeUnsignedHeaderHash(dsd.marshalizer, dsd.hasher, header)
+	if err != nil {
+		log.Trace("doubleSigningDetector.ReceivedHeader: hash", "error", err)
+		return
+	}
+
+	var leaderPubKey []byte
+	hasLeaderSignature := len(header.GetLeaderSignature()) > 0
+	if hasLeaderSignature {
+		leaderPubKey, err = dsd.getLeader(header)
+		if err != nil {
+			log.Trace("doubleSigningDetector.ReceivedHeader: leader", "error", err)
+			hasLeaderSignature = false
+		}
+	}
+
+	dsd.mutDetector.Lock()
+	defer dsd.mutDetector.Unlock()
+
+	received := &receivedHeader{
+		buff:    buff,
+		shardID: header.GetShardID(),
+		round:   header.GetRound(),
+	}
+	dsd.headers.Put(headerHash, received, len(buff))
+
+	if hasLeaderSignature {
+		dsd.checkRecord(slash.DoubleProposal, leaderPubKey, received, &signedHeaderRecord{
+			headerHash: headerHash,
+			header:     buff,
+			signature:  header.GetLeaderSignature(),
+		})
+	}
+
+	value, ok := dsd.pendingShares.Get(headerHash)
+	if !ok {
+		return
+	}
+	dsd.pendingShares.Remove(headerHash)
+
+	shares, ok := value.([]*pendingShare)
+	if !ok {
+		return
+	}
+	for _, share := range shares {
+		dsd.checkRecord(slash.DoubleSignature, share.pubKey, received, &signedHeaderRecord{
+			headerHash: headerHash,
+			header:     buff,
+			signature:  share.signature,
+		})
+	}
+}
+
+func (dsd *doubleSigningDetector) getLeader(header data.HeaderHandler) ([]byte, error) {
+	consensusGroup, err := computeConsensusGroup(dsd.nodesCoordinator, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusGroup[0].PubKey(), nil
+}
+
+// ReceivedSignatureShare checks the signature share against the other headers signed by the same key. The share is
+// kept aside until the header it was given for is received
+func (dsd *doubleSigningDetector) ReceivedSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if len(pubKey) == 0 || len(headerHash) == 0 || len(signatureShare) == 0 {
+		return
+	}
+
+	dsd.mutDetector.Lock()
+	defer dsd.mutDetector.Unlock()
+
+	value, ok := dsd.headers.Get(headerHash)
+	if !ok {
+		dsd.addPendingShare(pubKey, headerHash, signatureShare)
+		return
+	}
+
+	received, ok := value.(*receivedHeader)
+	if !ok {
+		return
+	}
+
+	dsd.checkRecord(slash.DoubleSignature, pubKey, received, &signedHeaderRecord{
+		headerHash: headerHash,
+		header:     received.buff,
+		signature:  signatureShare,
+	})
+}
+
+func (dsd *doubleSigningDetector) addPendingShare(pubKey []byte, headerHash []byte, signatureShare []byte) {
+	shares := make([]*pendingShare, 0)
+	value, ok := dsd.pendingShares.Get(headerHash)
+	if ok {
+		existingShares, isSlice := value.([]*pendingShare)
+		if isSlice {
+			shares = existingShares
+		}
+	}
+
+	shares = append(shares, &pendingShare{
+		pubKey:    pubKey,
+		signature: signatureShare,
+	})
+	dsd.pendingShares.Put(headerHash, shares, len(shares))
+}
+
+func (dsd *doubleSigningDetector) checkRecord(
+	proofType slash.ProofType,
+	pubKey []byte,
+	received *receivedHeader,
+	record *signedHeaderRecord,
+) {
+	recordKey := []byte(fmt.Sprintf("%d_%d_%d_%s", proofType, received.shardID, received.round, pubKey))
+	value, ok := dsd.records.Get(recordKey)
+	if !ok {
+		dsd.records.Put(recordKey, record, len(record.header))
+		return
+	}
+
+	existingRecord, ok := value.(*signedHeaderRecord)
+	if !ok || bytes.Equal(existingRecord.headerHash, record.headerHash) {
+		return
+	}
+
+	_, alreadyProven := dsd.proofKeys[string(recordKey)]
+	if alreadyProven {
+		return
+	}
+
+	proof := &slash.DoubleSigningProof{
+		Type:    proofType,
+		PubKey:  pubKey,
+		ShardID: received.shardID,
+		Round:   received.round,
+		First: &slash.SignedHeader{
+			Header:    existingRecord.header,
+			Signature: existingRecord.signature,
+		},
+		Second: &slash.SignedHeader{
+			Header:    record.header,
+			Signature: record.signature,
+		},
+	}
+	buff, err := dsd.marshalizer.Marshal(proof)
+	if err != nil {
+		log.Debug("doubleSigningDetector: marshal proof", "error", err)
+		return
+	}
+
+	_, err = dsd.proofVerifier.VerifyProof(buff)
+	if err != nil {
+		log.Debug("doubleSigningDetector: built an invalid proof", "pubKey", pubKey, "error", err)
+		return
+	}
+
+	log.Warn("double signing detected",
+		"type", proofType.String(),
+		"pubKey", pubKey,
+		"shard", received.shardID,
+		"round", received.round,
+	)
+
+	dsd.proofKeys[string(recordKey)] = struct{}{}
+	dsd.proofs = append(dsd.proofs, buff)
+	if len(dsd.proofs) > dsd.maxNumProofs {
+		dsd.proofs = dsd.proofs[1:]
+	}
+}
+
+// GetProofs returns the most recent proofs of double signing, marshalized as expected by the staking system smart
+// contract's slash function
+func (dsd *doubleSigningDetector) GetProofs() [][]byte {
+	dsd.mutDetector.Lock()
+	defer dsd.mutDetector.Unlock()
+
+	proofs := make([][]byte, len(dsd.proofs))
+	copy(proofs, dsd.proofs)
+
+	return proofs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsd *doubleSigningDetector) IsInterfaceNil() bool {
+	return dsd == nil
+}
@@ -0,0 +1,215 @@
+package slash
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgDoubleSigningDetector() ArgDoubleSigningDetector {
+	proofVerifier, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ComputeValidatorsGroupCalled = func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+		return []sharding.Validator{mock.NewValidatorMock(offendingPubKey)}, nil
+	}
+
+	return ArgDoubleSigningDetector{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           mock.HasherMock{},
+		NodesCoordinator: nodesCoordinator,
+		ProofVerifier:    proofVerifier,
+		CacheCapacity:    100,
+		MaxNumProofs:     2,
+	}
+}
+
+func unmarshalProof(t *testing.T, buff []byte) *slash.DoubleSigningProof {
+	proof := &slash.DoubleSigningProof{}
+	err := (&mock.MarshalizerMock{}).Unmarshal(proof, buff)
+	require.Nil(t, err)
+
+	return proof
+}
+
+func TestNewDoubleSigningDetector(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgDoubleSigningDetector()
+	args.Marshalizer = nil
+	dsd, err := NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgDoubleSigningDetector()
+	args.Hasher = nil
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.Equal(t, process.ErrNilHasher, err)
+
+	args = createMockArgDoubleSigningDetector()
+	args.NodesCoordinator = nil
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+
+	args = createMockArgDoubleSigningDetector()
+	args.ProofVerifier = nil
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.Equal(t, process.ErrNilDoubleSigningProofVerifier, err)
+
+	args = createMockArgDoubleSigningDetector()
+	args.MaxNumProofs = 0
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.Equal(t, process.ErrInvalidMaxNumDoubleSigningProofs, err)
+
+	args = createMockArgDoubleSigningDetector()
+	args.CacheCapacity = 0
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.True(t, check.IfNil(dsd))
+	assert.NotNil(t, err)
+
+	args = createMockArgDoubleSigningDetector()
+	dsd, err = NewDoubleSigningDetector(args)
+	assert.False(t, check.IfNil(dsd))
+	assert.Nil(t, err)
+	assert.Empty(t, dsd.GetProofs())
+}
+
+func TestDoubleSigningDetector_ReceivedSignatureSharesForDifferentHeadersShouldBuildProof(t *testing.T) {
+	t.Parallel()
+
+	dsd, _ := NewDoubleSigningDetector(createMockArgDoubleSigningDetector())
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash B")
+	firstHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, first)
+	secondHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, second)
+
+	dsd.ReceivedHeader(first, firstHash)
+	dsd.ReceivedSignatureShare(offendingPubKey, firstHash, signatureShare(t, offendingPubKey, first))
+	dsd.ReceivedSignatureShare([]byte("honest public key"), firstHash, signatureShare(t, []byte("honest public key"), first))
+	assert.Empty(t, dsd.GetProofs())
+
+	// the share for the second header is received before the header itself
+	dsd.ReceivedSignatureShare(offendingPubKey, secondHash, signatureShare(t, offendingPubKey, second))
+	assert.Empty(t, dsd.GetProofs())
+	dsd.ReceivedHeader(second, secondHash)
+
+	proofs := dsd.GetProofs()
+	require.Equal(t, 1, len(proofs))
+	proof := unmarshalProof(t, proofs[0])
+	assert.Equal(t, slash.DoubleSignature, proof.Type)
+	assert.Equal(t, offendingPubKey, proof.PubKey)
+	assert.Equal(t, uint32(1), proof.ShardID)
+	assert.Equal(t, uint64(10), proof.Round)
+
+	// the same offense is not proven twice
+	third := createHeader(10, "root hash C")
+	thirdHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, third)
+	dsd.ReceivedHeader(third, thirdHash)
+	dsd.ReceivedSignatureShare(offendingPubKey, thirdHash, signatureShare(t, offendingPubKey, third))
+	assert.Equal(t, 1, len(dsd.GetProofs()))
+}
+
+func TestDoubleSigningDetector_ReceivedSignatureSharesForTheSameHeaderShouldNotBuildProof(t *testing.T) {
+	t.Parallel()
+
+	dsd, _ := NewDoubleSigningDetector(createMockArgDoubleSigningDetector())
+	header := createHeader(10, "root hash A")
+	headerHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, header)
+
+	dsd.ReceivedHeader(header, headerHash)
+	dsd.ReceivedSignatureShare(offendingPubKey, headerHash, signatureShare(t, offendingPubKey, header))
+	dsd.ReceivedSignatureShare(offendingPubKey, headerHash, signatureShare(t, offendingPubKey, header))
+
+	// the final header only adds the aggregated signature, the bitmap and the leader signature
+	header.Signature = []byte("aggregated signature")
+	header.PubKeysBitmap = []byte{1}
+	signAsLeader(t, offendingPubKey, header)
+	dsd.ReceivedHeader(header, []byte("final header hash"))
+
+	assert.Empty(t, dsd.GetProofs())
+}
+
+func TestDoubleSigningDetector_ReceivedInvalidSignatureShareShouldNotBuildProof(t *testing.T) {
+	t.Parallel()
+
+	dsd, _ := NewDoubleSigningDetector(createMockArgDoubleSigningDetector())
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash B")
+	firstHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, first)
+	secondHash, _ := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, second)
+
+	dsd.ReceivedHeader(first, firstHash)
+	dsd.ReceivedHeader(second, secondHash)
+	dsd.ReceivedSignatureShare(offendingPubKey, firstHash, signatureShare(t, offendingPubKey, first))
+	dsd.ReceivedSignatureShare(offendingPubKey, secondHash, []byte("forged signature"))
+
+	assert.Empty(t, dsd.GetProofs())
+}
+
+func TestDoubleSigningDetector_ReceivedHeadersProposedByTheSameLeaderShouldBuildProof(t *testing.T) {
+	t.Parallel()
+
+	dsd, _ := NewDoubleSigningDetector(createMockArgDoubleSigningDetector())
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash B")
+	signAsLeader(t, offendingPubKey, first)
+	signAsLeader(t, offendingPubKey, second)
+
+	dsd.ReceivedHeader(first, []byte("first hash"))
+	dsd.ReceivedHeader(createHeader(11, "root hash B"), []byte("other round hash"))
+	assert.Empty(t, dsd.GetProofs())
+
+	dsd.ReceivedHeader(second, []byte("second hash"))
+
+	proofs := dsd.GetProofs()
+	require.Equal(t, 1, len(proofs))
+	proof := unmarshalProof(t, proofs[0])
+	assert.Equal(t, slash.DoubleProposal, proof.Type)
+	assert.Equal(t, offendingPubKey, proof.PubKey)
+	assert.Equal(t, uint64(10), proof.Round)
+}
+
+func TestDoubleSigningDetector_GetProofsShouldKeepOnlyTheMostRecentOnes(t *testing.T) {
+	t.Parallel()
+
+	dsd, _ := NewDoubleSigningDetector(createMockArgDoubleSigningDetector())
+	for round := uint64(1); round <= 3; round++ {
+		first := createHeader(round, "root hash A")
+		second := createHeader(round, "root hash B")
+		signAsLeader(t, offendingPubKey, first)
+		signAsLeader(t, offendingPubKey, second)
+		dsd.ReceivedHeader(first, nil)
+		dsd.ReceivedHeader(second, nil)
+	}
+
+	proofs := dsd.GetProofs()
+	require.Equal(t, 2, len(proofs))
+	assert.Equal(t, uint64(2), unmarshalProof(t, proofs[0]).Round)
+	assert.Equal(t, uint64(3), unmarshalProof(t, proofs[1]).Round)
+}
+
+func TestDisabledDoubleSigningDetector(t *testing.T) {
+	t.Parallel()
+
+	dsd := NewDisabledDoubleSigningDetector()
+	assert.False(t, check.IfNil(dsd))
+
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash B")
+	signAsLeader(t, offendingPubKey, first)
+	signAsLeader(t, offendingPubKey, second)
+	dsd.ReceivedHeader(first, nil)
+	dsd.ReceivedHeader(second, nil)
+	dsd.ReceivedSignatureShare(offendingPubKey, []byte("hash"), []byte("signature"))
+
+	assert.Empty(t, dsd.GetProofs())
+}
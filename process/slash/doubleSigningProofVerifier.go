@@ -0,0 +1,223 @@
+package slash
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.DoubleSigningProofVerifier = (*doubleSigningProofVerifier)(nil)
+
+// ArgDoubleSigningProofVerifier is the argument for the double signing proof verifier constructor
+type ArgDoubleSigningProofVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	SigVerifier      vm.MessageSignVerifier
+	NodesCoordinator sharding.NodesCoordinator
+	ChainID          []byte
+}
+
+type doubleSigningProofVerifier struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	sigVerifier      vm.MessageSignVerifier
+	nodesCoordinator sharding.NodesCoordinator
+	chainID          []byte
+}
+
+// NewDoubleSigningProofVerifier creates a verifier for the proofs of double signing
+func NewDoubleSigningProofVerifier(args ArgDoubleSigningProofVerifier) (*doubleSigningProofVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.SigVerifier) {
+		return nil, process.ErrNilMessageSignVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if len(args.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+
+	return &doubleSigningProofVerifier{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		sigVerifier:      args.SigVerifier,
+		nodesCoordinator: args.NodesCoordinator,
+		chainID:          args.ChainID,
+	}, nil
+}
+
+// VerifyProof decodes the provided proof and checks that the offending key signed two different headers of this chain
+// in the proof's round and shard, while being part of the consensus group. A double proposal is checked against the
+// leader signatures of the group leader while a double signature is checked against the consensus signature shares
+func (dspv *doubleSigningProofVerifier) VerifyProof(buff []byte) (*slash.DoubleSigningProof, error) {
+	proof := &slash.DoubleSigningProof{}
+	err := dspv.marshalizer.Unmarshal(proof, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(proof.PubKey) == 0 {
+		return nil, fmt.Errorf("%w: missing public key", process.ErrInvalidDoubleSigningProof)
+	}
+	if proof.Type != slash.DoubleProposal && proof.Type != slash.DoubleSignature {
+		return nil, fmt.Errorf("%w: unknown proof type %d", process.ErrInvalidDoubleSigningProof, proof.Type)
+	}
+
+	firstHash, err := dspv.verifySignedHeader(proof, proof.First)
+	if err != nil {
+		return nil, err
+	}
+	secondHash, err := dspv.verifySignedHeader(proof, proof.Second)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(firstHash, secondHash) {
+		return nil, fmt.Errorf("%w: the same header was signed twice", process.ErrInvalidDoubleSigningProof)
+	}
+
+	return proof, nil
+}
+
+// verifySignedHeader checks that the header belongs to this chain and that the offending key was selected in the
+// consensus group for its shard, round and epoch. It returns the hash of the header without any of its signatures,
+// which is also the message signed by the consensus group members
+func (dspv *doubleSigningProofVerifier) verifySignedHeader(proof *slash.DoubleSigningProof, signedHeader *slash.SignedHeader) ([]byte, error) {
+	if signedHeader == nil {
+		return nil, fmt.Errorf("%w: missing signed header", process.ErrInvalidDoubleSigningProof)
+	}
+
+	header, err := dspv.unmarshalHeader(proof.ShardID, signedHeader.Header)
+	if err != nil {
+		return nil, err
+	}
+	if header.GetShardID() != proof.ShardID || header.GetRound() != proof.Round {
+		return nil, fmt.Errorf("%w: header from shard %d round %d does not match the proof",
+			process.ErrInvalidDoubleSigningProof,
+			header.GetShardID(),
+			header.GetRound(),
+		)
+	}
+	if !bytes.Equal(header.GetChainID(), dspv.chainID) {
+		return nil, fmt.Errorf("%w: header from chain %s", process.ErrInvalidDoubleSigningProof, header.GetChainID())
+	}
+
+	err = dspv.checkConsensusMembership(proof, header)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHash, err := computeUnsignedHeaderHash(dspv.marshalizer, dspv.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+
+	signedMessage := headerHash
+	if proof.Type == slash.DoubleProposal {
+		signedMessage, err = computeLeaderSignedMessage(dspv.marshalizer, header)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = dspv.sigVerifier.Verify(signedMessage, signedHeader.Signature, proof.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidDoubleSigningProof, err.Error())
+	}
+
+	return headerHash, nil
+}
+
+func (dspv *doubleSigningProofVerifier) checkConsensusMembership(proof *slash.DoubleSigningProof, header data.HeaderHandler) error {
+	consensusGroup, err := computeConsensusGroup(dspv.nodesCoordinator, header)
+	if err != nil {
+		return fmt.Errorf("%w: %s", process.ErrInvalidDoubleSigningProof, err.Error())
+	}
+
+	if proof.Type == slash.DoubleProposal {
+		if !bytes.Equal(consensusGroup[0].PubKey(), proof.PubKey) {
+			return fmt.Errorf("%w: the key is not the leader of round %d", process.ErrInvalidDoubleSigningProof, header.GetRound())
+		}
+		return nil
+	}
+
+	for _, validator := range consensusGroup {
+		if bytes.Equal(validator.PubKey(), proof.PubKey) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: the key is not in the consensus group of round %d", process.ErrInvalidDoubleSigningProof, header.GetRound())
+}
+
+// computeConsensusGroup returns the consensus group which built the header. The start of epoch blocks are built by the
+// consensus group of the previous epoch
+func computeConsensusGroup(nodesCoordinator sharding.NodesCoordinator, header data.HeaderHandler) ([]sharding.Validator, error) {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, process.ErrEmptyConsensusGroup
+	}
+
+	return consensusGroup, nil
+}
+
+func (dspv *doubleSigningProofVerifier) unmarshalHeader(shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err := dspv.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// computeUnsignedHeaderHash computes the hash of the header without the aggregated signature, the bitmap and the
+// leader signature, as this is the message signed in the consensus
+func computeUnsignedHeaderHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher, header data.HeaderHandler) ([]byte, error) {
+	headerCopy := header.Clone()
+	headerCopy.SetSignature(nil)
+	headerCopy.SetPubKeysBitmap(nil)
+	headerCopy.SetLeaderSignature(nil)
+
+	return core.CalculateHash(marshalizer, hasher, headerCopy)
+}
+
+// computeLeaderSignedMessage returns the marshalized header without the leader signature, as this is the message
+// signed by the leader
+func computeLeaderSignedMessage(marshalizer marshal.Marshalizer, header data.HeaderHandler) ([]byte, error) {
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+
+	return marshalizer.Marshal(headerCopy)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dspv *doubleSigningProofVerifier) IsInterfaceNil() bool {
+	return dspv == nil
+}
@@ -0,0 +1,346 @@
+package slash
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	offendingPubKey = []byte("offending public key")
+	otherPubKey     = []byte("other public key")
+	testChainID     = []byte("chain ID")
+	errInvalidSig   = errors.New("invalid signature")
+)
+
+// testSign mimics a signature as the hash of the public key concatenated with the message
+func testSign(pubKey []byte, message []byte) []byte {
+	return mock.HasherMock{}.Compute(string(pubKey) + string(message))
+}
+
+func createSigVerifier() *mock.MessageSignVerifierStub {
+	return &mock.MessageSignVerifierStub{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if !bytes.Equal(signedMessage, testSign(pubKey, message)) {
+				return errInvalidSig
+			}
+			return nil
+		},
+	}
+}
+
+// createConsensusNodesCoordinator returns a nodes coordinator for which the offending key leads the consensus group
+func createConsensusNodesCoordinator() *mock.NodesCoordinatorMock {
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ComputeValidatorsGroupCalled = func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+		return []sharding.Validator{mock.NewValidatorMock(offendingPubKey), mock.NewValidatorMock(otherPubKey)}, nil
+	}
+
+	return nodesCoordinator
+}
+
+func createMockArgDoubleSigningProofVerifier() ArgDoubleSigningProofVerifier {
+	return ArgDoubleSigningProofVerifier{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           mock.HasherMock{},
+		SigVerifier:      createSigVerifier(),
+		NodesCoordinator: createConsensusNodesCoordinator(),
+		ChainID:          testChainID,
+	}
+}
+
+func createHeader(round uint64, rootHash string) *block.Header {
+	return &block.Header{
+		ShardID:      1,
+		Round:        round,
+		Nonce:        round,
+		RootHash:     []byte(rootHash),
+		PrevRandSeed: []byte("prev rand seed"),
+		ChainID:      testChainID,
+	}
+}
+
+// signatureShare returns the signature share given by the key for the header in the consensus
+func signatureShare(t *testing.T, pubKey []byte, header data.HeaderHandler) []byte {
+	headerHash, err := computeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, header)
+	require.Nil(t, err)
+
+	return testSign(pubKey, headerHash)
+}
+
+// signAsLeader sets the leader signature of the key on the header
+func signAsLeader(t *testing.T, pubKey []byte, header data.HeaderHandler) {
+	message, err := computeLeaderSignedMessage(&mock.MarshalizerMock{}, header)
+	require.Nil(t, err)
+
+	header.SetLeaderSignature(testSign(pubKey, message))
+}
+
+func createProof(t *testing.T, proofType slash.ProofType, first data.HeaderHandler, second data.HeaderHandler) *slash.DoubleSigningProof {
+	marshalizer := &mock.MarshalizerMock{}
+	proof := &slash.DoubleSigningProof{
+		Type:    proofType,
+		PubKey:  offendingPubKey,
+		ShardID: first.GetShardID(),
+		Round:   first.GetRound(),
+	}
+
+	signedHeaders := make([]*slash.SignedHeader, 0, 2)
+	for _, header := range []data.HeaderHandler{first, second} {
+		buff, err := marshalizer.Marshal(header)
+		require.Nil(t, err)
+
+		signature := header.GetLeaderSignature()
+		if proofType == slash.DoubleSignature {
+			signature = signatureShare(t, offendingPubKey, header)
+		}
+		signedHeaders = append(signedHeaders, &slash.SignedHeader{Header: buff, Signature: signature})
+	}
+	proof.First = signedHeaders[0]
+	proof.Second = signedHeaders[1]
+
+	return proof
+}
+
+func marshalProof(t *testing.T, proof *slash.DoubleSigningProof) []byte {
+	buff, err := (&mock.MarshalizerMock{}).Marshal(proof)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestNewDoubleSigningProofVerifier(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgDoubleSigningProofVerifier()
+	args.Marshalizer = nil
+	dspv, err := NewDoubleSigningProofVerifier(args)
+	assert.True(t, check.IfNil(dspv))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgDoubleSigningProofVerifier()
+	args.Hasher = nil
+	dspv, err = NewDoubleSigningProofVerifier(args)
+	assert.True(t, check.IfNil(dspv))
+	assert.Equal(t, process.ErrNilHasher, err)
+
+	args = createMockArgDoubleSigningProofVerifier()
+	args.SigVerifier = nil
+	dspv, err = NewDoubleSigningProofVerifier(args)
+	assert.True(t, check.IfNil(dspv))
+	assert.Equal(t, process.ErrNilMessageSignVerifier, err)
+
+	args = createMockArgDoubleSigningProofVerifier()
+	args.NodesCoordinator = nil
+	dspv, err = NewDoubleSigningProofVerifier(args)
+	assert.True(t, check.IfNil(dspv))
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+
+	args = createMockArgDoubleSigningProofVerifier()
+	args.ChainID = nil
+	dspv, err = NewDoubleSigningProofVerifier(args)
+	assert.True(t, check.IfNil(dspv))
+	assert.Equal(t, process.ErrInvalidChainID, err)
+
+	args = createMockArgDoubleSigningProofVerifier()
+	dspv, err = NewDoubleSigningProofVerifier(args)
+	assert.False(t, check.IfNil(dspv))
+	assert.Nil(t, err)
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+
+	proof, err := dspv.VerifyProof([]byte("not a proof"))
+	assert.Nil(t, proof)
+	assert.NotNil(t, err)
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofDoubleSignatureShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+
+	verifiedProof, err := dspv.VerifyProof(marshalProof(t, proof))
+	require.Nil(t, err)
+	assert.Equal(t, proof, verifiedProof)
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofDoubleProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	first := &block.MetaBlock{Round: 7, Nonce: 7, RootHash: []byte("root hash A"), Signature: []byte("aggregated A"), ChainID: testChainID}
+	second := &block.MetaBlock{Round: 7, Nonce: 7, RootHash: []byte("root hash B"), Signature: []byte("aggregated B"), ChainID: testChainID}
+	signAsLeader(t, offendingPubKey, first)
+	signAsLeader(t, offendingPubKey, second)
+	proof := createProof(t, slash.DoubleProposal, first, second)
+	assert.Equal(t, core.MetachainShardId, proof.ShardID)
+
+	verifiedProof, err := dspv.VerifyProof(marshalProof(t, proof))
+	require.Nil(t, err)
+	assert.Equal(t, proof, verifiedProof)
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofSameHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash A")
+	second.Signature = []byte("other aggregated signature")
+	second.PubKeysBitmap = []byte{1}
+	proof := createProof(t, slash.DoubleSignature, first, second)
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofDifferentRoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(11, "root hash B"))
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofDifferentShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	second := createHeader(10, "root hash B")
+	second.ShardID = 2
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), second)
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+	proof.Second.Signature = []byte("forged signature")
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+
+	proof = createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+	proof.PubKey = otherPubKey
+
+	_, err = dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofMissingFieldsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+	proof.Second = nil
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+
+	proof = createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+	proof.PubKey = nil
+	_, err = dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+
+	proof = createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+	proof.Type = 5
+	_, err = dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofHeadersFromOtherChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dspv, _ := NewDoubleSigningProofVerifier(createMockArgDoubleSigningProofVerifier())
+	first := createHeader(10, "root hash A")
+	first.ChainID = []byte("other chain ID")
+	second := createHeader(10, "root hash B")
+	second.ChainID = []byte("other chain ID")
+	proof := createProof(t, slash.DoubleSignature, first, second)
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofKeyNotInConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgDoubleSigningProofVerifier()
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ComputeValidatorsGroupCalled = func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+		assert.Equal(t, []byte("prev rand seed"), randomness)
+		assert.Equal(t, uint64(10), round)
+		assert.Equal(t, uint32(1), shardId)
+		assert.Equal(t, uint32(2), epoch)
+		return []sharding.Validator{mock.NewValidatorMock(otherPubKey)}, nil
+	}
+	args.NodesCoordinator = nodesCoordinator
+	dspv, _ := NewDoubleSigningProofVerifier(args)
+	first := createHeader(10, "root hash A")
+	first.Epoch = 2
+	second := createHeader(10, "root hash B")
+	second.Epoch = 2
+	proof := createProof(t, slash.DoubleSignature, first, second)
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofDoubleProposalFromOtherThanTheLeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgDoubleSigningProofVerifier()
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ComputeValidatorsGroupCalled = func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+		return []sharding.Validator{mock.NewValidatorMock(otherPubKey), mock.NewValidatorMock(offendingPubKey)}, nil
+	}
+	args.NodesCoordinator = nodesCoordinator
+	dspv, _ := NewDoubleSigningProofVerifier(args)
+	first := createHeader(10, "root hash A")
+	second := createHeader(10, "root hash B")
+	signAsLeader(t, offendingPubKey, first)
+	signAsLeader(t, offendingPubKey, second)
+	proof := createProof(t, slash.DoubleProposal, first, second)
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
+
+func TestDoubleSigningProofVerifier_VerifyProofConsensusGroupNotComputedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgDoubleSigningProofVerifier()
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodesCoordinator.ComputeValidatorsGroupCalled = func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+		return nil, errors.New("epoch not found")
+	}
+	args.NodesCoordinator = nodesCoordinator
+	dspv, _ := NewDoubleSigningProofVerifier(args)
+	proof := createProof(t, slash.DoubleSignature, createHeader(10, "root hash A"), createHeader(10, "root hash B"))
+
+	_, err := dspv.VerifyProof(marshalProof(t, proof))
+	assert.True(t, errors.Is(err, process.ErrInvalidDoubleSigningProof))
+}
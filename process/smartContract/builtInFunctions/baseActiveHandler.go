@@ -0,0 +1,30 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+)
+
+// baseAlwaysActive is embedded by the built-in functions which are active since genesis
+type baseAlwaysActive struct {
+}
+
+// IsActive returns true as the built-in function is always active
+func (b baseAlwaysActive) IsActive() bool {
+	return true
+}
+
+// baseEnabled is embedded by the built-in functions which become active starting with a configured epoch
+type baseEnabled struct {
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (b *baseEnabled) EpochConfirmed(epoch uint32) {
+	b.flagEnabled.Toggle(epoch >= b.enableEpoch)
+}
+
+// IsActive returns true if the built-in function is active in the current epoch
+func (b *baseEnabled) IsActive() bool {
+	return b.flagEnabled.IsSet()
+}
@@ -14,6 +14,7 @@ import (
 var _ process.BuiltinFunction = (*changeOwnerAddress)(nil)
 
 type changeOwnerAddress struct {
+	baseAlwaysActive
 	gasCost      uint64
 	mutExecution sync.RWMutex
 }
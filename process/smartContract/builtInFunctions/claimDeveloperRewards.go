@@ -15,6 +15,7 @@ import (
 var _ process.BuiltinFunction = (*claimDeveloperRewards)(nil)
 
 type claimDeveloperRewards struct {
+	baseAlwaysActive
 	gasCost      uint64
 	mutExecution sync.RWMutex
 }
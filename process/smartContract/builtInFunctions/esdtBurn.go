@@ -17,6 +17,7 @@ import (
 var _ process.BuiltinFunction = (*esdtBurn)(nil)
 
 type esdtBurn struct {
+	baseAlwaysActive
 	funcGasCost  uint64
 	marshalizer  marshal.Marshalizer
 	keyPrefix    []byte
@@ -15,6 +15,7 @@ import (
 var _ process.BuiltinFunction = (*esdtFreezeWipe)(nil)
 
 type esdtFreezeWipe struct {
+	baseAlwaysActive
 	marshalizer marshal.Marshalizer
 	keyPrefix   []byte
 	wipe        bool
@@ -0,0 +1,110 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalBurn)(nil)
+
+type esdtLocalBurn struct {
+	baseEnabled
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	mutExecution sync.RWMutex
+}
+
+// NewESDTLocalBurnFunc returns the esdt local burn built-in function component
+func NewESDTLocalBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtLocalBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtLocalBurn{
+		baseEnabled:  baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLocalBurn) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTLocalBurn
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT local burn function call
+// format: ESDTLocalBurn@tokenIdentifier@value
+func (e *esdtLocalBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleLocalBurn))
+	if err != nil {
+		return nil, err
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalBurn) IsInterfaceNil() bool {
+	return e == nil
+}
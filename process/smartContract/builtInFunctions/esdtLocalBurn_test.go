@@ -0,0 +1,83 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTLocalBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	localBurnFunc, err := NewESDTLocalBurnFunc(10, nil, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localBurnFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	localBurnFunc, err = NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, nil, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localBurnFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	localBurnFunc, err = NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localBurnFunc)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+
+	localBurnFunc, err = NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesFunc, 0, nil)
+	assert.Nil(t, localBurnFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTLocalBurn_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("burner")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalBurn)
+	saveESDTBalance(acnt, tokenID, 100, marshalizer)
+	localBurnFunc, _ := NewESDTLocalBurnFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 1, &mock.EpochNotifierStub{})
+
+	_, err := localBurnFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(acnt, tokenID, marshalizer))
+}
+
+func TestESDTLocalBurn_ProcessBuiltinFunctionWithoutRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("burner")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalMint)
+	saveESDTBalance(acnt, tokenID, 100, marshalizer)
+	localBurnFunc, _ := NewESDTLocalBurnFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := localBurnFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(acnt, tokenID, marshalizer))
+}
+
+func TestESDTLocalBurn_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("burner")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalBurn)
+	saveESDTBalance(acnt, tokenID, 100, marshalizer)
+	localBurnFunc, _ := NewESDTLocalBurnFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := localBurnFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 101))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	vmOutput, err := localBurnFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 40))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(50), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(60), getESDTBalance(acnt, tokenID, marshalizer))
+}
@@ -0,0 +1,132 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalMint)(nil)
+
+type esdtLocalMint struct {
+	baseEnabled
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	mutExecution sync.RWMutex
+}
+
+// NewESDTLocalMintFunc returns the esdt local mint built-in function component
+func NewESDTLocalMintFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtLocalMint, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtLocalMint{
+		baseEnabled:  baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLocalMint) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTLocalMint
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT local mint function call
+// format: ESDTLocalMint@tokenIdentifier@value
+func (e *esdtLocalMint) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleLocalMint))
+	if err != nil {
+		return nil, err
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	return vmOutput, nil
+}
+
+// checkInputArgumentsForLocalAction verifies the common input of the local mint and local burn functions: both of them
+// are sent by the role holder to itself and have exactly the token identifier and a positive value as arguments
+func checkInputArgumentsForLocalAction(
+	account state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) error {
+	err := checkNFTCreateBurnAddQuantityInput(account, vmInput, 2)
+	if err != nil {
+		return err
+	}
+	if len(vmInput.Arguments) != 2 {
+		return process.ErrInvalidArguments
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return process.ErrNegativeValue
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalMint) IsInterfaceNil() bool {
+	return e == nil
+}
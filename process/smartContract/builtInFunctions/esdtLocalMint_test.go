@@ -0,0 +1,136 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalActionInput(address []byte, tokenID []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: address,
+	}
+}
+
+func TestNewESDTLocalMintFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	localMintFunc, err := NewESDTLocalMintFunc(10, nil, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localMintFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	localMintFunc, err = NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, nil, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localMintFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	localMintFunc, err = NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localMintFunc)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+
+	localMintFunc, err = NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesFunc, 0, nil)
+	assert.Nil(t, localMintFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTLocalMint_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("minter")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalMint)
+	saveESDTBalance(acnt, tokenID, 100, marshalizer)
+	localMintFunc, _ := NewESDTLocalMintFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 1, &mock.EpochNotifierStub{})
+
+	_, err := localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(acnt, tokenID, marshalizer))
+}
+
+func TestESDTLocalMint_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("minter")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalBurn)
+	localMintFunc, _ := NewESDTLocalMintFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := localMintFunc.ProcessBuiltinFunction(nil, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createLocalActionInput(address, tokenID, 10)
+	input.RecipientAddr = []byte("other")
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createLocalActionInput(address, tokenID, 10)
+	input.Arguments = append(input.Arguments, []byte("extra"))
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 0))
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	acnt, rolesFunc = createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalMint)
+	localMintFunc, _ = NewESDTLocalMintFunc(500, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTLocalMint_ProcessBuiltinFunctionPausedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("minter")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalMint)
+	pauseHandler := &mock.PauseHandlerStub{
+		IsPausedCalled: func(_ []byte) bool {
+			return true
+		},
+	}
+	localMintFunc, _ := NewESDTLocalMintFunc(50, marshalizer, pauseHandler, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+}
+
+func TestESDTLocalMint_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("minter")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleLocalMint)
+	localMintFunc, _ := NewESDTLocalMintFunc(50, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	vmOutput, err := localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(50), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(acnt, tokenID, marshalizer))
+
+	_, err = localMintFunc.ProcessBuiltinFunction(acnt, nil, createLocalActionInput(address, tokenID, 5))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(15), getESDTBalance(acnt, tokenID, marshalizer))
+}
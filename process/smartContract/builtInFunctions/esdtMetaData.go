@@ -1,5 +1,9 @@
 package builtInFunctions
 
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
 const lengthOfESDTMetadata = 2
 
 const (
@@ -12,9 +16,15 @@ const (
 	MetadataFrozen = 1
 )
 
+const (
+	// MetadataTokenType is the location of the token type in the esdt global meta data
+	MetadataTokenType = 1
+)
+
 // ESDTGlobalMetadata represents esdt global metadata saved on system account
 type ESDTGlobalMetadata struct {
-	Paused bool
+	Paused    bool
+	TokenType core.ESDTType
 }
 
 // ESDTGlobalMetadataFromBytes creates a metadata object from bytes
@@ -24,7 +34,8 @@ func ESDTGlobalMetadataFromBytes(bytes []byte) ESDTGlobalMetadata {
 	}
 
 	return ESDTGlobalMetadata{
-		Paused: (bytes[0] & MetadataPaused) != 0,
+		Paused:    (bytes[0] & MetadataPaused) != 0,
+		TokenType: core.ESDTType(bytes[MetadataTokenType]),
 	}
 }
 
@@ -35,6 +46,7 @@ func (metadata *ESDTGlobalMetadata) ToBytes() []byte {
 	if metadata.Paused {
 		bytes[0] |= MetadataPaused
 	}
+	bytes[MetadataTokenType] = byte(metadata.TokenType)
 
 	return bytes
 }
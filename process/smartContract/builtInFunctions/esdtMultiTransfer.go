@@ -0,0 +1,222 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtMultiTransfer)(nil)
+
+// esdtMultiTransfer moves several fungible tokens from the sender to the receiver of the transaction. All the
+// transfers are executed or none: any failure reverts the whole call
+type esdtMultiTransfer struct {
+	baseEnabled
+	funcGasCost    uint64
+	marshalizer    marshal.Marshalizer
+	pauseHandler   process.ESDTPauseHandler
+	payableHandler process.PayableHandler
+	mutExecution   sync.RWMutex
+}
+
+// NewESDTMultiTransferFunc returns the esdt multi transfer built-in function component
+func NewESDTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtMultiTransfer{
+		baseEnabled:    baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:    funcGasCost,
+		marshalizer:    marshalizer,
+		pauseHandler:   pauseHandler,
+		payableHandler: &disabledPayableHandler{},
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtMultiTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MultiESDTTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT multi transfer function calls
+// format: MultiESDTTransfer@numOfTokens@tokenIdentifier1@value1@tokenIdentifier2@value2...[@function@arguments...]
+func (e *esdtMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	transfers, err := getESDTTransfersFromArguments(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+	numOfTransferArgs := getNumOfArgsForMultiTransfer(len(transfers))
+
+	gasToUse := e.funcGasCost * uint64(len(transfers))
+	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, gasToUse)
+
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		if vmInput.GasProvided < gasToUse {
+			return nil, process.ErrNotEnoughGas
+		}
+
+		for _, transfer := range transfers {
+			esdtTokenKey := computeESDTTokenKey(transfer.ESDTTokenName)
+			log.Trace("esdtMultiTransfer", "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "value", transfer.ESDTValue, "token", esdtTokenKey)
+
+			err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(transfer.ESDTValue), e.marshalizer, e.pauseHandler)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	isSCCallAfter := core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > numOfTransferArgs
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	if !check.IfNil(acntDst) {
+		mustVerifyPayable := vmInput.CallType != vmcommon.AsynchronousCallBack && !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress)
+		if mustVerifyPayable && len(vmInput.Arguments) == numOfTransferArgs {
+			isPayable, errPayable := e.payableHandler.IsPayable(vmInput.RecipientAddr)
+			if errPayable != nil {
+				return nil, errPayable
+			}
+			if !isPayable {
+				return nil, process.ErrAccountNotPayable
+			}
+		}
+
+		for _, transfer := range transfers {
+			esdtTokenKey := computeESDTTokenKey(transfer.ESDTTokenName)
+			err = addToESDTBalance(vmInput.CallerAddr, acntDst, esdtTokenKey, transfer.ESDTValue, e.marshalizer, e.pauseHandler)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if isSCCallAfter {
+			vmOutput.GasRemaining, err = core.SafeSubUint64(vmInput.GasProvided, gasToUse)
+			log.LogIfError(err, "esdtMultiTransfer", "isSCCallAfter")
+			var callArgs [][]byte
+			if len(vmInput.Arguments) > numOfTransferArgs+1 {
+				callArgs = vmInput.Arguments[numOfTransferArgs+1:]
+			}
+
+			addOutPutTransferToVMOutput(
+				string(vmInput.Arguments[numOfTransferArgs]),
+				callArgs,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	// cross-shard ESDT multi transfer call through a smart contract
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		addOutPutTransferToVMOutput(
+			core.BuiltInFunctionMultiESDTTransfer,
+			vmInput.Arguments,
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+// getESDTTransfersFromArguments parses the token/value pairs of a multi transfer call. The same token can not be
+// transferred twice in the same call
+func getESDTTransfersFromArguments(arguments [][]byte) ([]*vmcommon.ESDTTransfer, error) {
+	if len(arguments) < getNumOfArgsForMultiTransfer(1) {
+		return nil, process.ErrInvalidArguments
+	}
+
+	numOfTransfers := big.NewInt(0).SetBytes(arguments[0]).Uint64()
+	if numOfTransfers == 0 || numOfTransfers > uint64(len(arguments)) {
+		return nil, process.ErrInvalidArguments
+	}
+	if len(arguments) < getNumOfArgsForMultiTransfer(int(numOfTransfers)) {
+		return nil, process.ErrInvalidArguments
+	}
+
+	transfers := make([]*vmcommon.ESDTTransfer, 0, numOfTransfers)
+	tokens := make(map[string]struct{}, numOfTransfers)
+	for i := 0; i < int(numOfTransfers); i++ {
+		tokenID := arguments[1+2*i]
+		_, exists := tokens[string(tokenID)]
+		if exists {
+			return nil, process.ErrInvalidArguments
+		}
+		tokens[string(tokenID)] = struct{}{}
+
+		value := big.NewInt(0).SetBytes(arguments[2+2*i])
+		if value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		transfers = append(transfers, &vmcommon.ESDTTransfer{
+			ESDTTokenName: tokenID,
+			ESDTValue:     value,
+		})
+	}
+
+	return transfers, nil
+}
+
+func getNumOfArgsForMultiTransfer(numOfTransfers int) int {
+	return 1 + 2*numOfTransfers
+}
+
+func (e *esdtMultiTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,193 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveESDTBalance(acnt state.UserAccountHandler, tokenID []byte, value int64, marshalizer marshal.Marshalizer) {
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(value)}
+	marshaledData, _ := marshalizer.Marshal(esdtToken)
+	_ = acnt.DataTrieTracker().SaveKeyValue(computeESDTTokenKey(tokenID), marshaledData)
+}
+
+func getESDTBalance(acnt state.UserAccountHandler, tokenID []byte, marshalizer marshal.Marshalizer) *big.Int {
+	esdtData, _ := getESDTDataFromKey(acnt, computeESDTTokenKey(tokenID), marshalizer)
+	return esdtData.Value
+}
+
+func TestNewESDTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, err := NewESDTMultiTransferFunc(10, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, multiTransferFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	multiTransferFunc, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, multiTransferFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	multiTransferFunc, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, nil)
+	assert.Nil(t, multiTransferFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, multiTransferFunc.IsActive())
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	multiTransferFunc.EpochConfirmed(1)
+	assert.True(t, multiTransferFunc.IsActive())
+}
+
+func TestESDTMultiTransfer_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_, err := multiTransferFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{big.NewInt(1).Bytes(), []byte("token")}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{big.NewInt(0).Bytes(), []byte("token"), big.NewInt(1).Bytes()}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{big.NewInt(2).Bytes(), []byte("token"), big.NewInt(1).Bytes()}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{big.NewInt(2).Bytes(), []byte("token"), big.NewInt(1).Bytes(), []byte("token"), big.NewInt(1).Bytes()}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{big.NewInt(1).Bytes(), []byte("token"), big.NewInt(0).Bytes()}
+	_, err = multiTransferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input.Arguments = [][]byte{big.NewInt(2).Bytes(), []byte("token1"), big.NewInt(1).Bytes(), []byte("token2"), big.NewInt(1).Bytes()}
+	input.GasProvided = 19
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	_, err = multiTransferFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltinFunctionSingleShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	token1, token2 := []byte("token1"), []byte("token2")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{big.NewInt(2).Bytes(), token1, big.NewInt(10).Bytes(), token2, big.NewInt(20).Bytes()},
+		},
+	}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	saveESDTBalance(accSnd, token1, 100, marshalizer)
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	saveESDTBalance(accSnd, token1, 100, marshalizer)
+	saveESDTBalance(accSnd, token2, 100, marshalizer)
+	vmOutput, err := multiTransferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(30), vmOutput.GasRemaining)
+
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, token1, marshalizer))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, token2, marshalizer))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, token1, marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, token2, marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltinFunctionDestinationNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(_ []byte) (bool, error) {
+			return false, nil
+		},
+	})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+			Arguments: [][]byte{big.NewInt(1).Bytes(), []byte("token"), big.NewInt(10).Bytes()},
+		},
+	}
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+
+	_, err := multiTransferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltinFunctionDestInShardWithSCCall(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransferFunc, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_ = multiTransferFunc.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(_ []byte) (bool, error) {
+			return false, nil
+		},
+	})
+
+	scAddress := bytes.Repeat([]byte{0}, 32)
+	token1, token2 := []byte("token1"), []byte("token2")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 100,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{big.NewInt(2).Bytes(), token1, big.NewInt(10).Bytes(), token2, big.NewInt(20).Bytes(), []byte("pay"), []byte("arg")},
+		},
+		RecipientAddr: scAddress,
+	}
+	require.True(t, core.IsSmartContractAddress(scAddress))
+	accDst, _ := state.NewUserAccount(scAddress)
+
+	vmOutput, err := multiTransferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, token1, marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, token2, marshalizer))
+
+	outAcc := vmOutput.OutputAccounts[string(scAddress)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, []byte("pay@617267"), outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, uint64(80), outAcc.OutputTransfers[0].GasLimit)
+}
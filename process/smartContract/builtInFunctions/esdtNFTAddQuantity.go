@@ -0,0 +1,126 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTAddQuantity)(nil)
+
+type esdtNFTAddQuantity struct {
+	baseEnabled
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	mutExecution sync.RWMutex
+}
+
+// NewESDTNFTAddQuantityFunc returns the esdt NFT add quantity built-in function component
+func NewESDTNFTAddQuantityFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTAddQuantity, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTAddQuantity{
+		baseEnabled:  baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTAddQuantity) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTAddQuantity
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT add quantity function call
+// format: ESDTNFTAddQuantity@tokenIdentifier@nonce@quantity
+func (e *esdtNFTAddQuantity) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkNFTCreateBurnAddQuantityInput(acntSnd, vmInput, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+	if err != nil {
+		return nil, err
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	err = checkFrozeAndPause(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Add(esdtData.Value, value)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTAddQuantity) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,103 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTAddQuantityFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	addQuantityFunc, err := NewESDTNFTAddQuantityFunc(10, nil, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, addQuantityFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	addQuantityFunc, err = NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, nil, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, addQuantityFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	addQuantityFunc, err = NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, addQuantityFunc)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.PauseHandlerStub{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, pauseHandler, rolesFunc, createTokenTypeHandler(core.SemiFungible), 0, &mock.EpochNotifierStub{})
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, pauseHandler, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(address, tokenID, 10, 0))
+	assert.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(2).Bytes(), big.NewInt(5).Bytes()},
+		},
+		RecipientAddr: address,
+	}
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(0).Bytes()}
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(5).Bytes()}
+	input.GasProvided = 9
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	pauseHandler.IsPausedCalled = func(_ []byte) bool {
+		return true
+	}
+	input.GasProvided = 100
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	pauseHandler.IsPausedCalled = nil
+	vmOutput, err := addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, big.NewInt(15), esdtData.Value)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltinFunctionWithoutRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate)
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(5).Bytes()},
+		},
+		RecipientAddr: address,
+	}
+	_, err := addQuantityFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
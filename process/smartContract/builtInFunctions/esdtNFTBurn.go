@@ -0,0 +1,130 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTBurn)(nil)
+
+type esdtNFTBurn struct {
+	baseEnabled
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	mutExecution sync.RWMutex
+}
+
+// NewESDTNFTBurnFunc returns the esdt NFT burn built-in function component
+func NewESDTNFTBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTBurn{
+		baseEnabled:  baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTBurn) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTBurn
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT burn function call
+// format: ESDTNFTBurn@tokenIdentifier@nonce@quantity
+func (e *esdtNFTBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkNFTCreateBurnAddQuantityInput(acntSnd, vmInput, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleNFTBurn))
+	if err != nil {
+		return nil, err
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	err = checkFrozeAndPause(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.Cmp(esdtData.Value) > 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	esdtData.Value.Sub(esdtData.Value, value)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTBurn) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,84 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTNFTBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	burnFunc, err := NewESDTNFTBurnFunc(10, nil, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, burnFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	burnFunc, err = NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, nil, rolesFunc, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, burnFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	burnFunc, err = NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, burnFunc)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.SemiFungible), 0, &mock.EpochNotifierStub{})
+	burnFunc, _ := NewESDTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, 0, &mock.EpochNotifierStub{})
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(address, tokenID, 10, 0))
+	assert.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(4).Bytes()},
+		},
+		RecipientAddr: address,
+	}
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRoleInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTBurn)},
+		},
+	}
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, setRoleInput)
+	assert.Nil(t, err)
+
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+
+	esdtData, _ := getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, big.NewInt(6), esdtData.Value)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(7).Bytes()}
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	input.Arguments = [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(6).Bytes()}
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+
+	_, err = getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
@@ -0,0 +1,203 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const minNumOfArgsForNFTCreate = 7
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+type esdtNFTCreate struct {
+	baseEnabled
+	funcGasCost      uint64
+	gasConfig        process.BaseOperationCost
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	rolesHandler     process.ESDTRoleHandler
+	tokenTypeHandler process.ESDTTokenTypeHandler
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	gasConfig process.BaseOperationCost,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+	tokenTypeHandler process.ESDTTokenTypeHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+	if check.IfNil(tokenTypeHandler) {
+		return nil, process.ErrNilTokenTypeHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTCreate{
+		baseEnabled:      baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:      funcGasCost,
+		gasConfig:        gasConfig,
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		rolesHandler:     rolesHandler,
+		tokenTypeHandler: tokenTypeHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTCreate) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTCreate
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function call
+// format: ESDTNFTCreate@tokenIdentifier@initialQuantity@name@royalties@hash@attributes@uri[@uri...]
+// the created token nonce is returned as the single return data
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkNFTCreateBurnAddQuantityInput(acntSnd, vmInput, minNumOfArgsForNFTCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	totalLength := 0
+	for _, arg := range vmInput.Arguments {
+		totalLength += len(arg)
+	}
+	gasToUse := e.funcGasCost + e.gasConfig.StorePerByte*uint64(totalLength)
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	esdtType := e.tokenTypeHandler.GetTokenType(esdtTokenKey)
+	if esdtType != core.NonFungible && esdtType != core.SemiFungible {
+		return nil, process.ErrInvalidESDTTokenType
+	}
+	if esdtType == core.NonFungible && quantity.Cmp(big.NewInt(1)) != 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	royalties := uint32(big.NewInt(0).SetBytes(vmInput.Arguments[3]).Uint64())
+	if royalties > core.MaxRoyalty {
+		return nil, process.ErrInvalidRoyalties
+	}
+
+	err = checkFrozeAndPause(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := getLatestNonce(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	nonce++
+
+	esdtData := &esdt.ESDigitalToken{
+		Type:  uint32(esdtType),
+		Value: quantity,
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  royalties,
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveLatestNonce(acntSnd, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()},
+	}
+	return vmOutput, nil
+}
+
+// checkNFTCreateBurnAddQuantityInput validates the input of the functions which can only be called by an address
+// on itself, over its own tokens
+func checkNFTCreateBurnAddQuantityInput(
+	account state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	minNumOfArgs int,
+) error {
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+	if len(vmInput.Arguments) < minNumOfArgs {
+		return process.ErrInvalidArguments
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,245 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAccountWithESDTRoles(
+	marshalizer marshal.Marshalizer,
+	address []byte,
+	tokenID []byte,
+	roles ...string,
+) (state.UserAccountHandler, *esdtRoles) {
+	rolesFunc, _ := NewESDTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
+	acnt, _ := state.NewUserAccount(address)
+
+	arguments := [][]byte{tokenID}
+	for _, role := range roles {
+		arguments = append(arguments, []byte(role))
+	}
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  arguments,
+		},
+	}
+	_, _ = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+
+	return acnt, rolesFunc
+}
+
+func createNFTCreateInput(address []byte, tokenID []byte, quantity int64, royalties int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  address,
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(quantity).Bytes(),
+				[]byte("name"),
+				big.NewInt(royalties).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: address,
+	}
+}
+
+func createTokenTypeHandler(tokenType core.ESDTType) *mock.ESDTTokenTypeHandlerStub {
+	return &mock.ESDTTokenTypeHandlerStub{
+		GetTokenTypeCalled: func(_ []byte) core.ESDTType {
+			return tokenType
+		},
+	}
+}
+
+func TestNewESDTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	nftCreateFunc, err := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, nil, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesFunc, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilTokenTypeHandler, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, nil)
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 1, &mock.EpochNotifierStub{})
+	assert.False(t, nftCreateFunc.IsActive())
+
+	vmInput := createNFTCreateInput(address, tokenID, 1, 0)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, vmInput)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	nftCreateFunc.EpochConfirmed(1)
+	assert.True(t, nftCreateFunc.IsActive())
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTCreateInput(address, tokenID, 1, 0)
+	input.RecipientAddr = []byte("other")
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createNFTCreateInput(address, tokenID, 1, 0)
+	input.Arguments = input.Arguments[:minNumOfArgsForNFTCreate-1]
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTCreateInput(address, []byte("otherToken"), 1, 0)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input = createNFTCreateInput(address, tokenID, 1, 0)
+	input.GasProvided = 10
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createNFTCreateInput(address, tokenID, 2, 0)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input = createNFTCreateInput(address, tokenID, 1, int64(core.MaxRoyalty)+1)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+
+	input := createNFTCreateInput(address, tokenID, 1, 100)
+	vmOutput, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, vmOutput.ReturnData)
+	assert.True(t, vmOutput.GasRemaining < input.GasProvided)
+
+	vmOutput, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(2).Bytes()}, vmOutput.ReturnData)
+
+	esdtData, err := getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 2, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(core.NonFungible), esdtData.Type)
+	assert.Equal(t, big.NewInt(1), esdtData.Value)
+	assert.Equal(t, &esdt.MetaData{
+		Nonce:      2,
+		Name:       []byte("name"),
+		Creator:    address,
+		Royalties:  100,
+		Hash:       []byte("hash"),
+		Attributes: []byte("attributes"),
+		URIs:       [][]byte{[]byte("uri")},
+	}, esdtData.TokenMetaData)
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionSemiFungibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.SemiFungible), 0, &mock.EpochNotifierStub{})
+
+	input := createNFTCreateInput(address, tokenID, 50, 0)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+
+	esdtData, err := getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(core.SemiFungible), esdtData.Type)
+	assert.Equal(t, big.NewInt(50), esdtData.Value)
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionUsesTheIssuedTokenType(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.NonFungible), 0, &mock.EpochNotifierStub{})
+
+	input := createNFTCreateInput(address, tokenID, 50, 0)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input = createNFTCreateInput(address, tokenID, 1, 0)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Nil(t, err)
+
+	esdtData, err := getESDTNFTToken(acnt, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(core.NonFungible), esdtData.Type)
+}
+
+func TestESDTNFTCreate_ProcessBuiltinFunctionFungibleTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	address := []byte("creator")
+	acnt, rolesFunc := createAccountWithESDTRoles(marshalizer, address, tokenID, core.ESDTRoleNFTCreate)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.Fungible), 0, &mock.EpochNotifierStub{})
+
+	input := createNFTCreateInput(address, tokenID, 1, 0)
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+}
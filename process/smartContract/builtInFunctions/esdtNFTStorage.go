@@ -0,0 +1,133 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+var nonceKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier)
+
+func computeESDTTokenKey(tokenIdentifier []byte) []byte {
+	key := make([]byte, 0, len(esdtKeyPrefix)+len(tokenIdentifier))
+	key = append(key, esdtKeyPrefix...)
+
+	return append(key, tokenIdentifier...)
+}
+
+// computeESDTNFTTokenKey returns the key under which the token with the given nonce is saved: the nonce is appended
+// as big endian bytes to the fungible token key
+func computeESDTNFTTokenKey(esdtTokenKey []byte, nonce uint64) []byte {
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+	key := make([]byte, 0, len(esdtTokenKey)+len(nonceBytes))
+	key = append(key, esdtTokenKey...)
+
+	return append(key, nonceBytes...)
+}
+
+func getESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, error) {
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, nonce)
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(esdtNFTTokenKey)
+	if err != nil || len(marshaledData) == 0 {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	esdtData := &esdt.ESDigitalToken{}
+	err = marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+
+	return esdtData, nil
+}
+
+// saveESDTNFTToken saves the token under its nonce key. A token without any remaining quantity is removed
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	if esdtData.TokenMetaData == nil {
+		return process.ErrNFTDoesNotHaveMetadata
+	}
+
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, esdtData.TokenMetaData.Nonce)
+	if esdtData.Value.Cmp(zero) <= 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("esdt NFT saved", "addr", acnt.AddressBytes(), "value", esdtData.Value, "tokenKey", esdtNFTTokenKey)
+	return acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, marshaledData)
+}
+
+// checkFrozeAndPause returns an error if the token is paused or if it is frozen for the given account. The frozen flag
+// is kept in the properties saved under the fungible token key, for all the token's nonces
+func checkFrozeAndPause(
+	senderAddr []byte,
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) error {
+	if bytes.Equal(senderAddr, vm.ESDTSCAddress) {
+		return nil
+	}
+
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+	if pauseHandler.IsPaused(esdtTokenKey) {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	return nil
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, tokenID []byte) (uint64, error) {
+	nonceKey := getNonceKey(tokenID)
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(nonceKey)
+	if err != nil || len(nonceData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64(), nil
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, tokenID []byte, nonce uint64) error {
+	nonceKey := getNonceKey(tokenID)
+	return acnt.DataTrieTracker().SaveKeyValue(nonceKey, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+func getNonceKey(tokenID []byte) []byte {
+	key := make([]byte, 0, len(nonceKeyPrefix)+len(tokenID))
+	key = append(key, nonceKeyPrefix...)
+
+	return append(key, tokenID...)
+}
@@ -0,0 +1,363 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const minNumOfArgsForNFTTransfer = 4
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+// esdtNFTTransfer moves a quantity of a non fungible or semi fungible token. The transaction is sent by the token
+// holder to itself, having the destination as argument. A destination in the sender's shard is credited right away,
+// while a destination in another shard, which does not know the token's metadata, receives the token through a
+// smart contract result which carries the marshaled token data
+type esdtNFTTransfer struct {
+	baseEnabled
+	funcGasCost      uint64
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	payableHandler   process.PayableHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTTransfer{
+		baseEnabled:      baseEnabled{enableEpoch: enableEpoch},
+		funcGasCost:      funcGasCost,
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		payableHandler:   &disabledPayableHandler{},
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function call
+// format on sender: ESDTNFTTransfer@tokenIdentifier@nonce@quantity@destination[@function@arguments...]
+// format on destination: ESDTNFTTransfer@tokenIdentifier@nonce@quantity@marshaledTokenData[@function@arguments...]
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < minNumOfArgsForNFTTransfer {
+		return nil, process.ErrInvalidArguments
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return e.processNFTTransferOnSenderShard(acntSnd, vmInput, quantity)
+	}
+
+	// the destination side can only be reached through the smart contract result created on the sender's shard, when
+	// the sender is in another shard. Any other call could credit a token which was never debited from anyone
+	if !check.IfNil(acntSnd) || vmInput.CallType != vmcommon.AsynchronousCall {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	return e.processNFTTransferOnDestination(acntDst, vmInput, quantity)
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnSenderShard(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	quantity *big.Int,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntSnd) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	esdtTokenKey := computeESDTTokenKey(tokenID)
+	err := checkFrozeAndPause(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if quantity.Cmp(esdtData.Value) > 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	esdtData.Value.Sub(esdtData.Value, quantity)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	transferredData := &esdt.ESDigitalToken{
+		Type:          esdtData.Type,
+		Value:         quantity,
+		TokenMetaData: esdtData.TokenMetaData,
+	}
+	log.Trace("esdtNFTTransfer", "sender", vmInput.CallerAddr, "receiver", dstAddress, "quantity", quantity, "token", esdtTokenKey, "nonce", nonce)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	isSCCallAfter := len(vmInput.Arguments) > minNumOfArgsForNFTTransfer
+	if e.shardCoordinator.ComputeId(dstAddress) != e.shardCoordinator.SelfId() {
+		marshaledTransferredData, errMarshal := e.marshalizer.Marshal(transferredData)
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+
+		destinationArgs := [][]byte{tokenID, vmInput.Arguments[1], vmInput.Arguments[2], marshaledTransferredData}
+		destinationArgs = append(destinationArgs, vmInput.Arguments[minNumOfArgsForNFTTransfer:]...)
+		addNFTTransferToVMOutput(destinationArgs, dstAddress, vmInput.GasLocked, isSCCallAfter, vmOutput)
+
+		return vmOutput, nil
+	}
+
+	err = e.processNFTTransferOnSelfShardDestination(vmInput.CallerAddr, dstAddress, esdtTokenKey, transferredData, isSCCallAfter)
+	if err != nil {
+		return nil, err
+	}
+	if isSCCallAfter && core.IsSmartContractAddress(dstAddress) {
+		addSCCallAfterNFTTransferToVMOutput(vmInput, dstAddress, vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnSelfShardDestination(
+	callerAddress []byte,
+	dstAddress []byte,
+	esdtTokenKey []byte,
+	transferredData *esdt.ESDigitalToken,
+	isSCCallAfter bool,
+) error {
+	account, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+	acntDst, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addNFTToDestination(callerAddress, dstAddress, acntDst, esdtTokenKey, transferredData, isSCCallAfter)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(acntDst)
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnDestination(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	quantity *big.Int,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	transferredData := &esdt.ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(transferredData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if transferredData.TokenMetaData == nil || transferredData.TokenMetaData.Nonce != nonce {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+	if transferredData.Value == nil || transferredData.Value.Cmp(quantity) != 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	isSCCallAfter := len(vmInput.Arguments) > minNumOfArgsForNFTTransfer
+	esdtTokenKey := computeESDTTokenKey(vmInput.Arguments[0])
+	err = e.addNFTToDestination(vmInput.CallerAddr, vmInput.RecipientAddr, acntDst, esdtTokenKey, transferredData, isSCCallAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+	}
+	if isSCCallAfter && core.IsSmartContractAddress(vmInput.RecipientAddr) {
+		addSCCallAfterNFTTransferToVMOutput(vmInput, vmInput.RecipientAddr, vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) addNFTToDestination(
+	callerAddress []byte,
+	dstAddress []byte,
+	acntDst state.UserAccountHandler,
+	esdtTokenKey []byte,
+	transferredData *esdt.ESDigitalToken,
+	isSCCallAfter bool,
+) error {
+	mustVerifyPayable := !isSCCallAfter && !bytes.Equal(callerAddress, vm.ESDTSCAddress)
+	if mustVerifyPayable {
+		isPayable, err := e.payableHandler.IsPayable(dstAddress)
+		if err != nil {
+			return err
+		}
+		if !isPayable {
+			return process.ErrAccountNotPayable
+		}
+	}
+
+	err := checkFrozeAndPause(callerAddress, acntDst, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	esdtData, err := getESDTNFTToken(acntDst, esdtTokenKey, transferredData.TokenMetaData.Nonce, e.marshalizer)
+	if err != nil {
+		esdtData = transferredData
+	} else {
+		esdtData.Value.Add(esdtData.Value, transferredData.Value)
+	}
+
+	return saveESDTNFTToken(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+}
+
+// addSCCallAfterNFTTransferToVMOutput creates the output transfer which calls the smart contract function following
+// the transfer, once the token was credited on the destination
+func addSCCallAfterNFTTransferToVMOutput(vmInput *vmcommon.ContractCallInput, dstAddress []byte, vmOutput *vmcommon.VMOutput) {
+	var callArgs [][]byte
+	if len(vmInput.Arguments) > minNumOfArgsForNFTTransfer+1 {
+		callArgs = vmInput.Arguments[minNumOfArgsForNFTTransfer+1:]
+	}
+
+	addOutPutTransferToVMOutput(
+		string(vmInput.Arguments[minNumOfArgsForNFTTransfer]),
+		callArgs,
+		dstAddress,
+		vmInput.GasLocked,
+		vmOutput)
+}
+
+// addNFTTransferToVMOutput creates the output transfer which will deliver the token on the destination. The remaining
+// gas is forwarded only if a smart contract call follows the transfer, otherwise it is refunded on the sender's shard
+func addNFTTransferToVMOutput(
+	arguments [][]byte,
+	recipient []byte,
+	gasLocked uint64,
+	isSCCallAfter bool,
+	vmOutput *vmcommon.VMOutput,
+) {
+	nftTransferTxData := core.BuiltInFunctionESDTNFTTransfer
+	for _, arg := range arguments {
+		nftTransferTxData += "@" + hex.EncodeToString(arg)
+	}
+
+	outTransfer := vmcommon.OutputTransfer{
+		Value:    big.NewInt(0),
+		Data:     []byte(nftTransferTxData),
+		CallType: vmcommon.AsynchronousCall,
+	}
+	if isSCCallAfter {
+		outTransfer.GasLimit = vmOutput.GasRemaining
+		outTransfer.GasLocked = gasLocked
+		vmOutput.GasRemaining = 0
+	}
+
+	vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	vmOutput.OutputAccounts[string(recipient)] = &vmcommon.OutputAccount{
+		Address:         recipient,
+		OutputTransfers: []vmcommon.OutputTransfer{outTransfer},
+	}
+}
+
+func (e *esdtNFTTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,275 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transferFunc, err := NewESDTNFTTransferFunc(10, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, transferFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	transferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, transferFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	transferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, transferFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	transferFunc, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, transferFunc)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	transferFunc, _ := NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	_, err := transferFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+			Arguments: [][]byte{[]byte("token"), big.NewInt(1).Bytes()},
+		},
+	}
+	_, err = transferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(1).Bytes(), big.NewInt(0).Bytes(), []byte("destination")}
+	_, err = transferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments = [][]byte{[]byte("token"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), []byte("destination")}
+	input.CallerAddr = []byte("sender")
+	input.RecipientAddr = []byte("receiver")
+	_, err = transferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input.RecipientAddr = input.CallerAddr
+	_, err = transferFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	acnt, _ := state.NewUserAccount(input.CallerAddr)
+	_, err = transferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments[3] = input.CallerAddr
+	_, err = transferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input.Arguments[3] = []byte("dstAdd")
+	_, err = transferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input.GasProvided = 10
+	_, err = transferFunc.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltinFunctionSenderAndDestination(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	senderAddress := []byte("sender")
+	destinationAddress := []byte("destin")
+	acntSnd, rolesFunc := createAccountWithESDTRoles(marshalizer, senderAddress, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	acntDst, _ := state.NewUserAccount(destinationAddress)
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.SemiFungible), 0, &mock.EpochNotifierStub{})
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, destinationAddress) {
+			return 1
+		}
+		return 0
+	}
+	transferFunc, _ := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, shardCoordinator, 0, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTCreateInput(senderAddress, tokenID, 10, 0))
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(4).Bytes(), destinationAddress},
+		},
+		RecipientAddr: senderAddress,
+	}
+	vmOutput, err := transferFunc.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acntSnd, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, big.NewInt(6), esdtData.Value)
+
+	outAcc := vmOutput.OutputAccounts[string(destinationAddress)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	outTransfer := outAcc.OutputTransfers[0]
+	assert.Equal(t, vmcommon.AsynchronousCall, outTransfer.CallType)
+	assert.True(t, bytes.HasPrefix(outTransfer.Data, []byte(core.BuiltInFunctionESDTNFTTransfer+"@")))
+
+	tokens := strings.Split(string(outTransfer.Data), "@")
+	assert.Equal(t, core.BuiltInFunctionESDTNFTTransfer, tokens[0])
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		arg, errDecode := hex.DecodeString(token)
+		require.Nil(t, errDecode)
+		arguments = append(arguments, arg)
+	}
+	require.Equal(t, minNumOfArgsForNFTTransfer, len(arguments))
+
+	destinationInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: senderAddress,
+			CallType:   vmcommon.AsynchronousCall,
+			Arguments:  arguments,
+		},
+		RecipientAddr: destinationAddress,
+	}
+	_, err = transferFunc.ProcessBuiltinFunction(nil, acntDst, destinationInput)
+	require.Nil(t, err)
+	_, err = transferFunc.ProcessBuiltinFunction(nil, acntDst, destinationInput)
+	require.Nil(t, err)
+
+	esdtData, err = getESDTNFTToken(acntDst, computeESDTTokenKey(tokenID), 1, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(8), esdtData.Value)
+	assert.Equal(t, senderAddress, esdtData.TokenMetaData.Creator)
+	assert.Equal(t, []byte("attributes"), esdtData.TokenMetaData.Attributes)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltinFunctionDestinationNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	transferFunc, _ := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(_ []byte) (bool, error) {
+			return false, nil
+		},
+	})
+
+	acntDst, _ := state.NewUserAccount([]byte("destin"))
+	transferredData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{
+		Type:          uint32(core.NonFungible),
+		Value:         big.NewInt(1),
+		TokenMetaData: &esdt.MetaData{Nonce: 1},
+	})
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: []byte("sender"),
+			CallType:   vmcommon.AsynchronousCall,
+			Arguments:  [][]byte{[]byte("token"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), transferredData},
+		},
+		RecipientAddr: []byte("destin"),
+	}
+	_, err := transferFunc.ProcessBuiltinFunction(nil, acntDst, input)
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltinFunctionSelfShardDestinationShouldBeCreditedDirectly(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	senderAddress := []byte("sender")
+	destinationAddress := []byte("destin")
+	acntSnd, rolesFunc := createAccountWithESDTRoles(marshalizer, senderAddress, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	acntDst, _ := state.NewUserAccount(destinationAddress)
+	savedAccounts := make(map[string]state.AccountHandler)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			require.Equal(t, destinationAddress, address)
+			return acntDst, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccounts[string(account.AddressBytes())] = account
+			return nil
+		},
+	}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, rolesFunc, createTokenTypeHandler(core.SemiFungible), 0, &mock.EpochNotifierStub{})
+	transferFunc, _ := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, accounts, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTCreateInput(senderAddress, tokenID, 10, 0))
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  senderAddress,
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(4).Bytes(), destinationAddress},
+		},
+		RecipientAddr: senderAddress,
+	}
+	vmOutput, err := transferFunc.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.Equal(t, acntDst, savedAccounts[string(destinationAddress)])
+
+	esdtData, _ := getESDTNFTToken(acntSnd, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, big.NewInt(6), esdtData.Value)
+	esdtData, err = getESDTNFTToken(acntDst, computeESDTTokenKey(tokenID), 1, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(4), esdtData.Value)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltinFunctionSelfShardAsyncCallWithForgedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	contractAddress := []byte("contract")
+	destinationAddress := []byte("destin")
+	acntSnd, _ := state.NewUserAccount(contractAddress)
+	acntDst, _ := state.NewUserAccount(destinationAddress)
+	transferFunc, _ := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), 0, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	forgedToken, _ := marshalizer.Marshal(&esdt.ESDigitalToken{
+		Type:          uint32(core.NonFungible),
+		Value:         big.NewInt(1000),
+		TokenMetaData: &esdt.MetaData{Nonce: 1, Creator: contractAddress},
+	})
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: contractAddress,
+			CallType:   vmcommon.AsynchronousCall,
+			Arguments:  [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(1000).Bytes(), forgedToken},
+		},
+		RecipientAddr: destinationAddress,
+	}
+	vmOutput, err := transferFunc.ProcessBuiltinFunction(acntSnd, acntDst, input)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	_, err = getESDTNFTToken(acntDst, computeESDTTokenKey(tokenID), 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
@@ -14,6 +14,7 @@ import (
 var _ process.BuiltinFunction = (*esdtPause)(nil)
 
 type esdtPause struct {
+	baseAlwaysActive
 	keyPrefix []byte
 	pause     bool
 	accounts  state.AccountsAdapter
@@ -92,7 +93,11 @@ func (e *esdtPause) togglePause(token []byte) error {
 }
 
 func (e *esdtPause) getSystemAccount() (state.UserAccountHandler, error) {
-	systemSCAccount, err := e.accounts.LoadAccount(core.SystemAccountAddress)
+	return loadSystemAccount(e.accounts)
+}
+
+func loadSystemAccount(accounts state.AccountsAdapter) (state.UserAccountHandler, error) {
+	systemSCAccount, err := accounts.LoadAccount(core.SystemAccountAddress)
 	if err != nil {
 		return nil, err
 	}
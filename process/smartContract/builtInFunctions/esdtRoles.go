@@ -0,0 +1,196 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var roleKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier)
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+
+type esdtRoles struct {
+	baseEnabled
+	set         bool
+	marshalizer marshal.Marshalizer
+}
+
+// NewESDTRolesFunc returns the esdt change roles built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtRoles{
+		baseEnabled: baseEnabled{enableEpoch: enableEpoch},
+		set:         set,
+		marshalizer: marshalizer,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtRoles) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT change roles function call
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := computeESDTRoleKey(vmInput.Arguments[0])
+	log.Trace(vmInput.Function, "address", vmInput.RecipientAddr, "token", esdtTokenRoleKey)
+
+	roles, _, err := getESDTRolesForAcnt(acntDst, esdtTokenRoleKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		addRoles(roles, vmInput.Arguments[1:])
+	} else {
+		deleteRoles(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveRolesToAccount(acntDst, esdtTokenRoleKey, roles, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+func addRoles(roles *esdt.ESDTRoles, rolesToAdd [][]byte) {
+	for _, role := range rolesToAdd {
+		if !isRoleInList(role, roles.Roles) {
+			roles.Roles = append(roles.Roles, role)
+		}
+	}
+}
+
+func deleteRoles(roles *esdt.ESDTRoles, rolesToDelete [][]byte) {
+	remainingRoles := make([][]byte, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		if !isRoleInList(role, rolesToDelete) {
+			remainingRoles = append(remainingRoles, role)
+		}
+	}
+
+	roles.Roles = remainingRoles
+}
+
+func isRoleInList(role []byte, roles [][]byte) bool {
+	for _, r := range roles {
+		if bytes.Equal(r, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func computeESDTRoleKey(tokenIdentifier []byte) []byte {
+	key := make([]byte, 0, len(roleKeyPrefix)+len(tokenIdentifier))
+	key = append(key, roleKeyPrefix...)
+
+	return append(key, tokenIdentifier...)
+}
+
+func getESDTRolesForAcnt(
+	acnt state.UserAccountHandler,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDTRoles, bool, error) {
+	roles := &esdt.ESDTRoles{
+		Roles: make([][]byte, 0),
+	}
+
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, true, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return roles, false, nil
+}
+
+func saveRolesToAccount(
+	acnt state.UserAccountHandler,
+	key []byte,
+	roles *esdt.ESDTRoles,
+	marshalizer marshal.Marshalizer,
+) error {
+	if len(roles.Roles) == 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// CheckAllowedToExecute returns nil if the account has the given role for the provided token
+func (e *esdtRoles) CheckAllowedToExecute(account state.UserAccountHandler, tokenID []byte, action []byte) error {
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+
+	roles, isNew, err := getESDTRolesForAcnt(account, computeESDTRoleKey(tokenID), e.marshalizer)
+	if err != nil {
+		return err
+	}
+	if isNew || !isRoleInList(action, roles.Roles) {
+		return process.ErrActionNotAllowed
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,136 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, err := NewESDTRolesFunc(nil, true, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, rolesFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewESDTRolesFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, err := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, nil)
+	assert.Nil(t, rolesFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTRoles_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 1, &mock.EpochNotifierStub{})
+	assert.False(t, rolesFunc.IsActive())
+
+	acnt, _ := state.NewUserAccount([]byte("destination"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{[]byte("token"), []byte(core.ESDTRoleNFTCreate)},
+		},
+	}
+	_, err := rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	rolesFunc.EpochConfirmed(1)
+	assert.True(t, rolesFunc.IsActive())
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+}
+
+func TestESDTRoles_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	_, err := rolesFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.ESDTRoleNFTCreate)}
+	input.CallerAddr = []byte("caller")
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTRoles_ProcessBuiltinFunctionSetAndUnSet(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setRolesFunc, _ := NewESDTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
+	unSetRolesFunc, _ := NewESDTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
+
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)},
+		},
+	}
+
+	err := setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	_, err = setRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	_, err = setRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	roles := &esdt.ESDTRoles{}
+	marshaledData, _ := acnt.DataTrieTracker().RetrieveValue(computeESDTRoleKey(tokenID))
+	_ = marshalizer.Unmarshal(roles, marshaledData)
+	assert.Equal(t, 2, len(roles.Roles))
+
+	assert.Nil(t, setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTCreate)))
+	assert.Nil(t, setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+	err = setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+	err = setRolesFunc.CheckAllowedToExecute(acnt, []byte("otherToken"), []byte(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate)}
+	_, err = unSetRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	err = setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+	assert.Nil(t, setRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTBurn)}
+	_, err = unSetRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	marshaledData, _ = acnt.DataTrieTracker().RetrieveValue(computeESDTRoleKey(tokenID))
+	assert.Equal(t, 0, len(marshaledData))
+}
@@ -0,0 +1,137 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtSetTokenType)(nil)
+var _ process.ESDTTokenTypeHandler = (*esdtSetTokenType)(nil)
+
+// esdtSetTokenType saves, in the global metadata kept on the system account of every shard, the type a token was
+// issued with. It is called by the ESDT system SC when a non fungible or a semi fungible collection is issued.
+type esdtSetTokenType struct {
+	baseEnabled
+	accounts state.AccountsAdapter
+}
+
+// NewESDTSetTokenTypeFunc returns the esdt set token type built-in function component
+func NewESDTSetTokenTypeFunc(
+	accounts state.AccountsAdapter,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtSetTokenType, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtSetTokenType{
+		baseEnabled: baseEnabled{enableEpoch: enableEpoch},
+		accounts:    accounts,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtSetTokenType) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set token type function call
+// format: ESDTSetTokenType@tokenIdentifier@tokenType
+func (e *esdtSetTokenType) ProcessBuiltinFunction(
+	_, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if !core.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, process.ErrOnlySystemAccountAccepted
+	}
+
+	tokenType, err := esdtTypeFromName(vmInput.Arguments[1])
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := computeESDTTokenKey(vmInput.Arguments[0])
+	log.Trace(vmInput.Function, "token", esdtTokenKey, "type", tokenType.String())
+
+	err = e.saveTokenType(esdtTokenKey, tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+func esdtTypeFromName(name []byte) (core.ESDTType, error) {
+	switch string(name) {
+	case core.FungibleESDT:
+		return core.Fungible, nil
+	case core.NonFungibleESDT:
+		return core.NonFungible, nil
+	case core.SemiFungibleESDT:
+		return core.SemiFungible, nil
+	default:
+		return core.Fungible, process.ErrInvalidESDTTokenType
+	}
+}
+
+func (e *esdtSetTokenType) saveTokenType(esdtTokenKey []byte, tokenType core.ESDTType) error {
+	systemSCAccount, err := loadSystemAccount(e.accounts)
+	if err != nil {
+		return err
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(esdtTokenKey)
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+	esdtMetaData.TokenType = tokenType
+	err = systemSCAccount.DataTrieTracker().SaveKeyValue(esdtTokenKey, esdtMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+// GetTokenType returns the type the token was issued with. The tokens without a saved type are fungible tokens.
+func (e *esdtSetTokenType) GetTokenType(esdtTokenKey []byte) core.ESDTType {
+	systemSCAccount, err := loadSystemAccount(e.accounts)
+	if err != nil {
+		return core.Fungible
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(esdtTokenKey)
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+
+	return esdtMetaData.TokenType
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtSetTokenType) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,100 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTSetTokenTypeFunc(t *testing.T) {
+	t.Parallel()
+
+	setTokenTypeFunc, err := NewESDTSetTokenTypeFunc(nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, setTokenTypeFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	setTokenTypeFunc, err = NewESDTSetTokenTypeFunc(&mock.AccountsStub{}, 0, nil)
+	assert.Nil(t, setTokenTypeFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	setTokenTypeFunc, err = NewESDTSetTokenTypeFunc(&mock.AccountsStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, err)
+	assert.False(t, setTokenTypeFunc.IsInterfaceNil())
+}
+
+func TestESDTSetTokenType_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt, _ := state.NewUserAccount(core.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setTokenTypeFunc, _ := NewESDTSetTokenTypeFunc(accounts, 0, &mock.EpochNotifierStub{})
+	_, err := setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	key := []byte("key")
+	input.Arguments = [][]byte{key}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{key, []byte(core.NonFungibleESDT)}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrOnlySystemAccountAccepted, err)
+
+	input.RecipientAddr = core.SystemAccountAddress
+	input.Arguments = [][]byte{key, []byte("unknown")}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+
+	esdtTokenKey := computeESDTTokenKey(key)
+	assert.Equal(t, core.Fungible, setTokenTypeFunc.GetTokenType(esdtTokenKey))
+
+	input.Arguments = [][]byte{key, []byte(core.NonFungibleESDT)}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, core.NonFungible, setTokenTypeFunc.GetTokenType(esdtTokenKey))
+
+	pauseFunc, _ := NewESDTPauseFunc(accounts, true)
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: core.SystemAccountAddress,
+	})
+	assert.Nil(t, err)
+	assert.True(t, pauseFunc.IsPaused(esdtTokenKey))
+	assert.Equal(t, core.NonFungible, setTokenTypeFunc.GetTokenType(esdtTokenKey))
+}
+
+func TestESDTSetTokenType_ProcessBuiltInFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setTokenTypeFunc, _ := NewESDTSetTokenTypeFunc(&mock.AccountsStub{}, 1, &mock.EpochNotifierStub{})
+	_, err := setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{})
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+}
@@ -21,6 +21,7 @@ var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 var zero = big.NewInt(0)
 
 type esdtTransfer struct {
+	baseAlwaysActive
 	funcGasCost    uint64
 	marshalizer    marshal.Marshalizer
 	keyPrefix      []byte
@@ -185,20 +186,14 @@ func addToESDTBalance(
 	marshalizer marshal.Marshalizer,
 	pauseHandler process.ESDTPauseHandler,
 ) error {
-	esdtData, err := getESDTDataFromKey(userAcnt, key, marshalizer)
+	err := checkFrozeAndPause(senderAddr, userAcnt, key, marshalizer, pauseHandler)
 	if err != nil {
 		return err
 	}
 
-	if !bytes.Equal(senderAddr, vm.ESDTSCAddress) {
-		esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
-		if esdtUserMetaData.Frozen {
-			return process.ErrESDTIsFrozenForAccount
-		}
-
-		if pauseHandler.IsPaused(key) {
-			return process.ErrESDTTokenIsPaused
-		}
+	esdtData, err := getESDTDataFromKey(userAcnt, key, marshalizer)
+	if err != nil {
+		return err
 	}
 
 	esdtData.Value.Add(esdtData.Value, value)
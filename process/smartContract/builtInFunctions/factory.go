@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,20 +15,38 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule                  core.GasScheduleNotifier
+	MapDNSAddresses              map[string]struct{}
+	EnableUserNameChange         bool
+	Marshalizer                  marshal.Marshalizer
+	Accounts                     state.AccountsAdapter
+	ShardCoordinator             sharding.Coordinator
+	GuardedAccounts              process.GuardedAccountHandler
+	MultisigAccounts             process.MultisigAccountHandler
+	EpochNotifier                process.EpochNotifier
+	ESDTNFTEnableEpoch           uint32
+	ESDTMultiTransferEnableEpoch uint32
+	ESDTLocalRolesEnableEpoch    uint32
+	GuardedAccountsEnableEpoch   uint32
+	MultisigAccountsEnableEpoch  uint32
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses              map[string]struct{}
+	enableUserNameChange         bool
+	marshalizer                  marshal.Marshalizer
+	accounts                     state.AccountsAdapter
+	shardCoordinator             sharding.Coordinator
+	guardedAccounts              process.GuardedAccountHandler
+	multisigAccounts             process.MultisigAccountHandler
+	epochNotifier                process.EpochNotifier
+	esdtNFTEnableEpoch           uint32
+	esdtMultiTransferEnableEpoch uint32
+	esdtLocalRolesEnableEpoch    uint32
+	guardedAccountsEnableEpoch   uint32
+	multisigAccountsEnableEpoch  uint32
+	builtInFunctions             process.BuiltInFunctionContainer
+	gasConfig                    *process.GasCost
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -41,15 +60,36 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if check.IfNil(args.Accounts) {
 		return nil, process.ErrNilAccountsAdapter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.GuardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(args.MultisigAccounts) {
+		return nil, process.ErrNilMultisigAccountHandler
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:              args.MapDNSAddresses,
+		enableUserNameChange:         args.EnableUserNameChange,
+		marshalizer:                  args.Marshalizer,
+		accounts:                     args.Accounts,
+		shardCoordinator:             args.ShardCoordinator,
+		guardedAccounts:              args.GuardedAccounts,
+		multisigAccounts:             args.MultisigAccounts,
+		epochNotifier:                args.EpochNotifier,
+		esdtNFTEnableEpoch:           args.ESDTNFTEnableEpoch,
+		esdtMultiTransferEnableEpoch: args.ESDTMultiTransferEnableEpoch,
+		esdtLocalRolesEnableEpoch:    args.ESDTLocalRolesEnableEpoch,
+		guardedAccountsEnableEpoch:   args.GuardedAccountsEnableEpoch,
+		multisigAccountsEnableEpoch:  args.MultisigAccountsEnableEpoch,
 	}
 
 	var err error
@@ -180,6 +220,125 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	// the roles are set by the ESDT system SC starting with the first epoch which introduced special roles
+	rolesEnableEpoch := core.MinUint32(b.esdtNFTEnableEpoch, b.esdtLocalRolesEnableEpoch)
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, false, rolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionUnSetESDTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	setRoleFunc, err := NewESDTRolesFunc(b.marshalizer, true, rolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetESDTRole, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	setTokenTypeFunc, err := NewESDTSetTokenTypeFunc(b.accounts, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTSetTokenType, setTokenTypeFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTCreateFunc(b.gasConfig.BuiltInCost.ESDTNFTCreate, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc, setTokenTypeFunc, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTCreate, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.ESDTNFTAddQuantity, b.marshalizer, pauseFunc, setRoleFunc, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTAddQuantity, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTBurnFunc(b.gasConfig.BuiltInCost.ESDTNFTBurn, b.marshalizer, pauseFunc, setRoleFunc, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTTransferFunc(b.gasConfig.BuiltInCost.ESDTNFTTransfer, b.marshalizer, pauseFunc, b.accounts, b.shardCoordinator, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTMultiTransferFunc(b.gasConfig.BuiltInCost.MultiESDTTransfer, b.marshalizer, pauseFunc, b.esdtMultiTransferEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionMultiESDTTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTLocalMintFunc(b.gasConfig.BuiltInCost.ESDTLocalMint, b.marshalizer, pauseFunc, setRoleFunc, b.esdtLocalRolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalMint, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTLocalBurnFunc(b.gasConfig.BuiltInCost.ESDTLocalBurn, b.marshalizer, pauseFunc, setRoleFunc, b.esdtLocalRolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewSetGuardianFunc(b.gasConfig.BuiltInCost.SetGuardian, b.guardedAccounts, b.guardedAccountsEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetGuardian, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewRemoveGuardianFunc(b.gasConfig.BuiltInCost.RemoveGuardian, b.guardedAccounts, b.guardedAccountsEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionRemoveGuardian, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewSetMultisigFunc(b.gasConfig.BuiltInCost.SetMultisig, b.multisigAccounts, b.multisigAccountsEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetMultisig, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -228,7 +387,41 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 		return process.ErrWrongTypeAssertion
 	}
 
-	return esdtTransferFunc.setPayableHandler(payableHandler)
+	err = esdtTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.Get(core.BuiltInFunctionESDTNFTTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtNFTTransferFunc, ok := builtInFunc.(*esdtNFTTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = esdtNFTTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.Get(core.BuiltInFunctionMultiESDTTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtMultiTransferFunc, ok := builtInFunc.(*esdtMultiTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	return esdtMultiTransferFunc.setPayableHandler(payableHandler)
 }
 
 // IsInterfaceNil returns true if underlying object is nil
@@ -20,6 +20,10 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewOneShardCoordinatorMock(),
+		GuardedAccounts:      &mock.GuardedAccountHandlerStub{},
+		MultisigAccounts:     &mock.MultisigAccountHandlerStub{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -52,6 +56,16 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["ESDTNFTCreate"] = value
+	gasMap["ESDTNFTAddQuantity"] = value
+	gasMap["ESDTNFTBurn"] = value
+	gasMap["ESDTNFTTransfer"] = value
+	gasMap["MultiESDTTransfer"] = value
+	gasMap["ESDTLocalMint"] = value
+	gasMap["ESDTLocalBurn"] = value
+	gasMap["SetGuardian"] = value
+	gasMap["RemoveGuardian"] = value
+	gasMap["SetMultisig"] = value
 
 	return gasMap
 }
@@ -65,16 +79,34 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.ShardCoordinator = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	args.MapDNSAddresses = nil
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.GuardedAccounts = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+	assert.Nil(t, factory)
+
+	args = createMockArguments()
+	args.MultisigAccounts = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Nil(t, err)
 	container, err := factory.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 11)
+	assert.Equal(t, len(container.Keys()), 24)
 }
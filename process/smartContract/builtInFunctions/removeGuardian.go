@@ -0,0 +1,83 @@
+package builtInFunctions
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*removeGuardian)(nil)
+
+type removeGuardian struct {
+	baseEnabled
+	gasCost               uint64
+	guardedAccountHandler process.GuardedAccountHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewRemoveGuardianFunc returns the remove guardian built-in function component
+func NewRemoveGuardianFunc(
+	gasCost uint64,
+	guardedAccountHandler process.GuardedAccountHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*removeGuardian, error) {
+	if check.IfNil(guardedAccountHandler) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	r := &removeGuardian{
+		baseEnabled:           baseEnabled{enableEpoch: enableEpoch},
+		gasCost:               gasCost,
+		guardedAccountHandler: guardedAccountHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(r)
+
+	return r, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (r *removeGuardian) SetNewGasConfig(gasCost *process.GasCost) {
+	r.mutExecution.Lock()
+	r.gasCost = gasCost.BuiltInCost.RemoveGuardian
+	r.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction removes the pending guardian of the caller account, or the active one if there is no
+// pending guardian
+func (r *removeGuardian) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	r.mutExecution.RLock()
+	defer r.mutExecution.RUnlock()
+
+	if !r.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkSelfCallInput(acntDst, vmInput, r.gasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	err = r.guardedAccountHandler.RemoveGuardian(acntDst)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - r.gasCost, ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (r *removeGuardian) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,83 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemoveGuardianFunc_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianFunc, err := NewRemoveGuardianFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(removeGuardianFunc))
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewRemoveGuardianFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianFunc, err := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(removeGuardianFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianFunc, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, removeGuardianFunc.IsActive())
+
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+	_, err := removeGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, nil))
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	removeGuardianFunc.EpochConfirmed(1)
+	assert.True(t, removeGuardianFunc.IsActive())
+	_, err = removeGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, nil))
+	assert.Nil(t, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionWithArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianFunc, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+
+	_, err := removeGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{[]byte("arg")}))
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	vmInput := createSelfCallInput(addr, nil)
+	vmInput.CallerAddr = []byte("other address")
+	_, err = removeGuardianFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+	removeGuardianWasCalled := false
+	removeGuardianFunc, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		RemoveGuardianCalled: func(account state.UserAccountHandler) error {
+			removeGuardianWasCalled = true
+			assert.Equal(t, acc, account)
+			return nil
+		},
+	}, 0, &mock.EpochNotifierStub{})
+
+	vmOutput, err := removeGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, nil))
+	require.Nil(t, err)
+	assert.True(t, removeGuardianWasCalled)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+}
@@ -15,6 +15,7 @@ import (
 var _ process.BuiltinFunction = (*saveUserName)(nil)
 
 type saveUserName struct {
+	baseAlwaysActive
 	gasCost         uint64
 	mapDnsAddresses map[string]struct{}
 	enableChange    bool
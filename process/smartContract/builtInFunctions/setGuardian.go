@@ -0,0 +1,104 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*setGuardian)(nil)
+
+type setGuardian struct {
+	baseEnabled
+	gasCost               uint64
+	guardedAccountHandler process.GuardedAccountHandler
+	mutExecution          sync.RWMutex
+}
+
+// NewSetGuardianFunc returns the set guardian built-in function component
+func NewSetGuardianFunc(
+	gasCost uint64,
+	guardedAccountHandler process.GuardedAccountHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*setGuardian, error) {
+	if check.IfNil(guardedAccountHandler) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	s := &setGuardian{
+		baseEnabled:           baseEnabled{enableEpoch: enableEpoch},
+		gasCost:               gasCost,
+		guardedAccountHandler: guardedAccountHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (s *setGuardian) SetNewGasConfig(gasCost *process.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost.BuiltInCost.SetGuardian
+	s.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction sets the provided address as the pending guardian of the caller account
+func (s *setGuardian) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	if !s.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkSelfCallInput(acntDst, vmInput, s.gasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	err = s.guardedAccountHandler.SetGuardian(acntDst, vmInput.Arguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - s.gasCost, ReturnCode: vmcommon.Ok}, nil
+}
+
+// checkSelfCallInput checks that a built-in function was called by an account on itself, without value
+func checkSelfCallInput(acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput, gasCost uint64) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if vmInput.GasProvided < gasCost {
+		return process.ErrNotEnoughGas
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrOperationNotPermitted
+	}
+	if check.IfNil(acntDst) {
+		return process.ErrNilUserAccount
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (s *setGuardian) IsInterfaceNil() bool {
+	return s == nil
+}
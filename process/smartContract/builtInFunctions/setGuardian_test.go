@@ -0,0 +1,141 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSelfCallInput(address []byte, arguments [][]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  address,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   arguments,
+		},
+		RecipientAddr: address,
+	}
+}
+
+func TestNewSetGuardianFunc_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setGuardianFunc, err := NewSetGuardianFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(setGuardianFunc))
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewSetGuardianFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setGuardianFunc, err := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(setGuardianFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setGuardianFunc, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, setGuardianFunc.IsActive())
+
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+	_, err := setGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{[]byte("guardian")}))
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	setGuardianFunc.EpochConfirmed(1)
+	assert.True(t, setGuardianFunc.IsActive())
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{[]byte("guardian")}))
+	assert.Nil(t, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	setGuardianFunc, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+
+	_, err := setGuardianFunc.ProcessBuiltinFunction(nil, acc, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	vmInput := createSelfCallInput(addr, [][]byte{[]byte("guardian")})
+	vmInput.CallValue = big.NewInt(1)
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput = createSelfCallInput(addr, [][]byte{[]byte("guardian")})
+	vmInput.GasProvided = 1
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	vmInput = createSelfCallInput(addr, [][]byte{[]byte("guardian")})
+	vmInput.RecipientAddr = []byte("other address")
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	vmInput = createSelfCallInput(addr, [][]byte{[]byte("guardian")})
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	vmInput = createSelfCallInput(addr, nil)
+	_, err = setGuardianFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionSetGuardianErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	setGuardianFunc, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		SetGuardianCalled: func(_ state.UserAccountHandler, _ []byte) error {
+			return expectedErr
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+
+	_, err := setGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{[]byte("guardian")}))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	guardian := []byte("guardian")
+	acc, _ := state.NewUserAccount(addr)
+	setGuardianWasCalled := false
+	setGuardianFunc, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		SetGuardianCalled: func(account state.UserAccountHandler, guardianAddress []byte) error {
+			setGuardianWasCalled = true
+			assert.Equal(t, acc, account)
+			assert.Equal(t, guardian, guardianAddress)
+			return nil
+		},
+	}, 0, &mock.EpochNotifierStub{})
+
+	vmOutput, err := setGuardianFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{guardian}))
+	require.Nil(t, err)
+	assert.True(t, setGuardianWasCalled)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+}
+
+func TestSetGuardian_SetNewGasConfig(t *testing.T) {
+	t.Parallel()
+
+	setGuardianFunc, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	setGuardianFunc.SetNewGasConfig(&process.GasCost{BuiltInCost: process.BuiltInCost{SetGuardian: 37}})
+	assert.Equal(t, uint64(37), setGuardianFunc.gasCost)
+}
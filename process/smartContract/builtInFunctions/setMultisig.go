@@ -0,0 +1,90 @@
+package builtInFunctions
+
+import (
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*setMultisig)(nil)
+
+type setMultisig struct {
+	baseEnabled
+	gasCost                uint64
+	multisigAccountHandler process.MultisigAccountHandler
+	mutExecution           sync.RWMutex
+}
+
+// NewSetMultisigFunc returns the set multisig built-in function component
+func NewSetMultisigFunc(
+	gasCost uint64,
+	multisigAccountHandler process.MultisigAccountHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*setMultisig, error) {
+	if check.IfNil(multisigAccountHandler) {
+		return nil, process.ErrNilMultisigAccountHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	s := &setMultisig{
+		baseEnabled:            baseEnabled{enableEpoch: enableEpoch},
+		gasCost:                gasCost,
+		multisigAccountHandler: multisigAccountHandler,
+	}
+	epochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (s *setMultisig) SetNewGasConfig(gasCost *process.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost.BuiltInCost.SetMultisig
+	s.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction turns the caller account into a multisig account. The first argument is the number of
+// signatures needed by the transactions of the account, followed by the public keys of the signers
+func (s *setMultisig) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	if !s.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
+	err := checkSelfCallInput(acntDst, vmInput, s.gasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	threshold := big.NewInt(0).SetBytes(vmInput.Arguments[0])
+	if !threshold.IsUint64() || threshold.Uint64() > math.MaxUint32 {
+		return nil, process.ErrInvalidMultisigThreshold
+	}
+
+	err = s.multisigAccountHandler.SetMultisig(acntDst, uint32(threshold.Uint64()), vmInput.Arguments[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - s.gasCost, ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (s *setMultisig) IsInterfaceNil() bool {
+	return s == nil
+}
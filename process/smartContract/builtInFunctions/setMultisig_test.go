@@ -0,0 +1,98 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetMultisigFunc_NilMultisigAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setMultisigFunc, err := NewSetMultisigFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(setMultisigFunc))
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+}
+
+func TestNewSetMultisigFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setMultisigFunc, err := NewSetMultisigFunc(10, &mock.MultisigAccountHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(setMultisigFunc))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestSetMultisig_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setMultisigFunc, _ := NewSetMultisigFunc(10, &mock.MultisigAccountHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	assert.False(t, setMultisigFunc.IsActive())
+
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+	_, err := setMultisigFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{{1}, []byte("signer")}))
+	assert.Equal(t, process.ErrBuiltInFunctionIsNotActive, err)
+
+	setMultisigFunc.EpochConfirmed(1)
+	assert.True(t, setMultisigFunc.IsActive())
+}
+
+func TestSetMultisig_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	setMultisigFunc, _ := NewSetMultisigFunc(10, &mock.MultisigAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+
+	_, err := setMultisigFunc.ProcessBuiltinFunction(nil, acc, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	_, err = setMultisigFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, [][]byte{{1}}))
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	vmInput := createSelfCallInput(addr, [][]byte{{1}, []byte("signer")})
+	vmInput.CallValue = big.NewInt(1)
+	_, err = setMultisigFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput = createSelfCallInput(addr, [][]byte{{1}, []byte("signer")})
+	vmInput.CallerAddr = []byte("other address")
+	_, err = setMultisigFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	vmInput = createSelfCallInput(addr, [][]byte{{1, 0, 0, 0, 0}, []byte("signer")})
+	_, err = setMultisigFunc.ProcessBuiltinFunction(nil, acc, vmInput)
+	assert.Equal(t, process.ErrInvalidMultisigThreshold, err)
+}
+
+func TestSetMultisig_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	acc, _ := state.NewUserAccount(addr)
+	signers := [][]byte{[]byte("signer A"), []byte("signer B"), []byte("signer C")}
+	setMultisigWasCalled := false
+	setMultisigFunc, _ := NewSetMultisigFunc(10, &mock.MultisigAccountHandlerStub{
+		SetMultisigCalled: func(account state.UserAccountHandler, threshold uint32, providedSigners [][]byte) error {
+			setMultisigWasCalled = true
+			assert.Equal(t, acc, account)
+			assert.Equal(t, uint32(2), threshold)
+			assert.Equal(t, signers, providedSigners)
+			return nil
+		},
+	}, 0, &mock.EpochNotifierStub{})
+
+	arguments := append([][]byte{{2}}, signers...)
+	vmOutput, err := setMultisigFunc.ProcessBuiltinFunction(nil, acc, createSelfCallInput(addr, arguments))
+	require.Nil(t, err)
+	assert.True(t, setMultisigWasCalled)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+}
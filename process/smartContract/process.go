@@ -928,18 +928,34 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 		AllowInitFunction: false,
 	}
 
-	fillWithESDTValue(vmInput, newVMInput)
+	sc.fillWithESDTValue(vmInput, newVMInput)
 
 	return true, newVMInput, nil
 }
 
-func fillWithESDTValue(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
-	if fullVMInput.Function != core.BuiltInFunctionESDTTransfer {
+func (sc *scProcessor) fillWithESDTValue(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
+	if !sc.isActiveBuiltInFunction(fullVMInput.Function) {
 		return
 	}
 
-	newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
-	newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+	switch fullVMInput.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+	case core.BuiltInFunctionESDTNFTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTTokenNonce = big.NewInt(0).SetBytes(fullVMInput.Arguments[1]).Uint64()
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[2])
+	case core.BuiltInFunctionMultiESDTTransfer:
+		numOfTransfers := int(big.NewInt(0).SetBytes(fullVMInput.Arguments[0]).Uint64())
+		newVMInput.ESDTTransfers = make([]*vmcommon.ESDTTransfer, 0, numOfTransfers)
+		for i := 0; i < numOfTransfers; i++ {
+			newVMInput.ESDTTransfers = append(newVMInput.ESDTTransfers, &vmcommon.ESDTTransfer{
+				ESDTTokenName: fullVMInput.Arguments[1+2*i],
+				ESDTValue:     big.NewInt(0).SetBytes(fullVMInput.Arguments[2+2*i]),
+			})
+		}
+	}
 }
 
 func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) bool {
@@ -958,7 +974,9 @@ func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) bool
 		return false
 	}
 
-	return function == core.BuiltInFunctionESDTTransfer
+	isESDTTransfer := function == core.BuiltInFunctionESDTTransfer || function == core.BuiltInFunctionMultiESDTTransfer
+
+	return isESDTTransfer && sc.isActiveBuiltInFunction(function)
 }
 
 // ProcessIfError creates a smart contract result, consumed the gas and returns the value to the user
@@ -1666,8 +1684,16 @@ func (sc *scProcessor) isTransferWithNoDataOrBuiltInCall(data []byte) bool {
 		return false
 	}
 
-	_, err = sc.builtInFunctions.Get(function)
-	return err == nil
+	return sc.isActiveBuiltInFunction(function)
+}
+
+func (sc *scProcessor) isActiveBuiltInFunction(function string) bool {
+	builtIn, err := sc.builtInFunctions.Get(function)
+	if err != nil {
+		return false
+	}
+
+	return builtIn.IsActive()
 }
 
 // createSCRForSender(vmOutput, tx, txHash, acntSnd)
@@ -2721,3 +2721,32 @@ func computeExpectedResults(args *economics.ArgsNewEconomicsData, tx *transactio
 	expectedDevFees := core.GetPercentageOfValue(processFee, args.Economics.RewardsSettings.DeveloperPercentage)
 	return expectedTotalFee, expectedDevFees
 }
+
+func TestScProcessor_IsCrossShardESDTTransferMultiTransferOnlyWhenActive(t *testing.T) {
+	t.Parallel()
+
+	isActive := false
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[len(address)-1])
+	}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.Coordinator = shardCoordinator
+	arguments.ArgsParser = NewArgumentParser()
+	_ = arguments.BuiltInFunctions.Add(core.BuiltInFunctionMultiESDTTransfer, &mock.BuiltInFunctionStub{
+		IsActiveCalled: func() bool {
+			return isActive
+		},
+	})
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{
+		SndAddr: []byte{1},
+		RcvAddr: []byte{2},
+		Data:    []byte(core.BuiltInFunctionMultiESDTTransfer + "@01@746f6b656e@0a"),
+	}
+	assert.False(t, sc.isCrossShardESDTTransfer(tx))
+
+	isActive = true
+	assert.True(t, sc.isCrossShardESDTTransfer(tx))
+}
@@ -19,12 +19,13 @@ var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer    process.VirtualMachinesContainer
-	economicsFee   process.FeeHandler
-	mutRunSc       sync.Mutex
-	blockChainHook process.BlockChainHookHandler
-	blockChain     data.ChainHandler
-	numQueries     int
+	vmContainer        process.VirtualMachinesContainer
+	economicsFee       process.FeeHandler
+	mutRunSc           sync.Mutex
+	blockChainHook     process.BlockChainHookHandler
+	blockChain         data.ChainHandler
+	historicalAccounts process.HistoricalAccountsHandler
+	numQueries         int
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -33,6 +34,7 @@ func NewSCQueryService(
 	economicsFee process.FeeHandler,
 	blockChainHook process.BlockChainHookHandler,
 	blockChain data.ChainHandler,
+	historicalAccounts process.HistoricalAccountsHandler,
 ) (*SCQueryService, error) {
 	if check.IfNil(vmContainer) {
 		return nil, process.ErrNoVM
@@ -46,12 +48,16 @@ func NewSCQueryService(
 	if check.IfNil(blockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(historicalAccounts) {
+		return nil, process.ErrNilHistoricalAccountsHandler
+	}
 
 	return &SCQueryService{
-		vmContainer:    vmContainer,
-		economicsFee:   economicsFee,
-		blockChain:     blockChain,
-		blockChainHook: blockChainHook,
+		vmContainer:        vmContainer,
+		economicsFee:       economicsFee,
+		blockChain:         blockChain,
+		blockChainHook:     blockChainHook,
+		historicalAccounts: historicalAccounts,
 	}, nil
 }
 
@@ -74,7 +80,17 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	header := service.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(query.BlockHeader) {
+		err := service.historicalAccounts.SelectRootHash(query.BlockHeader.GetRootHash())
+		if err != nil {
+			return nil, err
+		}
+		defer service.historicalAccounts.ResetRootHash()
+
+		header = query.BlockHeader
+	}
+	service.blockChainHook.SetCurrentHeader(header)
 
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
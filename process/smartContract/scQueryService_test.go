@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"errors"
 	"math"
 	"math/big"
 	"sync"
@@ -10,6 +11,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -22,7 +25,7 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalAccountsHandlerStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -31,16 +34,25 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalAccountsHandlerStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewSCQueryService_NilHistoricalAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, nil)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilHistoricalAccountsHandler, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalAccountsHandlerStub{})
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -50,7 +62,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalAccountsHandlerStub{})
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -67,7 +79,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalAccountsHandlerStub{})
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -116,6 +128,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	dataArgs := make([][]byte, len(args))
@@ -159,6 +172,7 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -174,6 +188,99 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestExecuteQuery_WithBlockHeaderShouldUseItsState(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 5, RootHash: []byte("root hash")}
+	var selectedRootHash []byte
+	resetCalled := false
+	var currentHeader data.HeaderHandler
+
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			assert.Equal(t, header.RootHash, selectedRootHash)
+			assert.False(t, resetCalled)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				currentHeader = hdr
+			},
+		},
+		&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 10}
+			},
+		},
+		&mock.HistoricalAccountsHandlerStub{
+			SelectRootHashCalled: func(rootHash []byte) error {
+				selectedRootHash = rootHash
+				return nil
+			},
+			ResetRootHashCalled: func() {
+				resetCalled = true
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress:   []byte(DummyScAddress),
+		FuncName:    "function",
+		BlockHeader: header,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.True(t, resetCalled)
+	assert.Equal(t, header, currentHeader)
+}
+
+func TestExecuteQuery_WithBlockHeaderStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("state not available")
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{
+			SelectRootHashCalled: func(rootHash []byte) error {
+				return expectedErr
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress:   []byte(DummyScAddress),
+		FuncName:    "function",
+		BlockHeader: &block.Header{},
+	}
+
+	vmOutput, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -198,6 +305,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -248,6 +356,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	noOfGoRoutines := 50
@@ -298,6 +407,7 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -342,6 +452,7 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -383,6 +494,7 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalAccountsHandlerStub{},
 	)
 
 	tx := &transaction.Transaction{
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -38,6 +39,7 @@ type ArgBaseBootstrapper struct {
 	MiniblocksProvider  process.MiniBlockProvider
 	Uint64Converter     typeConverters.Uint64ByteSliceConverter
 	Indexer             indexer.Indexer
+	HistoryRepository   dblookupext.HistoryRepository
 }
 
 // ArgShardBootstrapper holds all dependencies required by the bootstrap data factory in order to create
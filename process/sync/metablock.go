@@ -60,6 +60,7 @@ func NewMetaBootstrap(arguments ArgMetaBootstrapper) (*MetaBootstrap, error) {
 		uint64Converter:     arguments.Uint64Converter,
 		poolsHolder:         arguments.PoolsHolder,
 		indexer:             arguments.Indexer,
+		historyRepository:   arguments.HistoryRepository,
 	}
 
 	boot := MetaBootstrap{
@@ -67,6 +67,7 @@ func CreateMetaBootstrapMockArguments() sync.ArgMetaBootstrapper {
 		MiniblocksProvider:  &mock.MiniBlocksProviderStub{},
 		Uint64Converter:     &mock.Uint64ByteSliceConverterMock{},
 		Indexer:             &mock.IndexerMock{},
+		HistoryRepository:   &testscommon.HistoryRepositoryStub{},
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
@@ -57,6 +57,7 @@ func NewShardBootstrap(arguments ArgShardBootstrapper) (*ShardBootstrap, error)
 		uint64Converter:     arguments.Uint64Converter,
 		poolsHolder:         arguments.PoolsHolder,
 		indexer:             arguments.Indexer,
+		historyRepository:   arguments.HistoryRepository,
 	}
 
 	boot := ShardBootstrap{
@@ -189,6 +189,7 @@ func CreateShardBootstrapMockArguments() sync.ArgShardBootstrapper {
 		MiniblocksProvider:  &mock.MiniBlocksProviderStub{},
 		Uint64Converter:     &mock.Uint64ByteSliceConverterMock{},
 		Indexer:             &mock.IndexerMock{},
+		HistoryRepository:   &testscommon.HistoryRepositoryStub{},
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -376,6 +377,18 @@ func TestNewShardBootstrap_NilBlackListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlackListCacher, err)
 }
 
+func TestNewShardBootstrap_NilHistoryRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateShardBootstrapMockArguments()
+	args.HistoryRepository = nil
+
+	bs, err := sync.NewShardBootstrap(args)
+
+	assert.Nil(t, bs)
+	assert.Equal(t, process.ErrNilHistoryRepository, err)
+}
+
 func TestNewShardBootstrap_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
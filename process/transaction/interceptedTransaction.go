@@ -36,11 +36,13 @@ type InterceptedTransaction struct {
 	whiteListerVerifiedTxs process.WhiteListHandler
 	argsParser             process.ArgumentsParser
 	txVersionChecker       process.TxVersionCheckerHandler
+	multisigAccounts       process.MultisigAccountHandler
 	chainID                []byte
 	rcvShard               uint32
 	sndShard               uint32
 	isForCurrentShard      bool
 	enableSignedTxWithHash bool
+	enableMultiRelayedTx   bool
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -58,8 +60,10 @@ func NewInterceptedTransaction(
 	argsParser process.ArgumentsParser,
 	chainID []byte,
 	enableSignedTxWithHash bool,
+	enableMultiRelayedTx bool,
 	txSignHasher hashing.Hasher,
 	txVersionChecker process.TxVersionCheckerHandler,
+	multisigAccounts process.MultisigAccountHandler,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -104,6 +108,9 @@ func NewInterceptedTransaction(
 	if check.IfNil(txVersionChecker) {
 		return nil, process.ErrNilTransactionVersionChecker
 	}
+	if check.IfNil(multisigAccounts) {
+		return nil, process.ErrNilMultisigAccountHandler
+	}
 
 	tx, err := createTx(protoMarshalizer, txBuff)
 	if err != nil {
@@ -124,7 +131,9 @@ func NewInterceptedTransaction(
 		argsParser:             argsParser,
 		chainID:                chainID,
 		enableSignedTxWithHash: enableSignedTxWithHash,
+		enableMultiRelayedTx:   enableMultiRelayedTx,
 		txVersionChecker:       txVersionChecker,
+		multisigAccounts:       multisigAccounts,
 		txSignHasher:           txSignHasher,
 	}
 
@@ -176,6 +185,9 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 	if err != nil {
 		return nil
 	}
+	if core.MultiRelayedTransaction == funcName && inTx.enableMultiRelayedTx {
+		return inTx.verifyMultiRelayedTx(tx, userTxArgs)
+	}
 	if core.RelayedTransaction != funcName {
 		return nil
 	}
@@ -193,7 +205,41 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
 	}
 
-	err = inTx.integrity(userTx)
+	return inTx.verifyUserTx(userTx)
+}
+
+// verifyMultiRelayedTx checks each of the inner transactions of a multi relayed tx. All the inner transactions have
+// to be sent from the shard of the relayed tx receiver, as they are executed there
+func (inTx *InterceptedTransaction) verifyMultiRelayedTx(tx *transaction.Transaction, userTxArgs [][]byte) error {
+	if len(userTxArgs) == 0 || len(userTxArgs) > MaxInnerTxsInMultiRelayedTx {
+		return process.ErrInvalidNumberOfInnerTransactions
+	}
+	if tx.Value.Sign() != 0 {
+		return process.ErrMultiRelayedTxValueNotZero
+	}
+
+	rcvShard := inTx.coordinator.ComputeId(tx.RcvAddr)
+	for _, userTxArg := range userTxArgs {
+		userTx, err := createTx(inTx.signMarshalizer, userTxArg)
+		if err != nil {
+			return err
+		}
+
+		if inTx.coordinator.ComputeId(userTx.SndAddr) != rcvShard {
+			return process.ErrInnerTxSenderNotInReceiverShard
+		}
+
+		err = inTx.verifyUserTx(userTx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (inTx *InterceptedTransaction) verifyUserTx(userTx *transaction.Transaction) error {
+	err := inTx.integrity(userTx)
 	if err != nil {
 		return err
 	}
@@ -207,13 +253,13 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 		return nil
 	}
 
-	funcName, _, err = inTx.argsParser.ParseCallData(string(userTx.Data))
+	funcName, _, err := inTx.argsParser.ParseCallData(string(userTx.Data))
 	if err != nil {
 		return nil
 	}
 
 	// recursive relayed transactions are not allowed
-	if core.RelayedTransaction == funcName {
+	if core.RelayedTransaction == funcName || core.MultiRelayedTransaction == funcName {
 		return process.ErrRecursiveRelayedTxIsNotAllowed
 	}
 
@@ -246,9 +292,12 @@ func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error
 	if !bytes.Equal(tx.ChainID, inTx.chainID) {
 		return process.ErrInvalidChainID
 	}
-	if tx.Signature == nil {
+	if tx.Signature == nil && len(tx.Signatures) == 0 {
 		return process.ErrNilSignature
 	}
+	if len(tx.Signature) > 0 && len(tx.Signatures) > 0 {
+		return process.ErrSignatureAndMultisigSignatures
+	}
 	if tx.RcvAddr == nil {
 		return process.ErrNilRcvAddr
 	}
@@ -267,33 +316,115 @@ func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error
 	if len(inTx.tx.SndUserName) > core.MaxUserNameLength {
 		return process.ErrInvalidUserNameLength
 	}
+	err = inTx.checkGuardianFields(tx)
+	if err != nil {
+		return err
+	}
 
 	return inTx.feeHandler.CheckValidityTxValues(tx)
 }
 
-// verifySig checks if the tx is correctly signed
+// checkGuardianFields checks that the guardian address and signature are set if and only if the transaction has
+// the guarded option set
+func (inTx *InterceptedTransaction) checkGuardianFields(tx *transaction.Transaction) error {
+	if inTx.txVersionChecker.IsGuardedTransaction(tx) {
+		if len(tx.GuardianAddr) == 0 || len(tx.GuardianSignature) == 0 {
+			return process.ErrMissingGuardianData
+		}
+
+		return nil
+	}
+
+	if len(tx.GuardianAddr) > 0 || len(tx.GuardianSignature) > 0 {
+		return process.ErrGuardianDataOnUnguardedTransaction
+	}
+
+	return nil
+}
+
+// verifySig checks if the tx is correctly signed by its sender, or by enough signers if the sender is a multisig
+// account, and, for a guarded tx, by its guardian
 func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error {
-	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	messageForSigning, err := inTx.getMessageForSigning(tx)
 	if err != nil {
 		return err
 	}
 
+	err = inTx.verifySenderSig(tx, messageForSigning)
+	if err != nil {
+		return err
+	}
+
+	if !inTx.txVersionChecker.IsGuardedTransaction(tx) {
+		return nil
+	}
+
+	guardianPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.GuardianAddr)
+	if err != nil {
+		return err
+	}
+
+	return inTx.singleSigner.Verify(guardianPubKey, messageForSigning, tx.GuardianSignature)
+}
+
+// verifySenderSig checks the signature of the sender or, if the transaction holds multiple signatures, the signatures
+// of the multisig account signers. The multisig configuration is part of the sender account state, so it can only be
+// checked in the sender shard, which verifies the signatures before adding the transaction to its pool
+func (inTx *InterceptedTransaction) verifySenderSig(tx *transaction.Transaction, messageForSigning []byte) error {
+	isSenderInSelfShard := inTx.coordinator.ComputeId(tx.SndAddr) == inTx.coordinator.SelfId()
+	if len(tx.Signatures) > 0 {
+		if !isSenderInSelfShard {
+			return nil
+		}
+
+		return inTx.verifyMultisig(tx, messageForSigning)
+	}
+
+	if isSenderInSelfShard && inTx.multisigAccounts.IsMultisigAccountsEnabled() {
+		_, err := inTx.multisigAccounts.GetMultisigConfigForAddress(tx.SndAddr)
+		if err == nil {
+			return process.ErrMultisigSignaturesRequired
+		}
+		if err != process.ErrAccountIsNotMultisig {
+			return err
+		}
+	}
+
 	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.SndAddr)
 	if err != nil {
 		return err
 	}
 
+	return inTx.singleSigner.Verify(senderPubKey, messageForSigning, tx.Signature)
+}
+
+// verifyMultisig checks the signatures of the transaction against the multisig configuration of the sender account
+func (inTx *InterceptedTransaction) verifyMultisig(tx *transaction.Transaction, messageForSigning []byte) error {
+	config, err := inTx.multisigAccounts.GetMultisigConfigForAddress(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	return inTx.multisigAccounts.VerifyMultisigSignatures(config, messageForSigning, tx.Signatures)
+}
+
+// getMessageForSigning returns the data the sender and the guardian have signed, which is either the serialized
+// transaction or its hash
+func (inTx *InterceptedTransaction) getMessageForSigning(tx *transaction.Transaction) ([]byte, error) {
+	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	if !inTx.txVersionChecker.IsSignedWithHash(tx) {
-		return inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, tx.Signature)
+		return buffCopiedTx, nil
 	}
 
 	if !inTx.enableSignedTxWithHash {
-		return process.ErrTransactionSignedWithHashIsNotEnabled
+		return nil, process.ErrTransactionSignedWithHashIsNotEnabled
 	}
 
-	txHash := inTx.txSignHasher.Compute(string(buffCopiedTx))
-
-	return inTx.singleSigner.Verify(senderPubKey, txHash, tx.Signature)
+	return inTx.txSignHasher.Compute(string(buffCopiedTx)), nil
 }
 
 // ReceiverShardId returns the receiver shard id
@@ -5,16 +5,19 @@ import (
 	"encoding/hex"
 	"errors"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/multisig"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	processMultisig "github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -101,12 +104,21 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		&mock.MultisigAccountHandlerStub{},
 	)
 }
 
 func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxWithArgParser(tx, true)
+}
+
+func createInterceptedTxWithArgParser(
+	tx *dataTransaction.Transaction,
+	enableMultiRelayedTx bool,
+) (*transaction.InterceptedTransaction, error) {
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, err := marshalizer.Marshal(tx)
 	if err != nil {
@@ -140,8 +152,10 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 		smartContract.NewArgumentParser(),
 		tx.ChainID,
 		false,
+		enableMultiRelayedTx,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(tx.Version),
+		&mock.MultisigAccountHandlerStub{},
 	)
 }
 
@@ -164,8 +178,10 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -189,8 +205,10 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -214,8 +232,10 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -239,8 +259,10 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -264,8 +286,10 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -289,8 +313,10 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -314,8 +340,10 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -339,8 +367,10 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -364,8 +394,10 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -389,8 +421,10 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -414,8 +448,10 @@ func TestNewInterceptedTransaction_InvalidChainIDShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		nil,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -439,14 +475,43 @@ func TestNewInterceptedTransaction_NilTxSignHasherShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		nil,
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
+func TestNewInterceptedTransaction_NilMultisigAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		createMockPubkeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+		&mock.ArgumentParserMock{},
+		[]byte("chainID"),
+		false,
+		true,
+		mock.HasherMock{},
+		versioning.NewTxVersionChecker(1),
+		nil,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+}
+
 func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -470,8 +535,10 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -888,8 +955,10 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *test
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	err := txi.CheckValidity()
@@ -944,14 +1013,335 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShoudWork(t *testing.
 		&mock.ArgumentParserMock{},
 		chainID,
 		true,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	err := txi.CheckValidity()
 	assert.Nil(t, err)
 }
 
+func createGuardedTx(chainID []byte, minTxVersion uint32) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:             1,
+		Value:             big.NewInt(2),
+		Data:              []byte("data"),
+		GasLimit:          3,
+		GasPrice:          4,
+		RcvAddr:           recvAddress,
+		SndAddr:           senderAddress,
+		Signature:         sigOk,
+		ChainID:           chainID,
+		Version:           minTxVersion + 1,
+		Options:           versioning.MaskGuardedTransaction,
+		GuardianAddr:      []byte("guardian"),
+		GuardianSignature: sigOk,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxWithoutGuardianDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTx(chainID, minTxVersion)
+	tx.GuardianSignature = nil
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrMissingGuardianData, err)
+
+	tx = createGuardedTx(chainID, minTxVersion)
+	tx.GuardianAddr = nil
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrMissingGuardianData, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardianDataOnUnguardedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTx(chainID, minTxVersion)
+	tx.Options = 0
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrGuardianDataOnUnguardedTransaction, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardianSignatureVerifyFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTx(chainID, minTxVersion)
+	tx.GuardianSignature = sigBad
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTx(chainID, minTxVersion)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+}
+
+var multisigSigners = [][]byte{[]byte("signer A"), []byte("signer B"), []byte("signer C")}
+
+func createMultisigTx(chainID []byte, minTxVersion uint32, signatures [][]byte) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		Data:       []byte("data"),
+		GasLimit:   3,
+		GasPrice:   4,
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		Signatures: signatures,
+		ChainID:    chainID,
+		Version:    minTxVersion,
+	}
+}
+
+// createInterceptedMultisigTx creates an intercepted tx, in the sender shard, for which each signer of the
+// multisig sender account is expected to sign with "sig-" followed by its public key
+func createInterceptedMultisigTx(
+	tx *dataTransaction.Transaction,
+	chainID []byte,
+	minTxVersion uint32,
+	multisigAccounts process.MultisigAccountHandler,
+) (*transaction.InterceptedTransaction, error) {
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, err := marshalizer.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = senderShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, recvAddress) {
+			return recvShard
+		}
+
+		return senderShard
+	}
+
+	keyGen, signer := createMultisigSignerMocks()
+
+	return transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		keyGen,
+		signer,
+		&mock.PubkeyConverterStub{},
+		shardCoordinator,
+		createFreeTxFeeHandler(),
+		&mock.WhiteListHandlerStub{},
+		&mock.ArgumentParserMock{},
+		chainID,
+		false,
+		true,
+		mock.HasherMock{},
+		versioning.NewTxVersionChecker(minTxVersion),
+		multisigAccounts,
+	)
+}
+
+// createMultisigSignerMocks creates a key generator and a signer for which each signature is valid only if it is
+// "sig-" followed by the public key of the signer
+func createMultisigSignerMocks() (*mock.SingleSignKeyGenMock, *mock.SignerMock) {
+	pubKeys := make(map[crypto.PublicKey][]byte)
+	keyGen := &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			pubKey := &mock.SingleSignPublicKey{}
+			pubKeys[pubKey] = b
+			return pubKey, nil
+		},
+	}
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if !bytes.Equal(sig, append([]byte("sig-"), pubKeys[public]...)) {
+				return errSignerMockVerifySigFails
+			}
+			return nil
+		},
+	}
+
+	return keyGen, signer
+}
+
+func createMultisigAccountHandlerStub(threshold uint32) *mock.MultisigAccountHandlerStub {
+	keyGen, signer := createMultisigSignerMocks()
+	multisigAccounts, _ := processMultisig.NewMultisigAccount(processMultisig.ArgsMultisigAccount{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Accounts:         &mock.AccountsStub{},
+		KeyGen:           keyGen,
+		SingleSigner:     signer,
+		PubkeyConv:       &mock.PubkeyConverterStub{},
+		SignMarshalizer:  &mock.MarshalizerMock{},
+		TxSignHasher:     mock.HasherMock{},
+		TxVersionChecker: versioning.NewTxVersionChecker(1),
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	})
+
+	return &mock.MultisigAccountHandlerStub{
+		GetMultisigConfigForAddressCalled: func(address []byte) (*multisig.MultisigConfig, error) {
+			if !bytes.Equal(address, senderAddress) {
+				return nil, process.ErrAccountIsNotMultisig
+			}
+			return &multisig.MultisigConfig{Threshold: threshold, Signers: multisigSigners}, nil
+		},
+		VerifyMultisigSignaturesCalled: multisigAccounts.VerifyMultisigSignatures,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer C"), []byte("sig-signer A")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(2))
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxNotEnoughSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer B")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(2))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrNotEnoughMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxTooManySignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer A"), []byte("sig-signer B"), []byte("sig-signer C"), []byte("sig-signer A")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(2))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrTooManyMultisigSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer A"), []byte("sig-signer D")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(2))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidMultisigSignature, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxSameSignerTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer A"), []byte("sig-signer A")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(2))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidMultisigSignature, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultisigTxFromRegularAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	signatures := [][]byte{[]byte("sig-signer A")}
+	tx := createMultisigTx(chainID, minTxVersion, signatures)
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, &mock.MultisigAccountHandlerStub{})
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrAccountIsNotMultisig, err)
+}
+
+func TestInterceptedTransaction_CheckValiditySingleSignedTxFromMultisigAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createMultisigTx(chainID, minTxVersion, nil)
+	tx.Signature = []byte("sig-sender")
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(1))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrMultisigSignaturesRequired, err)
+
+	txi, _ = createInterceptedMultisigTx(tx, chainID, minTxVersion, &mock.MultisigAccountHandlerStub{})
+
+	err = txi.CheckValidity()
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValiditySingleSignedTxBeforeMultisigEnableEpochShouldNotReadConfig(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createMultisigTx(chainID, minTxVersion, nil)
+	tx.Signature = []byte("sig-sender")
+	multisigAccounts := &mock.MultisigAccountHandlerStub{
+		GetMultisigConfigForAddressCalled: func(address []byte) (*multisig.MultisigConfig, error) {
+			assert.Fail(t, "should have not read the multisig configuration")
+			return nil, process.ErrAccountIsNotMultisig
+		},
+		IsMultisigAccountsEnabledCalled: func() bool {
+			return false
+		},
+	}
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, multisigAccounts)
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValiditySignatureAndMultisigSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createMultisigTx(chainID, minTxVersion, [][]byte{[]byte("sig-signer A")})
+	tx.Signature = []byte("sig-sender")
+	txi, _ := createInterceptedMultisigTx(tx, chainID, minTxVersion, createMultisigAccountHandlerStub(1))
+
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrSignatureAndMultisigSignatures, err)
+}
+
 func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1029,8 +1419,10 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		&mock.MultisigAccountHandlerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -1164,8 +1556,10 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		&mock.MultisigAccountHandlerStub{},
 	)
 	require.Nil(t, err)
 
@@ -1261,6 +1655,110 @@ func TestInterceptedTransaction_CheckValidityOfRelayedTx(t *testing.T) {
 	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
 }
 
+func TestInterceptedTransaction_CheckValidityOfMultiRelayedTx(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(0),
+		Data:      []byte(core.MultiRelayedTransaction),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   chainID,
+		Version:   minTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTxWithArgParser(tx)
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidNumberOfInnerTransactions, err)
+
+	userTx := &dataTransaction.Transaction{
+		SndAddr:   recvAddress,
+		RcvAddr:   senderAddress,
+		Value:     big.NewInt(0),
+		Data:      []byte("hello"),
+		GasLimit:  3,
+		GasPrice:  4,
+		Signature: sigOk,
+		ChainID:   chainID,
+		Version:   minTxVersion,
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	userTxData, _ := marshalizer.Marshal(userTx)
+	tx.Data = []byte(core.MultiRelayedTransaction + "@" + hex.EncodeToString(userTxData) + "@" + hex.EncodeToString(userTxData))
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Nil(t, err)
+
+	tx.Value = big.NewInt(1)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrMultiRelayedTxValueNotZero, err)
+
+	tx.Value = big.NewInt(0)
+	otherUserTx := *userTx
+	otherUserTx.SndAddr = []byte("otherAddress")
+	otherUserTxData, _ := marshalizer.Marshal(&otherUserTx)
+	tx.Data = []byte(core.MultiRelayedTransaction + "@" + hex.EncodeToString(userTxData) + "@" + hex.EncodeToString(otherUserTxData))
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrInnerTxSenderNotInReceiverShard, err)
+
+	otherUserTx.SndAddr = recvAddress
+	otherUserTx.Signature = []byte("notOk")
+	otherUserTxData, _ = marshalizer.Marshal(&otherUserTx)
+	tx.Data = []byte(core.MultiRelayedTransaction + "@" + hex.EncodeToString(userTxData) + "@" + hex.EncodeToString(otherUserTxData))
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+
+	otherUserTx.Signature = sigOk
+	otherUserTx.Data = []byte(core.MultiRelayedTransaction)
+	otherUserTxData, _ = marshalizer.Marshal(&otherUserTx)
+	tx.Data = []byte(core.MultiRelayedTransaction + "@" + hex.EncodeToString(userTxData) + "@" + hex.EncodeToString(otherUserTxData))
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
+
+	tooManyInnerTxs := core.MultiRelayedTransaction + strings.Repeat("@"+hex.EncodeToString(userTxData), transaction.MaxInnerTxsInMultiRelayedTx+1)
+	tx.Data = []byte(tooManyInnerTxs)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidNumberOfInnerTransactions, err)
+}
+
+func TestInterceptedTransaction_CheckValidityOfMultiRelayedTxBeforeEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(0),
+		Data:      []byte(core.MultiRelayedTransaction),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   chainID,
+		Version:   minTxVersion,
+	}
+
+	// before the activation the transaction is a regular smart contract call, so the inner transactions are not checked
+	txi, _ := createInterceptedTxWithArgParser(tx, false)
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+
+	txi, _ = createInterceptedTxWithArgParser(tx, true)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidNumberOfInnerTransactions, err)
+}
+
 //------- IsInterfaceNil
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
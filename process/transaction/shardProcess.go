@@ -29,6 +29,9 @@ var _ process.TransactionProcessor = (*txProcessor)(nil)
 // for move balance transactions that provide more gas than needed
 const RefundGasMessage = "refundedGas"
 
+// MaxInnerTxsInMultiRelayedTx is the maximum number of user transactions a multi relayed transaction can carry
+const MaxInnerTxsInMultiRelayedTx = 50
+
 // txProcessor implements TransactionProcessor interface and can modify account states according to a transaction
 type txProcessor struct {
 	*baseTxProcessor
@@ -39,9 +42,13 @@ type txProcessor struct {
 	argsParser                     process.ArgumentsParser
 	scrForwarder                   process.IntermediateTransactionHandler
 	signMarshalizer                marshal.Marshalizer
+	guardedAccounts                process.GuardedAccountHandler
+	multisigAccounts               process.MultisigAccountHandler
 	flagRelayedTx                  atomic.Flag
+	flagMultiRelayedTx             atomic.Flag
 	flagMetaProtection             atomic.Flag
 	relayedTxEnableEpoch           uint32
+	multiRelayedTxEnableEpoch      uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	metaProtectionEnableEpoch      uint32
 }
@@ -63,9 +70,12 @@ type ArgsNewTxProcessor struct {
 	ArgsParser                     process.ArgumentsParser
 	ScrForwarder                   process.IntermediateTransactionHandler
 	RelayedTxEnableEpoch           uint32
+	MultiRelayedTxEnableEpoch      uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
 	EpochNotifier                  process.EpochNotifier
+	GuardedAccounts                process.GuardedAccountHandler
+	MultisigAccounts               process.MultisigAccountHandler
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -115,6 +125,12 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.GuardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(args.MultisigAccounts) {
+		return nil, process.ErrNilMultisigAccountHandler
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         args.Accounts,
@@ -135,7 +151,10 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		argsParser:                     args.ArgsParser,
 		scrForwarder:                   args.ScrForwarder,
 		signMarshalizer:                args.SignMarshalizer,
+		guardedAccounts:                args.GuardedAccounts,
+		multisigAccounts:               args.MultisigAccounts,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
+		multiRelayedTxEnableEpoch:      args.MultiRelayedTxEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
 	}
@@ -183,6 +202,14 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 		return vmcommon.UserError, err
 	}
 
+	err = txProc.checkGuardian(tx, acntSnd)
+	if err == nil {
+		err = txProc.checkMultisig(tx, acntSnd)
+	}
+	if err != nil {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, acntSnd, err)
+	}
+
 	switch txType {
 	case process.MoveBalance:
 		err = txProc.processMoveBalance(tx, acntSnd, acntDst, dstShardTxType, false)
@@ -198,11 +225,56 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 		return txProc.processBuiltInFunctionCall(tx, acntSnd, acntDst)
 	case process.RelayedTx:
 		return txProc.processRelayedTx(tx, acntSnd, acntDst)
+	case process.MultiRelayedTx:
+		return txProc.processMultiRelayedTx(tx, acntSnd, acntDst)
 	}
 
 	return vmcommon.UserError, txProc.executingFailedTransaction(tx, acntSnd, process.ErrWrongTransaction)
 }
 
+// checkGuardian checks that a transaction sent from a guarded account in the current shard is co-signed by the
+// active guardian of the account
+func (txProc *txProcessor) checkGuardian(tx *transaction.Transaction, acntSnd state.UserAccountHandler) error {
+	if check.IfNil(acntSnd) {
+		return nil
+	}
+
+	return txProc.guardedAccounts.CheckGuardedTransaction(acntSnd, tx)
+}
+
+// checkMultisig checks that a transaction sent from a multisig account in the current shard carries enough valid
+// signatures of its signers. The signatures are verified at interception, but they are verified again against the
+// current configuration as the sender might have become multisig or might have changed its signers afterwards
+func (txProc *txProcessor) checkMultisig(tx *transaction.Transaction, acntSnd state.UserAccountHandler) error {
+	if check.IfNil(acntSnd) {
+		return nil
+	}
+	if !txProc.multisigAccounts.IsMultisigAccountsEnabled() {
+		if len(tx.Signatures) > 0 {
+			return process.ErrMultisigAccountsNotEnabled
+		}
+		return nil
+	}
+
+	config, err := txProc.multisigAccounts.GetMultisigConfig(acntSnd)
+	isMultisig := err == nil
+	if err != nil && err != process.ErrAccountIsNotMultisig {
+		return err
+	}
+
+	if len(tx.Signatures) == 0 {
+		if isMultisig {
+			return process.ErrMultisigSignaturesRequired
+		}
+		return nil
+	}
+	if !isMultisig {
+		return process.ErrAccountIsNotMultisig
+	}
+
+	return txProc.multisigAccounts.VerifyMultisigTransaction(config, tx)
+}
+
 func (txProc *txProcessor) executeAfterFailedMoveBalanceTransaction(
 	tx *transaction.Transaction,
 	txError error,
@@ -578,6 +650,144 @@ func (txProc *txProcessor) processRelayedTx(
 	return txProc.processUserTx(tx, userTx, tx.Value, tx.Nonce, txHash)
 }
 
+// processMultiRelayedTx charges the relayer once for all the inner transactions and executes each of them, in the
+// receiver shard, as if it was relayed on its own. The outcome of each inner transaction is reported through the
+// smart contract results it generates
+func (txProc *txProcessor) processMultiRelayedTx(
+	tx *transaction.Transaction,
+	relayerAcnt, acntDst state.UserAccountHandler,
+) (vmcommon.ReturnCode, error) {
+
+	_, args, err := txProc.argsParser.ParseCallData(string(tx.GetData()))
+	if err != nil {
+		return 0, err
+	}
+
+	if !txProc.flagMultiRelayedTx.IsSet() {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrMultiRelayedTxDisabled)
+	}
+	if len(args) == 0 || len(args) > MaxInnerTxsInMultiRelayedTx {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrInvalidNumberOfInnerTransactions)
+	}
+	if tx.Value.Sign() != 0 {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrMultiRelayedTxValueNotZero)
+	}
+
+	_, _, relayerFee, remainingGasLimit := txProc.computeRelayedTxFees(tx)
+	userTxs, err := txProc.createInnerTxsOfMultiRelayedTx(tx, args, remainingGasLimit)
+	if err != nil {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, err)
+	}
+
+	// the relayer pays exactly what is moved to the senders of the inner transactions, on top of its own fee
+	totalFee := big.NewInt(0).Set(relayerFee)
+	for _, userTx := range userTxs {
+		totalFee.Add(totalFee, txProc.economicsFee.ComputeFeeForProcessing(userTx, userTx.GasLimit))
+	}
+
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	if !check.IfNil(relayerAcnt) {
+		err = relayerAcnt.SubFromBalance(totalFee)
+		if err != nil {
+			return 0, err
+		}
+
+		relayerAcnt.IncreaseNonce(1)
+		err = txProc.accounts.SaveAccount(relayerAcnt)
+		if err != nil {
+			return 0, err
+		}
+
+		txProc.txFeeHandler.ProcessTransactionFee(relayerFee, big.NewInt(0), txHash)
+	}
+
+	if check.IfNil(acntDst) {
+		return vmcommon.Ok, nil
+	}
+
+	for _, userTx := range userTxs {
+		err = txProc.processInnerTxOfMultiRelayedTx(tx, userTx, txHash)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return vmcommon.Ok, nil
+}
+
+func (txProc *txProcessor) createInnerTxsOfMultiRelayedTx(
+	tx *transaction.Transaction,
+	args [][]byte,
+	remainingGasLimit uint64,
+) ([]*transaction.Transaction, error) {
+	rcvShard := txProc.shardCoordinator.ComputeId(tx.RcvAddr)
+	userTxs := make([]*transaction.Transaction, 0, len(args))
+	totalUserTxsGasLimit := uint64(0)
+	for _, arg := range args {
+		userTx := &transaction.Transaction{}
+		err := txProc.signMarshalizer.Unmarshal(userTx, arg)
+		if err != nil {
+			return nil, err
+		}
+		if txProc.shardCoordinator.ComputeId(userTx.SndAddr) != rcvShard {
+			return nil, process.ErrInnerTxSenderNotInReceiverShard
+		}
+		if userTx.GasPrice != tx.GasPrice {
+			return nil, process.ErrRelayedGasPriceMissmatch
+		}
+
+		totalUserTxsGasLimit, err = core.SafeAddUint64(totalUserTxsGasLimit, userTx.GasLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		userTxs = append(userTxs, userTx)
+	}
+
+	if totalUserTxsGasLimit != remainingGasLimit {
+		return nil, process.ErrRelayedTxGasLimitMissmatch
+	}
+
+	return userTxs, nil
+}
+
+// processInnerTxOfMultiRelayedTx moves the fee paid by the relayer for the inner transaction to its sender and
+// executes the inner transaction
+func (txProc *txProcessor) processInnerTxOfMultiRelayedTx(
+	tx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	txHash []byte,
+) error {
+	userAcnt, err := txProc.getAccountFromAddress(userTx.SndAddr)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(userAcnt) {
+		return process.ErrNilUserAccount
+	}
+
+	userTxFee := txProc.economicsFee.ComputeFeeForProcessing(userTx, userTx.GasLimit)
+	err = userAcnt.AddToBalance(userTxFee)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.accounts.SaveAccount(userAcnt)
+	if err != nil {
+		return err
+	}
+
+	// the nonce of the inner transaction is used for the results sent back to the relayer, as several inner
+	// transactions of the same relayed transaction can fail
+	_, err = txProc.processUserTx(tx, userTx, big.NewInt(0), userTx.Nonce, txHash)
+
+	return err
+}
+
 func (txProc *txProcessor) computeRelayedTxFees(tx *transaction.Transaction) (*big.Int, *big.Int, *big.Int, uint64) {
 	relayerGasLimit := txProc.economicsFee.ComputeGasLimit(tx)
 	relayerFee := txProc.economicsFee.ComputeMoveBalanceFee(tx)
@@ -651,6 +861,12 @@ func (txProc *txProcessor) processUserTx(
 	relayerAdr := originalTx.SndAddr
 	txType, dstShardTxType := txProc.txTypeHandler.ComputeTransactionType(userTx)
 	err = txProc.checkTxValues(userTx, acntSnd, acntDst, true)
+	if err == nil {
+		err = txProc.checkGuardian(userTx, acntSnd)
+	}
+	if err == nil {
+		err = txProc.checkMultisig(userTx, acntSnd)
+	}
 	if err != nil {
 		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue)
 		if errRemove != nil {
@@ -835,9 +1051,13 @@ func (txProc *txProcessor) executeFailedRelayedUserTx(
 			return err
 		}
 
-		err = txProc.badTxForwarder.AddIntermediateTransactions([]data.TransactionHandler{originalTx})
-		if err != nil {
-			return err
+		// a multi relayed transaction stays valid when some of its inner transactions fail
+		txType, _ := txProc.txTypeHandler.ComputeTransactionType(originalTx)
+		if txType != process.MultiRelayedTx {
+			err = txProc.badTxForwarder.AddIntermediateTransactions([]data.TransactionHandler{originalTx})
+			if err != nil {
+				return err
+			}
 		}
 
 		err = txProc.accounts.SaveAccount(relayerAcnt)
@@ -854,6 +1074,9 @@ func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
 	txProc.flagRelayedTx.Toggle(epoch >= txProc.relayedTxEnableEpoch)
 	log.Debug("txProcessor: relayed transactions", "enabled", txProc.flagRelayedTx.IsSet())
 
+	txProc.flagMultiRelayedTx.Toggle(epoch >= txProc.multiRelayedTxEnableEpoch)
+	log.Debug("txProcessor: multi relayed transactions", "enabled", txProc.flagMultiRelayedTx.IsSet())
+
 	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("txProcessor: penalized too much gas", "enabled", txProc.flagPenalizedTooMuchGas.IsSet())
 
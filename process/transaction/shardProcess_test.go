@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/multisig"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -20,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -83,6 +85,8 @@ func createArgsForTxProcessor() txproc.ArgsNewTxProcessor {
 		ArgsParser:       &mock.ArgumentParserMock{},
 		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
 		EpochNotifier:    &mock.EpochNotifierStub{},
+		GuardedAccounts:  &mock.GuardedAccountHandlerStub{},
+		MultisigAccounts: &mock.MultisigAccountHandlerStub{},
 	}
 	return args
 }
@@ -259,6 +263,28 @@ func TestNewTxProcessor_NilEpochNotifierShouldErr(t *testing.T) {
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilGuardedAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.GuardedAccounts = nil
+	txProc, err := txproc.NewTxProcessor(args)
+
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_NilMultisigAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.MultisigAccounts = nil
+	txProc, err := txproc.NewTxProcessor(args)
+
+	assert.Equal(t, process.ErrNilMultisigAccountHandler, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -988,6 +1014,209 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, 2, saveAccountCalled)
 }
 
+func TestTxProcessor_ProcessTransactionNotGuardedFromGuardedAccountShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+
+	acntSrc, _ := state.NewUserAccount(tx.SndAddr)
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	badTxWasForwarded := false
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxWasForwarded = true
+			return nil
+		},
+	}
+	args.GuardedAccounts = &mock.GuardedAccountHandlerStub{
+		CheckGuardedTransactionCalled: func(account state.UserAccountHandler, checkedTx *transaction.Transaction) error {
+			assert.Equal(t, acntSrc, account)
+			assert.Equal(t, &tx, checkedTx)
+			return process.ErrTransactionNotGuarded
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(&tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, badTxWasForwarded)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionSingleSignedFromMultisigAccountShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+
+	acntSrc, _ := state.NewUserAccount(tx.SndAddr)
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	badTxWasForwarded := false
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxWasForwarded = true
+			return nil
+		},
+	}
+	args.MultisigAccounts = &mock.MultisigAccountHandlerStub{
+		GetMultisigConfigCalled: func(account state.UserAccountHandler) (*multisig.MultisigConfig, error) {
+			assert.Equal(t, acntSrc, account)
+			return &multisig.MultisigConfig{Threshold: 1, Signers: [][]byte{[]byte("signer")}}, nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(&tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, badTxWasForwarded)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionFromMultisigAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+
+	multisigChecked := false
+	multisigAccounts := &mock.MultisigAccountHandlerStub{
+		GetMultisigConfigCalled: func(account state.UserAccountHandler) (*multisig.MultisigConfig, error) {
+			multisigChecked = true
+			return &multisig.MultisigConfig{Threshold: 1, Signers: [][]byte{[]byte("signer")}}, nil
+		},
+		IsMultisigAccountsEnabledCalled: func() bool {
+			return false
+		},
+	}
+
+	acntSrc, _ := state.NewUserAccount(tx.SndAddr)
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(200)
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.MultisigAccounts = multisigAccounts
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	// before the enable epoch the multisig configuration is not read
+	_, err := execTx.ProcessTransaction(&tx)
+	assert.Nil(t, err)
+	assert.False(t, multisigChecked)
+
+	// the signatures are verified against the current configuration
+	multisigAccounts.IsMultisigAccountsEnabledCalled = nil
+	multisigVerified := false
+	multisigAccounts.VerifyMultisigTransactionCalled = func(config *multisig.MultisigConfig, txToVerify *transaction.Transaction) error {
+		multisigVerified = true
+		assert.Equal(t, uint32(1), config.Threshold)
+		assert.Equal(t, &tx, txToVerify)
+		return nil
+	}
+	tx.Nonce = 5
+	tx.Signatures = [][]byte{[]byte("sig-signer")}
+	_, err = execTx.ProcessTransaction(&tx)
+	assert.Nil(t, err)
+	assert.True(t, multisigChecked)
+	assert.True(t, multisigVerified)
+	assert.Equal(t, uint64(6), acntSrc.Nonce)
+}
+
+func TestTxProcessor_ProcessTransactionWithSignaturesNotMatchingTheCurrentMultisigConfigShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+	tx.Signatures = [][]byte{[]byte("sig-old signer")}
+
+	acntSrc, _ := state.NewUserAccount(tx.SndAddr)
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	badTxWasForwarded := false
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxWasForwarded = true
+			return nil
+		},
+	}
+	args.MultisigAccounts = &mock.MultisigAccountHandlerStub{
+		GetMultisigConfigCalled: func(account state.UserAccountHandler) (*multisig.MultisigConfig, error) {
+			return &multisig.MultisigConfig{Threshold: 1, Signers: [][]byte{[]byte("new signer")}}, nil
+		},
+		VerifyMultisigTransactionCalled: func(config *multisig.MultisigConfig, tx *transaction.Transaction) error {
+			return process.ErrInvalidMultisigSignature
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(&tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, badTxWasForwarded)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionWithSignaturesFromRegularAccountShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 4
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(61)
+	tx.Signatures = [][]byte{[]byte("sig-signer")}
+
+	acntSrc, _ := state.NewUserAccount(tx.SndAddr)
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+	acntSrc.Nonce = 4
+	acntSrc.Balance = big.NewInt(90)
+	acntDst.Balance = big.NewInt(10)
+
+	args := createArgsForTxProcessor()
+	args.Accounts = createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(&tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, uint64(5), acntSrc.Nonce)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+}
+
 func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 	saveAccountCalled := 0
 
@@ -1281,8 +1510,9 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  mock.NewPubkeyConverterMock(32),
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	computeType, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 
@@ -1658,8 +1888,9 @@ func TestTxProcessor_ProcessRelayedTransaction(t *testing.T) {
 	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubKeyConverter,
 		ShardCoordinator: shardC,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
 
@@ -2183,8 +2414,9 @@ func TestTxProcessor_ProcessRelayedTransactionDisabled(t *testing.T) {
 	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubKeyConverter,
 		ShardCoordinator: shardC,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
 
@@ -2791,3 +3023,256 @@ func TestTxProcessor_ExecuteFailingRelayedTxShouldNotHaveNegativeFee(t *testing.
 	assert.Nil(t, err)
 	assert.False(t, negativeCost)
 }
+
+func createMultiRelayedTxProcessorArgs(accounts ...state.UserAccountHandler) txproc.ArgsNewTxProcessor {
+	pubKeyConverter := mock.NewPubkeyConverterMock(4)
+	shardC, _ := sharding.NewMultiShardCoordinator(1, 0)
+	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
+		PubkeyConverter:  pubKeyConverter,
+		ShardCoordinator: shardC,
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
+
+	args := createArgsForTxProcessor()
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			for _, account := range accounts {
+				if bytes.Equal(address, account.AddressBytes()) {
+					return account, nil
+				}
+			}
+
+			return nil, errors.New("failure")
+		},
+	}
+	args.ShardCoordinator = shardC
+	args.TxTypeHandler = txTypeHandler
+	args.PubkeyConv = pubKeyConverter
+	args.ArgsParser = smartContract.NewArgumentParser()
+	args.EconomicsFee = &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 1
+		},
+		ComputeMoveBalanceFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(1)
+		},
+		ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return big.NewInt(0).SetUint64(gasToUse)
+		},
+		ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(0).SetUint64(tx.GetGasLimit())
+		},
+	}
+
+	return args
+}
+
+func createMultiRelayedTx(relayerAddr []byte, userTxs ...*transaction.Transaction) *transaction.Transaction {
+	marshalizer := &mock.MarshalizerMock{}
+	tx := &transaction.Transaction{
+		SndAddr:  relayerAddr,
+		RcvAddr:  userTxs[0].SndAddr,
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 1,
+		Data:     []byte(core.MultiRelayedTransaction),
+	}
+	for _, userTx := range userTxs {
+		userTxMarshalled, _ := marshalizer.Marshal(userTx)
+		tx.Data = append(tx.Data, []byte("@"+hex.EncodeToString(userTxMarshalled))...)
+		tx.GasLimit += userTx.GasLimit
+	}
+
+	return tx
+}
+
+func createUserTxOfMultiRelayedTx(sndAddr []byte, rcvAddr []byte, value int64, gasLimit uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Value:    big.NewInt(value),
+		RcvAddr:  rcvAddr,
+		SndAddr:  sndAddr,
+		GasPrice: 1,
+		GasLimit: gasLimit,
+	}
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionDisabledShouldFail(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rlyr"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("usr1"))
+	userTx := createUserTxOfMultiRelayedTx(userAcnt.AddressBytes(), []byte("rcv1"), 5, 2)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx)
+
+	args := createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt)
+	args.MultiRelayedTxEnableEpoch = maxEpoch
+	badTxAdded := false
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxAdded = true
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, badTxAdded)
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionWithValueShouldFail(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rlyr"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("usr1"))
+	userTx := createUserTxOfMultiRelayedTx(userAcnt.AddressBytes(), []byte("rcv1"), 5, 2)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx)
+	tx.Value = big.NewInt(1)
+
+	execTx, _ := txproc.NewTxProcessor(createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt))
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, uint64(1), relayerAcnt.GetNonce())
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionGasLimitMismatchShouldFail(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rlyr"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("usr1"))
+	userTx1 := createUserTxOfMultiRelayedTx(userAcnt.AddressBytes(), []byte("rcv1"), 5, 2)
+	userTx2 := createUserTxOfMultiRelayedTx([]byte("usr2"), []byte("rcv2"), 5, 3)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx1, userTx2)
+	tx.GasLimit++
+
+	execTx, _ := txproc.NewTxProcessor(createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt))
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionInnerSenderInOtherShardShouldFail(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rly0"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("usr0"))
+	userTx1 := createUserTxOfMultiRelayedTx(userAcnt.AddressBytes(), []byte("rcv0"), 5, 2)
+	userTx2 := createUserTxOfMultiRelayedTx([]byte("usr1"), []byte("rcv1"), 5, 3)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx1, userTx2)
+
+	args := createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt)
+	args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(2, 0)
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionShouldChargeRelayerOnceAndExecuteAllInnerTxs(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rlyr"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt1, _ := state.NewUserAccount([]byte("usr1"))
+	userAcnt1.Balance = big.NewInt(10)
+	userAcnt2, _ := state.NewUserAccount([]byte("usr2"))
+	userAcnt2.Balance = big.NewInt(10)
+	rcvAcnt1, _ := state.NewUserAccount([]byte("rcv1"))
+	rcvAcnt2, _ := state.NewUserAccount([]byte("rcv2"))
+
+	userTx1 := createUserTxOfMultiRelayedTx(userAcnt1.AddressBytes(), rcvAcnt1.AddressBytes(), 5, 2)
+	userTx2 := createUserTxOfMultiRelayedTx(userAcnt2.AddressBytes(), rcvAcnt2.AddressBytes(), 7, 3)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx1, userTx2)
+
+	args := createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt1, userAcnt2, rcvAcnt1, rcvAcnt2)
+	relayerFeeProcessed := false
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+			if cost.Cmp(big.NewInt(1)) == 0 {
+				assert.False(t, relayerFeeProcessed)
+				relayerFeeProcessed = true
+			}
+		},
+	}
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			assert.Fail(t, "should have not added the multi relayed transaction as bad transaction")
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.True(t, relayerFeeProcessed)
+	assert.Equal(t, big.NewInt(94), relayerAcnt.GetBalance())
+	assert.Equal(t, uint64(1), relayerAcnt.GetNonce())
+	assert.Equal(t, big.NewInt(5), userAcnt1.GetBalance())
+	assert.Equal(t, uint64(1), userAcnt1.GetNonce())
+	assert.Equal(t, big.NewInt(3), userAcnt2.GetBalance())
+	assert.Equal(t, uint64(1), userAcnt2.GetNonce())
+	assert.Equal(t, big.NewInt(5), rcvAcnt1.GetBalance())
+	assert.Equal(t, big.NewInt(7), rcvAcnt2.GetBalance())
+}
+
+func TestTxProcessor_ProcessMultiRelayedTransactionFailedInnerTxShouldNotFailTheOthers(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("rlyr"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt1, _ := state.NewUserAccount([]byte("usr1"))
+	userAcnt1.Balance = big.NewInt(1)
+	userAcnt2, _ := state.NewUserAccount([]byte("usr2"))
+	userAcnt2.Balance = big.NewInt(10)
+	rcvAcnt1, _ := state.NewUserAccount([]byte("rcv1"))
+	rcvAcnt2, _ := state.NewUserAccount([]byte("rcv2"))
+
+	userTx1 := createUserTxOfMultiRelayedTx(userAcnt1.AddressBytes(), rcvAcnt1.AddressBytes(), 5, 2)
+	userTx2 := createUserTxOfMultiRelayedTx(userAcnt2.AddressBytes(), rcvAcnt2.AddressBytes(), 7, 3)
+	tx := createMultiRelayedTx(relayerAcnt.AddressBytes(), userTx1, userTx2)
+
+	args := createMultiRelayedTxProcessorArgs(relayerAcnt, userAcnt1, userAcnt2, rcvAcnt1, rcvAcnt2)
+	badTxAdded := false
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxAdded = true
+			return nil
+		},
+	}
+	var failedResults []*smartContractResult.SmartContractResult
+	args.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			for _, txHandler := range txs {
+				scr, ok := txHandler.(*smartContractResult.SmartContractResult)
+				if ok && len(scr.ReturnMessage) > 0 {
+					failedResults = append(failedResults, scr)
+				}
+			}
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.False(t, badTxAdded)
+	assert.Equal(t, 1, len(failedResults))
+	assert.Equal(t, relayerAcnt.AddressBytes(), failedResults[0].RcvAddr)
+	assert.Equal(t, userAcnt1.AddressBytes(), failedResults[0].SndAddr)
+	assert.Equal(t, big.NewInt(0), rcvAcnt1.GetBalance())
+	assert.Equal(t, big.NewInt(7), rcvAcnt2.GetBalance())
+}
@@ -0,0 +1,278 @@
+package badgerdb
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+const valueLogGCInterval = 5 * time.Minute
+const valueLogGCDiscardRatio = 0.5
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+type DB struct {
+	*baseBadgerDb
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (s *DB, err error) {
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := openBadgerDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bbdb := &baseBadgerDb{
+		db: db,
+	}
+
+	dbStore := &DB{
+		baseBadgerDb:      bbdb,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		dbClosed:          make(chan struct{}),
+	}
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	timerValueLogGC := time.NewTicker(valueLogGCInterval)
+	defer timerValueLogGC.Stop()
+
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-timerValueLogGC.C:
+			s.runValueLogGC()
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// runValueLogGC rewrites the value log files until there is nothing left to reclaim
+func (s *DB) runValueLogGC() {
+	for {
+		err := s.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			if err != badger.ErrNoRewrite {
+				log.Debug("badgerdb RunValueLogGC", "path", s.path, "error", err.Error())
+			}
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	if s.batch.isRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	if s.batch.isRemoved(key) {
+		return storage.ErrKeyNotFound
+	}
+	data := s.batch.Get(key)
+	if data != nil {
+		return nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	if b.isEmpty() {
+		return nil
+	}
+
+	writeBatch := s.db.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for key, val := range b.cachedData {
+		err := writeBatch.Set([]byte(key), val)
+		if err != nil {
+			return err
+		}
+	}
+	for key := range b.removedKeys {
+		err := writeBatch.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeBatch.Flush()
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.dbClosed <- struct{}{}
+	err := s.db.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(s.path)
+
+	return err
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,102 @@
+package badgerdb
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Persister = (*ReadOnlyDB)(nil)
+
+// ReadOnlyDB holds a pointer to an existing badger database that is opened without being written to
+type ReadOnlyDB struct {
+	*baseBadgerDb
+	path string
+}
+
+// NewReadOnlyDB opens the existing badger database from the provided location in read-only mode
+func NewReadOnlyDB(path string) (*ReadOnlyDB, error) {
+	db, err := badger.Open(createOptions(path).WithReadOnly(true))
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &ReadOnlyDB{
+		baseBadgerDb: &baseBadgerDb{
+			db: db,
+		},
+		path: path,
+	}, nil
+}
+
+// Put returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Get returns the value associated to the key
+func (s *ReadOnlyDB) Get(key []byte) ([]byte, error) {
+	var data []byte
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *ReadOnlyDB) Has(key []byte) error {
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *ReadOnlyDB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *ReadOnlyDB) Close() error {
+	return s.db.Close()
+}
+
+// Remove returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Destroy returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Destroy() error {
+	return storage.ErrReadOnlyDB
+}
+
+// DestroyClosed returns ErrReadOnlyDB
+func (s *ReadOnlyDB) DestroyClosed() error {
+	return storage.ErrReadOnlyDB
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *ReadOnlyDB) IsInterfaceNil() bool {
+	return s == nil
+}
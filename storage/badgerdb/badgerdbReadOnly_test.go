@@ -0,0 +1,41 @@
+package badgerdb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyDB_ShouldReadButNotWrite(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	bdb, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+	_ = bdb.Put(key, val)
+	_ = bdb.Close()
+
+	rodb, err := badgerdb.NewReadOnlyDB(dir)
+	require.Nil(t, err)
+	defer func() {
+		_ = rodb.Close()
+	}()
+
+	recovered, err := rodb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, rodb.Has(key))
+	assert.Equal(t, storage.ErrKeyNotFound, rodb.Has([]byte("missing key")))
+
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Put(key, val))
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Remove(key))
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Destroy())
+}
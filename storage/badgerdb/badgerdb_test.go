@@ -0,0 +1,297 @@
+package badgerdb_test
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) (p *badgerdb.DB) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb, err := badgerdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+
+	assert.Nil(t, err, "Failed creating badgerdb database file")
+	return bdb
+}
+
+func TestDB_InitNoError(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Init()
+
+	assert.Nil(t, err, "error initializing DB")
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_DoubleOpenButClosedInTimeShouldWork(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	go func() {
+		time.Sleep(time.Second * 3)
+		_ = bdb1.Close()
+	}()
+
+	bdb2, err := badgerdb.NewDB(dir, 10, 1)
+	assert.Nil(t, err)
+	assert.NotNil(t, bdb2)
+
+	_ = bdb2.Close()
+}
+
+func TestDB_ReopenShouldKeepData(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	bdb, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("value")
+	err = bdb.Put(key, val)
+	require.Nil(t, err)
+	_ = bdb.Close()
+
+	bdbReopened, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	recovered, err := bdbReopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	_ = bdbReopened.Close()
+}
+
+func TestDB_GetErrorAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetErrorOnFail(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 100)
+	_ = bdb.Close()
+
+	v, err := bdb.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.NotNil(t, err)
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	_ = bdb.Remove(key)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has(key))
+}
+
+func TestDB_RemoveAfterTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	_ = bdb.Remove(key)
+	time.Sleep(time.Second * 2)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_PutGetValueWithRemovedContentShouldWork(t *testing.T) {
+	key, val := []byte("key"), []byte("removed")
+	bdb := createBadgerDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_HasPresent(t *testing.T) {
+	key, val := []byte("key3"), []byte("value3")
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err, "error saving in DB")
+
+	err = bdb.Has(key)
+	assert.Nil(t, err)
+}
+
+func TestDB_HasNotPresent(t *testing.T) {
+	key := []byte("key4")
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemovePresent(t *testing.T) {
+	key, val := []byte("key5"), []byte("value5")
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err, "error saving in DB")
+
+	err = bdb.Remove(key)
+	assert.Nil(t, err, "no error expected but got %s", err)
+
+	err = bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_Close(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Close()
+	assert.Nil(t, err, "no error expected but got %s", err)
+
+	err = bdb.DestroyClosed()
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Destroy()
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+		"key5": []byte("value5"),
+		"key6": []byte("value6"),
+		"key7": []byte("value7"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+}
+
+func TestDB_PutGetLargeValue(t *testing.T) {
+	t.Parallel()
+
+	buffLargeValue := make([]byte, 32*1000000) //equivalent to ~1000000 hashes
+	key := []byte("key")
+	_, _ = rand.Read(buffLargeValue)
+
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, buffLargeValue)
+	assert.Nil(t, err)
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+
+	assert.Equal(t, buffLargeValue, recovered)
+}
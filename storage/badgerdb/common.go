@@ -0,0 +1,124 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/options"
+)
+
+const maxRetries = 10
+const timeBetweenRetries = time.Second
+
+// the default badger values are tuned for a single big database while a node opens tens of them
+const maxTableSize = 16 << 20
+const valueLogFileSize = 128 << 20
+const numMemtables = 2
+const numLevelZeroTables = 2
+const numLevelZeroTablesStall = 4
+const valueThreshold = 1 << 10
+
+func createOptions(path string) badger.Options {
+	return badger.DefaultOptions(path).
+		WithLogger(&loggerAdapter{}).
+		WithSyncWrites(true).
+		WithMaxTableSize(maxTableSize).
+		WithValueLogFileSize(valueLogFileSize).
+		WithNumMemtables(numMemtables).
+		WithNumLevelZeroTables(numLevelZeroTables).
+		WithNumLevelZeroTablesStall(numLevelZeroTablesStall).
+		WithValueThreshold(valueThreshold).
+		WithTableLoadingMode(options.FileIO).
+		WithCompression(options.None).
+		// disable internal cache
+		WithBlockCacheSize(0)
+}
+
+func openBadgerDB(path string) (*badger.DB, error) {
+	retries := 0
+	for {
+		db, err := badger.Open(createOptions(path))
+		if err == nil {
+			return db, nil
+		}
+		if !isLockError(err) {
+			return nil, err
+		}
+
+		log.Debug("error opening DB",
+			"error", err,
+			"path", path,
+			"retry", retries,
+		)
+
+		time.Sleep(timeBetweenRetries)
+		retries++
+		if retries > maxRetries {
+			return nil, fmt.Errorf("%w, retried %d number of times", err, maxRetries)
+		}
+	}
+}
+
+func isLockError(err error) bool {
+	return strings.Contains(err.Error(), "Cannot acquire directory lock")
+}
+
+type baseBadgerDb struct {
+	db *badger.DB
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (bbdb *baseBadgerDb) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := bbdb.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedKey := item.KeyCopy(nil)
+			clonedVal, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badgerdb RangeKeys", "error", err.Error())
+	}
+}
+
+type loggerAdapter struct{}
+
+// Errorf logs the badger internal errors
+func (la *loggerAdapter) Errorf(format string, args ...interface{}) {
+	log.Error(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// Warningf logs the badger internal warnings
+func (la *loggerAdapter) Warningf(format string, args ...interface{}) {
+	log.Warn(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// Infof logs the badger internal information messages at debug level
+func (la *loggerAdapter) Infof(format string, args ...interface{}) {
+	log.Debug(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// Debugf logs the badger internal debug messages at trace level
+func (la *loggerAdapter) Debugf(format string, args ...interface{}) {
+	log.Trace(strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,208 @@
+package badgerdb_test
+
+import (
+	"bufio"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/require"
+)
+
+const numTrieLeaves = 20000
+const numLeavesPerCommit = 1000
+const trieLeafValueSize = 100
+const maxTrieLevelInMemory = 5
+
+type persisterCreator func(path string) (storage.Persister, error)
+
+func getPersisterCreators() map[string]persisterCreator {
+	return map[string]persisterCreator{
+		"LvlDB": func(path string) (storage.Persister, error) {
+			return leveldb.NewDB(path, 2, 100, 10)
+		},
+		"BadgerDB": func(path string) (storage.Persister, error) {
+			return badgerdb.NewDB(path, 2, 100)
+		},
+	}
+}
+
+// countingPersister counts the bytes written and the read operations issued by the trie
+type countingPersister struct {
+	storage.Persister
+	bytesWritten uint64
+	numGets      uint64
+}
+
+func (cp *countingPersister) Put(key, val []byte) error {
+	atomic.AddUint64(&cp.bytesWritten, uint64(len(key)+len(val)))
+	return cp.Persister.Put(key, val)
+}
+
+func (cp *countingPersister) Get(key []byte) ([]byte, error) {
+	atomic.AddUint64(&cp.numGets, 1)
+	return cp.Persister.Get(key)
+}
+
+func createTrie(b *testing.B, db data.DBWriteCacher) data.Trie {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(b, err)
+
+	tr, err := trie.NewTrie(trieStorage, &marshal.GogoProtoMarshalizer{}, &blake2b.Blake2b{}, maxTrieLevelInMemory)
+	require.Nil(b, err)
+
+	return tr
+}
+
+func fillTrie(b *testing.B, tr data.Trie) [][]byte {
+	keys := make([][]byte, 0, numTrieLeaves)
+	for i := 0; i < numTrieLeaves; i++ {
+		key := make([]byte, 32)
+		value := make([]byte, trieLeafValueSize)
+		_, _ = rand.Read(key)
+		_, _ = rand.Read(value)
+
+		err := tr.Update(key, value)
+		require.Nil(b, err)
+		keys = append(keys, key)
+
+		if (i+1)%numLeavesPerCommit == 0 {
+			err = tr.Commit()
+			require.Nil(b, err)
+		}
+	}
+
+	return keys
+}
+
+// readWrittenBytes returns the number of bytes this process passed to the write syscalls, as reported by the kernel
+func readWrittenBytes() (uint64, bool) {
+	file, err := os.Open("/proc/self/io")
+	if err != nil {
+		return 0, false
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "wchar:" {
+			continue
+		}
+
+		value, errParse := strconv.ParseUint(fields[1], 10, 64)
+		return value, errParse == nil
+	}
+
+	return 0, false
+}
+
+func directorySize(path string) uint64 {
+	size := uint64(0)
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+
+	return size
+}
+
+// BenchmarkTrieWorkload_WriteAmplification commits random trie leaves and reports how many bytes each engine
+// wrote to disk (write-amp) and how many bytes it keeps on disk (space-amp) for each byte written by the trie
+func BenchmarkTrieWorkload_WriteAmplification(b *testing.B) {
+	for name, createPersister := range getPersisterCreators() {
+		b.Run(name, func(b *testing.B) {
+			sumWriteAmplification := 0.0
+			sumSpaceAmplification := 0.0
+			for i := 0; i < b.N; i++ {
+				dir, _ := ioutil.TempDir("", "trie_workload_temp")
+				persister, err := createPersister(dir)
+				require.Nil(b, err)
+
+				db := &countingPersister{Persister: persister}
+				tr := createTrie(b, db)
+
+				writtenBefore, canReadWrittenBytes := readWrittenBytes()
+				_ = fillTrie(b, tr)
+				err = persister.Close()
+				require.Nil(b, err)
+				writtenAfter, _ := readWrittenBytes()
+
+				logicalBytes := float64(atomic.LoadUint64(&db.bytesWritten))
+				if canReadWrittenBytes {
+					sumWriteAmplification += float64(writtenAfter-writtenBefore) / logicalBytes
+				}
+				sumSpaceAmplification += float64(directorySize(dir)) / logicalBytes
+
+				_ = os.RemoveAll(dir)
+			}
+
+			b.ReportMetric(sumWriteAmplification/float64(b.N), "write-amp")
+			b.ReportMetric(sumSpaceAmplification/float64(b.N), "space-amp")
+		})
+	}
+}
+
+// BenchmarkTrieWorkload_ReadLatency measures the latency of a trie leaf lookup starting from a collapsed root,
+// so that every lookup reads the nodes on its path from the reopened engine
+func BenchmarkTrieWorkload_ReadLatency(b *testing.B) {
+	for name, createPersister := range getPersisterCreators() {
+		b.Run(name, func(b *testing.B) {
+			dir, _ := ioutil.TempDir("", "trie_workload_temp")
+			defer func() {
+				_ = os.RemoveAll(dir)
+			}()
+
+			persister, err := createPersister(dir)
+			require.Nil(b, err)
+			tr := createTrie(b, persister)
+			keys := fillTrie(b, tr)
+			err = tr.Commit()
+			require.Nil(b, err)
+			rootHash, _ := tr.Root()
+			err = persister.Close()
+			require.Nil(b, err)
+
+			persister, err = createPersister(dir)
+			require.Nil(b, err)
+			defer func() {
+				_ = persister.Close()
+			}()
+
+			db := &countingPersister{Persister: persister}
+			tr = createTrie(b, db)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				recreatedTrie, errRecreate := tr.Recreate(rootHash)
+				if errRecreate != nil {
+					b.Fatal(errRecreate)
+				}
+
+				_, errGet := recreatedTrie.Get(keys[i%len(keys)])
+				if errGet != nil {
+					b.Fatal(errGet)
+				}
+			}
+			b.StopTimer()
+
+			b.ReportMetric(float64(atomic.LoadUint64(&db.numGets))/float64(b.N), "db-reads/op")
+		})
+	}
+}
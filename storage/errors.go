@@ -124,3 +124,6 @@ var ErrNilTimeCache = errors.New("nil time cache")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler")
 
+
+// ErrReadOnlyDB signals that a write operation was attempted on a database opened in read-only mode
+var ErrReadOnlyDB = errors.New("the database is opened in read-only mode")
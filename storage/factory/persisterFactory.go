@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -39,6 +40,8 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	case storageUnit.LvlDBSerial:
 		return leveldb.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+	case storageUnit.BadgerDB:
+		return badgerdb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize)
 	case storageUnit.MemoryDB:
 		return memorydb.New(), nil
 	default:
@@ -46,6 +49,22 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 	}
 }
 
+// CreateReadOnly will open the existing DB from the given path without allowing any writes
+func (pf *PersisterFactory) CreateReadOnly(path string) (storage.Persister, error) {
+	if len(path) == 0 {
+		return nil, errors.New("invalid file path")
+	}
+
+	switch storageUnit.DBType(pf.dbType) {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		return leveldb.NewReadOnlyDB(path, pf.maxOpenFiles)
+	case storageUnit.BadgerDB:
+		return badgerdb.NewReadOnlyDB(path)
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
 // CreateDisabled will return a new disabled persister
 func (pf *PersisterFactory) CreateDisabled() storage.Persister {
 	return &disabledPersister{}
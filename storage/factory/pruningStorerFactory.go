@@ -236,6 +236,11 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	err = psf.setupTxPoolPersistence(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
@@ -407,9 +412,60 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	err = psf.setupValidatorParticipation(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
+func (psf *StorageServiceFactory) setupValidatorParticipation(chainStorer *dataRetriever.ChainStorer, createdStorers *[]storage.Storer) error {
+	if !psf.generalConfig.ValidatorParticipation.Enabled {
+		return nil
+	}
+
+	// Create the validatorParticipation (STATIC) storer, as the history of a validator spans across epochs
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	participationConfig := psf.generalConfig.ValidatorParticipation.StorageConfig
+	participationDbConfig := GetDBFromConfig(participationConfig.DB)
+	participationDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, participationConfig.DB.FilePath)
+	participationCacherConfig := GetCacherFromConfig(participationConfig.Cache)
+	participationBloomFilter := GetBloomFromConfig(participationConfig.Bloom)
+	participationUnit, err := storageUnit.NewStorageUnitFromConf(participationCacherConfig, participationDbConfig, participationBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, participationUnit)
+	chainStorer.AddStorer(dataRetriever.ValidatorParticipationUnit, participationUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setupTxPoolPersistence(chainStorer *dataRetriever.ChainStorer, createdStorers *[]storage.Storer) error {
+	if !psf.generalConfig.TxPoolPersistence.Enabled {
+		return nil
+	}
+
+	// Create the txPool (STATIC) storer, as the pending transactions do not belong to an epoch
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	txPoolConfig := psf.generalConfig.TxPoolPersistence.StorageConfig
+	txPoolDbConfig := GetDBFromConfig(txPoolConfig.DB)
+	txPoolDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, txPoolConfig.DB.FilePath)
+	txPoolCacherConfig := GetCacherFromConfig(txPoolConfig.Cache)
+	txPoolBloomFilter := GetBloomFromConfig(txPoolConfig.Bloom)
+	txPoolUnit, err := storageUnit.NewStorageUnitFromConf(txPoolCacherConfig, txPoolDbConfig, txPoolBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, txPoolUnit)
+	chainStorer.AddStorer(dataRetriever.TxPoolUnit, txPoolUnit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetriever.ChainStorer, createdStorers *[]storage.Storer) error {
 	if !psf.generalConfig.DbLookupExtensions.Enabled {
 		return nil
@@ -467,6 +523,38 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		// Create the addressTransactions (STATIC) storer
+		addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+		addressTransactionsDbConfig := GetDBFromConfig(addressTransactionsConfig.DB)
+		addressTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, addressTransactionsConfig.DB.FilePath)
+		addressTransactionsCacherConfig := GetCacherFromConfig(addressTransactionsConfig.Cache)
+		addressTransactionsBloomFilter := GetBloomFromConfig(addressTransactionsConfig.Bloom)
+		addressTransactionsUnit, errCreate := storageUnit.NewStorageUnitFromConf(addressTransactionsCacherConfig, addressTransactionsDbConfig, addressTransactionsBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, addressTransactionsUnit)
+		chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		// Create the events (STATIC) storer
+		eventsConfig := psf.generalConfig.DbLookupExtensions.EventsStorageConfig
+		eventsDbConfig := GetDBFromConfig(eventsConfig.DB)
+		eventsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, eventsConfig.DB.FilePath)
+		eventsCacherConfig := GetCacherFromConfig(eventsConfig.Cache)
+		eventsBloomFilter := GetBloomFromConfig(eventsConfig.Bloom)
+		eventsUnit, errCreate := storageUnit.NewStorageUnitFromConf(eventsCacherConfig, eventsDbConfig, eventsBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, eventsUnit)
+		chainStorer.AddStorer(dataRetriever.EventsUnit, eventsUnit)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,108 @@
+package leveldb
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var _ storage.Persister = (*ReadOnlyDB)(nil)
+
+// ReadOnlyDB holds a pointer to an existing leveldb database that is opened without being written to
+type ReadOnlyDB struct {
+	*baseLevelDb
+	path string
+}
+
+// NewReadOnlyDB opens the existing leveldb database from the provided location in read-only mode.
+// No recovery is attempted on a corrupted database as that would alter the files
+func NewReadOnlyDB(path string, maxOpenFiles int) (*ReadOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+		// disable internal cache
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+	}
+
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &ReadOnlyDB{
+		baseLevelDb: &baseLevelDb{
+			db: db,
+		},
+		path: path,
+	}, nil
+}
+
+// Put returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Get returns the value associated to the key
+func (s *ReadOnlyDB) Get(key []byte) ([]byte, error) {
+	data, err := s.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *ReadOnlyDB) Has(key []byte) error {
+	has, err := s.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return nil
+	}
+
+	return storage.ErrKeyNotFound
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *ReadOnlyDB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *ReadOnlyDB) Close() error {
+	return s.db.Close()
+}
+
+// Remove returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyDB
+}
+
+// Destroy returns ErrReadOnlyDB
+func (s *ReadOnlyDB) Destroy() error {
+	return storage.ErrReadOnlyDB
+}
+
+// DestroyClosed returns ErrReadOnlyDB
+func (s *ReadOnlyDB) DestroyClosed() error {
+	return storage.ErrReadOnlyDB
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *ReadOnlyDB) IsInterfaceNil() bool {
+	return s == nil
+}
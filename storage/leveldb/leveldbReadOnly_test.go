@@ -0,0 +1,66 @@
+package leveldb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadOnlyDB_MissingDatabaseShouldErr(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	rodb, err := leveldb.NewReadOnlyDB(filepath.Join(dir, "missing"), 10)
+	assert.Nil(t, rodb)
+	assert.NotNil(t, err)
+}
+
+func TestNewReadOnlyDB_InvalidNumOpenFilesShouldErr(t *testing.T) {
+	rodb, err := leveldb.NewReadOnlyDB("path", 0)
+	assert.Nil(t, rodb)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestReadOnlyDB_ShouldReadButNotWrite(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	ldb, err := leveldb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	_ = ldb.Put(key, val)
+	_ = ldb.Close()
+
+	rodb, err := leveldb.NewReadOnlyDB(dir, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = rodb.Close()
+	}()
+
+	recovered, err := rodb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, rodb.Has(key))
+	assert.Equal(t, storage.ErrKeyNotFound, rodb.Has([]byte("missing key")))
+
+	numKeys := 0
+	rodb.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 1, numKeys)
+
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Put(key, val))
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Remove(key))
+	assert.Equal(t, storage.ErrReadOnlyDB, rodb.Destroy())
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
@@ -481,6 +484,47 @@ func TestNewPruningStorer_GetDataFromClosedPersister(t *testing.T) {
 	assert.Equal(t, testVal, res)
 }
 
+func TestNewPruningStorer_GetDataFromClosedBadgerDBPersister(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "badgerdb_pruning_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := getDefaultArgs()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(dir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+	}}
+	args.PersisterFactory = factory.NewPersisterFactory(config.DBConfig{
+		Type:              string(storageUnit.BadgerDB),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	})
+	args.NumOfActivePersisters = 1
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	testKey, testVal := []byte("key"), []byte("value")
+	err = ps.Put(testKey, testVal)
+	assert.Nil(t, err)
+
+	// the first persister will be closed as only one persister is active at a moment
+	err = ps.ChangeEpochSimple(1)
+	assert.Nil(t, err)
+
+	ps.ClearCache()
+
+	// the data has to be read from the reopened badger database
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+}
+
 func TestNewPruningStorer_GetBulkFromEpoch(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
@@ -43,11 +44,12 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
+// LvlDB and BadgerDB are the supported on-disk DBs
 // More to be added
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	BadgerDB    DBType = "BadgerDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -78,6 +80,8 @@ type CacheConfig struct {
 	Capacity             uint32
 	SizePerSender        uint32
 	Shards               uint32
+
+	MinGasPriceBumpPercentageForReplacement uint32
 }
 
 // String returns a readable representation of the object
@@ -438,6 +442,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
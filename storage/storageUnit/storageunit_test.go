@@ -506,6 +506,23 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfBadgerDBOk(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.BadgerDB,
+		Path:              dir,
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.NotNil(t, persister, "valid persister expected but got nil")
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
 func TestCreateBloomFilterFromConfWrongSize(t *testing.T) {
 	bfConfig := storageUnit.BloomConfig{
 		Size:     2,
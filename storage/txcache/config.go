@@ -17,6 +17,7 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const minGasPriceBumpPercentageUpperBound = 1000
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,11 +29,17 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+
+	// MinGasPriceBumpPercentageForReplacement is the minimum percentage by which the gas price of an incoming transaction
+	// has to exceed the one of the pooled transaction having the same sender and nonce, in order to replace it.
+	// Zero disables replace-by-fee: transactions with the same nonce are kept side by side.
+	MinGasPriceBumpPercentageForReplacement uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                     uint32
+	maxNumBytes                   uint32
+	minGasPriceBumpForReplacement uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -49,6 +56,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if config.MinGasPriceBumpPercentageForReplacement > minGasPriceBumpPercentageUpperBound {
+		return fmt.Errorf("%w: config.MinGasPriceBumpPercentageForReplacement is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -66,8 +76,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                   config.NumBytesPerSenderThreshold,
+		maxNumTxs:                     config.CountPerSenderThreshold,
+		minGasPriceBumpForReplacement: config.MinGasPriceBumpPercentageForReplacement,
 	}
 }
 
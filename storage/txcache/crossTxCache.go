@@ -56,6 +56,8 @@ func (cache *CrossTxCache) ImmunizeTxsAgainstEviction(keys [][]byte) {
 }
 
 // AddTx adds a transaction in the cache
+// Replace-by-fee is not applied here: the destination shard cannot know which of the transactions having the same
+// sender and nonce will be executed by the source shard, so all of them are kept until processed or evicted
 func (cache *CrossTxCache) AddTx(tx *WrappedTransaction) (has, added bool) {
 	return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
 }
@@ -0,0 +1,89 @@
+package txcache
+
+// NonceGap represents a range of nonces, both ends included, that are missing from the queue of a sender
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// SenderPoolInfo holds a snapshot of the transactions of a sender, sorted by nonce, together with the data used by the
+// cache when deciding whether the sender's transactions can be selected
+type SenderPoolInfo struct {
+	Sender              []byte
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	Score               uint32
+	NumFailedSelections uint64
+	IsInGracePeriod     bool
+	Transactions        []*WrappedTransaction
+	NonceGaps           []NonceGap
+}
+
+// GetSenderPoolInfo returns a snapshot of the transactions held for the provided sender. The nonce gaps are computed
+// against the account nonce received through NotifyAccountNonce, or against the lowest queued nonce when the
+// account nonce has not been notified yet
+func (cache *TxCache) GetSenderPoolInfo(sender []byte) (*SenderPoolInfo, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getPoolInfo(), true
+}
+
+// NumBytesThreshold returns the configured number of bytes above which the cache starts evicting transactions
+func (cache *TxCache) NumBytesThreshold() uint32 {
+	return cache.config.NumBytesThreshold
+}
+
+func (listForSender *txListForSender) getPoolInfo() *SenderPoolInfo {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	info := &SenderPoolInfo{
+		Sender:              []byte(listForSender.sender),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		Score:               listForSender.getLastComputedScore(),
+		NumFailedSelections: listForSender.numFailedSelections.GetUint64(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		Transactions:        make([]*WrappedTransaction, 0, listForSender.countTx()),
+		NonceGaps:           make([]NonceGap, 0),
+	}
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		info.Transactions = append(info.Transactions, element.Value.(*WrappedTransaction))
+	}
+
+	info.NonceGaps = computeNonceGaps(info.Transactions, info.AccountNonce, info.AccountNonceKnown)
+
+	return info
+}
+
+// computeNonceGaps expects the transactions to be sorted by nonce, as they are held in the sender's list
+func computeNonceGaps(transactions []*WrappedTransaction, accountNonce uint64, accountNonceKnown bool) []NonceGap {
+	gaps := make([]NonceGap, 0)
+	if len(transactions) == 0 {
+		return gaps
+	}
+
+	expectedNonce := transactions[0].Tx.GetNonce()
+	if accountNonceKnown {
+		expectedNonce = accountNonce
+	}
+
+	for _, tx := range transactions {
+		nonce := tx.Tx.GetNonce()
+		if nonce < expectedNonce {
+			// already executed nonce or a transaction with the same nonce
+			continue
+		}
+		if nonce > expectedNonce {
+			gaps = append(gaps, NonceGap{From: expectedNonce, To: nonce - 1})
+		}
+
+		expectedNonce = nonce + 1
+	}
+
+	return gaps
+}
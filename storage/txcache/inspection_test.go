@@ -0,0 +1,68 @@
+package txcache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_GetSenderPoolInfo(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-8"), "alice", 8))
+	cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+	cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+
+	info, ok := cache.GetSenderPoolInfo([]byte("carol"))
+	require.False(t, ok)
+	require.Nil(t, info)
+
+	info, ok = cache.GetSenderPoolInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), info.Sender)
+	require.False(t, info.AccountNonceKnown)
+	require.Len(t, info.Transactions, 3)
+	require.Equal(t, []byte("hash-alice-5"), info.Transactions[0].TxHash)
+	require.Equal(t, []byte("hash-alice-6"), info.Transactions[1].TxHash)
+	require.Equal(t, []byte("hash-alice-8"), info.Transactions[2].TxHash)
+	require.Equal(t, []NonceGap{{From: 7, To: 7}}, info.NonceGaps)
+
+	cache.NotifyAccountNonce([]byte("alice"), 2)
+	info, _ = cache.GetSenderPoolInfo([]byte("alice"))
+	require.True(t, info.AccountNonceKnown)
+	require.Equal(t, uint64(2), info.AccountNonce)
+	require.Equal(t, []NonceGap{{From: 2, To: 4}, {From: 7, To: 7}}, info.NonceGaps)
+}
+
+func TestComputeNonceGaps(t *testing.T) {
+	txs := []*WrappedTransaction{
+		createTx([]byte("a"), "alice", 3),
+		createTx([]byte("b"), "alice", 4),
+		createTx([]byte("c"), "alice", 4),
+		createTx([]byte("d"), "alice", 10),
+	}
+
+	require.Equal(t, []NonceGap{}, computeNonceGaps(nil, 0, true))
+	require.Equal(t, []NonceGap{{From: 5, To: 9}}, computeNonceGaps(txs, 0, false))
+	require.Equal(t, []NonceGap{{From: 5, To: 9}}, computeNonceGaps(txs, 3, true))
+	require.Equal(t, []NonceGap{{From: 0, To: 2}, {From: 5, To: 9}}, computeNonceGaps(txs, 0, true))
+	require.Equal(t, []NonceGap{}, computeNonceGaps(txs, 11, true))
+}
+
+func TestTxCache_NumBytesThreshold(t *testing.T) {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                          "test",
+		NumChunks:                     16,
+		EvictionEnabled:               true,
+		NumBytesThreshold:             maxNumBytesLowerBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+		CountThreshold:                math.MaxUint32,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 1,
+	}, txGasHandler)
+	require.Nil(t, err)
+	require.Equal(t, uint32(maxNumBytesLowerBound), cache.NumBytesThreshold())
+}
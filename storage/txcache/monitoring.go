@@ -12,10 +12,10 @@ import (
 var log = logger.GetOrCreate("txcache")
 
 func (cache *TxCache) monitorEvictionWrtSenderLimit(sender []byte, evicted [][]byte) {
-	log.Trace("TxCache.AddTx() evict transactions wrt. limit by sender", "name", cache.name, "sender", sender, "num", len(evicted))
+	log.Trace("TxCache.AddTx() remove transactions replaced by fee or evicted wrt. limit by sender", "name", cache.name, "sender", sender, "num", len(evicted))
 
 	for i := 0; i < core.MinInt(len(evicted), numEvictedTxsToDisplay); i++ {
-		log.Trace("TxCache.AddTx() evict transactions wrt. limit by sender", "name", cache.name, "sender", sender, "tx", evicted[i])
+		log.Trace("TxCache.AddTx() remove transactions replaced by fee or evicted wrt. limit by sender", "name", cache.name, "sender", sender, "tx", evicted[i])
 	}
 }
 
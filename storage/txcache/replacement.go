@@ -0,0 +1,109 @@
+package txcache
+
+import (
+	"bytes"
+	"container/list"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const percentageDenominator = 100
+
+// isReplaceByFeeEnabled returns whether a transaction can replace a pooled transaction having the same sender and nonce
+func (constraints *senderConstraints) isReplaceByFeeEnabled() bool {
+	return constraints.minGasPriceBumpForReplacement > 0
+}
+
+// canReplace returns whether the incoming transaction pays enough, in terms of gas price, to replace the pooled one.
+// Only the gas price is compared (not the total fee), so that a cancellation, which usually has a lower gas limit
+// than the transaction it cancels, is accepted as long as it bids a higher price.
+func (constraints *senderConstraints) canReplace(pooledTx *WrappedTransaction, incomingTx *WrappedTransaction) bool {
+	pooledGasPrice := pooledTx.Tx.GetGasPrice()
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+	if incomingGasPrice <= pooledGasPrice {
+		return false
+	}
+
+	// incomingGasPrice * 100 >= pooledGasPrice * (100 + bump)
+	incoming := core.SafeMul(incomingGasPrice, percentageDenominator)
+	required := core.SafeMul(pooledGasPrice, uint64(percentageDenominator+constraints.minGasPriceBumpForReplacement))
+
+	return incoming.Cmp(required) >= 0
+}
+
+// isCancellation returns whether the transaction is a cancellation: a zero-value transfer from the sender to itself,
+// without data. Once it replaces a pooled transaction, its execution only consumes the nonce (and pays the fee),
+// leaving the account otherwise unchanged.
+func (wrappedTx *WrappedTransaction) isCancellation() bool {
+	tx := wrappedTx.Tx
+	value := tx.GetValue()
+	hasValue := value != nil && value.Cmp(big.NewInt(0)) != 0
+
+	return !hasValue && len(tx.GetData()) == 0 && bytes.Equal(tx.GetSndAddr(), tx.GetRcvAddr())
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findListElementWithNonce(nonce uint64) *list.Element {
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		value := element.Value.(*WrappedTransaction)
+		valueNonce := value.Tx.GetNonce()
+
+		if valueNonce == nonce {
+			return element
+		}
+
+		// Optimization: stop search at this point, since the list is sorted by nonce
+		if valueNonce < nonce {
+			break
+		}
+	}
+
+	return nil
+}
+
+// replaceTx applies the replace-by-fee rule for an incoming transaction having the same nonce as a pooled one.
+// If the incoming transaction does not pay enough, it is discarded and its own hash is returned among the removed
+// ones, so that the caller drops it from the other indexes of the cache as well (as in the case of the eviction
+// by sender limits).
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) replaceTx(
+	pooledElement *list.Element,
+	incomingTx *WrappedTransaction,
+	gasHandler TxGasHandler,
+	txFeeHelper feeHelper,
+) (bool, [][]byte) {
+	pooledTx := pooledElement.Value.(*WrappedTransaction)
+
+	if !listForSender.constraints.canReplace(pooledTx, incomingTx) {
+		log.Trace("txListForSender.replaceTx(): gas price too low for replacement",
+			"sender", []byte(listForSender.sender),
+			"nonce", incomingTx.Tx.GetNonce(),
+			"pooled", pooledTx.TxHash,
+			"incoming", incomingTx.TxHash,
+		)
+		return false, [][]byte{incomingTx.TxHash}
+	}
+
+	incomingElement := listForSender.items.InsertAfter(incomingTx, pooledElement)
+	listForSender.items.Remove(pooledElement)
+	listForSender.onRemovedListElement(pooledElement)
+
+	// A selection in progress continues with the replacement, instead of the (now detached) replaced element
+	if listForSender.copyBatchIndex == pooledElement {
+		listForSender.copyBatchIndex = incomingElement
+	}
+
+	log.Trace("txListForSender.replaceTx()",
+		"sender", []byte(listForSender.sender),
+		"nonce", incomingTx.Tx.GetNonce(),
+		"replaced", pooledTx.TxHash,
+		"replacement", incomingTx.TxHash,
+		"isCancellation", incomingTx.isCancellation(),
+	)
+
+	listForSender.onAddedTransaction(incomingTx, gasHandler, txFeeHelper)
+	removed := append([][]byte{pooledTx.TxHash}, listForSender.applySizeConstraints()...)
+	listForSender.triggerScoreChange()
+	return true, removed
+}
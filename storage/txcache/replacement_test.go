@@ -0,0 +1,228 @@
+package txcache
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func newReplaceByFeeCacheToTest(bumpPercentage uint32, countPerSenderThreshold uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                                    "test",
+		NumChunks:                               16,
+		NumBytesPerSenderThreshold:              maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:                 countPerSenderThreshold,
+		MinGasPriceBumpPercentageForReplacement: bumpPercentage,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newReplaceByFeeCacheToTest(): %s", err))
+	}
+
+	return cache
+}
+
+func createCancellationTx(hash []byte, sender string, nonce uint64, gasPrice uint64) *WrappedTransaction {
+	tx := &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte(sender),
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		GasLimit: 50000,
+		GasPrice: gasPrice,
+	}
+
+	return &WrappedTransaction{
+		Tx:     tx,
+		TxHash: hash,
+		Size:   int64(estimatedSizeOfBoundedTxFields),
+	}
+}
+
+func TestSenderConstraints_CanReplace(t *testing.T) {
+	t.Parallel()
+
+	constraints := &senderConstraints{minGasPriceBumpForReplacement: 10}
+	pooled := createTxWithParams([]byte("a"), ".", 1, 128, 50000, 1000)
+
+	require.False(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, 900)))
+	require.False(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, 1000)))
+	require.False(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, 1099)))
+	require.True(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, 1100)))
+	require.True(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, 5000)))
+
+	// no overflow on huge gas prices
+	pooled = createTxWithParams([]byte("a"), ".", 1, 128, 50000, math.MaxUint64-1)
+	require.False(t, constraints.canReplace(pooled, createTxWithParams([]byte("b"), ".", 1, 128, 50000, math.MaxUint64)))
+}
+
+func TestWrappedTransaction_IsCancellation(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, createCancellationTx([]byte("a"), "alice", 1, 1000).isCancellation())
+
+	withValue := createCancellationTx([]byte("a"), "alice", 1, 1000)
+	withValue.Tx.(*transaction.Transaction).Value = big.NewInt(1)
+	require.False(t, withValue.isCancellation())
+
+	withData := createCancellationTx([]byte("a"), "alice", 1, 1000)
+	withData.Tx.(*transaction.Transaction).Data = []byte("foo")
+	require.False(t, withData.isCancellation())
+
+	toOther := createCancellationTx([]byte("a"), "alice", 1, 1000)
+	toOther.Tx.(*transaction.Transaction).RcvAddr = []byte("bob")
+	require.False(t, toOther.isCancellation())
+}
+
+func TestTxCache_AddTx_ReplaceByFeeDisabledKeepsBoth(t *testing.T) {
+	t.Parallel()
+
+	cache := newReplaceByFeeCacheToTest(0, math.MaxUint32)
+
+	cache.AddTx(createTxWithParams([]byte("tx-low"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-high"), "alice", 1, 128, 50000, 2*oneBillion))
+
+	require.Equal(t, []string{"tx-high", "tx-low"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+}
+
+func TestTxCache_AddTx_ReplaceByFee(t *testing.T) {
+	t.Parallel()
+
+	cache := newReplaceByFeeCacheToTest(10, math.MaxUint32)
+
+	cache.AddTx(createTxWithParams([]byte("tx-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-3"), "alice", 3, 128, 50000, oneBillion))
+
+	// not enough of a bump: the newcomer is discarded, though still reported as added (for notifications)
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-2-underpriced"), "alice", 2, 128, 50000, oneBillion+1))
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-1", "tx-2", "tx-3"}, cache.getHashesForSender("alice"))
+	_, found := cache.GetByTxHash([]byte("tx-2-underpriced"))
+	require.False(t, found)
+
+	// same transaction again
+	_, added = cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, oneBillion))
+	require.False(t, added)
+	_, found = cache.GetByTxHash([]byte("tx-2"))
+	require.True(t, found)
+
+	// enough of a bump: the pooled transaction is replaced
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-2-bumped"), "alice", 2, 128, 50000, 2*oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-1", "tx-2-bumped", "tx-3"}, cache.getHashesForSender("alice"))
+	_, found = cache.GetByTxHash([]byte("tx-2"))
+	require.False(t, found)
+	_, found = cache.GetByTxHash([]byte("tx-2-bumped"))
+	require.True(t, found)
+
+	require.Equal(t, uint64(3), cache.CountTx())
+	require.Equal(t, 3*128, cache.NumBytes())
+	require.True(t, cache.areInternalMapsConsistent())
+
+	selected := cache.SelectTransactions(10, 10)
+	require.Len(t, selected, 3)
+	require.Equal(t, []byte("tx-2-bumped"), selected[1].TxHash)
+}
+
+func TestTxCache_AddTx_ReplaceByFeeShouldUpdateScoreParams(t *testing.T) {
+	t.Parallel()
+
+	cache := newReplaceByFeeCacheToTest(10, math.MaxUint32)
+
+	cache.AddTx(createTxWithParams([]byte("tx-1"), "alice", 1, 128, 100000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-1-bumped"), "alice", 1, 256, 50000, 2*oneBillion))
+
+	list := cache.getListForSender("alice")
+	require.Equal(t, int64(256), list.totalBytes.Get())
+	require.Equal(t, int64(50000), list.totalGas.Get())
+	require.Equal(t, uint64(1), list.getScoreParams().count)
+}
+
+func TestTxCache_AddTx_Cancellation(t *testing.T) {
+	t.Parallel()
+
+	cache := newReplaceByFeeCacheToTest(10, math.MaxUint32)
+
+	cache.AddTx(createTxWithParams([]byte("tx-1"), "alice", 1, 128, 500000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 500000, oneBillion))
+
+	// a cancellation bidding the same price is not accepted
+	cache.AddTx(createCancellationTx([]byte("cancel-1-cheap"), "alice", 1, oneBillion))
+	require.Equal(t, []string{"tx-1", "tx-2"}, cache.getHashesForSender("alice"))
+
+	// a cancellation bidding a high enough price replaces the transaction, even if it pays a lower total fee
+	cache.AddTx(createCancellationTx([]byte("cancel-1"), "alice", 1, 2*oneBillion))
+	require.Equal(t, []string{"cancel-1", "tx-2"}, cache.getHashesForSender("alice"))
+	require.True(t, cache.areInternalMapsConsistent())
+
+	// the cancellation itself can be replaced by fee, as any other transaction
+	cache.AddTx(createTxWithParams([]byte("tx-1-again"), "alice", 1, 128, 500000, 3*oneBillion))
+	require.Equal(t, []string{"tx-1-again", "tx-2"}, cache.getHashesForSender("alice"))
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func TestTxCache_AddTx_ReplaceByFeeWithSenderLimits(t *testing.T) {
+	t.Parallel()
+
+	cache := newReplaceByFeeCacheToTest(10, 2)
+
+	cache.AddTx(createTxWithParams([]byte("tx-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-3"), "alice", 3, 128, 50000, oneBillion))
+	require.Equal(t, []string{"tx-1", "tx-2"}, cache.getHashesForSender("alice"))
+
+	// replacing does not change the number of transactions, thus nothing is evicted
+	cache.AddTx(createTxWithParams([]byte("tx-1-bumped"), "alice", 1, 128, 50000, 2*oneBillion))
+	require.Equal(t, []string{"tx-1-bumped", "tx-2"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func TestTxCache_AddTx_ReplaceByFeeDuringSelectionShouldNotBreakIteration(t *testing.T) {
+	t.Parallel()
+
+	list := newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                   math.MaxUint32,
+		maxNumTxs:                     math.MaxUint32,
+		minGasPriceBumpForReplacement: 10,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx-1"), ".", 1, 128, 50000, oneBillion), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx-2"), ".", 2, 128, 50000, oneBillion), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx-3"), ".", 3, 128, 50000, oneBillion), txGasHandler, txFeeHelper)
+
+	destination := make([]*WrappedTransaction, 3)
+	journal := list.selectBatchTo(true, destination, 1)
+	require.Equal(t, 1, journal.copied)
+
+	// the next element to be copied is replaced between batches
+	added, removed := list.AddTx(createTxWithParams([]byte("tx-2-bumped"), ".", 2, 128, 50000, 2*oneBillion), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, [][]byte{[]byte("tx-2")}, removed)
+
+	journal = list.selectBatchTo(false, destination[1:], 2)
+	require.Equal(t, 2, journal.copied)
+	require.Equal(t, []byte("tx-1"), destination[0].TxHash)
+	require.Equal(t, []byte("tx-2-bumped"), destination[1].TxHash)
+	require.Equal(t, []byte("tx-3"), destination[2].TxHash)
+}
+
+func TestCrossTxCache_AddTx_ShouldNotReplaceByFee(t *testing.T) {
+	t.Parallel()
+
+	cache := newCrossTxCacheToTest(1, 8, math.MaxUint16)
+
+	cache.AddTx(createTxWithParams([]byte("tx-low"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-high"), "alice", 1, 128, 50000, 2*oneBillion))
+
+	require.Equal(t, 2, cache.Len())
+}
@@ -86,8 +86,9 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.txByHash.RemoveTxsBulk(evicted)
 	}
 
-	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
-	// This it to ensure that onAdded() notification is triggered.
+	// The return value "added" is true even if transaction added, but then removed due to limits be sender,
+	// or discarded because it does not pay enough to replace the transaction having the same nonce.
+	// This it to ensure that onAdded() notification is triggered (e.g. a transaction requested for processing a block).
 	return true, addedInByHash || addedInBySender
 }
 
@@ -55,6 +55,10 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold", txGasHandler)
 
+	badConfig = config
+	badConfig.MinGasPriceBumpPercentageForReplacement = minGasPriceBumpPercentageUpperBound + 1
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.MinGasPriceBumpPercentageForReplacement", txGasHandler)
+
 	badConfig = config
 	cache, err = NewTxCache(config, nil)
 	require.Nil(t, cache)
@@ -49,11 +49,24 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
+// The returned hashes are the ones of the transactions removed as a consequence of the insertion: the replaced
+// transaction (replace-by-fee) and those evicted due to the sender limits
 func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	if listForSender.constraints.isReplaceByFeeEnabled() {
+		pooledElement := listForSender.findListElementWithNonce(tx.Tx.GetNonce())
+		if pooledElement != nil {
+			if tx.sameAs(pooledElement.Value.(*WrappedTransaction)) {
+				return false, nil
+			}
+
+			return listForSender.replaceTx(pooledElement, tx, gasHandler, txFeeHelper)
+		}
+	}
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
 		return false, nil
@@ -9,12 +9,16 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
-	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
-	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
-	GetEpochByHashCalled               func(hash []byte) (uint32, error)
-	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
-	IsEnabledCalled                    func() bool
+	RecordBlockCalled                       func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, txs map[string]data.TransactionHandler) error
+	RevertBlockCalled                       func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
+	OnNotarizedBlocksCalled                 func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+	GetMiniblockMetadataByTxHashCalled      func(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	GetEpochByHashCalled                    func(hash []byte) (uint32, error)
+	GetEventsHashesByTxHashCalled           func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetAddressTransactionsCalled            func(address []byte, query dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsResult, error)
+	GetEventsCalled                         func(address []byte, query dblookupext.EventsQuery) (*dblookupext.EventsResult, error)
+	IsAddressTransactionsIndexEnabledCalled func() bool
+	IsEnabledCalled                         func() bool
 }
 
 // RecordBlock -
@@ -24,9 +28,18 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockBody data.BodyHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
+	txs map[string]data.TransactionHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, txs)
+	}
+	return nil
+}
+
+// RevertBlock -
+func (hp *HistoryRepositoryStub) RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hp.RevertBlockCalled != nil {
+		return hp.RevertBlockCalled(blockHeaderHash, blockHeader, blockBody)
 	}
 	return nil
 }
@@ -51,6 +64,14 @@ func (hp *HistoryRepositoryStub) GetEpochByHash(hash []byte) (uint32, error) {
 	return hp.GetEpochByHashCalled(hash)
 }
 
+// IsAddressTransactionsIndexEnabled -
+func (hp *HistoryRepositoryStub) IsAddressTransactionsIndexEnabled() bool {
+	if hp.IsAddressTransactionsIndexEnabledCalled != nil {
+		return hp.IsAddressTransactionsIndexEnabledCalled()
+	}
+	return true
+}
+
 // IsEnabled -
 func (hp *HistoryRepositoryStub) IsEnabled() bool {
 	if hp.IsEnabledCalled != nil {
@@ -67,6 +88,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetAddressTransactions -
+func (hp *HistoryRepositoryStub) GetAddressTransactions(address []byte, query dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsResult, error) {
+	if hp.GetAddressTransactionsCalled != nil {
+		return hp.GetAddressTransactionsCalled(address, query)
+	}
+	return nil, dblookupext.ErrAddressTransactionsIndexNotEnabled
+}
+
+// GetEvents -
+func (hp *HistoryRepositoryStub) GetEvents(address []byte, query dblookupext.EventsQuery) (*dblookupext.EventsResult, error) {
+	if hp.GetEventsCalled != nil {
+		return hp.GetEventsCalled(address, query)
+	}
+	return nil, dblookupext.ErrEventsIndexNotEnabled
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
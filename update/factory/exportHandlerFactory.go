@@ -78,6 +78,7 @@ type ArgsExporter struct {
 	InterceptorDebugConfig    config.InterceptorResolverDebugConfig
 	MinTxVersion              uint32
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -125,6 +126,7 @@ type exportHandlerFactory struct {
 	interceptorDebugConfig    config.InterceptorResolverDebugConfig
 	minTxVersion              uint32
 	enableSignTxWithHashEpoch uint32
+	multiRelayedTxEnableEpoch uint32
 	txSignHasher              hashing.Hasher
 	epochNotifier             process.EpochNotifier
 }
@@ -269,6 +271,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		interceptorDebugConfig:    args.InterceptorDebugConfig,
 		minTxVersion:              args.MinTxVersion,
 		enableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		multiRelayedTxEnableEpoch: args.MultiRelayedTxEnableEpoch,
 		txSignHasher:              args.TxSignHasher,
 		epochNotifier:             args.EpochNotifier,
 	}
@@ -533,6 +536,7 @@ func (e *exportHandlerFactory) createInterceptors() error {
 		ChainID:                   e.chainID,
 		MinTxVersion:              e.minTxVersion,
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
+		MultiRelayedTxEnableEpoch: e.multiRelayedTxEnableEpoch,
 		TxSignHasher:              e.txSignHasher,
 		EpochNotifier:             e.epochNotifier,
 	}
@@ -13,10 +13,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/multisig"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -83,6 +85,7 @@ type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	ChainID                   []byte
 	MinTxVersion              uint32
 	EnableSignTxWithHashEpoch uint32
+	MultiRelayedTxEnableEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -180,8 +183,12 @@ func NewFullSyncInterceptorsContainerFactory(
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTxVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		MultiRelayedTxEnableEpoch: args.MultiRelayedTxEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
+		// the configuration of the multisig accounts can not be read from the synced state, which may be outdated,
+		// so the transactions signed by multiple signers are not accepted while syncing
+		MultisigAccounts: multisig.NewDisabledMultisigAccount(),
 	}
 
 	icf := &fullSyncInterceptorsContainerFactory{
@@ -517,11 +524,13 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateTxInterceptors() error
 }
 
 func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic string) (process.Interceptor, error) {
+	// the guardian of the sender is checked when the transactions are processed, as the synced state may be outdated
 	txValidator, err := dataValidators.NewTxValidator(
 		ficf.accounts,
 		ficf.shardCoordinator,
 		ficf.whiteListHandler,
 		ficf.addressPubkeyConv,
+		guardian.NewDisabledGuardedAccount(),
 		ficf.maxTxNonceDeltaAllowed,
 	)
 	if err != nil {
@@ -215,6 +215,9 @@ var ErrNilGasSchedule = errors.New("nil gas schedule")
 // ErrDuplicatesFoundInArguments signals that duplicates were found in arguments
 var ErrDuplicatesFoundInArguments = errors.New("duplicates found in arguments")
 
+// ErrInvalidGovernanceAction signals that an invalid governance proposal action has been provided
+var ErrInvalidGovernanceAction = errors.New("invalid governance action")
+
 // ErrInvalidCaller signals that the functions was called by a not authorized user
 var ErrInvalidCaller = errors.New("the function was called by a not authorized user")
 
@@ -229,3 +232,9 @@ var ErrOwnerCannotUnDelegate = errors.New("owner cannot undelegate, contract sti
 
 // ErrNotEnoughInitialOwnerFunds signals that not enough initial owner funds has been provided
 var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
+
+// ErrNilDoubleSigningProofVerifier signals that a nil double signing proof verifier has been provided
+var ErrNilDoubleSigningProofVerifier = errors.New("nil double signing proof verifier")
+
+// ErrInvalidDoubleSigningSlashPercentage signals that an invalid double signing slash percentage has been provided
+var ErrInvalidDoubleSigningSlashPercentage = errors.New("invalid double signing slash percentage")
@@ -12,31 +12,37 @@ import (
 )
 
 type systemSCFactory struct {
-	systemEI               vm.ContextHandler
-	economics              vm.EconomicsHandler
-	nodesConfigProvider    vm.NodesConfigProvider
-	sigVerifier            vm.MessageSignVerifier
-	gasCost                vm.GasCost
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	systemSCConfig         *config.SystemSmartContractsConfig
-	epochNotifier          vm.EpochNotifier
-	systemSCsContainer     vm.SystemSCContainer
-	addressPubKeyConverter core.PubkeyConverter
+	systemEI                  vm.ContextHandler
+	economics                 vm.EconomicsHandler
+	nodesConfigProvider       vm.NodesConfigProvider
+	sigVerifier               vm.MessageSignVerifier
+	proofVerifier             vm.DoubleSigningProofVerifier
+	gasCost                   vm.GasCost
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	systemSCConfig            *config.SystemSmartContractsConfig
+	epochNotifier             vm.EpochNotifier
+	systemSCsContainer        vm.SystemSCContainer
+	addressPubKeyConverter    core.PubkeyConverter
+	esdtNFTEnableEpoch        uint32
+	esdtLocalRolesEnableEpoch uint32
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
 type ArgsNewSystemSCFactory struct {
-	SystemEI               vm.ContextHandler
-	Economics              vm.EconomicsHandler
-	NodesConfigProvider    vm.NodesConfigProvider
-	SigVerifier            vm.MessageSignVerifier
-	GasSchedule            core.GasScheduleNotifier
-	Marshalizer            marshal.Marshalizer
-	Hasher                 hashing.Hasher
-	SystemSCConfig         *config.SystemSmartContractsConfig
-	EpochNotifier          vm.EpochNotifier
-	AddressPubKeyConverter core.PubkeyConverter
+	SystemEI                  vm.ContextHandler
+	Economics                 vm.EconomicsHandler
+	NodesConfigProvider       vm.NodesConfigProvider
+	SigVerifier               vm.MessageSignVerifier
+	ProofVerifier             vm.DoubleSigningProofVerifier
+	GasSchedule               core.GasScheduleNotifier
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	SystemSCConfig            *config.SystemSmartContractsConfig
+	EpochNotifier             vm.EpochNotifier
+	AddressPubKeyConverter    core.PubkeyConverter
+	ESDTNFTEnableEpoch        uint32
+	ESDTLocalRolesEnableEpoch uint32
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -47,6 +53,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if check.IfNil(args.SigVerifier) {
 		return nil, vm.ErrNilMessageSignVerifier
 	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, vm.ErrNilDoubleSigningProofVerifier
+	}
 	if check.IfNil(args.NodesConfigProvider) {
 		return nil, vm.ErrNilNodesConfigProvider
 	}
@@ -70,15 +79,18 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	}
 
 	scf := &systemSCFactory{
-		systemEI:               args.SystemEI,
-		sigVerifier:            args.SigVerifier,
-		nodesConfigProvider:    args.NodesConfigProvider,
-		marshalizer:            args.Marshalizer,
-		hasher:                 args.Hasher,
-		systemSCConfig:         args.SystemSCConfig,
-		economics:              args.Economics,
-		epochNotifier:          args.EpochNotifier,
-		addressPubKeyConverter: args.AddressPubKeyConverter,
+		systemEI:                  args.SystemEI,
+		sigVerifier:               args.SigVerifier,
+		proofVerifier:             args.ProofVerifier,
+		nodesConfigProvider:       args.NodesConfigProvider,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		systemSCConfig:            args.SystemSCConfig,
+		economics:                 args.Economics,
+		epochNotifier:             args.EpochNotifier,
+		addressPubKeyConverter:    args.AddressPubKeyConverter,
+		esdtNFTEnableEpoch:        args.ESDTNFTEnableEpoch,
+		esdtLocalRolesEnableEpoch: args.ESDTLocalRolesEnableEpoch,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -159,6 +171,7 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 		GasCost:              scf.gasCost,
 		Marshalizer:          scf.marshalizer,
 		EpochNotifier:        scf.epochNotifier,
+		ProofVerifier:        scf.proofVerifier,
 	}
 	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
 	return staking, err
@@ -184,14 +197,16 @@ func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, e
 
 func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error) {
 	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
-		Eei:                    scf.systemEI,
-		GasCost:                scf.gasCost,
-		ESDTSCAddress:          vm.ESDTSCAddress,
-		Marshalizer:            scf.marshalizer,
-		Hasher:                 scf.hasher,
-		ESDTSCConfig:           scf.systemSCConfig.ESDTSystemSCConfig,
-		EpochNotifier:          scf.epochNotifier,
-		AddressPubKeyConverter: scf.addressPubKeyConverter,
+		Eei:                       scf.systemEI,
+		GasCost:                   scf.gasCost,
+		ESDTSCAddress:             vm.ESDTSCAddress,
+		Marshalizer:               scf.marshalizer,
+		Hasher:                    scf.hasher,
+		ESDTSCConfig:              scf.systemSCConfig.ESDTSystemSCConfig,
+		EpochNotifier:             scf.epochNotifier,
+		AddressPubKeyConverter:    scf.addressPubKeyConverter,
+		ESDTNFTEnableEpoch:        scf.esdtNFTEnableEpoch,
+		ESDTLocalRolesEnableEpoch: scf.esdtLocalRolesEnableEpoch,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	return esdt, err
@@ -208,6 +223,7 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 		GovernanceSCAddress: vm.GovernanceSCAddress,
 		StakingSCAddress:    vm.StakingSCAddress,
 		ValidatorSCAddress:  vm.ValidatorSCAddress,
+		EndOfEpochAddress:   vm.EndOfEpochAddress,
 		EpochNotifier:       scf.epochNotifier,
 	}
 	governance, err := systemSmartContracts.NewGovernanceContract(argsGovernance)
@@ -23,6 +23,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 		SystemEI:            &mock.SystemEIStub{},
 		Economics:           &mock.EconomicsHandlerStub{},
 		SigVerifier:         &mock.MessageSignVerifierMock{},
+		ProofVerifier:       &mock.DoubleSigningProofVerifierStub{},
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Marshalizer:         &mock.MarshalizerMock{},
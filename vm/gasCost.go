@@ -33,6 +33,7 @@ type MetaChainSystemSCsCost struct {
 	UnBondTokens        uint64
 	DelegationMgrOps    uint64
 	GetAllNodeStates    uint64
+	Slash               uint64
 }
 
 // BuiltInCost defines cost for built-in methods
@@ -43,6 +44,13 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+	ESDTNFTCreate         uint64
+	ESDTNFTAddQuantity    uint64
+	ESDTNFTBurn           uint64
+	ESDTNFTTransfer       uint64
+	MultiESDTTransfer     uint64
+	ESDTLocalMint         uint64
+	ESDTLocalBurn         uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
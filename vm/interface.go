@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 )
 
 // SystemSmartContract interface defines the function a system smart contract should have
@@ -84,6 +85,12 @@ type MessageSignVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// DoubleSigningProofVerifier is used to verify the proofs of double signing sent to the staking smart contract
+type DoubleSigningProofVerifier interface {
+	VerifyProof(proof []byte) (*slash.DoubleSigningProof, error)
+	IsInterfaceNil() bool
+}
+
 // ArgumentsParser defines the functionality to parse transaction data into arguments and code for smart contracts
 type ArgumentsParser interface {
 	ParseData(data string) (string, [][]byte, error)
@@ -28,6 +28,7 @@ type AccountsStub struct {
 	IsPruningEnabledCalled   func() bool
 	GetAllLeavesCalled       func(rootHash []byte) (chan core.KeyValueHolder, error)
 	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	GetTrieCalled            func(rootHash []byte) (data.Trie, error)
 	GetNumCheckpointsCalled  func() uint32
 	IsLowRatingCalled        func(blsKey []byte) bool
 }
@@ -194,3 +195,12 @@ func (as *AccountsStub) GetNumCheckpoints() uint32 {
 func (as *AccountsStub) IsInterfaceNil() bool {
 	return as == nil
 }
+
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+
+	return nil, nil
+}
@@ -33,6 +33,16 @@ func FillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["ESDTNFTCreate"] = value
+	gasMap["ESDTNFTAddQuantity"] = value
+	gasMap["ESDTNFTBurn"] = value
+	gasMap["ESDTNFTTransfer"] = value
+	gasMap["MultiESDTTransfer"] = value
+	gasMap["ESDTLocalMint"] = value
+	gasMap["ESDTLocalBurn"] = value
+	gasMap["SetGuardian"] = value
+	gasMap["RemoveGuardian"] = value
+	gasMap["SetMultisig"] = value
 
 	return gasMap
 }
@@ -60,6 +70,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["Slash"] = value
 
 	return gasMap
 }
@@ -42,31 +42,37 @@ const upgradable = "canUpgrade"
 const conversionBase = 10
 
 type esdt struct {
-	eei                    vm.SystemEI
-	gasCost                vm.GasCost
-	baseIssuingCost        *big.Int
-	ownerAddress           []byte
-	eSDTSCAddress          []byte
-	endOfEpochSCAddress    []byte
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	enabledEpoch           uint32
-	flagEnabled            atomic.Flag
-	mutExecution           sync.RWMutex
-	addressPubKeyConverter core.PubkeyConverter
+	eei                       vm.SystemEI
+	gasCost                   vm.GasCost
+	baseIssuingCost           *big.Int
+	ownerAddress              []byte
+	eSDTSCAddress             []byte
+	endOfEpochSCAddress       []byte
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	enabledEpoch              uint32
+	flagEnabled               atomic.Flag
+	esdtNFTEnableEpoch        uint32
+	flagESDTNFT               atomic.Flag
+	esdtLocalRolesEnableEpoch uint32
+	flagESDTLocalRoles        atomic.Flag
+	mutExecution              sync.RWMutex
+	addressPubKeyConverter    core.PubkeyConverter
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
 type ArgsNewESDTSmartContract struct {
-	Eei                    vm.SystemEI
-	GasCost                vm.GasCost
-	ESDTSCConfig           config.ESDTSystemSCConfig
-	ESDTSCAddress          []byte
-	Marshalizer            marshal.Marshalizer
-	Hasher                 hashing.Hasher
-	EpochNotifier          vm.EpochNotifier
-	EndOfEpochSCAddress    []byte
-	AddressPubKeyConverter core.PubkeyConverter
+	Eei                       vm.SystemEI
+	GasCost                   vm.GasCost
+	ESDTSCConfig              config.ESDTSystemSCConfig
+	ESDTSCAddress             []byte
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	EpochNotifier             vm.EpochNotifier
+	EndOfEpochSCAddress       []byte
+	AddressPubKeyConverter    core.PubkeyConverter
+	ESDTNFTEnableEpoch        uint32
+	ESDTLocalRolesEnableEpoch uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -93,16 +99,18 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	}
 
 	e := &esdt{
-		eei:                    args.Eei,
-		gasCost:                args.GasCost,
-		baseIssuingCost:        baseIssuingCost,
-		ownerAddress:           []byte(args.ESDTSCConfig.OwnerAddress),
-		eSDTSCAddress:          args.ESDTSCAddress,
-		hasher:                 args.Hasher,
-		marshalizer:            args.Marshalizer,
-		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
-		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
-		addressPubKeyConverter: args.AddressPubKeyConverter,
+		eei:                       args.Eei,
+		gasCost:                   args.GasCost,
+		baseIssuingCost:           baseIssuingCost,
+		ownerAddress:              []byte(args.ESDTSCConfig.OwnerAddress),
+		eSDTSCAddress:             args.ESDTSCAddress,
+		hasher:                    args.Hasher,
+		marshalizer:               args.Marshalizer,
+		enabledEpoch:              args.ESDTSCConfig.EnabledEpoch,
+		endOfEpochSCAddress:       args.EndOfEpochSCAddress,
+		addressPubKeyConverter:    args.AddressPubKeyConverter,
+		esdtNFTEnableEpoch:        args.ESDTNFTEnableEpoch,
+		esdtLocalRolesEnableEpoch: args.ESDTLocalRolesEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(e)
 
@@ -126,10 +134,22 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("ESDT SC disabled")
 		return vmcommon.UserError
 	}
+	if !e.isFunctionEnabled(args.Function) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
 
 	switch args.Function {
 	case "issue":
 		return e.issue(args)
+	case "issueNonFungible":
+		return e.issueNonFungible(args, []byte(core.NonFungibleESDT))
+	case "issueSemiFungible":
+		return e.issueNonFungible(args, []byte(core.SemiFungibleESDT))
+	case "setSpecialRole":
+		return e.toggleSpecialRole(args, core.BuiltInFunctionSetESDTRole)
+	case "unSetSpecialRole":
+		return e.toggleSpecialRole(args, core.BuiltInFunctionUnSetESDTRole)
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -162,6 +182,20 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.FunctionNotFound
 }
 
+// isFunctionEnabled returns false for the functions which are not yet enabled, those being handled as unknown
+// functions until their enable epoch. The special roles functions are enabled by the first of the roles' epochs,
+// each role being checked against its own epoch
+func (e *esdt) isFunctionEnabled(function string) bool {
+	switch function {
+	case "issueNonFungible", "issueSemiFungible":
+		return e.flagESDTNFT.IsSet()
+	case "setSpecialRole", "unSetSpecialRole":
+		return e.flagESDTNFT.IsSet() || e.flagESDTLocalRoles.IsSet()
+	default:
+		return true
+	}
+}
+
 func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	scConfig := &ESDTConfig{
 		OwnerAddress:       e.ownerAddress,
@@ -182,6 +216,47 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("not enough arguments")
 		return vmcommon.FunctionWrongSignature
 	}
+	returnCode := e.checkIssueCostAndName(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err := e.issueToken(args.CallerAddr, args.Arguments)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// format: issueNonFungible@tokenName@ticker@optional-list-of-properties
+// format: issueSemiFungible@tokenName@ticker@optional-list-of-properties
+// the collection is issued without any supply, the tokens being created by the addresses holding the special roles
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput, tokenType []byte) vmcommon.ReturnCode {
+	if len(args.Arguments) < 2 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	returnCode := e.checkIssueCostAndName(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	tokenIdentifier, err := e.createNewToken(args.CallerAddr, args.Arguments[0], args.Arguments[1], big.NewInt(0), 0, args.Arguments[2:], tokenType)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	// the shards decide on the type of the created tokens from the type the collection was issued with
+	esdtSetTokenTypeData := core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString(tokenType)
+	e.eei.SendGlobalSettingToAll(e.eSDTSCAddress, []byte(esdtSetTokenTypeData))
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) checkIssueCostAndName(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		e.eei.AddReturnMessage("not enough gas")
@@ -202,12 +277,6 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return vmcommon.OutOfFunds
 	}
 
-	err = e.issueToken(args.CallerAddr, args.Arguments)
-	if err != nil {
-		e.eei.AddReturnMessage(err.Error())
-		return vmcommon.UserError
-	}
-
 	return vmcommon.Ok
 }
 
@@ -265,16 +334,6 @@ func (e *esdt) createNewTokenIdentifier(caller []byte, ticker []byte) ([]byte, e
 
 // format: issue@tokenName@ticker@initialSupply@numOfDecimals@optional-list-of-properties
 func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
-	tokenName := arguments[0]
-	if !isTokenNameHumanReadable(tokenName) {
-		return vm.ErrTokenNameNotHumanReadable
-	}
-
-	tickerName := arguments[1]
-	if !isTickerValid(tickerName) {
-		return vm.ErrTickerNameNotValid
-	}
-
 	initialSupply := big.NewInt(0).SetBytes(arguments[2])
 	if initialSupply.Cmp(big.NewInt(0)) <= 0 {
 		return vm.ErrNegativeOrZeroInitialSupply
@@ -290,38 +349,58 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 		)
 	}
 
-	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
+	tokenIdentifier, err := e.createNewToken(owner, arguments[0], arguments[1], initialSupply, numOfDecimals, arguments[4:], []byte(core.FungibleESDT))
 	if err != nil {
 		return err
 	}
 
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString(initialSupply.Bytes())
+	return e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+}
+
+func (e *esdt) createNewToken(
+	owner []byte,
+	tokenName []byte,
+	tickerName []byte,
+	initialSupply *big.Int,
+	numOfDecimals uint32,
+	properties [][]byte,
+	tokenType []byte,
+) ([]byte, error) {
+	if !isTokenNameHumanReadable(tokenName) {
+		return nil, vm.ErrTokenNameNotHumanReadable
+	}
+	if !isTickerValid(tickerName) {
+		return nil, vm.ErrTickerNameNotValid
+	}
+
+	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
+	if err != nil {
+		return nil, err
+	}
+
 	newESDTToken := &ESDTData{
 		OwnerAddress: owner,
 		TokenName:    tokenName,
 		TickerName:   tickerName,
+		TokenType:    tokenType,
 		NumDecimals:  numOfDecimals,
 		MintedValue:  initialSupply,
 		BurntValue:   big.NewInt(0),
 		Upgradable:   true,
 	}
-	err = upgradeProperties(newESDTToken, arguments[4:])
+	err = upgradeProperties(newESDTToken, properties)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = e.saveToken(tokenIdentifier, newESDTToken)
 	if err != nil {
-		return err
-	}
-
-	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString(initialSupply.Bytes())
-	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
-	if err != nil {
-		return err
+		return nil, err
 	}
 
 	e.addToIssuedTokens(string(tokenIdentifier))
 
-	return nil
+	return tokenIdentifier, nil
 }
 
 func upgradeProperties(token *ESDTData, args [][]byte) error {
@@ -444,6 +523,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be minted, use the special roles for the others")
+		return vmcommon.UserError
+	}
 
 	token.MintedValue.Add(token.MintedValue, mintValue)
 	err := e.saveToken(args.Arguments[0], token)
@@ -563,6 +646,78 @@ func (e *esdt) togglePause(args *vmcommon.ContractCallInput, builtInFunc string)
 	return vmcommon.Ok
 }
 
+// format: setSpecialRole@tokenIdentifier@address@role[@role...]
+// format: unSetSpecialRole@tokenIdentifier@address@role[@role...]
+func (e *esdt) toggleSpecialRole(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !e.isAddressValid(args.Arguments[1]) {
+		e.eei.AddReturnMessage("invalid address to set/unset special role")
+		return vmcommon.UserError
+	}
+
+	roles := args.Arguments[2:]
+	err := e.checkSpecialRoles(token, roles)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtTransferData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	for _, role := range roles {
+		esdtTransferData += "@" + hex.EncodeToString(role)
+	}
+	err = e.eei.Transfer(args.Arguments[1], e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) checkSpecialRoles(token *ESDTData, roles [][]byte) error {
+	mapRoles := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		_, exists := mapRoles[string(role)]
+		if exists {
+			return vm.ErrDuplicatesFoundInArguments
+		}
+		mapRoles[string(role)] = struct{}{}
+
+		if !e.isRoleAllowedForToken(token, string(role)) {
+			return fmt.Errorf("%w, role %s is not allowed for token type %s", vm.ErrInvalidArgument, role, token.TokenType)
+		}
+	}
+
+	return nil
+}
+
+func (e *esdt) isRoleAllowedForToken(token *ESDTData, role string) bool {
+	switch role {
+	case core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn:
+		return e.flagESDTLocalRoles.IsSet() && isFungibleToken(token)
+	case core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn:
+		return e.flagESDTNFT.IsSet() && !isFungibleToken(token)
+	case core.ESDTRoleNFTAddQuantity:
+		return e.flagESDTNFT.IsSet() && bytes.Equal(token.TokenType, []byte(core.SemiFungibleESDT))
+	default:
+		return false
+	}
+}
+
+// isFungibleToken returns true for the tokens issued as fungible and for the ones issued before the token types
+// were introduced
+func isFungibleToken(token *ESDTData) bool {
+	return len(token.TokenType) == 0 || bytes.Equal(token.TokenType, []byte(core.FungibleESDT))
+}
+
 func (e *esdt) configChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, e.ownerAddress) {
 		e.eei.AddReturnMessage("configChange can be called by whitelisted address only")
@@ -701,10 +856,19 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	e.eei.Finish([]byte("CanPause-" + getStringFromBool(esdtToken.CanPause)))
 	e.eei.Finish([]byte("CanFreeze-" + getStringFromBool(esdtToken.CanFreeze)))
 	e.eei.Finish([]byte("CanWipe-" + getStringFromBool(esdtToken.CanWipe)))
+	e.eei.Finish([]byte("TokenType-" + getTokenTypeString(esdtToken)))
 
 	return vmcommon.Ok
 }
 
+func getTokenTypeString(token *ESDTData) string {
+	if isFungibleToken(token) {
+		return core.FungibleESDT
+	}
+
+	return string(token.TokenType)
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -846,6 +1010,12 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagESDTNFT.Toggle(epoch >= e.esdtNFTEnableEpoch)
+	log.Debug("esdt contract: NFT", "enabled", e.flagESDTNFT.IsSet())
+
+	e.flagESDTLocalRoles.Toggle(epoch >= e.esdtLocalRolesEnableEpoch)
+	log.Debug("esdt contract: local mint and burn roles", "enabled", e.flagESDTLocalRoles.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
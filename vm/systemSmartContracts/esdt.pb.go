@@ -43,6 +43,7 @@ type ESDTData struct {
 	MintedValue    *math_big.Int `protobuf:"bytes,12,opt,name=MintedValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MintedValue"`
 	BurntValue     *math_big.Int `protobuf:"bytes,13,opt,name=BurntValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BurntValue"`
 	NumDecimals    uint32        `protobuf:"varint,14,opt,name=NumDecimals,proto3" json:"NumDecimals"`
+	TokenType      []byte        `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -171,6 +172,13 @@ func (m *ESDTData) GetNumDecimals() uint32 {
 	return 0
 }
 
+func (m *ESDTData) GetTokenType() []byte {
+	if m != nil {
+		return m.TokenType
+	}
+	return nil
+}
+
 type ESDTConfig struct {
 	OwnerAddress       []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
 	BaseIssuingCost    *math_big.Int `protobuf:"bytes,2,opt,name=BaseIssuingCost,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseIssuingCost"`
@@ -242,46 +250,47 @@ func init() {
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xb3, 0xfd, 0xf5, 0x4f, 0xba, 0x49, 0xda, 0x9f, 0x56, 0x08, 0x59, 0x1c, 0xd6, 0x55,
-	0x25, 0xa4, 0x48, 0xa8, 0x89, 0x10, 0x9c, 0xe0, 0x54, 0xbb, 0xad, 0x14, 0x89, 0x06, 0xb4, 0x0d,
-	0x7f, 0xc4, 0x6d, 0x13, 0x6f, 0x1d, 0xab, 0xf1, 0xba, 0xda, 0x5d, 0x53, 0xe0, 0x84, 0x78, 0x02,
-	0x5e, 0x02, 0x09, 0xf1, 0x24, 0x1c, 0x7b, 0xa3, 0x27, 0x43, 0xdd, 0x0b, 0xf2, 0xa9, 0x8f, 0x80,
-	0x3c, 0x25, 0xb6, 0x1b, 0x72, 0x42, 0x3d, 0xe5, 0x3b, 0x9f, 0xf9, 0xee, 0x6c, 0x66, 0x36, 0x13,
-	0x8c, 0x85, 0xf6, 0x4c, 0xe7, 0x58, 0x45, 0x26, 0x22, 0x4b, 0xf0, 0x71, 0x67, 0xcb, 0x0f, 0xcc,
-	0x38, 0x1e, 0x76, 0x46, 0x51, 0xd8, 0xf5, 0x23, 0x3f, 0xea, 0x02, 0x1e, 0xc6, 0x87, 0x10, 0x41,
-	0x00, 0xea, 0xea, 0xd4, 0xe6, 0xe7, 0x65, 0x5c, 0xdf, 0x3d, 0xd8, 0x19, 0xec, 0x70, 0xc3, 0xc9,
-	0x43, 0xdc, 0x7c, 0x7a, 0x22, 0x85, 0xda, 0xf6, 0x3c, 0x25, 0xb4, 0xb6, 0xd0, 0x06, 0x6a, 0x37,
-	0x9d, 0xff, 0xb3, 0xc4, 0xbe, 0xc6, 0xd9, 0xb5, 0x88, 0xdc, 0xc3, 0xab, 0x83, 0xe8, 0x48, 0xc8,
-	0x3e, 0x0f, 0x85, 0xb5, 0x00, 0x47, 0x5a, 0x59, 0x62, 0x97, 0x90, 0x95, 0x92, 0x74, 0x30, 0x1e,
-	0x04, 0xa3, 0x23, 0xa1, 0xc0, 0xfd, 0x1f, 0xb8, 0xd7, 0xb2, 0xc4, 0xae, 0x50, 0x56, 0xd1, 0xa4,
-	0x8d, 0xeb, 0xfb, 0x81, 0x34, 0x7c, 0x38, 0x11, 0xd6, 0xe2, 0x06, 0x6a, 0xd7, 0x9d, 0x66, 0x96,
-	0xd8, 0x05, 0x63, 0x85, 0xca, 0x9d, 0x4e, 0xac, 0x24, 0x38, 0x97, 0x4a, 0xe7, 0x94, 0xb1, 0x42,
-	0xe5, 0x4e, 0x97, 0xcb, 0x67, 0x3c, 0xd6, 0xc2, 0x5a, 0x2e, 0x9d, 0x53, 0xc6, 0x0a, 0x95, 0xb7,
-	0xe6, 0x72, 0xb9, 0xa7, 0x84, 0x78, 0x2f, 0xac, 0x15, 0xb0, 0x42, 0x6b, 0x05, 0x64, 0xa5, 0x24,
-	0x77, 0xf1, 0x8a, 0xcb, 0xe5, 0xcb, 0xe0, 0x58, 0x58, 0x75, 0xb0, 0x36, 0xb2, 0xc4, 0x9e, 0x22,
-	0x36, 0x15, 0xf9, 0x04, 0x9e, 0x1f, 0xfb, 0x8a, 0x7b, 0xf0, 0x4d, 0x57, 0xc1, 0x09, 0x13, 0x70,
-	0xb9, 0xbc, 0x4a, 0x08, 0x56, 0x71, 0x90, 0x47, 0x78, 0xcd, 0xe5, 0xd2, 0x1d, 0x73, 0xe9, 0x0b,
-	0x98, 0xbb, 0x85, 0xe1, 0x0c, 0xc9, 0x12, 0x7b, 0x26, 0xc3, 0x66, 0xe2, 0xbc, 0xd3, 0x9e, 0x86,
-	0x56, 0x3c, 0xab, 0x51, 0x76, 0x3a, 0x65, 0xac, 0x50, 0xe4, 0x0d, 0x6e, 0xe4, 0x93, 0x14, 0xde,
-	0x0b, 0x3e, 0x89, 0x85, 0xd5, 0x84, 0x87, 0x19, 0x64, 0x89, 0x5d, 0xc5, 0x5f, 0x7f, 0xd8, 0xdb,
-	0x21, 0x37, 0xe3, 0xee, 0x30, 0xf0, 0x3b, 0x3d, 0x69, 0x1e, 0x57, 0x7e, 0x6b, 0xbb, 0x13, 0x15,
-	0x49, 0xaf, 0x2f, 0xcc, 0x49, 0xa4, 0x8e, 0xba, 0x02, 0xa2, 0x2d, 0x3f, 0xea, 0x7a, 0xdc, 0xf0,
-	0x8e, 0x13, 0xf8, 0x3d, 0x69, 0x5c, 0xae, 0x8d, 0x50, 0xac, 0x5a, 0x91, 0x68, 0x8c, 0xf3, 0x77,
-	0x31, 0x57, 0xd7, 0xb6, 0xe0, 0xda, 0x83, 0x7c, 0x1a, 0x25, 0xbd, 0x99, 0x5b, 0x2b, 0x05, 0xc9,
-	0x7d, 0xdc, 0xe8, 0xc7, 0xe1, 0x8e, 0x18, 0x05, 0x21, 0x9f, 0x68, 0x6b, 0x6d, 0x03, 0xb5, 0x5b,
-	0xce, 0x7a, 0xde, 0x6c, 0x05, 0xb3, 0x6a, 0xb0, 0xf9, 0x7d, 0x01, 0xe3, 0x7c, 0x4f, 0xdc, 0x48,
-	0x1e, 0x06, 0xfe, 0x3f, 0x6e, 0xca, 0x47, 0x84, 0xd7, 0x1d, 0xae, 0x45, 0x4f, 0xeb, 0x38, 0x90,
-	0xbe, 0x1b, 0x69, 0xf3, 0x67, 0x61, 0x5e, 0x65, 0x89, 0x3d, 0x9b, 0xba, 0x99, 0xbe, 0x67, 0xab,
-	0x92, 0x3d, 0x4c, 0xf6, 0x03, 0x59, 0x6c, 0xe4, 0x13, 0x21, 0x7d, 0x33, 0x86, 0x4d, 0x6c, 0x39,
-	0xb7, 0xb3, 0xc4, 0x9e, 0x93, 0x65, 0x73, 0x18, 0xd4, 0xe1, 0x6f, 0x67, 0xeb, 0x2c, 0x56, 0xea,
-	0xfc, 0x95, 0x65, 0x73, 0x98, 0xd3, 0x3f, 0x3d, 0xa7, 0xb5, 0xb3, 0x73, 0x5a, 0xbb, 0x3c, 0xa7,
-	0xe8, 0x43, 0x4a, 0xd1, 0x97, 0x94, 0xa2, 0x6f, 0x29, 0x45, 0xa7, 0x29, 0x45, 0x67, 0x29, 0x45,
-	0x3f, 0x53, 0x8a, 0x7e, 0xa5, 0xb4, 0x76, 0x99, 0x52, 0xf4, 0xe9, 0x82, 0xd6, 0x4e, 0x2f, 0x68,
-	0xed, 0xec, 0x82, 0xd6, 0x5e, 0xdf, 0xd2, 0xef, 0xb4, 0x11, 0xe1, 0x41, 0xc8, 0x95, 0x71, 0x23,
-	0x69, 0x14, 0x1f, 0x19, 0x3d, 0x5c, 0x86, 0x3f, 0xb6, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x26, 0x99, 0x78, 0x1c, 0x05, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0xa5, 0x1f, 0xe9, 0x26, 0x69, 0xd1, 0x0a, 0x21, 0x8b, 0xc3, 0xba, 0xaa, 0x84,
+	0x14, 0x09, 0x35, 0x11, 0x82, 0x13, 0x9c, 0x6a, 0xb7, 0x95, 0x22, 0xd1, 0x80, 0xb6, 0xe1, 0x43,
+	0xdc, 0x36, 0xf1, 0xd6, 0xb1, 0x1a, 0xaf, 0x23, 0xef, 0x9a, 0x52, 0x4e, 0x88, 0x27, 0xe0, 0x31,
+	0x10, 0x4f, 0xc2, 0xb1, 0xe2, 0x42, 0x4f, 0x86, 0xba, 0x17, 0xe4, 0x53, 0x1f, 0x01, 0x79, 0x4a,
+	0x6c, 0xd7, 0xe4, 0x84, 0x7a, 0xea, 0x7f, 0x7e, 0xf3, 0xdf, 0xd9, 0xce, 0x78, 0x27, 0x18, 0x0b,
+	0xe5, 0xe8, 0xce, 0x34, 0x0c, 0x74, 0x40, 0x96, 0xe0, 0xcf, 0xbd, 0x2d, 0xd7, 0xd3, 0xe3, 0x68,
+	0xd8, 0x19, 0x05, 0x7e, 0xd7, 0x0d, 0xdc, 0xa0, 0x0b, 0x78, 0x18, 0x1d, 0x42, 0x04, 0x01, 0xa8,
+	0xab, 0x53, 0x9b, 0xdf, 0x97, 0x71, 0x7d, 0xf7, 0x60, 0x67, 0xb0, 0xc3, 0x35, 0x27, 0x8f, 0x71,
+	0xf3, 0xf9, 0xb1, 0x14, 0xe1, 0xb6, 0xe3, 0x84, 0x42, 0x29, 0x03, 0x6d, 0xa0, 0x76, 0xd3, 0xba,
+	0x9d, 0xc6, 0xe6, 0x35, 0xce, 0xae, 0x45, 0xe4, 0x01, 0x5e, 0x1d, 0x04, 0x47, 0x42, 0xf6, 0xb9,
+	0x2f, 0x8c, 0x05, 0x38, 0xd2, 0x4a, 0x63, 0xb3, 0x80, 0xac, 0x90, 0xa4, 0x83, 0xf1, 0xc0, 0x1b,
+	0x1d, 0x89, 0x10, 0xdc, 0xb7, 0xc0, 0xbd, 0x96, 0xc6, 0x66, 0x89, 0xb2, 0x92, 0x26, 0x6d, 0x5c,
+	0xdf, 0xf7, 0xa4, 0xe6, 0xc3, 0x89, 0x30, 0x16, 0x37, 0x50, 0xbb, 0x6e, 0x35, 0xd3, 0xd8, 0xcc,
+	0x19, 0xcb, 0x55, 0xe6, 0xb4, 0xa2, 0x50, 0x82, 0x73, 0xa9, 0x70, 0xce, 0x18, 0xcb, 0x55, 0xe6,
+	0xb4, 0xb9, 0x7c, 0xc1, 0x23, 0x25, 0x8c, 0xe5, 0xc2, 0x39, 0x63, 0x2c, 0x57, 0x59, 0x6b, 0x36,
+	0x97, 0x7b, 0xa1, 0x10, 0x1f, 0x84, 0xb1, 0x02, 0x56, 0x68, 0x2d, 0x87, 0xac, 0x90, 0xe4, 0x3e,
+	0x5e, 0xb1, 0xb9, 0x7c, 0xed, 0x4d, 0x85, 0x51, 0x07, 0x6b, 0x23, 0x8d, 0xcd, 0x19, 0x62, 0x33,
+	0x91, 0x4d, 0xe0, 0xe5, 0xd4, 0x0d, 0xb9, 0x03, 0xff, 0xe9, 0x2a, 0x38, 0x61, 0x02, 0x36, 0x97,
+	0x57, 0x09, 0xc1, 0x4a, 0x0e, 0xf2, 0x04, 0xaf, 0xd9, 0x5c, 0xda, 0x63, 0x2e, 0x5d, 0x01, 0x73,
+	0x37, 0x30, 0x9c, 0x21, 0x69, 0x6c, 0x56, 0x32, 0xac, 0x12, 0x67, 0x9d, 0xf6, 0x14, 0xb4, 0xe2,
+	0x18, 0x8d, 0xa2, 0xd3, 0x19, 0x63, 0xb9, 0x22, 0xef, 0x70, 0x23, 0x9b, 0xa4, 0x70, 0x5e, 0xf1,
+	0x49, 0x24, 0x8c, 0x26, 0x7c, 0x98, 0x41, 0x1a, 0x9b, 0x65, 0xfc, 0xf5, 0xa7, 0xb9, 0xed, 0x73,
+	0x3d, 0xee, 0x0e, 0x3d, 0xb7, 0xd3, 0x93, 0xfa, 0x69, 0xe9, 0xad, 0xed, 0x4e, 0xc2, 0x40, 0x3a,
+	0x7d, 0xa1, 0x8f, 0x83, 0xf0, 0xa8, 0x2b, 0x20, 0xda, 0x72, 0x83, 0xae, 0xc3, 0x35, 0xef, 0x58,
+	0x9e, 0xdb, 0x93, 0xda, 0xe6, 0x4a, 0x8b, 0x90, 0x95, 0x2b, 0x12, 0x85, 0x71, 0xf6, 0x5d, 0xf4,
+	0xd5, 0xb5, 0x2d, 0xb8, 0xf6, 0x20, 0x9b, 0x46, 0x41, 0x6f, 0xe6, 0xd6, 0x52, 0x41, 0xf2, 0x10,
+	0x37, 0xfa, 0x91, 0xbf, 0x23, 0x46, 0x9e, 0xcf, 0x27, 0xca, 0x58, 0xdb, 0x40, 0xed, 0x96, 0xb5,
+	0x9e, 0x35, 0x5b, 0xc2, 0xac, 0x1c, 0xe4, 0x8f, 0x7c, 0x70, 0x32, 0x15, 0xc6, 0x7a, 0xe5, 0x91,
+	0x67, 0x90, 0x15, 0x72, 0xf3, 0xc7, 0x02, 0xc6, 0xd9, 0x52, 0xd9, 0x81, 0x3c, 0xf4, 0xdc, 0xff,
+	0x5c, 0xab, 0x4f, 0x08, 0xaf, 0x5b, 0x5c, 0x89, 0x9e, 0x52, 0x91, 0x27, 0x5d, 0x3b, 0x50, 0xfa,
+	0xef, 0x76, 0xbd, 0x49, 0x63, 0xb3, 0x9a, 0xba, 0x99, 0x21, 0x55, 0xab, 0x92, 0x3d, 0x4c, 0xf6,
+	0x3d, 0x99, 0xaf, 0xef, 0x33, 0x21, 0x5d, 0x3d, 0x86, 0xb5, 0x6d, 0x59, 0x77, 0xd3, 0xd8, 0x9c,
+	0x93, 0x65, 0x73, 0x18, 0xd4, 0xe1, 0xef, 0xab, 0x75, 0x16, 0x4b, 0x75, 0xfe, 0xc9, 0xb2, 0x39,
+	0xcc, 0xea, 0x9f, 0x9e, 0xd3, 0xda, 0xd9, 0x39, 0xad, 0x5d, 0x9e, 0x53, 0xf4, 0x31, 0xa1, 0xe8,
+	0x4b, 0x42, 0xd1, 0xb7, 0x84, 0xa2, 0xd3, 0x84, 0xa2, 0xb3, 0x84, 0xa2, 0x5f, 0x09, 0x45, 0xbf,
+	0x13, 0x5a, 0xbb, 0x4c, 0x28, 0xfa, 0x7c, 0x41, 0x6b, 0xa7, 0x17, 0xb4, 0x76, 0x76, 0x41, 0x6b,
+	0x6f, 0xef, 0xa8, 0x13, 0xa5, 0x85, 0x7f, 0xe0, 0xf3, 0x50, 0xdb, 0x81, 0xd4, 0x21, 0x1f, 0x69,
+	0x35, 0x5c, 0x86, 0x5f, 0xc1, 0x47, 0x7f, 0x06, 0x00, 0x05, 0x34, 0xcb, 0x53, 0x49, 0x05, 0x00,
+	0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -351,6 +360,9 @@ func (this *ESDTData) Equal(that interface{}) bool {
 	if this.NumDecimals != that1.NumDecimals {
 		return false
 	}
+	if !bytes.Equal(this.TokenType, that1.TokenType) {
+		return false
+	}
 	return true
 }
 func (this *ESDTConfig) Equal(that interface{}) bool {
@@ -393,7 +405,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 19)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -409,6 +421,7 @@ func (this *ESDTData) GoString() string {
 	s = append(s, "MintedValue: "+fmt.Sprintf("%#v", this.MintedValue)+",\n")
 	s = append(s, "BurntValue: "+fmt.Sprintf("%#v", this.BurntValue)+",\n")
 	s = append(s, "NumDecimals: "+fmt.Sprintf("%#v", this.NumDecimals)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -453,6 +466,13 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.NumDecimals != 0 {
 		i = encodeVarintEsdt(dAtA, i, uint64(m.NumDecimals))
 		i--
@@ -701,6 +721,10 @@ func (m *ESDTData) Size() (n int) {
 	if m.NumDecimals != 0 {
 		n += 1 + sovEsdt(uint64(m.NumDecimals))
 	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
 	return n
 }
 
@@ -753,6 +777,7 @@ func (this *ESDTData) String() string {
 		`MintedValue:` + fmt.Sprintf("%v", this.MintedValue) + `,`,
 		`BurntValue:` + fmt.Sprintf("%v", this.BurntValue) + `,`,
 		`NumDecimals:` + fmt.Sprintf("%v", this.NumDecimals) + `,`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1164,6 +1189,40 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = append(m.TokenType[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenType == nil {
+				m.TokenType = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -2190,7 +2190,7 @@ func TestEsdt_ExecuteEsdtControlChangesSavesTokenWithUpgradedPropreties(t *testi
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.Ok, output)
 
-	assert.Equal(t, 13, len(eei.output))
+	assert.Equal(t, 14, len(eei.output))
 	assert.Equal(t, []byte("esdtToken"), eei.output[0])
 	assert.Equal(t, vmInput.CallerAddr, eei.output[1])
 }
@@ -2262,6 +2262,401 @@ func TestEsdt_ExecuteClaim(t *testing.T) {
 	assert.True(t, receiver.BalanceDelta.Cmp(big.NewInt(100)) == 0)
 }
 
+func TestEsdt_ExecuteIssueNonFungibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("name")},
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueNonFungible",
+	}
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER"), []byte(canFreeze), []byte("true")}
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	_, accCreated := vmOutput.OutputAccounts[string(vmInput.CallerAddr)]
+	assert.False(t, accCreated)
+
+	tokenIdentifier := bytes.Split(eei.GetStorage([]byte(allIssuedTokens)), []byte("@"))[0]
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenIdentifier))
+	assert.Equal(t, []byte(core.NonFungibleESDT), esdtData.TokenType)
+	assert.Equal(t, vmInput.CallerAddr, esdtData.OwnerAddress)
+	assert.True(t, esdtData.CanFreeze)
+	assert.Equal(t, big.NewInt(0), esdtData.MintedValue)
+
+	systemAddress := make([]byte, len(core.SystemAccountAddress))
+	copy(systemAddress, core.SystemAccountAddress)
+	systemAddress[len(core.SystemAccountAddress)-1] = 0
+
+	createdAcc, accCreated := vmOutput.OutputAccounts[string(systemAddress)]
+	assert.True(t, accCreated)
+	assert.Equal(t, 1, len(createdAcc.OutputTransfers))
+	expectedInput := core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString([]byte(core.NonFungibleESDT))
+	assert.Equal(t, []byte(expectedInput), createdAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteNFTFunctionsBeforeEnableEpochShouldBeUnknown(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTNFTEnableEpoch = 1
+	args.ESDTLocalRolesEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("name"), []byte("TICKER")},
+		},
+		RecipientAddr: []byte("addr"),
+	}
+	eei.gasRemaining = vmInput.GasProvided
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	for _, function := range []string{"issueNonFungible", "issueSemiFungible", "setSpecialRole", "unSetSpecialRole"} {
+		vmInput.Function = function
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.FunctionNotFound, output)
+	}
+
+	e.EpochConfirmed(1)
+	vmInput.Function = "issueNonFungible"
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteMintNonFungibleTokenShouldFail(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+		Mintable:     true,
+		TokenType:    []byte(core.SemiFungibleESDT),
+		MintedValue:  big.NewInt(0),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("mint", [][]byte{tokenName, big.NewInt(10).Bytes()})
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only fungible tokens can be minted"))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+		TokenType:    []byte(core.SemiFungibleESDT),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTAddQuantity)})
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc, accCreated := vmOutput.OutputAccounts[string(address)]
+	assert.True(t, accCreated)
+	assert.True(t, len(destAcc.OutputTransfers) == 1)
+
+	expectedInput := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) + "@" + hex.EncodeToString([]byte(core.ESDTRoleNFTAddQuantity))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteSetSpecialRoleInvalidRolesShouldFail(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	nftName := []byte("nftToken")
+	fungibleName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	tokensMap[string(nftName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    nftName,
+		OwnerAddress: owner,
+		TokenType:    []byte(core.NonFungibleESDT),
+	})
+	tokensMap[string(fungibleName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    fungibleName,
+		OwnerAddress: owner,
+	})
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, []byte("invalid"), []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleNFTAddQuantity)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleNFTBurn), []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrDuplicatesFoundInArguments.Error()))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{fungibleName, address, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleLocalMint)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleNFTCreate)})
+	vmInput.CallerAddr = []byte("notOwner")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by owner only"))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleLocalRolesForFungibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	tokensMap[string(tokenName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+	})
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)})
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc, accCreated := vmOutput.OutputAccounts[string(address)]
+	assert.True(t, accCreated)
+	assert.True(t, len(destAcc.OutputTransfers) == 1)
+
+	expectedInput := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalMint)) + "@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteUnSetSpecialRoleLocalRolesForFungibleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	tokensMap[string(tokenName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+	})
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)})
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc, accCreated := vmOutput.OutputAccounts[string(address)]
+	assert.True(t, accCreated)
+	assert.True(t, len(destAcc.OutputTransfers) == 1)
+
+	expectedInput := core.BuiltInFunctionUnSetESDTRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalMint)) + "@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteSetSpecialRoleLocalRolesBeforeEnableEpochShouldFail(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	args.ESDTLocalRolesEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	tokensMap[string(tokenName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+	})
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+	for _, role := range []string{core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn} {
+		vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(role)})
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+	}
+
+	e.EpochConfirmed(1)
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteSetSpecialRoleNFTRolesBeforeNFTEnableEpochShouldFail(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	fungibleName := []byte("fungible")
+	nftName := []byte("nft")
+	args := createMockArgumentsForESDT()
+	args.ESDTNFTEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	tokensMap := map[string][]byte{}
+	tokensMap[string(fungibleName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    fungibleName,
+		OwnerAddress: owner,
+	})
+	tokensMap[string(nftName)], _ = args.Marshalizer.Marshal(ESDTData{
+		TokenName:    nftName,
+		OwnerAddress: owner,
+		TokenType:    []byte(core.NonFungibleESDT),
+	})
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	address := getAddress()
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleNFTCreate)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{fungibleName, address, []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{fungibleName, address, []byte(core.ESDTRoleLocalMint)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	e.EpochConfirmed(1)
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{nftName, address, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{fungibleName, address, []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
 func getAddress() []byte {
 	key := make([]byte, 32)
 	_, _ = rand.Read(key)
@@ -4,6 +4,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"math/big"
 	"sync"
 
@@ -25,6 +26,37 @@ const validatorPrefix = "validator"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
+// GovernanceProposalsListKey is the storage key holding the number of governance proposals. Their references are
+// kept in pages of at most GovernanceProposalsListPageSize entries, see GovernanceProposalsListPageKey
+const GovernanceProposalsListKey = "proposalsList"
+
+// GovernanceProposalsListPageSize is the maximum number of proposal references kept in one page of the proposals list
+const GovernanceProposalsListPageSize = 100
+
+// GovernancePendingProposalsKey is the storage key holding the references of the passed proposals with an action
+// which were not yet executed
+const GovernancePendingProposalsKey = "pendingProposals"
+
+// GovernanceActionChangeGasSchedule is the proposal action which changes one entry of the gas schedule
+const GovernanceActionChangeGasSchedule = "changeGasSchedule"
+
+// GovernanceActionChangeEconomics is the proposal action which changes one of the economics fee parameters
+const GovernanceActionChangeEconomics = "changeEconomics"
+
+// GovernanceActionHardFork is the proposal action which schedules a hardfork
+const GovernanceActionHardFork = "hardFork"
+
+// GovernanceActionWhiteList is the proposal action which whitelists an address
+const GovernanceActionWhiteList = "whiteList"
+
+var economicsParametersForGovernance = map[string]struct{}{
+	"MaxGasLimitPerBlock":     {},
+	"MaxGasLimitPerMetaBlock": {},
+	"GasPerDataByte":          {},
+	"MinGasPrice":             {},
+	"MinGasLimit":             {},
+}
+
 // ArgsNewGovernanceContract defines the arguments needed for the on-chain governance contract
 type ArgsNewGovernanceContract struct {
 	Eei                 vm.SystemEI
@@ -36,6 +68,7 @@ type ArgsNewGovernanceContract struct {
 	GovernanceSCAddress []byte
 	StakingSCAddress    []byte
 	ValidatorSCAddress  []byte
+	EndOfEpochAddress   []byte
 	EpochNotifier       vm.EpochNotifier
 }
 
@@ -47,11 +80,14 @@ type governanceContract struct {
 	governanceSCAddress []byte
 	stakingSCAddress    []byte
 	validatorSCAddress  []byte
+	endOfEpochAddress   []byte
 	marshalizer         marshal.Marshalizer
 	hasher              hashing.Hasher
 	governanceConfig    config.GovernanceSystemSCConfig
 	enabledEpoch        uint32
 	flagEnabled         atomic.Flag
+	proposalsListEpoch  uint32
+	flagProposalsList   atomic.Flag
 	mutExecution        sync.RWMutex
 }
 
@@ -69,6 +105,9 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if check.IfNil(args.EpochNotifier) {
 		return nil, vm.ErrNilEpochNotifier
 	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, vm.ErrInvalidEndOfEpochAccessAddress
+	}
 
 	baseProposalCost, okConvert := big.NewInt(0).SetString(args.GovernanceConfig.ProposalCost, conversionBase)
 	if !okConvert || baseProposalCost.Cmp(big.NewInt(0)) < 0 {
@@ -83,10 +122,12 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		governanceSCAddress: args.GovernanceSCAddress,
 		stakingSCAddress:    args.StakingSCAddress,
 		validatorSCAddress:  args.ValidatorSCAddress,
+		endOfEpochAddress:   args.EndOfEpochAddress,
 		marshalizer:         args.Marshalizer,
 		hasher:              args.Hasher,
 		governanceConfig:    args.GovernanceConfig,
 		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+		proposalsListEpoch:  args.GovernanceConfig.ProposalsListEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
@@ -127,6 +168,12 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.changeConfig(args)
 	case "closeProposal":
 		return g.closeProposal(args)
+	case "proposeAction":
+		return g.proposeAction(args)
+	case "executeProposals":
+		return g.executeProposals(args)
+	case "setExecutionFailed":
+		return g.setExecutionFailed(args)
 	}
 
 	g.eei.AddReturnMessage("invalid method to call")
@@ -289,6 +336,12 @@ func (g *governanceContract) whiteListProposal(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 
+	err = g.addToProposalsList(args.CallerAddr)
+	if err != nil {
+		g.eei.AddReturnMessage("add to proposals list error " + err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
@@ -418,6 +471,12 @@ func (g *governanceContract) whiteListAtGenesis(args *vmcommon.ContractCallInput
 		return vmcommon.UserError
 	}
 
+	err = g.addToProposalsList(args.CallerAddr)
+	if err != nil {
+		log.Warn("add to proposals list", "err", err)
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
@@ -511,6 +570,12 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 
+	err = g.addToProposalsList(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage("addToProposalsList " + err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
@@ -567,9 +632,357 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		return vmcommon.UserError
 	}
 
+	err = g.addToProposalsList(gitHubCommit)
+	if err != nil {
+		g.eei.AddReturnMessage("addToProposalsList " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// proposeAction creates a proposal which, once closed as passed, is automatically executed at the first epoch start
+// after the execution epoch
+// format: proposeAction@gitHubCommit@startVoteNonce@endVoteNonce@executionEpoch@actionType@actionArguments...
+func (g *governanceContract) proposeAction(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(g.baseProposalCost) != 0 {
+		g.eei.AddReturnMessage("invalid proposal cost, expected " + g.baseProposalCost.String())
+		return vmcommon.OutOfFunds
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 6 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected at least 6")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !g.isWhiteListed(args.CallerAddr) {
+		g.eei.AddReturnMessage("called address is not whiteListed")
+		return vmcommon.UserError
+	}
+	gitHubCommit := args.Arguments[0]
+	if len(gitHubCommit) != githubCommitLength {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", githubCommitLength))
+		return vmcommon.UserError
+	}
+	if g.proposalExists(gitHubCommit) {
+		g.eei.AddReturnMessage("proposal already exists")
+		return vmcommon.UserError
+	}
+
+	startVoteNonce, endVoteNonce, err := g.startEndNonceFromArguments(args.Arguments[1], args.Arguments[2])
+	if err != nil {
+		g.eei.AddReturnMessage("invalid start/end vote nonce " + err.Error())
+		return vmcommon.UserError
+	}
+
+	executionEpoch, okConvert := big.NewInt(0).SetString(string(args.Arguments[3]), conversionBase)
+	if !okConvert || !executionEpoch.IsUint64() || executionEpoch.Uint64() > math.MaxUint32 {
+		g.eei.AddReturnMessage("invalid argument for execution epoch")
+		return vmcommon.UserError
+	}
+	if uint32(executionEpoch.Uint64()) < g.eei.BlockChainHook().CurrentEpoch() {
+		g.eei.AddReturnMessage("execution epoch is in the past")
+		return vmcommon.UserError
+	}
+
+	actionType := args.Arguments[4]
+	actionArguments := args.Arguments[5:]
+	err = g.checkProposalAction(string(actionType), actionArguments, args.CallerAddr)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	generalProposal := &GeneralProposal{
+		IssuerAddress:   args.CallerAddr,
+		GitHubCommit:    gitHubCommit,
+		StartVoteNonce:  startVoteNonce,
+		EndVoteNonce:    endVoteNonce,
+		Voters:          make([][]byte, 0),
+		ActionType:      actionType,
+		ActionArguments: actionArguments,
+		ExecutionEpoch:  uint32(executionEpoch.Uint64()),
+	}
+	err = g.saveGeneralProposal(gitHubCommit, generalProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = g.addToProposalsList(gitHubCommit)
+	if err != nil {
+		g.eei.AddReturnMessage("addToProposalsList " + err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
+func (g *governanceContract) checkProposalAction(actionType string, arguments [][]byte, callerAddress []byte) error {
+	switch actionType {
+	case GovernanceActionChangeGasSchedule:
+		// arguments: gas map name, operation name, new cost
+		if len(arguments) != 3 || len(arguments[0]) == 0 || len(arguments[1]) == 0 {
+			return fmt.Errorf("%w, %s needs the gas map, the operation and the new cost", vm.ErrInvalidGovernanceAction, actionType)
+		}
+		return checkUint64Argument(arguments[2])
+	case GovernanceActionChangeEconomics:
+		// arguments: fee parameter name, new value
+		if len(arguments) != 2 {
+			return fmt.Errorf("%w, %s needs the parameter and the new value", vm.ErrInvalidGovernanceAction, actionType)
+		}
+		_, isSupported := economicsParametersForGovernance[string(arguments[0])]
+		if !isSupported {
+			return fmt.Errorf("%w, economics parameter %s can not be changed", vm.ErrInvalidGovernanceAction, arguments[0])
+		}
+		return checkUint64Argument(arguments[1])
+	case GovernanceActionHardFork:
+		// arguments: hardfork epoch, new software version
+		if len(arguments) != 2 || len(arguments[1]) == 0 {
+			return fmt.Errorf("%w, %s needs the epoch and the new software version", vm.ErrInvalidGovernanceAction, actionType)
+		}
+		return checkUint64Argument(arguments[0])
+	case GovernanceActionWhiteList:
+		// arguments: the address to whitelist
+		if len(arguments) != 1 || len(arguments[0]) != len(callerAddress) {
+			return fmt.Errorf("%w, %s needs a valid address", vm.ErrInvalidGovernanceAction, actionType)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w, unknown action type %s", vm.ErrInvalidGovernanceAction, actionType)
+	}
+}
+
+func checkUint64Argument(argument []byte) error {
+	value, okConvert := big.NewInt(0).SetString(string(argument), conversionBase)
+	if !okConvert || !value.IsUint64() {
+		return fmt.Errorf("%w, %s is not a valid value", vm.ErrInvalidGovernanceAction, argument)
+	}
+
+	return nil
+}
+
+// executeProposals is called at every epoch start and executes the actions of the pending proposals whose execution
+// epoch was reached. The executed proposals are returned so that the node can apply the actions which are not stored
+// in this contract
+func (g *governanceContract) executeProposals(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, g.endOfEpochAddress) {
+		g.eei.AddReturnMessage("executeProposals can be called by end of epoch address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("executeProposals callValue expected to be 0")
+		return vmcommon.UserError
+	}
+
+	pendingList, err := g.getProposalsList(GovernancePendingProposalsKey)
+	if err != nil {
+		g.eei.AddReturnMessage("getProposalsList error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(pendingList.References) == 0 {
+		return vmcommon.Ok
+	}
+
+	currentEpoch := g.eei.BlockChainHook().CurrentEpoch()
+	stillPending := make([][]byte, 0, len(pendingList.References))
+	for _, reference := range pendingList.References {
+		generalProposal, errGet := g.getGeneralProposal(reference)
+		if errGet != nil {
+			log.Warn("executeProposals getGeneralProposal", "proposal", reference, "err", errGet)
+			continue
+		}
+		if generalProposal.ExecutionEpoch > currentEpoch {
+			stillPending = append(stillPending, reference)
+			continue
+		}
+
+		if string(generalProposal.ActionType) == GovernanceActionWhiteList {
+			err = g.whiteListAddress(generalProposal.ActionArguments[0], generalProposal.GitHubCommit)
+			if err != nil {
+				generalProposal.ExecutionFailed = true
+				generalProposal.ExecutionError = []byte(err.Error())
+			}
+		}
+
+		generalProposal.Executed = true
+		err = g.saveGeneralProposal(reference, generalProposal)
+		if err != nil {
+			g.eei.AddReturnMessage("saveGeneralProposal error " + err.Error())
+			return vmcommon.UserError
+		}
+
+		marshaledData, errMarshal := g.marshalizer.Marshal(generalProposal)
+		if errMarshal != nil {
+			g.eei.AddReturnMessage("marshal error " + errMarshal.Error())
+			return vmcommon.UserError
+		}
+		g.eei.Finish(marshaledData)
+
+		log.Debug("governance proposal executed",
+			"proposal", reference,
+			"action", generalProposal.ActionType,
+			"epoch", currentEpoch,
+			"failed", generalProposal.ExecutionFailed,
+		)
+	}
+
+	if len(stillPending) == len(pendingList.References) {
+		return vmcommon.Ok
+	}
+
+	pendingList.References = stillPending
+	err = g.saveProposalsList(GovernancePendingProposalsKey, pendingList)
+	if err != nil {
+		g.eei.AddReturnMessage("saveProposalsList error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// setExecutionFailed records that the node could not apply the action of an executed proposal
+// format: setExecutionFailed@reference@errorMessage
+func (g *governanceContract) setExecutionFailed(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, g.endOfEpochAddress) {
+		g.eei.AddReturnMessage("setExecutionFailed can be called by end of epoch address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("setExecutionFailed callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments expected 2")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage("getGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+	if !generalProposal.Executed {
+		g.eei.AddReturnMessage("proposal was not executed")
+		return vmcommon.UserError
+	}
+
+	generalProposal.ExecutionFailed = true
+	generalProposal.ExecutionError = args.Arguments[1]
+	err = g.saveGeneralProposal(args.Arguments[0], generalProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("saveGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) whiteListAddress(address []byte, gitHubCommit []byte) error {
+	key := append([]byte(proposalPrefix), address...)
+	whiteListAcc := &WhiteListProposal{
+		WhiteListAddress: address,
+		ProposalStatus:   key,
+	}
+	marshaledData, err := g.marshalizer.Marshal(whiteListAcc)
+	if err != nil {
+		return err
+	}
+
+	key = append([]byte(whiteListPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+
+	generalProposal := &GeneralProposal{
+		IssuerAddress: address,
+		GitHubCommit:  gitHubCommit,
+		Voted:         true,
+		Closed:        true,
+		TopReference:  key,
+		Voters:        make([][]byte, 0),
+	}
+
+	return g.saveGeneralProposal(address, generalProposal)
+}
+
+// GovernanceProposalKey returns the storage key of the governance proposal with the provided reference
+func GovernanceProposalKey(reference []byte) []byte {
+	key := make([]byte, 0, len(proposalPrefix)+len(reference))
+	key = append(key, proposalPrefix...)
+	return append(key, reference...)
+}
+
+func (g *governanceContract) getProposalsList(key string) (*ProposalsList, error) {
+	proposalsList := &ProposalsList{
+		References: make([][]byte, 0),
+	}
+	marshaledData := g.eei.GetStorage([]byte(key))
+	if len(marshaledData) == 0 {
+		return proposalsList, nil
+	}
+
+	err := g.marshalizer.Unmarshal(proposalsList, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposalsList, nil
+}
+
+func (g *governanceContract) saveProposalsList(key string, proposalsList *ProposalsList) error {
+	marshaledData, err := g.marshalizer.Marshal(proposalsList)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(key), marshaledData)
+	return nil
+}
+
+func (g *governanceContract) addToPendingProposals(reference []byte) error {
+	pendingList, err := g.getProposalsList(GovernancePendingProposalsKey)
+	if err != nil {
+		return err
+	}
+
+	pendingList.References = append(pendingList.References, reference)
+	return g.saveProposalsList(GovernancePendingProposalsKey, pendingList)
+}
+
+// GovernanceProposalsListPageKey returns the storage key of the page of the proposals list with the provided index
+func GovernanceProposalsListPageKey(page uint64) []byte {
+	return []byte(fmt.Sprintf("%s_%d", GovernanceProposalsListKey, page))
+}
+
+func (g *governanceContract) addToProposalsList(reference []byte) error {
+	if !g.flagProposalsList.IsSet() {
+		return nil
+	}
+
+	err := g.eei.UseGas(g.gasCost.BaseOperationCost.StorePerByte * uint64(len(reference)))
+	if err != nil {
+		return err
+	}
+
+	numProposals := big.NewInt(0).SetBytes(g.eei.GetStorage([]byte(GovernanceProposalsListKey))).Uint64()
+	pageKey := string(GovernanceProposalsListPageKey(numProposals / GovernanceProposalsListPageSize))
+	page, err := g.getProposalsList(pageKey)
+	if err != nil {
+		return err
+	}
+
+	page.References = append(page.References, reference)
+	err = g.saveProposalsList(pageKey, page)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(GovernanceProposalsListKey), big.NewInt(0).SetUint64(numProposals+1).Bytes())
+	return nil
+}
+
 func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		g.eei.AddReturnMessage("invalid proposal cost, expected 0")
@@ -876,6 +1289,14 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	if generalProposal.Voted && len(generalProposal.ActionType) > 0 {
+		err = g.addToPendingProposals(proposal)
+		if err != nil {
+			g.eei.AddReturnMessage("addToPendingProposals error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	for _, voter := range generalProposal.Voters {
 		key := append(proposal, voter...)
 		g.eei.SetStorage(key, nil)
@@ -912,6 +1333,9 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagProposalsList.Toggle(epoch >= g.proposalsListEpoch)
+	log.Debug("governance contract: proposals list", "enabled", g.flagProposalsList.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
@@ -29,18 +29,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GeneralProposal struct {
-	IssuerAddress  []byte   `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
-	GitHubCommit   []byte   `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
-	StartVoteNonce uint64   `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
-	EndVoteNonce   uint64   `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
-	Yes            int32    `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
-	No             int32    `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
-	Veto           int32    `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
-	DontCare       int32    `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
-	Voted          bool     `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
-	Voters         [][]byte `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
-	TopReference   []byte   `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
-	Closed         bool     `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	IssuerAddress   []byte   `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
+	GitHubCommit    []byte   `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
+	StartVoteNonce  uint64   `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
+	EndVoteNonce    uint64   `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
+	Yes             int32    `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
+	No              int32    `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
+	Veto            int32    `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
+	DontCare        int32    `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
+	Voted           bool     `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
+	Voters          [][]byte `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
+	TopReference    []byte   `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
+	Closed          bool     `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	ActionType      []byte   `protobuf:"bytes,13,opt,name=ActionType,proto3" json:"ActionType"`
+	ActionArguments [][]byte `protobuf:"bytes,14,rep,name=ActionArguments,proto3" json:"ActionArguments"`
+	ExecutionEpoch  uint32   `protobuf:"varint,15,opt,name=ExecutionEpoch,proto3" json:"ExecutionEpoch"`
+	Executed        bool     `protobuf:"varint,16,opt,name=Executed,proto3" json:"Executed"`
+	ExecutionFailed bool     `protobuf:"varint,17,opt,name=ExecutionFailed,proto3" json:"ExecutionFailed"`
+	ExecutionError  []byte   `protobuf:"bytes,18,opt,name=ExecutionError,proto3" json:"ExecutionError"`
 }
 
 func (m *GeneralProposal) Reset()      { *m = GeneralProposal{} }
@@ -155,6 +161,48 @@ func (m *GeneralProposal) GetClosed() bool {
 	return false
 }
 
+func (m *GeneralProposal) GetActionType() []byte {
+	if m != nil {
+		return m.ActionType
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetActionArguments() [][]byte {
+	if m != nil {
+		return m.ActionArguments
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetExecutionEpoch() uint32 {
+	if m != nil {
+		return m.ExecutionEpoch
+	}
+	return 0
+}
+
+func (m *GeneralProposal) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *GeneralProposal) GetExecutionFailed() bool {
+	if m != nil {
+		return m.ExecutionFailed
+	}
+	return false
+}
+
+func (m *GeneralProposal) GetExecutionError() []byte {
+	if m != nil {
+		return m.ExecutionError
+	}
+	return nil
+}
+
 type WhiteListProposal struct {
 	WhiteListAddress []byte `protobuf:"bytes,1,opt,name=WhiteListAddress,proto3" json:"WhiteListAddress"`
 	ProposalStatus   []byte `protobuf:"bytes,2,opt,name=ProposalStatus,proto3" json:"ProposalStatus"`
@@ -469,6 +517,45 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+type ProposalsList struct {
+	References [][]byte `protobuf:"bytes,1,rep,name=References,proto3" json:"References"`
+}
+
+func (m *ProposalsList) Reset()      { *m = ProposalsList{} }
+func (*ProposalsList) ProtoMessage() {}
+func (*ProposalsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *ProposalsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProposalsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalsList.Merge(m, src)
+}
+func (m *ProposalsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalsList proto.InternalMessageInfo
+
+func (m *ProposalsList) GetReferences() [][]byte {
+	if m != nil {
+		return m.References
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +564,73 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*ProposalsList)(nil), "proto.ProposalsList")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
-	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	// 959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x8b, 0x23, 0x45,
+	0x18, 0x4e, 0x27, 0x93, 0x99, 0xa4, 0x26, 0x99, 0xc9, 0x94, 0xcb, 0xd2, 0x8a, 0x74, 0x85, 0x80,
+	0x10, 0x90, 0x4d, 0x40, 0x05, 0x41, 0x11, 0x77, 0x92, 0xf9, 0xd8, 0x01, 0x37, 0xac, 0x35, 0x21,
+	0xa2, 0x78, 0xa9, 0xa4, 0x6b, 0x3a, 0xcd, 0x26, 0x5d, 0xa1, 0xaa, 0x7a, 0xc7, 0xc5, 0x8b, 0x3f,
+	0x41, 0xff, 0x85, 0xf8, 0x4b, 0x3c, 0xce, 0x45, 0x98, 0x83, 0xb4, 0x4e, 0x06, 0x41, 0xfa, 0xb4,
+	0x3f, 0x41, 0xaa, 0x3a, 0xfd, 0x99, 0x39, 0xb8, 0x97, 0xae, 0xf7, 0x79, 0x9e, 0x7e, 0x3f, 0xea,
+	0xad, 0xea, 0xb7, 0x41, 0xcb, 0x61, 0xaf, 0x28, 0xf7, 0x88, 0x37, 0xa3, 0xbd, 0x15, 0x67, 0x92,
+	0xc1, 0xaa, 0x5e, 0xde, 0x7b, 0xe2, 0xb8, 0x72, 0xee, 0x4f, 0x7b, 0x33, 0xb6, 0xec, 0x3b, 0xcc,
+	0x61, 0x7d, 0x4d, 0x4f, 0xfd, 0x2b, 0x8d, 0x34, 0xd0, 0x56, 0xe4, 0xd5, 0xf9, 0x73, 0x17, 0x1c,
+	0x9e, 0x53, 0x8f, 0x72, 0xb2, 0x78, 0xc1, 0xd9, 0x8a, 0x09, 0xb2, 0x80, 0x9f, 0x82, 0xe6, 0x85,
+	0x10, 0x3e, 0xe5, 0xc7, 0xb6, 0xcd, 0xa9, 0x10, 0xa6, 0xd1, 0x36, 0xba, 0x8d, 0xc1, 0x51, 0x18,
+	0xa0, 0xbc, 0x80, 0xf3, 0x10, 0x7e, 0x02, 0x1a, 0xe7, 0xae, 0x7c, 0xe6, 0x4f, 0x87, 0x6c, 0xb9,
+	0x74, 0xa5, 0x59, 0xd6, 0x7e, 0xad, 0x30, 0x40, 0x39, 0x1e, 0xe7, 0x10, 0xfc, 0x0c, 0x1c, 0x5c,
+	0x4a, 0xc2, 0xe5, 0x84, 0x49, 0x3a, 0x62, 0xde, 0x8c, 0x9a, 0x95, 0xb6, 0xd1, 0xdd, 0x19, 0xc0,
+	0x30, 0x40, 0x05, 0x05, 0x17, 0xb0, 0xca, 0x78, 0xea, 0xd9, 0xa9, 0xe7, 0x8e, 0xf6, 0xd4, 0x19,
+	0xb3, 0x3c, 0xce, 0x21, 0xf8, 0x2e, 0xa8, 0x7c, 0x4b, 0x85, 0x59, 0x6d, 0x1b, 0xdd, 0xea, 0x60,
+	0x2f, 0x0c, 0x90, 0x82, 0x58, 0x3d, 0xe0, 0x63, 0x50, 0x1e, 0x31, 0x73, 0x57, 0x2b, 0xbb, 0x61,
+	0x80, 0xca, 0x23, 0x86, 0xcb, 0x23, 0x06, 0xdf, 0x07, 0x3b, 0x13, 0x2a, 0x99, 0xb9, 0xa7, 0x95,
+	0x5a, 0x18, 0x20, 0x8d, 0xb1, 0x7e, 0xc2, 0x2e, 0xa8, 0x9d, 0x30, 0x4f, 0x0e, 0x09, 0xa7, 0x66,
+	0x4d, 0xbf, 0xd1, 0x08, 0x03, 0x94, 0x70, 0x38, 0xb1, 0x20, 0x02, 0x55, 0x55, 0x87, 0x6d, 0xd6,
+	0xdb, 0x46, 0xb7, 0x36, 0xa8, 0x87, 0x01, 0x8a, 0x08, 0x1c, 0x2d, 0xb0, 0x03, 0x76, 0x95, 0xc1,
+	0x85, 0x09, 0xda, 0x95, 0x6e, 0x63, 0x00, 0xc2, 0x00, 0x6d, 0x18, 0xbc, 0x59, 0xd5, 0xae, 0xc7,
+	0x6c, 0x85, 0xe9, 0x15, 0xe5, 0x54, 0xed, 0x7a, 0x3f, 0xed, 0x73, 0x96, 0xc7, 0x39, 0xa4, 0x22,
+	0x0f, 0x17, 0x4c, 0x50, 0xdb, 0x6c, 0xe8, 0xdc, 0x3a, 0x72, 0xc4, 0xe0, 0xcd, 0x0a, 0x7b, 0x00,
+	0x1c, 0xcf, 0xa4, 0xcb, 0xbc, 0xf1, 0xeb, 0x15, 0x35, 0x9b, 0x3a, 0xee, 0x41, 0x18, 0xa0, 0x0c,
+	0x8b, 0x33, 0x36, 0xfc, 0x02, 0x1c, 0x46, 0xe8, 0x98, 0x3b, 0xfe, 0x92, 0x7a, 0x52, 0x98, 0x07,
+	0xba, 0xec, 0x77, 0xc2, 0x00, 0x15, 0x25, 0x5c, 0x24, 0xd4, 0xd1, 0x9f, 0xfe, 0x40, 0x67, 0xbe,
+	0x62, 0x4f, 0x57, 0x6c, 0x36, 0x37, 0x0f, 0xdb, 0x46, 0xb7, 0x19, 0x1d, 0x7d, 0x5e, 0xc1, 0x05,
+	0xac, 0x7a, 0x1e, 0x31, 0xd4, 0x36, 0x5b, 0x7a, 0x43, 0xba, 0xe7, 0x31, 0x87, 0x13, 0x4b, 0x15,
+	0x99, 0xf8, 0x9e, 0x11, 0x77, 0x41, 0x6d, 0xf3, 0x48, 0x3b, 0xe8, 0x22, 0x0b, 0x12, 0x2e, 0x12,
+	0xf9, 0x22, 0x39, 0x67, 0xdc, 0x84, 0xba, 0x2f, 0x85, 0x22, 0x95, 0x82, 0x0b, 0xb8, 0xf3, 0x8b,
+	0x01, 0x8e, 0xbe, 0x99, 0xbb, 0x92, 0x7e, 0xe5, 0x0a, 0x99, 0x7c, 0x60, 0x4f, 0x41, 0x2b, 0x21,
+	0xf3, 0xdf, 0xd8, 0xa3, 0x30, 0x40, 0x5b, 0x1a, 0xde, 0x62, 0x54, 0x4d, 0x71, 0xb4, 0x4b, 0x49,
+	0xa4, 0x2f, 0xcc, 0x72, 0x5a, 0x53, 0x5e, 0xc1, 0x05, 0xdc, 0xf9, 0xc3, 0x00, 0xad, 0x67, 0x84,
+	0xdb, 0x67, 0x8c, 0xbf, 0x4c, 0x4a, 0x52, 0x3d, 0x52, 0x6d, 0x1d, 0xb3, 0x58, 0xd2, 0x15, 0x35,
+	0x37, 0x3d, 0xca, 0x4b, 0xb8, 0x48, 0xc0, 0x33, 0x00, 0x47, 0xf4, 0xfa, 0x92, 0x5d, 0xc9, 0x6b,
+	0xc2, 0xe9, 0x84, 0x72, 0xe1, 0x32, 0x6f, 0x53, 0xd3, 0xe3, 0x30, 0x40, 0x0f, 0xa8, 0xf8, 0x01,
+	0xee, 0x81, 0x7d, 0x55, 0xfe, 0xf7, 0xbe, 0xfe, 0x29, 0x83, 0xd6, 0x79, 0x32, 0x15, 0x87, 0xcc,
+	0xbb, 0x72, 0x1d, 0x75, 0x4b, 0x46, 0xfe, 0x72, 0xc4, 0x6c, 0x1a, 0xb5, 0xb8, 0x12, 0xdd, 0x92,
+	0x98, 0xc3, 0x89, 0x05, 0x3f, 0x04, 0xf5, 0xe7, 0xae, 0xf7, 0xb5, 0xcf, 0xb8, 0xbf, 0xd4, 0x95,
+	0x57, 0x07, 0xcd, 0x30, 0x40, 0x29, 0x89, 0x53, 0x53, 0x9d, 0xe0, 0x73, 0xd7, 0x7b, 0x41, 0x84,
+	0x18, 0xcf, 0x39, 0x15, 0x73, 0xb6, 0xb0, 0x75, 0xa5, 0xd5, 0xe8, 0x04, 0x8b, 0x1a, 0xde, 0x62,
+	0x36, 0x11, 0xd4, 0xf4, 0x48, 0x23, 0xec, 0xe4, 0x22, 0xe4, 0x34, 0xbc, 0xc5, 0xc0, 0x57, 0x60,
+	0x3f, 0xee, 0xc0, 0x19, 0xa5, 0x7a, 0x9a, 0x35, 0x06, 0xe3, 0x30, 0x40, 0x59, 0xfa, 0xb7, 0xbf,
+	0xd0, 0xf1, 0x92, 0xc8, 0x79, 0x7f, 0xea, 0x3a, 0xbd, 0x0b, 0x4f, 0x7e, 0x9e, 0xf9, 0x3d, 0x9c,
+	0x2e, 0x38, 0xf3, 0xec, 0x11, 0x95, 0xd7, 0x8c, 0xbf, 0xec, 0x53, 0x8d, 0x9e, 0x38, 0xac, 0x6f,
+	0x13, 0x49, 0x7a, 0x03, 0xd7, 0xb9, 0x50, 0x33, 0x4b, 0x48, 0xca, 0x71, 0x36, 0x62, 0xe7, 0x7b,
+	0x50, 0xd7, 0x73, 0xe8, 0x84, 0x48, 0x02, 0x3f, 0x00, 0x7b, 0xf9, 0x1b, 0xbc, 0x1f, 0x06, 0x28,
+	0xa6, 0x70, 0x6c, 0xe4, 0x8e, 0xa1, 0x9c, 0x0e, 0xc8, 0xed, 0x63, 0xe8, 0xfc, 0x08, 0x9a, 0x13,
+	0xb2, 0x70, 0x6d, 0x22, 0x59, 0x94, 0xe1, 0x29, 0x00, 0x27, 0x74, 0x41, 0x1d, 0x45, 0xa8, 0x24,
+	0x95, 0xee, 0xfe, 0x47, 0xad, 0xe8, 0xef, 0xd5, 0x4b, 0xea, 0x88, 0x86, 0x54, 0xfa, 0x1e, 0xce,
+	0xd8, 0x6f, 0x91, 0x9c, 0x80, 0x9a, 0x0a, 0xa9, 0xf3, 0x46, 0x5e, 0x0a, 0x46, 0x5b, 0xdb, 0x78,
+	0xc5, 0x3a, 0x4e, 0x54, 0x75, 0x73, 0x94, 0x31, 0x21, 0x0b, 0x9f, 0xea, 0x04, 0xf5, 0xe8, 0xe6,
+	0x24, 0x24, 0x4e, 0xcd, 0xce, 0x97, 0xa0, 0x19, 0x37, 0x53, 0xa8, 0x2f, 0x5a, 0x8d, 0xdc, 0x64,
+	0x46, 0x47, 0xfb, 0xdb, 0x8c, 0xdc, 0x94, 0xc5, 0x19, 0x7b, 0x30, 0xba, 0xb9, 0xb3, 0x4a, 0xb7,
+	0x77, 0x56, 0xe9, 0xcd, 0x9d, 0x65, 0xfc, 0xb4, 0xb6, 0x8c, 0x5f, 0xd7, 0x96, 0xf1, 0xfb, 0xda,
+	0x32, 0x6e, 0xd6, 0x96, 0x71, 0xbb, 0xb6, 0x8c, 0xbf, 0xd7, 0x96, 0xf1, 0xef, 0xda, 0x2a, 0xbd,
+	0x59, 0x5b, 0xc6, 0xcf, 0xf7, 0x56, 0xe9, 0xe6, 0xde, 0x2a, 0xdd, 0xde, 0x5b, 0xa5, 0xef, 0x1e,
+	0x89, 0xd7, 0x42, 0xd2, 0xe5, 0xe5, 0x92, 0x70, 0x39, 0x64, 0x9e, 0xe4, 0x64, 0x26, 0xc5, 0x74,
+	0x57, 0xb7, 0xf2, 0xe3, 0xff, 0x06, 0x00, 0x3e, 0xda, 0x25, 0x64, 0x52, 0x08, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -598,6 +693,29 @@ func (this *GeneralProposal) Equal(that interface{}) bool {
 	if this.Closed != that1.Closed {
 		return false
 	}
+	if !bytes.Equal(this.ActionType, that1.ActionType) {
+		return false
+	}
+	if len(this.ActionArguments) != len(that1.ActionArguments) {
+		return false
+	}
+	for i := range this.ActionArguments {
+		if !bytes.Equal(this.ActionArguments[i], that1.ActionArguments[i]) {
+			return false
+		}
+	}
+	if this.ExecutionEpoch != that1.ExecutionEpoch {
+		return false
+	}
+	if this.Executed != that1.Executed {
+		return false
+	}
+	if this.ExecutionFailed != that1.ExecutionFailed {
+		return false
+	}
+	if !bytes.Equal(this.ExecutionError, that1.ExecutionError) {
+		return false
+	}
 	return true
 }
 func (this *WhiteListProposal) Equal(that interface{}) bool {
@@ -782,11 +900,40 @@ func (this *VoteData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ProposalsList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposalsList)
+	if !ok {
+		that2, ok := that.(ProposalsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.References) != len(that1.References) {
+		return false
+	}
+	for i := range this.References {
+		if !bytes.Equal(this.References[i], that1.References[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GeneralProposal) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 16)
+	s := make([]string, 0, 22)
 	s = append(s, "&systemSmartContracts.GeneralProposal{")
 	s = append(s, "IssuerAddress: "+fmt.Sprintf("%#v", this.IssuerAddress)+",\n")
 	s = append(s, "GitHubCommit: "+fmt.Sprintf("%#v", this.GitHubCommit)+",\n")
@@ -800,6 +947,12 @@ func (this *GeneralProposal) GoString() string {
 	s = append(s, "Voters: "+fmt.Sprintf("%#v", this.Voters)+",\n")
 	s = append(s, "TopReference: "+fmt.Sprintf("%#v", this.TopReference)+",\n")
 	s = append(s, "Closed: "+fmt.Sprintf("%#v", this.Closed)+",\n")
+	s = append(s, "ActionType: "+fmt.Sprintf("%#v", this.ActionType)+",\n")
+	s = append(s, "ActionArguments: "+fmt.Sprintf("%#v", this.ActionArguments)+",\n")
+	s = append(s, "ExecutionEpoch: "+fmt.Sprintf("%#v", this.ExecutionEpoch)+",\n")
+	s = append(s, "Executed: "+fmt.Sprintf("%#v", this.Executed)+",\n")
+	s = append(s, "ExecutionFailed: "+fmt.Sprintf("%#v", this.ExecutionFailed)+",\n")
+	s = append(s, "ExecutionError: "+fmt.Sprintf("%#v", this.ExecutionError)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -875,6 +1028,16 @@ func (this *VoteData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ProposalsList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ProposalsList{")
+	s = append(s, "References: "+fmt.Sprintf("%#v", this.References)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGovernance(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -903,6 +1066,60 @@ func (m *GeneralProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionError) > 0 {
+		i -= len(m.ExecutionError)
+		copy(dAtA[i:], m.ExecutionError)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.ExecutionError)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ExecutionFailed {
+		i--
+		if m.ExecutionFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ExecutionEpoch != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.ExecutionEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ActionArguments) > 0 {
+		for iNdEx := len(m.ActionArguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActionArguments[iNdEx])
+			copy(dAtA[i:], m.ActionArguments[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.ActionArguments[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ActionType) > 0 {
+		i -= len(m.ActionType)
+		copy(dAtA[i:], m.ActionType)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.ActionType)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.Closed {
 		i--
 		if m.Closed {
@@ -1231,6 +1448,38 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.References[iNdEx])
+			copy(dAtA[i:], m.References[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.References[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1290,6 +1539,29 @@ func (m *GeneralProposal) Size() (n int) {
 	if m.Closed {
 		n += 2
 	}
+	l = len(m.ActionType)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if len(m.ActionArguments) > 0 {
+		for _, b := range m.ActionArguments {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	if m.ExecutionEpoch != 0 {
+		n += 1 + sovGovernance(uint64(m.ExecutionEpoch))
+	}
+	if m.Executed {
+		n += 3
+	}
+	if m.ExecutionFailed {
+		n += 3
+	}
+	l = len(m.ExecutionError)
+	if l > 0 {
+		n += 2 + l + sovGovernance(uint64(l))
+	}
 	return n
 }
 
@@ -1406,6 +1678,21 @@ func (m *VoteData) Size() (n int) {
 	return n
 }
 
+func (m *ProposalsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.References) > 0 {
+		for _, b := range m.References {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGovernance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1429,6 +1716,12 @@ func (this *GeneralProposal) String() string {
 		`Voters:` + fmt.Sprintf("%v", this.Voters) + `,`,
 		`TopReference:` + fmt.Sprintf("%v", this.TopReference) + `,`,
 		`Closed:` + fmt.Sprintf("%v", this.Closed) + `,`,
+		`ActionType:` + fmt.Sprintf("%v", this.ActionType) + `,`,
+		`ActionArguments:` + fmt.Sprintf("%v", this.ActionArguments) + `,`,
+		`ExecutionEpoch:` + fmt.Sprintf("%v", this.ExecutionEpoch) + `,`,
+		`Executed:` + fmt.Sprintf("%v", this.Executed) + `,`,
+		`ExecutionFailed:` + fmt.Sprintf("%v", this.ExecutionFailed) + `,`,
+		`ExecutionError:` + fmt.Sprintf("%v", this.ExecutionError) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1508,6 +1801,16 @@ func (this *VoteData) String() string {
 	}, "")
 	return s
 }
+func (this *ProposalsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProposalsList{`,
+		`References:` + fmt.Sprintf("%v", this.References) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGovernance(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1833,6 +2136,165 @@ func (m *GeneralProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Closed = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionType = append(m.ActionType[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActionType == nil {
+				m.ActionType = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionArguments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionArguments = append(m.ActionArguments, make([]byte, postIndex-iNdEx))
+			copy(m.ActionArguments[len(m.ActionArguments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionEpoch", wireType)
+			}
+			m.ExecutionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutionFailed = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionError", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionError = append(m.ExecutionError[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionError == nil {
+				m.ExecutionError = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovernance(dAtA[iNdEx:])
@@ -2601,6 +3063,91 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, make([]byte, postIndex-iNdEx))
+			copy(m.References[len(m.References)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
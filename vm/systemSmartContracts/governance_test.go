@@ -35,6 +35,7 @@ func createMockGovernanceArgs() ArgsNewGovernanceContract {
 		GovernanceSCAddress: []byte("governanceSC"),
 		StakingSCAddress:    []byte("stakingSC"),
 		ValidatorSCAddress:  nil,
+		EndOfEpochAddress:   vm.EndOfEpochAddress,
 		EpochNotifier:       &mock.EpochNotifierStub{},
 	}
 }
@@ -152,6 +153,9 @@ func TestGovernanceContract_ExecuteChangeConfigShouldWork(t *testing.T) {
 			return configBytes
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			newConfig := &GovernanceConfig{}
 			_ = json.Unmarshal(value, newConfig)
 			require.Equal(t, numNodes, newConfig.NumNodes)
@@ -214,6 +218,9 @@ func TestGovernanceContract_ExecuteWhiteListProposalAtGenesisShouldWork(t *testi
 			}
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			if !strings.Contains(string(key), whiteListPrefix) {
 				genProposal := &GeneralProposal{}
 				_ = json.Unmarshal(value, genProposal)
@@ -254,6 +261,9 @@ func TestGovernanceContract_ExecuteWhiteListProposalShouldWork(t *testing.T) {
 			}
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			if strings.Contains(string(key), proposalPrefix) {
 				genProposal := &GeneralProposal{}
 				_ = json.Unmarshal(value, genProposal)
@@ -302,6 +312,9 @@ func TestGovernanceContract_ExecuteWhiteListProposalShouldNOTWorkDisabled(t *tes
 			}
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			if strings.Contains(string(key), proposalPrefix) {
 				genProposal := &GeneralProposal{}
 				_ = json.Unmarshal(value, genProposal)
@@ -356,6 +369,9 @@ func TestGovernanceContract_ExecuteHardfork(t *testing.T) {
 			return generalProposalBytes
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			if bytes.Equal(key, append([]byte(hardForkPrefix), gitHubCommit...)) {
 				hardForkProposal := &HardForkProposal{}
 				_ = json.Unmarshal(value, hardForkProposal)
@@ -412,6 +428,9 @@ func TestGovernanceContract_ExecuteProposal(t *testing.T) {
 			return generalProposalBytes
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), GovernanceProposalsListKey) {
+				return
+			}
 			genProposal := &GeneralProposal{}
 			_ = json.Unmarshal(value, genProposal)
 			require.Equal(t, gitHubCommit, genProposal.GitHubCommit)
@@ -640,6 +659,295 @@ func whiteListAddrAtGenesis(t *testing.T, g *governanceContract, genesisWLAddr,
 	require.Equal(t, vmcommon.Ok, retCode)
 }
 
+func TestGovernanceContract_ProposeActionInvalidActionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	recipientAddr := []byte("recipientAddress")
+	wlAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, wlAddr, recipientAddr)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	proposeAction := func(actionType string, actionArgs ...string) vmcommon.ReturnCode {
+		callInput := createVMInput(big.NewInt(100), "proposeAction", wlAddr, recipientAddr)
+		callInput.Arguments = [][]byte{gitHubCommit, []byte("10"), []byte("20"), []byte("2"), []byte(actionType)}
+		for _, arg := range actionArgs {
+			callInput.Arguments = append(callInput.Arguments, []byte(arg))
+		}
+		return gsc.Execute(callInput)
+	}
+
+	require.Equal(t, vmcommon.UserError, proposeAction("unknownAction", "arg"))
+	require.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidGovernanceAction.Error()))
+
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionChangeGasSchedule, "BuiltInCost", "ESDTTransfer"))
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionChangeGasSchedule, "BuiltInCost", "ESDTTransfer", "-1"))
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionChangeEconomics, "LeaderPercentage", "1"))
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionHardFork, "epoch", "v1.1.0"))
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionWhiteList, "short"))
+
+	callInput := createVMInput(big.NewInt(100), "proposeAction", []byte("notWhiteList"), recipientAddr)
+	callInput.Arguments = [][]byte{gitHubCommit, []byte("10"), []byte("20"), []byte("2"), []byte(GovernanceActionChangeEconomics), []byte("MinGasPrice"), []byte("10")}
+	require.Equal(t, vmcommon.UserError, gsc.Execute(callInput))
+
+	blockChainHook.CurrentEpochCalled = func() uint32 {
+		return 3
+	}
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionChangeEconomics, "MinGasPrice", "10"))
+	require.True(t, strings.Contains(eei.returnMessage, "execution epoch is in the past"))
+
+	blockChainHook.CurrentEpochCalled = func() uint32 {
+		return 2
+	}
+	require.Equal(t, vmcommon.Ok, proposeAction(GovernanceActionChangeEconomics, "MinGasPrice", "10"))
+	require.Equal(t, vmcommon.UserError, proposeAction(GovernanceActionChangeEconomics, "MinGasPrice", "10"))
+}
+
+func TestGovernanceContract_ExecuteProposalsWhiteListAction(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	validatorAddress := []byte("vala1")
+	blsKey := []byte("blsKey1")
+	validatorDataBytes, _ := json.Marshal(&ValidatorDataV2{NumRegistered: 1, BlsPubKeys: [][]byte{blsKey}})
+	eei.SetStorageForAddress(args.ValidatorSCAddress, validatorAddress, validatorDataBytes)
+	stakedDataBytes, _ := json.Marshal(&StakedDataV2_0{Staked: true})
+	eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+
+	args.GovernanceConfig.MinQuorum = 1
+	args.GovernanceConfig.MinPassThreshold = 0
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	recipientAddr := []byte("recipientAddress")
+	genesisWLAddr := []byte("genesisAddr")
+	newWLAddr := []byte("newWLAddres")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+
+	startNonce := uint64(10)
+	stopNonce := uint64(20)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	callInput := createVMInput(big.NewInt(100), "proposeAction", genesisWLAddr, recipientAddr)
+	callInput.Arguments = [][]byte{
+		gitHubCommit,
+		[]byte(fmt.Sprintf("%d", startNonce)),
+		[]byte(fmt.Sprintf("%d", stopNonce)),
+		[]byte("2"),
+		[]byte(GovernanceActionWhiteList),
+		newWLAddr,
+	}
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, recipientAddr, "yes")
+
+	executeInput := createVMInput(big.NewInt(0), "executeProposals", vm.EndOfEpochAddress, recipientAddr)
+
+	// not closed yet
+	currentEpoch = 2
+	numOutputs := len(eei.output)
+	require.Equal(t, vmcommon.Ok, gsc.Execute(executeInput))
+	require.Equal(t, numOutputs, len(eei.output))
+	require.False(t, gsc.isWhiteListed(newWLAddr))
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, genesisWLAddr, gitHubCommit, recipientAddr)
+
+	pendingList, err := gsc.getProposalsList(GovernancePendingProposalsKey)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{gitHubCommit}, pendingList.References)
+
+	// execution epoch not reached
+	currentEpoch = 1
+	require.Equal(t, vmcommon.Ok, gsc.Execute(executeInput))
+	require.Equal(t, numOutputs, len(eei.output))
+
+	executeInput.CallerAddr = genesisWLAddr
+	require.Equal(t, vmcommon.UserError, gsc.Execute(executeInput))
+
+	currentEpoch = 2
+	executeInput.CallerAddr = vm.EndOfEpochAddress
+	numOutputs = len(eei.output)
+	require.Equal(t, vmcommon.Ok, gsc.Execute(executeInput))
+	require.Equal(t, numOutputs+1, len(eei.output))
+
+	executedProposal := &GeneralProposal{}
+	_ = json.Unmarshal(eei.output[numOutputs], executedProposal)
+	require.Equal(t, gitHubCommit, executedProposal.GitHubCommit)
+	require.True(t, executedProposal.Executed)
+	require.False(t, executedProposal.ExecutionFailed)
+	require.True(t, gsc.isWhiteListed(newWLAddr))
+
+	pendingList, err = gsc.getProposalsList(GovernancePendingProposalsKey)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(pendingList.References))
+
+	// executed only once
+	currentEpoch = 3
+	numOutputs = len(eei.output)
+	require.Equal(t, vmcommon.Ok, gsc.Execute(executeInput))
+	require.Equal(t, numOutputs, len(eei.output))
+
+	proposalsList, err := gsc.getProposalsList(string(GovernanceProposalsListPageKey(0)))
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{genesisWLAddr, gitHubCommit}, proposalsList.References)
+}
+
+func TestGovernanceContract_ProposalsListBeforeEnableEpochShouldNotBeSaved(t *testing.T) {
+	t.Parallel()
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	args.GovernanceConfig.ProposalsListEnableEpoch = 1
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	err := gsc.addToProposalsList([]byte("reference"))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(eei.GetStorage([]byte(GovernanceProposalsListKey))))
+	require.Equal(t, 0, len(eei.GetStorage(GovernanceProposalsListPageKey(0))))
+
+	gsc.EpochConfirmed(1)
+	err = gsc.addToProposalsList([]byte("reference"))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1).Bytes(), eei.GetStorage([]byte(GovernanceProposalsListKey)))
+}
+
+func TestGovernanceContract_ProposalsListShouldBePaged(t *testing.T) {
+	t.Parallel()
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	numProposals := GovernanceProposalsListPageSize + 1
+	for i := 0; i < numProposals; i++ {
+		err := gsc.addToProposalsList([]byte(fmt.Sprintf("reference%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Equal(t, big.NewInt(int64(numProposals)).Bytes(), eei.GetStorage([]byte(GovernanceProposalsListKey)))
+	firstPage, _ := gsc.getProposalsList(string(GovernanceProposalsListPageKey(0)))
+	require.Equal(t, GovernanceProposalsListPageSize, len(firstPage.References))
+	require.Equal(t, []byte("reference0"), firstPage.References[0])
+	secondPage, _ := gsc.getProposalsList(string(GovernanceProposalsListPageKey(1)))
+	require.Equal(t, [][]byte{[]byte(fmt.Sprintf("reference%d", GovernanceProposalsListPageSize))}, secondPage.References)
+}
+
+func TestGovernanceContract_ProposalsListShouldChargeStorageGas(t *testing.T) {
+	t.Parallel()
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	args.GasCost.BaseOperationCost.StorePerByte = 10
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	reference := []byte("reference")
+	eei.SetGasProvided(uint64(len(reference))*10 - 1)
+	err := gsc.addToProposalsList(reference)
+	require.Equal(t, vm.ErrNotEnoughGas, err)
+	require.Equal(t, 0, len(eei.GetStorage([]byte(GovernanceProposalsListKey))))
+
+	eei.SetGasProvided(uint64(len(reference)) * 10)
+	err = gsc.addToProposalsList(reference)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), eei.GasLeft())
+}
+
+func TestGovernanceContract_SetExecutionFailed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	reference := []byte("0123456789012345678901234567890123456789")
+	_ = gsc.saveGeneralProposal(reference, &GeneralProposal{
+		GitHubCommit: reference,
+		ActionType:   []byte(GovernanceActionChangeEconomics),
+		Closed:       true,
+		Voted:        true,
+	})
+
+	recipientAddr := []byte("recipientAddress")
+	callInput := createVMInput(big.NewInt(0), "setExecutionFailed", []byte("notEndOfEpoch"), recipientAddr)
+	callInput.Arguments = [][]byte{reference, []byte("error")}
+	require.Equal(t, vmcommon.UserError, gsc.Execute(callInput))
+
+	callInput.CallerAddr = vm.EndOfEpochAddress
+	callInput.Arguments = [][]byte{reference}
+	require.Equal(t, vmcommon.FunctionWrongSignature, gsc.Execute(callInput))
+
+	callInput.Arguments = [][]byte{reference, []byte("error")}
+	require.Equal(t, vmcommon.UserError, gsc.Execute(callInput))
+	require.True(t, strings.Contains(eei.returnMessage, "proposal was not executed"))
+
+	generalProposal, _ := gsc.getGeneralProposal(reference)
+	generalProposal.Executed = true
+	_ = gsc.saveGeneralProposal(reference, generalProposal)
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+
+	generalProposal, _ = gsc.getGeneralProposal(reference)
+	require.True(t, generalProposal.ExecutionFailed)
+	require.Equal(t, []byte("error"), generalProposal.ExecutionError)
+}
+
 func voteProposal(t *testing.T, g *governanceContract, validatorAddr, propAddr, recipientAddr []byte, vote string) {
 	callInput := createVMInput(big.NewInt(0), "vote", validatorAddr, recipientAddr)
 	callInput.Arguments = [][]byte{
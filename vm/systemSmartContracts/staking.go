@@ -26,6 +26,8 @@ const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
 const waitingListHeadKey = "waitingList"
 const waitingElementPrefix = "w_"
+const slashedOffensePrefix = "slashed_"
+const slashedNodesToJailKey = "slashedNodesToJail"
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -48,6 +50,9 @@ type stakingSC struct {
 	walletAddressLen         int
 	mutExecution             sync.RWMutex
 	minNodePrice             *big.Int
+	proofVerifier            vm.DoubleSigningProofVerifier
+	slashingEnableEpoch      uint32
+	flagSlashing             atomic.Flag
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -61,6 +66,7 @@ type ArgsNewStakingSmartContract struct {
 	GasCost              vm.GasCost
 	Marshalizer          marshal.Marshalizer
 	EpochNotifier        vm.EpochNotifier
+	ProofVerifier        vm.DoubleSigningProofVerifier
 }
 
 type waitingListReturnData struct {
@@ -104,6 +110,9 @@ func NewStakingSmartContract(
 	if check.IfNil(args.EpochNotifier) {
 		return nil, vm.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, vm.ErrNilDoubleSigningProofVerifier
+	}
 
 	minStakeValue, okValue := big.NewInt(0).SetString(args.StakingSCConfig.MinStakeValue, conversionBase)
 	if !okValue || minStakeValue.Cmp(zero) <= 0 {
@@ -127,6 +136,8 @@ func NewStakingSmartContract(
 		stakingV2Epoch:           args.StakingSCConfig.StakingV2Epoch,
 		walletAddressLen:         len(args.StakingAccessAddr),
 		minNodePrice:             minStakeValue,
+		proofVerifier:            args.ProofVerifier,
+		slashingEnableEpoch:      args.StakingSCConfig.DoubleSigningSlashingEnableEpoch,
 	}
 
 	conversionOk := true
@@ -175,6 +186,10 @@ func (s *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return s.changeValidatorKey(args)
 	case "switchJailedWithWaiting":
 		return s.switchJailedWithWaiting(args)
+	case "getSlashedNodesToJail":
+		return s.getSlashedNodesToJail(args)
+	case "switchSlashedWithWaiting":
+		return s.switchSlashedWithWaiting(args)
 	case "getQueueIndex":
 		return s.getWaitingListIndex(args)
 	case "getQueueSize":
@@ -774,10 +789,242 @@ func (s *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	return vmcommon.Ok
 }
 
-// backward compatibility
-func (s *stakingSC) slash(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	s.eei.AddReturnMessage("slash function called by not the owners address")
-	return vmcommon.UserError
+// slash jails the key proven to have signed two different headers in the same round and shard and asks the validator
+// smart contract to slash the stake of its owner. Anyone can send the proof, but each offense is punished only once.
+// The key is kept in the slashed nodes to jail, so it is removed from the nodes lists at the next epoch start
+func (s *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagSlashing.IsSet() {
+		// backward compatibility
+		s.eei.AddReturnMessage("slash function called by not the owners address")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.Slash)
+	if err != nil {
+		s.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	proof, err := s.proofVerifier.VerifyProof(args.Arguments[0])
+	if err != nil {
+		s.eei.AddReturnMessage("invalid double signing proof: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	offenseKey := s.createSlashedOffenseKey(proof.PubKey, proof.ShardID, proof.Round)
+	if len(s.eei.GetStorage(offenseKey)) > 0 {
+		s.eei.AddReturnMessage("offense was already slashed")
+		return vmcommon.UserError
+	}
+
+	stakedData, err := s.getOrCreateRegisteredData(proof.PubKey)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(stakedData.RewardAddress) == 0 {
+		s.eei.AddReturnMessage("cannot slash a key that is not registered")
+		return vmcommon.UserError
+	}
+
+	ownerAddress := stakedData.OwnerAddress
+	if len(ownerAddress) == 0 {
+		ownerAddress = stakedData.RewardAddress
+	}
+	vmOutput, err := s.eei.ExecuteOnDestContext(
+		s.stakeAccessAddr,
+		args.RecipientAddr,
+		big.NewInt(0),
+		[]byte("slash@"+hex.EncodeToString(ownerAddress)+"@"+hex.EncodeToString(proof.PubKey)),
+	)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot slash the stake of the owner: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	slashedValue := big.NewInt(0)
+	if len(vmOutput.ReturnData) > 0 {
+		slashedValue.SetBytes(vmOutput.ReturnData[len(vmOutput.ReturnData)-1])
+	}
+
+	stakedData.SlashValue.Add(stakedData.SlashValue, slashedValue)
+	stakedData.JailedRound = s.eei.BlockChainHook().CurrentRound()
+	stakedData.JailedNonce = s.eei.BlockChainHook().CurrentNonce()
+	stakedData.Jailed = true
+	stakedData.NumJailed++
+	err = s.saveStakingData(proof.PubKey, stakedData)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = s.addToSlashedNodesToJail(proof.PubKey)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot add to the slashed nodes to jail: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.SetStorage(offenseKey, []byte{1})
+	s.eei.Finish(slashedValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (s *stakingSC) getSlashedNodesList() (*SlashedNodesList, error) {
+	slashedNodes := &SlashedNodesList{
+		BLSKeys: make([][]byte, 0),
+	}
+	marshaledData := s.eei.GetStorage([]byte(slashedNodesToJailKey))
+	if len(marshaledData) == 0 {
+		return slashedNodes, nil
+	}
+
+	err := s.marshalizer.Unmarshal(slashedNodes, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashedNodes, nil
+}
+
+func (s *stakingSC) saveSlashedNodesList(slashedNodes *SlashedNodesList) error {
+	if len(slashedNodes.BLSKeys) == 0 {
+		s.eei.SetStorage([]byte(slashedNodesToJailKey), nil)
+		return nil
+	}
+
+	marshaledData, err := s.marshalizer.Marshal(slashedNodes)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage([]byte(slashedNodesToJailKey), marshaledData)
+	return nil
+}
+
+func (s *stakingSC) addToSlashedNodesToJail(blsKey []byte) error {
+	slashedNodes, err := s.getSlashedNodesList()
+	if err != nil {
+		return err
+	}
+
+	for _, slashedKey := range slashedNodes.BLSKeys {
+		if bytes.Equal(slashedKey, blsKey) {
+			return nil
+		}
+	}
+
+	slashedNodes.BLSKeys = append(slashedNodes.BLSKeys, blsKey)
+	return s.saveSlashedNodesList(slashedNodes)
+}
+
+func (s *stakingSC) removeFromSlashedNodesToJail(blsKey []byte) (bool, error) {
+	slashedNodes, err := s.getSlashedNodesList()
+	if err != nil {
+		return false, err
+	}
+
+	for index, slashedKey := range slashedNodes.BLSKeys {
+		if bytes.Equal(slashedKey, blsKey) {
+			slashedNodes.BLSKeys = append(slashedNodes.BLSKeys[:index], slashedNodes.BLSKeys[index+1:]...)
+			return true, s.saveSlashedNodesList(slashedNodes)
+		}
+	}
+
+	return false, nil
+}
+
+// getSlashedNodesToJail returns the keys slashed since the last epoch start, which still have to leave the nodes lists
+func (s *stakingSC) getSlashedNodesToJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagSlashing.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.endOfEpochAccessAddr) {
+		s.eei.AddReturnMessage("getSlashedNodesToJail function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+
+	slashedNodes, err := s.getSlashedNodesList()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range slashedNodes.BLSKeys {
+		s.eei.Finish(blsKey)
+	}
+
+	return vmcommon.Ok
+}
+
+// switchSlashedWithWaiting unStakes a slashed key, replacing it with the first node from the waiting list, if any.
+// Unlike the jailing for bad rating, the key is removed from the staked nodes even if nobody can take its place
+func (s *stakingSC) switchSlashedWithWaiting(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagSlashing.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.endOfEpochAccessAddr) {
+		s.eei.AddReturnMessage("switchSlashedWithWaiting function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage("number of arguments must be equal to 1")
+		return vmcommon.UserError
+	}
+
+	removed, err := s.removeFromSlashedNodesToJail(args.Arguments[0])
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !removed {
+		s.eei.AddReturnMessage("key is not in the slashed nodes to jail")
+		return vmcommon.UserError
+	}
+
+	registrationData, err := s.getOrCreateRegisteredData(args.Arguments[0])
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !registrationData.Staked {
+		s.eei.AddReturnMessage("no need to switch as not a validator")
+		return vmcommon.Ok
+	}
+
+	switched, err := s.moveFirstFromWaitingToStakedIfNeeded(args.Arguments[0])
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !switched {
+		s.eei.AddReturnMessage("did not switch as nobody in waiting, but unStaked")
+	}
+
+	s.unStakeSwitchedNode(registrationData)
+	err = s.saveStakingData(args.Arguments[0], registrationData)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (s *stakingSC) createSlashedOffenseKey(blsKey []byte, shardID uint32, round uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s_%d_%d", slashedOffensePrefix, blsKey, shardID, round))
 }
 
 func (s *stakingSC) isStaked(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
@@ -1108,11 +1355,7 @@ func (s *stakingSC) switchJailedWithWaiting(args *vmcommon.ContractCallInput) vm
 	if !switched {
 		s.eei.AddReturnMessage("did not switch as nobody in waiting, but jailed")
 	} else {
-		s.removeFromStakedNodes()
-		registrationData.Staked = false
-		registrationData.UnStakedEpoch = s.eei.BlockChainHook().CurrentEpoch()
-		registrationData.UnStakedNonce = s.eei.BlockChainHook().CurrentNonce()
-		registrationData.StakedNonce = math.MaxUint64
+		s.unStakeSwitchedNode(registrationData)
 	}
 
 	err = s.saveStakingData(args.Arguments[0], registrationData)
@@ -1124,6 +1367,14 @@ func (s *stakingSC) switchJailedWithWaiting(args *vmcommon.ContractCallInput) vm
 	return vmcommon.Ok
 }
 
+func (s *stakingSC) unStakeSwitchedNode(registrationData *StakedDataV2_0) {
+	s.removeFromStakedNodes()
+	registrationData.Staked = false
+	registrationData.UnStakedEpoch = s.eei.BlockChainHook().CurrentEpoch()
+	registrationData.UnStakedNonce = s.eei.BlockChainHook().CurrentNonce()
+	registrationData.StakedNonce = math.MaxUint64
+}
+
 func (s *stakingSC) updateConfigMinNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, s.endOfEpochAccessAddr) {
 		s.eei.AddReturnMessage("updateConfigMinNodes function not allowed to be called by address " + string(args.CallerAddr))
@@ -1644,6 +1895,9 @@ func (s *stakingSC) EpochConfirmed(epoch uint32) {
 
 	s.flagStakingV2.Toggle(epoch >= s.stakingV2Epoch)
 	log.Debug("stakingSC: set owner", "enabled", s.flagStakingV2.IsSet())
+
+	s.flagSlashing.Toggle(epoch >= s.slashingEnableEpoch)
+	log.Debug("stakingSC: double signing slashing", "enabled", s.flagSlashing.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
@@ -630,6 +630,45 @@ func (m *WaitingList) GetLastJailedKey() []byte {
 	return nil
 }
 
+type SlashedNodesList struct {
+	BLSKeys [][]byte `protobuf:"bytes,1,rep,name=BLSKeys,proto3" json:"BLSKeys"`
+}
+
+func (m *SlashedNodesList) Reset()      { *m = SlashedNodesList{} }
+func (*SlashedNodesList) ProtoMessage() {}
+func (*SlashedNodesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+func (m *SlashedNodesList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedNodesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SlashedNodesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedNodesList.Merge(m, src)
+}
+func (m *SlashedNodesList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedNodesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedNodesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedNodesList proto.InternalMessageInfo
+
+func (m *SlashedNodesList) GetBLSKeys() [][]byte {
+	if m != nil {
+		return m.BLSKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StakedDataV1_0)(nil), "proto.StakedDataV1_0")
 	proto.RegisterType((*StakedDataV1_1)(nil), "proto.StakedDataV1_1")
@@ -637,60 +676,63 @@ func init() {
 	proto.RegisterType((*StakingNodesConfig)(nil), "proto.StakingNodesConfig")
 	proto.RegisterType((*ElementInList)(nil), "proto.ElementInList")
 	proto.RegisterType((*WaitingList)(nil), "proto.WaitingList")
+	proto.RegisterType((*SlashedNodesList)(nil), "proto.SlashedNodesList")
 }
 
 func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
 
 var fileDescriptor_289e7c8aea278311 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xce, 0x25, 0x69, 0xda, 0x5e, 0x92, 0xf6, 0xfb, 0x2c, 0x06, 0x8b, 0xc1, 0x8e, 0x22, 0x21,
-	0x45, 0x42, 0x4d, 0x28, 0x20, 0x31, 0x30, 0x35, 0xa5, 0x48, 0x85, 0x10, 0xaa, 0x8b, 0x28, 0x12,
-	0x0b, 0xba, 0xc4, 0x57, 0xc7, 0x6a, 0x72, 0x57, 0xd9, 0x67, 0xda, 0x6e, 0x88, 0x5f, 0xc0, 0x3f,
-	0x60, 0x45, 0xfd, 0x0b, 0xfc, 0x01, 0x06, 0x86, 0x8e, 0x9d, 0x4c, 0xeb, 0x2e, 0xc8, 0x53, 0x7f,
-	0x02, 0xba, 0x3b, 0x27, 0x3e, 0x77, 0x44, 0x0c, 0x0c, 0x99, 0xee, 0x9e, 0xe7, 0xee, 0x79, 0xef,
-	0xed, 0xfb, 0xbc, 0xaf, 0xd5, 0xc0, 0x7a, 0xc0, 0xf1, 0xa1, 0x47, 0xdd, 0xf6, 0x91, 0xcf, 0x38,
-	0x33, 0x96, 0xe4, 0x72, 0x77, 0xc3, 0xf5, 0xf8, 0x38, 0x1c, 0xb6, 0x47, 0x6c, 0xda, 0x71, 0x99,
-	0xcb, 0x3a, 0x92, 0x1e, 0x86, 0x07, 0x12, 0x49, 0x20, 0x77, 0x4a, 0xd5, 0x3c, 0x5b, 0x82, 0x6b,
-	0x03, 0x8e, 0x0f, 0x89, 0xf3, 0x0c, 0x73, 0xbc, 0xbf, 0xf9, 0xfe, 0x81, 0xf1, 0x04, 0xd6, 0x11,
-	0x71, 0xbd, 0x80, 0x13, 0xbf, 0xcf, 0xe8, 0x88, 0x98, 0xa0, 0x01, 0x5a, 0xe5, 0xee, 0xff, 0x49,
-	0x64, 0xe7, 0x0f, 0x50, 0x1e, 0x1a, 0x9b, 0xb0, 0xaa, 0x42, 0x29, 0x59, 0x51, 0xca, 0xd6, 0x93,
-	0xc8, 0xd6, 0x69, 0xa4, 0x03, 0xa3, 0x09, 0x2b, 0x0a, 0x9a, 0xa5, 0x06, 0x68, 0xad, 0x74, 0x61,
-	0x12, 0xd9, 0x29, 0x83, 0xd2, 0x55, 0xe4, 0xf3, 0x86, 0xea, 0x81, 0xcb, 0x59, 0x3e, 0xb9, 0x03,
-	0x94, 0x87, 0xba, 0x70, 0xe7, 0x88, 0x8d, 0xc6, 0xe6, 0x52, 0x03, 0xb4, 0xea, 0x79, 0xa1, 0x3c,
-	0x40, 0x79, 0xa8, 0x2a, 0x70, 0x8c, 0x7d, 0x67, 0xcb, 0x71, 0x7c, 0x12, 0x04, 0x66, 0xa5, 0x01,
-	0x5a, 0xb5, 0x59, 0x05, 0xb4, 0x03, 0x94, 0x87, 0x46, 0x00, 0xa1, 0x8c, 0xb3, 0x8f, 0x27, 0x21,
-	0x31, 0x97, 0xa5, 0x6a, 0x90, 0x44, 0xb6, 0xc6, 0x9e, 0xfd, 0xb4, 0xb7, 0xa6, 0x98, 0x8f, 0x3b,
-	0x43, 0xcf, 0x6d, 0xef, 0x52, 0xfe, 0x54, 0xf3, 0x6b, 0x67, 0xe2, 0x33, 0xea, 0xf4, 0x09, 0x3f,
-	0x66, 0xfe, 0x61, 0x87, 0x48, 0xb4, 0xe1, 0xb2, 0x8e, 0x83, 0x39, 0x6e, 0x77, 0x3d, 0x77, 0x97,
-	0xf2, 0x6d, 0x2c, 0xea, 0x8d, 0xb4, 0x80, 0xa2, 0xec, 0x2f, 0xb0, 0x37, 0x21, 0x0e, 0x62, 0x21,
-	0x75, 0xcc, 0x95, 0xac, 0xec, 0x1a, 0x8d, 0x74, 0x90, 0x49, 0x54, 0x41, 0x57, 0x6f, 0x4b, 0x52,
-	0xa7, 0x34, 0xa0, 0x8a, 0xa9, 0x8b, 0xa0, 0xee, 0x82, 0x2e, 0xcb, 0x43, 0x61, 0xb1, 0x82, 0x66,
-	0x35, 0xb3, 0x38, 0x4d, 0x26, 0x5d, 0x8d, 0x7b, 0x70, 0xf9, 0x2d, 0xf6, 0xb8, 0x47, 0x5d, 0xb3,
-	0x26, 0x2f, 0x55, 0x93, 0xc8, 0x9e, 0x51, 0x68, 0xb6, 0x69, 0xfe, 0xa8, 0xdc, 0x6a, 0xd6, 0xcd,
-	0x45, 0xb3, 0x2e, 0x9a, 0xf5, 0xdf, 0x6c, 0x56, 0xe3, 0x3e, 0x5c, 0xed, 0x87, 0xd3, 0x34, 0x5a,
-	0x5d, 0x9a, 0x59, 0x4f, 0x22, 0x3b, 0x23, 0x51, 0xb6, 0x95, 0x5e, 0x4c, 0x70, 0x30, 0x56, 0x5e,
-	0xac, 0x69, 0x5e, 0xcc, 0xd9, 0xbf, 0xe5, 0xc5, 0x3c, 0x60, 0xf3, 0xd3, 0x72, 0x6e, 0x9c, 0x1e,
-	0x2e, 0xbe, 0xfd, 0x8b, 0x71, 0x5a, 0x8c, 0xd3, 0x9f, 0x8e, 0x93, 0xf1, 0x18, 0xd6, 0x5e, 0x1f,
-	0x53, 0xe2, 0xcf, 0x1a, 0x67, 0x5d, 0x3e, 0xfb, 0x5f, 0x12, 0xd9, 0x39, 0x1e, 0xe5, 0x50, 0xf3,
-	0x12, 0x40, 0x63, 0xa0, 0xfe, 0x91, 0xeb, 0x33, 0x87, 0x04, 0xdb, 0x8c, 0x1e, 0x78, 0xae, 0x70,
-	0xe9, 0x95, 0x47, 0xfb, 0xe1, 0x54, 0x92, 0x72, 0x0c, 0x4b, 0xca, 0x25, 0x8d, 0x46, 0x3a, 0x90,
-	0x12, 0x7c, 0x32, 0x97, 0x14, 0x35, 0x49, 0x46, 0x23, 0x1d, 0xe8, 0x53, 0x2b, 0x24, 0xa5, 0x4c,
-	0xa2, 0xd1, 0x48, 0x07, 0x7a, 0xfb, 0x08, 0x49, 0x39, 0x93, 0x68, 0x34, 0xd2, 0x41, 0xf3, 0x0b,
-	0x80, 0xf5, 0x9d, 0x09, 0x99, 0x12, 0xca, 0x77, 0x69, 0xcf, 0x0b, 0xb8, 0x28, 0x55, 0xb7, 0x37,
-	0xd8, 0x0b, 0x87, 0x13, 0x6f, 0xf4, 0x92, 0x9c, 0xca, 0x3f, 0x2f, 0x2d, 0x95, 0xce, 0xa3, 0x1c,
-	0x12, 0x4f, 0xef, 0xf9, 0xe4, 0x83, 0xc7, 0xc2, 0x40, 0x88, 0x8a, 0x52, 0x24, 0x9f, 0xd6, 0x68,
-	0xa4, 0x03, 0xd1, 0x5c, 0x7d, 0x72, 0xc2, 0xc5, 0xf5, 0x92, 0xbc, 0x2e, 0x9b, 0x2b, 0xa5, 0xd0,
-	0x6c, 0xd3, 0xfc, 0x06, 0x60, 0x35, 0x6d, 0x34, 0x99, 0x5f, 0x0b, 0xae, 0x3c, 0xf7, 0xfc, 0x80,
-	0x67, 0xb9, 0xd5, 0x92, 0xc8, 0x9e, 0x73, 0x68, 0xbe, 0x13, 0x0f, 0xf4, 0xb0, 0xba, 0x58, 0xcc,
-	0x1e, 0x48, 0x29, 0x34, 0xdb, 0x88, 0x41, 0xe8, 0x11, 0xea, 0xf2, 0xb1, 0x4c, 0xa3, 0xae, 0x06,
-	0x41, 0x31, 0x28, 0x5d, 0xc5, 0x94, 0x89, 0xeb, 0xaa, 0x72, 0x22, 0x60, 0x39, 0xfb, 0xf2, 0xe4,
-	0x0e, 0x50, 0x1e, 0x76, 0xfb, 0xe7, 0x57, 0x56, 0xe1, 0xe2, 0xca, 0x2a, 0xdc, 0x5c, 0x59, 0xe0,
-	0x63, 0x6c, 0x81, 0xaf, 0xb1, 0x05, 0xbe, 0xc7, 0x16, 0x38, 0x8f, 0x2d, 0x70, 0x11, 0x5b, 0xe0,
-	0x32, 0xb6, 0xc0, 0xaf, 0xd8, 0x2a, 0xdc, 0xc4, 0x16, 0xf8, 0x7c, 0x6d, 0x15, 0xce, 0xaf, 0xad,
-	0xc2, 0xc5, 0xb5, 0x55, 0x78, 0x77, 0x27, 0x38, 0x0d, 0x38, 0x99, 0x0e, 0xa6, 0xd8, 0xe7, 0xdb,
-	0x8c, 0x72, 0x1f, 0x8f, 0x78, 0x30, 0xac, 0xc8, 0x9f, 0x06, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x9c, 0xc2, 0xf8, 0xd3, 0x61, 0x0c, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xc1, 0x4e, 0xdb, 0x48,
+	0x18, 0xce, 0x90, 0x90, 0xc0, 0x24, 0x01, 0xd6, 0xda, 0x83, 0xb5, 0x07, 0x3b, 0xb2, 0x84, 0x14,
+	0x69, 0x45, 0xb2, 0xec, 0xae, 0xb4, 0x5a, 0xf5, 0x44, 0x28, 0x95, 0x28, 0x69, 0x8a, 0x26, 0x2a,
+	0x95, 0x7a, 0xa9, 0x26, 0xf1, 0xe0, 0x58, 0x24, 0x33, 0xc8, 0x1e, 0x17, 0xb8, 0x55, 0x7d, 0x82,
+	0xbe, 0x41, 0xaf, 0x15, 0xaf, 0xd0, 0x17, 0xe8, 0xa1, 0x07, 0x8e, 0x9c, 0x5c, 0x30, 0x97, 0xca,
+	0x27, 0x1e, 0xa1, 0x9a, 0xb1, 0x1d, 0x8f, 0x39, 0x56, 0x3d, 0xf4, 0x90, 0xd3, 0xcc, 0xf7, 0xcd,
+	0x7c, 0xff, 0xfc, 0xfc, 0xdf, 0xff, 0x5b, 0x04, 0x36, 0x7d, 0x8e, 0x4f, 0x5c, 0xea, 0x74, 0x4e,
+	0x3d, 0xc6, 0x99, 0xb6, 0x2c, 0x97, 0x3f, 0xb6, 0x1c, 0x97, 0x4f, 0x82, 0x51, 0x67, 0xcc, 0x66,
+	0x5d, 0x87, 0x39, 0xac, 0x2b, 0xe9, 0x51, 0x70, 0x2c, 0x91, 0x04, 0x72, 0x97, 0xa8, 0xac, 0xcb,
+	0x65, 0xb8, 0x36, 0xe4, 0xf8, 0x84, 0xd8, 0x8f, 0x31, 0xc7, 0x47, 0xdb, 0xaf, 0xff, 0xd2, 0xfe,
+	0x83, 0x4d, 0x44, 0x1c, 0xd7, 0xe7, 0xc4, 0x1b, 0x30, 0x3a, 0x26, 0x3a, 0x68, 0x81, 0x76, 0xa5,
+	0xf7, 0x5b, 0x1c, 0x9a, 0xc5, 0x03, 0x54, 0x84, 0xda, 0x36, 0xac, 0x27, 0xa1, 0x12, 0xd9, 0x92,
+	0x94, 0xad, 0xc7, 0xa1, 0xa9, 0xd2, 0x48, 0x05, 0x9a, 0x05, 0xab, 0x09, 0xd4, 0xcb, 0x2d, 0xd0,
+	0x5e, 0xe9, 0xc1, 0x38, 0x34, 0x53, 0x06, 0xa5, 0xab, 0xc8, 0xe7, 0x05, 0x55, 0x03, 0x57, 0xf2,
+	0x7c, 0x0a, 0x07, 0xa8, 0x08, 0x55, 0xe1, 0xde, 0x29, 0x1b, 0x4f, 0xf4, 0xe5, 0x16, 0x68, 0x37,
+	0x8b, 0x42, 0x79, 0x80, 0x8a, 0x30, 0xa9, 0xc0, 0x19, 0xf6, 0xec, 0x1d, 0xdb, 0xf6, 0x88, 0xef,
+	0xeb, 0xd5, 0x16, 0x68, 0x37, 0xb2, 0x0a, 0x28, 0x07, 0xa8, 0x08, 0x35, 0x1f, 0x42, 0x19, 0xe7,
+	0x08, 0x4f, 0x03, 0xa2, 0xd7, 0xa4, 0x6a, 0x18, 0x87, 0xa6, 0xc2, 0x5e, 0x7e, 0x35, 0x77, 0x66,
+	0x98, 0x4f, 0xba, 0x23, 0xd7, 0xe9, 0xec, 0x53, 0xfe, 0x48, 0xf1, 0x6b, 0x6f, 0xea, 0x31, 0x6a,
+	0x0f, 0x08, 0x3f, 0x63, 0xde, 0x49, 0x97, 0x48, 0xb4, 0xe5, 0xb0, 0xae, 0x8d, 0x39, 0xee, 0xf4,
+	0x5c, 0x67, 0x9f, 0xf2, 0x5d, 0x2c, 0xea, 0x8d, 0x94, 0x80, 0xa2, 0xec, 0x4f, 0xb1, 0x3b, 0x25,
+	0x36, 0x62, 0x01, 0xb5, 0xf5, 0x95, 0xbc, 0xec, 0x0a, 0x8d, 0x54, 0x90, 0x4b, 0x92, 0x82, 0xae,
+	0x3e, 0x94, 0xa4, 0x4e, 0x29, 0x20, 0x29, 0xa6, 0x2a, 0x82, 0xaa, 0x0b, 0xaa, 0xac, 0x08, 0x85,
+	0xc5, 0x09, 0xd4, 0xeb, 0xb9, 0xc5, 0x69, 0x32, 0xe9, 0xaa, 0x6d, 0xc2, 0xda, 0x4b, 0xec, 0x72,
+	0x97, 0x3a, 0x7a, 0x43, 0x5e, 0xaa, 0xc7, 0xa1, 0x99, 0x51, 0x28, 0xdb, 0x58, 0x5f, 0xaa, 0x0f,
+	0x9a, 0x75, 0x7b, 0xd1, 0xac, 0x8b, 0x66, 0xfd, 0x35, 0x9b, 0x55, 0xfb, 0x13, 0xae, 0x0e, 0x82,
+	0x59, 0x1a, 0xad, 0x29, 0xcd, 0x6c, 0xc6, 0xa1, 0x99, 0x93, 0x28, 0xdf, 0x4a, 0x2f, 0xa6, 0xd8,
+	0x9f, 0x24, 0x5e, 0xac, 0x29, 0x5e, 0xcc, 0xd9, 0x9f, 0xe5, 0xc5, 0x3c, 0xa0, 0xf5, 0xae, 0x56,
+	0x18, 0xa7, 0xbf, 0x17, 0xdf, 0xfe, 0xc5, 0x38, 0x2d, 0xc6, 0xe9, 0x47, 0xc7, 0x49, 0xfb, 0x17,
+	0x36, 0x9e, 0x9f, 0x51, 0xe2, 0x65, 0x8d, 0xb3, 0x2e, 0x9f, 0xdd, 0x88, 0x43, 0xb3, 0xc0, 0xa3,
+	0x02, 0xb2, 0x6e, 0x00, 0xd4, 0x86, 0xc9, 0x3f, 0x72, 0x03, 0x66, 0x13, 0x7f, 0x97, 0xd1, 0x63,
+	0xd7, 0x11, 0x2e, 0x3d, 0x73, 0xe9, 0x20, 0x98, 0x49, 0x52, 0x8e, 0x61, 0x39, 0x71, 0x49, 0xa1,
+	0x91, 0x0a, 0xa4, 0x04, 0x9f, 0xcf, 0x25, 0x4b, 0x8a, 0x24, 0xa7, 0x91, 0x0a, 0xd4, 0xa9, 0x15,
+	0x92, 0x72, 0x2e, 0x51, 0x68, 0xa4, 0x02, 0xb5, 0x7d, 0x84, 0xa4, 0x92, 0x4b, 0x14, 0x1a, 0xa9,
+	0xc0, 0xfa, 0x00, 0x60, 0x73, 0x6f, 0x4a, 0x66, 0x84, 0xf2, 0x7d, 0xda, 0x77, 0x7d, 0x2e, 0x4a,
+	0xd5, 0xeb, 0x0f, 0x0f, 0x83, 0xd1, 0xd4, 0x1d, 0x1f, 0x90, 0x0b, 0x1d, 0xe4, 0xa5, 0x52, 0x79,
+	0x54, 0x40, 0xe2, 0xe9, 0x43, 0x8f, 0xbc, 0x71, 0x59, 0xe0, 0x0b, 0xd1, 0x92, 0x14, 0xc9, 0xa7,
+	0x15, 0x1a, 0xa9, 0x40, 0x34, 0xd7, 0x80, 0x9c, 0x73, 0x71, 0xbd, 0x2c, 0xaf, 0xcb, 0xe6, 0x4a,
+	0x29, 0x94, 0x6d, 0xac, 0x4f, 0x00, 0xd6, 0xd3, 0x46, 0x93, 0xf9, 0xb5, 0xe1, 0xca, 0x13, 0xd7,
+	0xf3, 0x79, 0x9e, 0x5b, 0x23, 0x0e, 0xcd, 0x39, 0x87, 0xe6, 0x3b, 0xf1, 0x40, 0x1f, 0xfb, 0x3c,
+	0xcf, 0x47, 0x3e, 0x90, 0x52, 0x28, 0xdb, 0x88, 0x41, 0xe8, 0x13, 0xea, 0xf0, 0x89, 0x4c, 0xa3,
+	0x99, 0x0c, 0x42, 0xc2, 0xa0, 0x74, 0x15, 0x53, 0x26, 0xae, 0x27, 0x95, 0x13, 0x01, 0x2b, 0xf9,
+	0x97, 0xa7, 0x70, 0x80, 0x8a, 0xd0, 0xfa, 0x1f, 0x6e, 0xc8, 0x36, 0x4c, 0xeb, 0x2d, 0xff, 0x82,
+	0x4d, 0x58, 0xeb, 0xf5, 0x87, 0x07, 0xe4, 0x42, 0xf4, 0x4e, 0x39, 0xcb, 0x2b, 0xa5, 0x50, 0xb6,
+	0xe9, 0x0d, 0xae, 0x6e, 0x8d, 0xd2, 0xf5, 0xad, 0x51, 0xba, 0xbf, 0x35, 0xc0, 0xdb, 0xc8, 0x00,
+	0x1f, 0x23, 0x03, 0x7c, 0x8e, 0x0c, 0x70, 0x15, 0x19, 0xe0, 0x3a, 0x32, 0xc0, 0x4d, 0x64, 0x80,
+	0x6f, 0x91, 0x51, 0xba, 0x8f, 0x0c, 0xf0, 0xfe, 0xce, 0x28, 0x5d, 0xdd, 0x19, 0xa5, 0xeb, 0x3b,
+	0xa3, 0xf4, 0xea, 0x77, 0xff, 0xc2, 0xe7, 0x64, 0x36, 0x9c, 0x61, 0x8f, 0xef, 0x32, 0xca, 0x3d,
+	0x3c, 0xe6, 0xfe, 0xa8, 0x2a, 0x7f, 0x55, 0xfc, 0xf3, 0x7d, 0x00, 0xcb, 0x3c, 0x76, 0xd9, 0x9c,
+	0x0c, 0x00, 0x00,
 }
 
 func (this *StakedDataV1_0) Equal(that interface{}) bool {
@@ -990,6 +1032,35 @@ func (this *WaitingList) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SlashedNodesList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashedNodesList)
+	if !ok {
+		that2, ok := that.(SlashedNodesList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BLSKeys) != len(that1.BLSKeys) {
+		return false
+	}
+	for i := range this.BLSKeys {
+		if !bytes.Equal(this.BLSKeys[i], that1.BLSKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StakedDataV1_0) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1096,6 +1167,16 @@ func (this *WaitingList) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *SlashedNodesList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.SlashedNodesList{")
+	s = append(s, "BLSKeys: "+fmt.Sprintf("%#v", this.BLSKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringStaking(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1597,6 +1678,38 @@ func (m *WaitingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashedNodesList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashedNodesList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedNodesList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BLSKeys) > 0 {
+		for iNdEx := len(m.BLSKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BLSKeys[iNdEx])
+			copy(dAtA[i:], m.BLSKeys[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.BLSKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -1838,6 +1951,21 @@ func (m *WaitingList) Size() (n int) {
 	return n
 }
 
+func (m *SlashedNodesList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BLSKeys) > 0 {
+		for _, b := range m.BLSKeys {
+			l = len(b)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1950,6 +2078,16 @@ func (this *WaitingList) String() string {
 	}, "")
 	return s
 }
+func (this *SlashedNodesList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlashedNodesList{`,
+		`BLSKeys:` + fmt.Sprintf("%v", this.BLSKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringStaking(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3518,6 +3656,91 @@ func (m *WaitingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashedNodesList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashedNodesList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashedNodesList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BLSKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BLSKeys = append(m.BLSKeys, make([]byte, postIndex-iNdEx))
+			copy(m.BLSKeys[len(m.BLSKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"math"
 	"math/big"
 	"strconv"
@@ -13,7 +14,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -46,6 +49,7 @@ func createMockStakingScArguments() ArgsNewStakingSmartContract {
 			MinUnstakeTokensValue:                "1",
 		},
 		EpochNotifier: &mock.EpochNotifierStub{},
+		ProofVerifier: &mock.DoubleSigningProofVerifierStub{},
 	}
 }
 
@@ -97,6 +101,17 @@ func TestNewStakingSmartContract_NilJailAccessAddrEIShouldErr(t *testing.T) {
 	assert.Equal(t, vm.ErrInvalidJailAccessAddress, err)
 }
 
+func TestNewStakingSmartContract_NilProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.ProofVerifier = nil
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrNilDoubleSigningProofVerifier, err)
+}
+
 func TestNewStakingSmartContract(t *testing.T) {
 	t.Parallel()
 
@@ -779,6 +794,246 @@ func TestStakingSC_ExecuteSlashNotStake(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, retCode)
 }
 
+func TestStakingSC_ExecuteSlashBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofVerified := false
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.DoubleSigningSlashingEnableEpoch = 5
+	args.ProofVerifier = &mock.DoubleSigningProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) (*slash.DoubleSigningProof, error) {
+			proofVerified = true
+			return &slash.DoubleSigningProof{}, nil
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.False(t, proofVerified)
+}
+
+func TestStakingSC_ExecuteSlashInvalidProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	eei := &mock.SystemEIStub{}
+	returnMessage := ""
+	eei.AddReturnMessageCalled = func(msg string) {
+		returnMessage = msg
+	}
+	args := createMockStakingScArguments()
+	args.Eei = eei
+	args.ProofVerifier = &mock.DoubleSigningProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) (*slash.DoubleSigningProof, error) {
+			return nil, expectedErr
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(returnMessage, expectedErr.Error()))
+}
+
+func TestStakingSC_ExecuteSlashShouldJailAndSlashTheOwnerOnce(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	blsKey := []byte("blsKey")
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 37
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.Eei = eei
+	argsStaking.StakingSCConfig.GenesisNodePrice = "1000"
+	argsStaking.ProofVerifier = &mock.DoubleSigningProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) (*slash.DoubleSigningProof, error) {
+			return &slash.DoubleSigningProof{PubKey: blsKey, ShardID: 1, Round: 10}, nil
+		},
+	}
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+
+	argsValidator := createMockArgumentsForValidatorSC()
+	argsValidator.Eei = eei
+	argsValidator.StakingSCConfig.DoubleSigningSlashPercentage = 0.1
+	validatorSc, _ := NewValidatorSmartContract(argsValidator)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		if bytes.Equal(key, argsValidator.StakingSCAddress) {
+			return stakingSc, nil
+		}
+		return validatorSc, nil
+	}})
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = ownerAddress
+	arguments.RecipientAddr = argsValidator.ValidatorSCAddress
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), blsKey, []byte("signed")}
+	arguments.CallValue = big.NewInt(1000)
+	eei.SetSCAddress(argsValidator.ValidatorSCAddress)
+	retCode := validatorSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("anyone")
+	arguments.RecipientAddr = argsValidator.StakingSCAddress
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	eei.SetSCAddress(argsValidator.StakingSCAddress)
+	retCode = stakingSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	stakedData, _ := stakingSc.getOrCreateRegisteredData(blsKey)
+	assert.True(t, stakedData.Jailed)
+	assert.Equal(t, uint64(37), stakedData.JailedNonce)
+	assert.Equal(t, uint32(1), stakedData.NumJailed)
+	assert.Equal(t, big.NewInt(100), stakedData.SlashValue)
+
+	eei.SetSCAddress(argsValidator.ValidatorSCAddress)
+	registrationData, _ := validatorSc.getOrCreateRegistrationData(ownerAddress)
+	assert.Equal(t, big.NewInt(900), registrationData.TotalStakeValue)
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.GetStorage([]byte(slashedFunds)))
+
+	eei.SetSCAddress(argsValidator.StakingSCAddress)
+	retCode = stakingSc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "offense was already slashed", eei.returnMessage)
+
+	slashedNodes, _ := stakingSc.getSlashedNodesList()
+	assert.Equal(t, [][]byte{blsKey}, slashedNodes.BLSKeys)
+}
+
+func TestStakingSC_SwitchSlashedWithWaitingShouldUnStakeTheSlashedNode(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	slashedKey := []byte("blsKey1")
+	waitingKey := []byte("blsKey2")
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.Eei = eei
+	argsStaking.StakingSCConfig.GenesisNodePrice = "1000"
+	argsStaking.StakingSCConfig.MaxNumberOfNodesForStake = 1
+	argsStaking.ProofVerifier = &mock.DoubleSigningProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) (*slash.DoubleSigningProof, error) {
+			return &slash.DoubleSigningProof{PubKey: slashedKey, ShardID: 1, Round: 10}, nil
+		},
+	}
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+
+	argsValidator := createMockArgumentsForValidatorSC()
+	argsValidator.Eei = eei
+	argsValidator.StakingSCConfig.DoubleSigningSlashPercentage = 0.1
+	validatorSc, _ := NewValidatorSmartContract(argsValidator)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		if bytes.Equal(key, argsValidator.StakingSCAddress) {
+			return stakingSc, nil
+		}
+		return validatorSc, nil
+	}})
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = ownerAddress
+	arguments.RecipientAddr = argsValidator.ValidatorSCAddress
+	arguments.Arguments = [][]byte{big.NewInt(2).Bytes(), slashedKey, []byte("signed"), waitingKey, []byte("signed")}
+	arguments.CallValue = big.NewInt(2000)
+	eei.SetSCAddress(argsValidator.ValidatorSCAddress)
+	retCode := validatorSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	eei.SetSCAddress(argsValidator.StakingSCAddress)
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("anyone")
+	arguments.RecipientAddr = argsValidator.StakingSCAddress
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	retCode = stakingSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "getSlashedNodesToJail"
+	arguments.CallerAddr = []byte("anyone")
+	retCode = stakingSc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	currentOutputIndex := len(eei.output)
+	arguments.CallerAddr = argsStaking.EndOfEpochAccessAddr
+	retCode = stakingSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{slashedKey}, eei.output[currentOutputIndex:])
+
+	arguments.Function = "switchSlashedWithWaiting"
+	arguments.Arguments = [][]byte{slashedKey}
+	retCode = stakingSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	slashedData, _ := stakingSc.getOrCreateRegisteredData(slashedKey)
+	assert.True(t, slashedData.Jailed)
+	assert.False(t, slashedData.Staked)
+	waitingData, _ := stakingSc.getOrCreateRegisteredData(waitingKey)
+	assert.True(t, waitingData.Staked)
+	assert.False(t, waitingData.Waiting)
+	assert.Equal(t, int64(1), stakingSc.getConfig().StakedNodes)
+
+	slashedNodes, _ := stakingSc.getSlashedNodesList()
+	assert.Equal(t, 0, len(slashedNodes.BLSKeys))
+
+	retCode = stakingSc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.HasSuffix(eei.returnMessage, "key is not in the slashed nodes to jail"))
+}
+
+func TestStakingSC_SwitchSlashedWithWaitingNobodyInWaitingShouldUnStake(t *testing.T) {
+	t.Parallel()
+
+	slashedKey := []byte("blsKey")
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	args := createMockStakingScArguments()
+	args.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(args)
+	stakingSc.setConfig(&StakingNodesConfig{MinNumNodes: 1, StakedNodes: 2, MaxNumNodes: 2})
+
+	registrationData := &StakedDataV2_0{
+		Staked:        true,
+		Jailed:        true,
+		RewardAddress: []byte("rewardAddress"),
+		StakeValue:    big.NewInt(100),
+		SlashValue:    big.NewInt(10),
+	}
+	_ = stakingSc.saveStakingData(slashedKey, registrationData)
+	_ = stakingSc.addToSlashedNodesToJail(slashedKey)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "switchSlashedWithWaiting"
+	arguments.CallerAddr = args.EndOfEpochAccessAddr
+	arguments.Arguments = [][]byte{slashedKey}
+	retCode := stakingSc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, "did not switch as nobody in waiting, but unStaked", eei.returnMessage)
+
+	slashedData, _ := stakingSc.getOrCreateRegisteredData(slashedKey)
+	assert.False(t, slashedData.Staked)
+	assert.Equal(t, uint64(math.MaxUint64), slashedData.StakedNonce)
+	assert.Equal(t, int64(1), stakingSc.getConfig().StakedNodes)
+}
+
 func TestStakingSC_ExecuteUnStakeAndUnBoundStake(t *testing.T) {
 	t.Parallel()
 
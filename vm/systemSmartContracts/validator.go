@@ -20,6 +20,7 @@ import (
 
 const unJailedFunds = "unJailFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
+const slashedFunds = "slashedFunds"
 
 var zero = big.NewInt(0)
 
@@ -51,6 +52,9 @@ type validatorSC struct {
 	minDeposit            *big.Int
 	mutExecution          sync.RWMutex
 	endOfEpochAddress     []byte
+	slashPercentage       float64
+	slashingEnableEpoch   uint32
+	flagSlashing          atomic.Flag
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
@@ -126,6 +130,10 @@ func NewValidatorSmartContract(
 	if !okConvert || minDeposit.Cmp(zero) < 0 {
 		return nil, vm.ErrInvalidMinCreationDeposit
 	}
+	slashPercentage := args.StakingSCConfig.DoubleSigningSlashPercentage
+	if slashPercentage < 0 || slashPercentage > 1 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidDoubleSigningSlashPercentage, slashPercentage)
+	}
 
 	reg := &validatorSC{
 		eei:                   args.Eei,
@@ -143,6 +151,8 @@ func NewValidatorSmartContract(
 		enableDoubleKeyEpoch:  args.StakingSCConfig.DoubleKeyProtectionEnableEpoch,
 		endOfEpochAddress:     args.EndOfEpochAddress,
 		minDeposit:            minDeposit,
+		slashPercentage:       slashPercentage,
+		slashingEnableEpoch:   args.StakingSCConfig.DoubleSigningSlashingEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -204,6 +214,8 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "slash":
+		return v.slash(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -258,6 +270,17 @@ func (v *validatorSC) addToUnJailFunds(value *big.Int) {
 	v.eei.SetStorage([]byte(unJailedFunds), currentValue.Bytes())
 }
 
+func (v *validatorSC) addToSlashedFunds(value *big.Int) {
+	currentValue := big.NewInt(0)
+	storageData := v.eei.GetStorage([]byte(slashedFunds))
+	if len(storageData) > 0 {
+		currentValue.SetBytes(storageData)
+	}
+
+	currentValue.Add(currentValue, value)
+	v.eei.SetStorage([]byte(slashedFunds), currentValue.Bytes())
+}
+
 func (v *validatorSC) unJailV1(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) == 0 {
 		v.eei.AddReturnMessage("invalid number of arguments: expected min 1, got 0")
@@ -1749,9 +1772,52 @@ func (v *validatorSC) getTotalStakedTopUpStakedBlsKeys(args *vmcommon.ContractCa
 	return vmcommon.Ok
 }
 
-func (v *validatorSC) slash(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	// TODO: implement this. It is needed as last component of slashing. Slashing should happen to the funds of the
-	// validator which is running the nodes
+// slash is called by the staking smart contract for a key proven to have double signed. The owner of the key loses
+// the configured percentage of the stake backing one node. The slashed value remains locked in the contract,
+// accounted under the slashed funds key
+func (v *validatorSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagSlashing.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.stakingSCAddress) {
+		v.eei.AddReturnMessage("slash function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	ownerAddress := args.Arguments[0]
+	blsKey := args.Arguments[1]
+	registrationData, err := v.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot get registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	err = verifyBLSPublicKeys(registrationData, [][]byte{blsKey})
+	if err != nil {
+		v.eei.AddReturnMessage("cannot slash: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	stakePerNode := big.NewInt(0).Div(registrationData.TotalStakeValue, big.NewInt(int64(len(registrationData.BlsPubKeys))))
+	slashValue, _ := big.NewFloat(0).Mul(
+		big.NewFloat(0).SetInt(stakePerNode),
+		big.NewFloat(v.slashPercentage),
+	).Int(nil)
+
+	registrationData.TotalStakeValue.Sub(registrationData.TotalStakeValue, slashValue)
+	err = v.saveRegistrationData(ownerAddress, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	v.addToSlashedFunds(slashValue)
+
+	v.eei.Finish(slashValue.Bytes())
+
 	return vmcommon.Ok
 }
 
@@ -1766,6 +1832,9 @@ func (v *validatorSC) EpochConfirmed(epoch uint32) {
 	v.flagDoubleKey.Toggle(epoch >= v.enableDoubleKeyEpoch)
 	log.Debug("stakingAuctionSC: doubleKeyProtection", "enabled", v.flagDoubleKey.IsSet())
 
+	v.flagSlashing.Toggle(epoch >= v.slashingEnableEpoch)
+	log.Debug("validatorSC: double signing slashing", "enabled", v.flagSlashing.IsSet())
+
 }
 
 // CanUseContract returns true if contract can be used
@@ -77,6 +77,22 @@ func createABid(totalStakeValue uint64, numBlsKeys uint32, maxStakePerNode uint6
 	return data
 }
 
+func TestNewStakingValidatorSmartContract_InvalidSlashPercentage(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsForValidatorSC()
+
+	arguments.StakingSCConfig.DoubleSigningSlashPercentage = -0.1
+	asc, err := NewValidatorSmartContract(arguments)
+	require.Nil(t, asc)
+	require.True(t, errors.Is(err, vm.ErrInvalidDoubleSigningSlashPercentage))
+
+	arguments.StakingSCConfig.DoubleSigningSlashPercentage = 1.1
+	asc, err = NewValidatorSmartContract(arguments)
+	require.Nil(t, asc)
+	require.True(t, errors.Is(err, vm.ErrInvalidDoubleSigningSlashPercentage))
+}
+
 func TestNewStakingValidatorSmartContract_InvalidUnJailValue(t *testing.T) {
 	t.Parallel()
 
@@ -2526,6 +2542,44 @@ func TestValidatorStakingSC_ExecuteSlashOwnerAddrNotOkShouldErr(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, retCode)
 }
 
+func TestValidatorStakingSC_ExecuteSlashShouldSlashPercentageOfNodeStake(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	args := createMockArgumentsForValidatorSC()
+	args.Eei = eei
+	args.StakingSCConfig.DoubleSigningSlashPercentage = 0.25
+	sc, _ := NewValidatorSmartContract(args)
+
+	validatorData := createABid(2000, 2, 1000)
+	marshaledData, _ := args.Marshalizer.Marshal(&validatorData)
+	eei.SetStorage(ownerAddress, marshaledData)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = args.StakingSCAddress
+	arguments.Arguments = [][]byte{ownerAddress, []byte("unknown key")}
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.CallerAddr = []byte("anyone")
+	arguments.Arguments = [][]byte{ownerAddress, validatorData.BlsPubKeys[1]}
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.CallerAddr = args.StakingSCAddress
+	retCode = sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, [][]byte{big.NewInt(250).Bytes()}, vmOutput.ReturnData)
+	registrationData, _ := sc.getOrCreateRegistrationData(ownerAddress)
+	assert.Equal(t, big.NewInt(1750), registrationData.TotalStakeValue)
+	assert.Equal(t, big.NewInt(250).Bytes(), eei.GetStorage([]byte(slashedFunds)))
+}
+
 func TestValidatorStakingSC_ExecuteUnStakeAndUnBondStake(t *testing.T) {
 	t.Parallel()
 